@@ -0,0 +1,46 @@
+package api
+
+import (
+	"fmt"
+	"net/url"
+
+	"github.com/hyperhq/hyperd/engine"
+)
+
+func (cli *Client) CheckpointPod(podId, dir string) error {
+	v := url.Values{}
+	v.Set("podId", podId)
+	v.Set("dir", dir)
+
+	_, _, err := readBody(cli.call("POST", "/pod/checkpoint?"+v.Encode(), nil, nil))
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func (cli *Client) RestorePod(dir string, coldBoot bool) (string, error) {
+	v := url.Values{}
+	v.Set("dir", dir)
+	if coldBoot {
+		v.Set("coldBoot", "yes")
+	}
+
+	body, _, err := readBody(cli.call("POST", "/pod/restore?"+v.Encode(), nil, nil))
+	if err != nil {
+		return "", err
+	}
+
+	out := engine.NewOutput()
+	remoteInfo, err := out.AddEnv()
+	if err != nil {
+		return "", err
+	}
+
+	if _, err := out.Write(body); err != nil {
+		return "", fmt.Errorf("Error reading remote info: %s", err)
+	}
+	out.Close()
+
+	return remoteInfo.Get("ID"), nil
+}
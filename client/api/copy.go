@@ -0,0 +1,31 @@
+package api
+
+import (
+	"io"
+	"net/url"
+)
+
+func (cli *Client) CopyFromContainer(container, path string) (io.ReadCloser, error) {
+	v := url.Values{}
+	v.Set("container", container)
+	v.Set("path", path)
+
+	out, _, err := cli.stream("GET", "/container/archive?"+v.Encode(), nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (cli *Client) CopyToContainer(container, path string, content io.Reader) error {
+	v := url.Values{}
+	v.Set("container", container)
+	v.Set("path", path)
+
+	headers := map[string][]string{"Content-Type": {"application/x-tar"}}
+	out, _, err := cli.stream("PUT", "/container/archive?"+v.Encode(), content, headers)
+	if err != nil {
+		return err
+	}
+	return out.Close()
+}
@@ -11,13 +11,40 @@ import (
 	"github.com/hyperhq/hyperd/lib/promise"
 )
 
-func (cli *Client) CreateExec(container string, command []byte, tty bool) (string, error) {
+// ExecOptions are the optional settings of an exec, the ones of the
+// container are used if not set.
+type ExecOptions struct {
+	Env        []string
+	User       string
+	Group      string
+	Workdir    string
+	Privileged bool
+}
+
+func (cli *Client) CreateExec(container string, command []byte, tty bool, opts *ExecOptions) (string, error) {
 	var execId string
 
 	v := url.Values{}
 	v.Set("container", container)
 	v.Set("command", string(command))
 	v.Set("tty", strconv.FormatBool(tty))
+	if opts != nil {
+		for _, e := range opts.Env {
+			v.Add("env", e)
+		}
+		if opts.User != "" {
+			v.Set("user", opts.User)
+		}
+		if opts.Group != "" {
+			v.Set("group", opts.Group)
+		}
+		if opts.Workdir != "" {
+			v.Set("workdir", opts.Workdir)
+		}
+		if opts.Privileged {
+			v.Set("privileged", "true")
+		}
+	}
 
 	body, statusCode, err := readBody(cli.call("POST", "/exec/create?"+v.Encode(), nil, nil))
 	if err != nil {
@@ -55,7 +82,7 @@ func (cli *Client) StartExec(containerId, execId string, tty bool, stdin io.Read
 	}()
 
 	errCh = promise.Go(func() error {
-		return cli.hijack("POST", "/exec/start?"+v.Encode(), tty, stdin, stdout, stdout, hijacked, nil, "")
+		return cli.hijack("POST", "/exec/start?"+v.Encode(), tty, stdin, stdout, stderr, hijacked, nil, "")
 	})
 
 	// Acknowledge the hijack before starting
@@ -60,3 +60,25 @@ func (cli *Client) GetContainerInfo(container string) (*types.ContainerInfo, err
 
 	return &jsonData, nil
 }
+
+type OrphanList struct {
+	Orphans []*types.OrphanResource `json:"orphans"`
+}
+
+func (cli *Client) SystemPrune(dryRun bool) ([]*types.OrphanResource, error) {
+	v := url.Values{}
+	if dryRun {
+		v.Set("dryRun", "1")
+	}
+
+	body, _, err := readBody(cli.call("POST", "/system/prune?"+v.Encode(), nil, nil))
+	if err != nil {
+		return nil, err
+	}
+
+	var orphans OrphanList
+	if err = json.Unmarshal(body, &orphans); err != nil {
+		return nil, err
+	}
+	return orphans.Orphans, nil
+}
@@ -13,13 +13,13 @@ type APIInterface interface {
 	Login(auth dockertypes.AuthConfig, response *dockertypes.AuthResponse) (remove bool, err error)
 
 	Attach(container string, tty bool, stdin io.ReadCloser, stdout, stderr io.Writer) error
-	CreateExec(containerId string, command []byte, tty bool) (string, error)
+	CreateExec(containerId string, command []byte, tty bool, opts *ExecOptions) (string, error)
 	StartExec(containerId, execId string, tty bool, stdin io.ReadCloser, stdout, stderr io.Writer) error
 	ExecVM(podID string, command []byte, stdin io.ReadCloser, stdout, stderr io.Writer) error
 
 	WinResize(id, tag string, height, width int) error
 
-	List(item, pod, vm string) (*engine.Env, error)
+	List(item, pod, vm string, filter *types.ListFilter) (*engine.Env, error)
 	CreateContainer(podID string, spec interface{}) (string, int, error)
 	StartContainer(container string) error
 	GetContainerInfo(container string) (*types.ContainerInfo, error)
@@ -29,6 +29,8 @@ type APIInterface interface {
 	KillContainer(container string, sig int) error
 	StopContainer(container string) error
 	RemoveContainer(container string) error
+	CopyFromContainer(container, path string) (io.ReadCloser, error)
+	CopyToContainer(container, path string, content io.Reader) error
 
 	GetPodInfo(podName string) (*types.PodInfo, error)
 	CreatePod(spec interface{}) (string, int, error)
@@ -37,13 +39,39 @@ type APIInterface interface {
 	RmPod(id string) error
 	PausePod(podId string) error
 	UnpausePod(podId string) error
+	CheckpointPod(podId, dir string) error
+	RestorePod(dir string, coldBoot bool) (string, error)
 	KillPod(pod string, sig int) error
+	PodStatsStream(podIDs []string, interval int) (io.ReadCloser, error)
 
 	// PortMapping APIs
 	ListPortMappings(podId string) ([]*types.PortMapping, error)
 	AddPortMappings(podId string, pms []*types.PortMapping) error
 	DeletePortMappings(podId string, pms []*types.PortMapping) error
 
+	// Network interface APIs
+	ListInterfaces(podId string) ([]*types.UserInterface, error)
+	AddInterface(podId string, inf *types.UserInterface) (*types.UserInterface, error)
+	RemoveInterface(podId, ifname string) error
+
+	// Named volume APIs
+	CreateVolume(req *types.VolumeCreateRequest) (*types.VolumeInfo, error)
+	ListVolumes() ([]*types.VolumeInfo, error)
+	InspectVolume(name string) (*types.VolumeInfo, error)
+	RemoveVolume(name string) error
+	ResizeVolume(podId, volume string, size int64) error
+	SnapshotVolume(req *types.VolumeSnapshotRequest) (*types.VolumeSnapshotInfo, error)
+	ListVolumeSnapshots(volume string) ([]*types.VolumeSnapshotInfo, error)
+	RemoveVolumeSnapshot(volume, name string) error
+	RestoreVolume(volume, snapshot string) error
+	CloneVolume(req *types.VolumeCloneRequest) (*types.VolumeInfo, error)
+
+	// User-defined network APIs
+	CreateNetwork(req *types.NetworkCreateRequest) (*types.NetworkInfo, error)
+	ListNetworks() ([]*types.NetworkInfo, error)
+	InspectNetwork(name string) (*types.NetworkInfo, error)
+	RemoveNetwork(name string) error
+
 	Build(name string, hasBody bool, body io.Reader) (io.ReadCloser, string, error)
 	Commit(container, repo, author, message string, changes []string, pause bool) (string, error)
 	Load(body io.Reader, name string, refs map[string]string) (io.ReadCloser, string, error)
@@ -57,4 +85,5 @@ type APIInterface interface {
 	RmVm(vm string) (err error)
 
 	Info() (*engine.Env, error)
+	SystemPrune(dryRun bool) ([]*types.OrphanResource, error)
 }
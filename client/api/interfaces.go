@@ -0,0 +1,68 @@
+package api
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/hyperhq/hyperd/types"
+)
+
+type InterfaceList struct {
+	Interfaces []*types.UserInterface `json:"interfaces"`
+}
+
+func (c *Client) ListInterfaces(podId string) ([]*types.UserInterface, error) {
+	path := fmt.Sprintf("/pod/%s/interfaces", podId)
+
+	body, code, err := readBody(c.call("GET", path, nil, nil))
+	if code == http.StatusNotFound {
+		return nil, fmt.Errorf("pod %s not found", podId)
+	} else if err != nil {
+		return nil, err
+	}
+
+	var infs InterfaceList
+	err = json.Unmarshal(body, &infs)
+	if err != nil {
+		return nil, err
+	}
+
+	return infs.Interfaces, nil
+}
+
+func (c *Client) AddInterface(podId string, inf *types.UserInterface) (*types.UserInterface, error) {
+	path := fmt.Sprintf("/pod/%s/interfaces", podId)
+
+	body, code, err := readBody(c.call("POST", path, inf, nil))
+	if code == http.StatusNotFound {
+		return nil, fmt.Errorf("pod %s not found", podId)
+	} else if err != nil {
+		return nil, err
+	}
+
+	var result struct {
+		Interface *types.UserInterface `json:"interface"`
+	}
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return nil, err
+	}
+
+	return result.Interface, nil
+}
+
+func (c *Client) RemoveInterface(podId, ifname string) error {
+	path := fmt.Sprintf("/pod/%s/interfaces/%s", podId, ifname)
+	r, code, err := readBody(c.call("DELETE", path, nil, nil))
+
+	if code == http.StatusNoContent || code == http.StatusOK {
+		return nil
+	} else if code == http.StatusNotFound {
+		return fmt.Errorf("pod %s not found", podId)
+	} else if err != nil {
+		return err
+	} else {
+		return fmt.Errorf("unexpect response code %d: %s", code, string(r))
+	}
+}
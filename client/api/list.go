@@ -6,6 +6,7 @@ import (
 	"strings"
 
 	"github.com/hyperhq/hyperd/engine"
+	"github.com/hyperhq/hyperd/types"
 )
 
 func (cli *Client) GetContainerByPod(podId string) (string, error) {
@@ -44,7 +45,7 @@ func (cli *Client) GetContainerByPod(podId string) (string, error) {
 	return "", fmt.Errorf("Container not found")
 }
 
-func (cli *Client) List(item, pod, vm string) (*engine.Env, error) {
+func (cli *Client) List(item, pod, vm string, filter *types.ListFilter) (*engine.Env, error) {
 	v := url.Values{}
 	v.Set("item", item)
 	if pod != "" {
@@ -53,6 +54,17 @@ func (cli *Client) List(item, pod, vm string) (*engine.Env, error) {
 	if vm != "" {
 		v.Set("vm", vm)
 	}
+	if filter != nil {
+		if filter.LabelSelector != "" {
+			v.Set("label", filter.LabelSelector)
+		}
+		for _, s := range filter.Status {
+			v.Add("status", s)
+		}
+		for _, i := range filter.Image {
+			v.Add("image", i)
+		}
+	}
 	body, _, err := readBody(cli.call("GET", "/list?"+v.Encode(), nil, nil))
 	if err != nil {
 		return nil, err
@@ -0,0 +1,62 @@
+package api
+
+import (
+	"encoding/json"
+	"net/url"
+
+	"github.com/hyperhq/hyperd/types"
+)
+
+type NetworkList struct {
+	Networks []*types.NetworkInfo `json:"networks"`
+}
+
+func (cli *Client) CreateNetwork(req *types.NetworkCreateRequest) (*types.NetworkInfo, error) {
+	body, _, err := readBody(cli.call("POST", "/network/create", req, nil))
+	if err != nil {
+		return nil, err
+	}
+
+	var info types.NetworkInfo
+	if err = json.Unmarshal(body, &info); err != nil {
+		return nil, err
+	}
+	return &info, nil
+}
+
+func (cli *Client) ListNetworks() ([]*types.NetworkInfo, error) {
+	body, _, err := readBody(cli.call("GET", "/network/list", nil, nil))
+	if err != nil {
+		return nil, err
+	}
+
+	var nets NetworkList
+	if err = json.Unmarshal(body, &nets); err != nil {
+		return nil, err
+	}
+	return nets.Networks, nil
+}
+
+func (cli *Client) InspectNetwork(name string) (*types.NetworkInfo, error) {
+	v := url.Values{}
+	v.Set("name", name)
+
+	body, _, err := readBody(cli.call("GET", "/network/info?"+v.Encode(), nil, nil))
+	if err != nil {
+		return nil, err
+	}
+
+	var info types.NetworkInfo
+	if err = json.Unmarshal(body, &info); err != nil {
+		return nil, err
+	}
+	return &info, nil
+}
+
+func (cli *Client) RemoveNetwork(name string) error {
+	v := url.Values{}
+	v.Set("name", name)
+
+	_, _, err := readBody(cli.call("DELETE", "/network?"+v.Encode(), nil, nil))
+	return err
+}
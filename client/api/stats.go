@@ -0,0 +1,24 @@
+package api
+
+import (
+	"fmt"
+	"io"
+	"net/url"
+)
+
+// PodStatsStream returns a stream of the json encoded stats samples of the
+// pods, which are taken every interval seconds.
+func (cli *Client) PodStatsStream(podIDs []string, interval int) (io.ReadCloser, error) {
+	v := url.Values{}
+	v.Set("stream", "yes")
+	v["podId"] = podIDs
+	if interval > 0 {
+		v.Set("interval", fmt.Sprintf("%d", interval))
+	}
+
+	out, _, err := cli.stream("GET", "/pod/stats?"+v.Encode(), nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
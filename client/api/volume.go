@@ -0,0 +1,136 @@
+package api
+
+import (
+	"encoding/json"
+	"net/url"
+
+	"github.com/hyperhq/hyperd/types"
+)
+
+type VolumeList struct {
+	Volumes []*types.VolumeInfo `json:"volumes"`
+}
+
+func (cli *Client) CreateVolume(req *types.VolumeCreateRequest) (*types.VolumeInfo, error) {
+	body, _, err := readBody(cli.call("POST", "/volume/create", req, nil))
+	if err != nil {
+		return nil, err
+	}
+
+	var info types.VolumeInfo
+	if err = json.Unmarshal(body, &info); err != nil {
+		return nil, err
+	}
+	return &info, nil
+}
+
+func (cli *Client) ListVolumes() ([]*types.VolumeInfo, error) {
+	body, _, err := readBody(cli.call("GET", "/volume/list", nil, nil))
+	if err != nil {
+		return nil, err
+	}
+
+	var vols VolumeList
+	if err = json.Unmarshal(body, &vols); err != nil {
+		return nil, err
+	}
+	return vols.Volumes, nil
+}
+
+func (cli *Client) InspectVolume(name string) (*types.VolumeInfo, error) {
+	v := url.Values{}
+	v.Set("name", name)
+
+	body, _, err := readBody(cli.call("GET", "/volume/info?"+v.Encode(), nil, nil))
+	if err != nil {
+		return nil, err
+	}
+
+	var info types.VolumeInfo
+	if err = json.Unmarshal(body, &info); err != nil {
+		return nil, err
+	}
+	return &info, nil
+}
+
+func (cli *Client) RemoveVolume(name string) error {
+	v := url.Values{}
+	v.Set("name", name)
+
+	_, _, err := readBody(cli.call("DELETE", "/volume?"+v.Encode(), nil, nil))
+	return err
+}
+
+func (cli *Client) ResizeVolume(podId, volume string, size int64) error {
+	req := &types.VolumeResizeRequest{
+		PodID:     podId,
+		Volume:    volume,
+		SizeBytes: size,
+	}
+	_, _, err := readBody(cli.call("POST", "/volume/resize", req, nil))
+	return err
+}
+
+type VolumeSnapshotList struct {
+	Snapshots []*types.VolumeSnapshotInfo `json:"snapshots"`
+}
+
+func (cli *Client) SnapshotVolume(req *types.VolumeSnapshotRequest) (*types.VolumeSnapshotInfo, error) {
+	body, _, err := readBody(cli.call("POST", "/volume/snapshot", req, nil))
+	if err != nil {
+		return nil, err
+	}
+
+	var snap types.VolumeSnapshotInfo
+	if err = json.Unmarshal(body, &snap); err != nil {
+		return nil, err
+	}
+	return &snap, nil
+}
+
+func (cli *Client) ListVolumeSnapshots(volume string) ([]*types.VolumeSnapshotInfo, error) {
+	v := url.Values{}
+	v.Set("volume", volume)
+
+	body, _, err := readBody(cli.call("GET", "/volume/snapshot/list?"+v.Encode(), nil, nil))
+	if err != nil {
+		return nil, err
+	}
+
+	var snaps VolumeSnapshotList
+	if err = json.Unmarshal(body, &snaps); err != nil {
+		return nil, err
+	}
+	return snaps.Snapshots, nil
+}
+
+func (cli *Client) RemoveVolumeSnapshot(volume, name string) error {
+	v := url.Values{}
+	v.Set("volume", volume)
+	v.Set("name", name)
+
+	_, _, err := readBody(cli.call("DELETE", "/volume/snapshot?"+v.Encode(), nil, nil))
+	return err
+}
+
+func (cli *Client) RestoreVolume(volume, snapshot string) error {
+	req := &types.VolumeRestoreRequest{
+		Volume:   volume,
+		Snapshot: snapshot,
+	}
+	_, _, err := readBody(cli.call("POST", "/volume/restore", req, nil))
+	return err
+}
+
+func (cli *Client) CloneVolume(req *types.VolumeCloneRequest) (*types.VolumeInfo, error) {
+	body, _, err := readBody(cli.call("POST", "/volume/clone", req, nil))
+	if err != nil {
+		return nil, err
+	}
+
+	var info types.VolumeInfo
+	if err = json.Unmarshal(body, &info); err != nil {
+		return nil, err
+	}
+	return &info, nil
+}
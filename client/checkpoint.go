@@ -0,0 +1,66 @@
+package client
+
+import (
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	gflag "github.com/jessevdk/go-flags"
+)
+
+func (cli *HyperClient) HyperCmdCheckpoint(args ...string) error {
+	var parser = gflag.NewParser(nil, gflag.Default|gflag.IgnoreUnknown)
+	parser.Usage = "checkpoint POD_ID DIR\n\nPause the pod, save its sandbox state and records to DIR, and then stop it"
+	args, err := parser.ParseArgs(args)
+	if err != nil {
+		if !strings.Contains(err.Error(), "Usage") {
+			return err
+		} else {
+			return nil
+		}
+	}
+	if len(args) < 2 {
+		return fmt.Errorf("\"checkpoint\" requires 2 arguments, please provide POD ID and the checkpoint directory.\n")
+	}
+
+	// the directory is accessed by hyperd on the same host
+	dir, err := filepath.Abs(args[1])
+	if err != nil {
+		return err
+	}
+	if err = cli.client.CheckpointPod(args[0], dir); err != nil {
+		return err
+	}
+	fmt.Fprintf(cli.out, "Successfully checkpointed the Pod(%s) to %s\n", args[0], dir)
+	return nil
+}
+
+func (cli *HyperClient) HyperCmdRestore(args ...string) error {
+	var opts struct {
+		ColdBoot bool `long:"cold-boot" default-mask:"-" description:"Boot the pod from the checkpointed records, discarding the saved sandbox state"`
+	}
+	var parser = gflag.NewParser(&opts, gflag.Default|gflag.IgnoreUnknown)
+	parser.Usage = "restore [OPTIONS] DIR\n\nRecreate the pod from the checkpoint in DIR and resume its sandbox"
+	args, err := parser.ParseArgs(args)
+	if err != nil {
+		if !strings.Contains(err.Error(), "Usage") {
+			return err
+		} else {
+			return nil
+		}
+	}
+	if len(args) == 0 {
+		return fmt.Errorf("\"restore\" requires a minimum of 1 argument, please provide the checkpoint directory.\n")
+	}
+
+	dir, err := filepath.Abs(args[0])
+	if err != nil {
+		return err
+	}
+	podId, err := cli.client.RestorePod(dir, opts.ColdBoot)
+	if err != nil {
+		return err
+	}
+	fmt.Fprintf(cli.out, "Successfully restored the Pod(%s) from %s\n", podId, dir)
+	return nil
+}
@@ -26,12 +26,12 @@ type CommonFlags struct {
 	Memory        int      `long:"memory" default:"128" value-name:"128" default-mask:"-" description:"Memory size (MB) for the VM"`
 	Env           []string `long:"env" value-name:"[]" default-mask:"-" description:"Set environment variables"`
 	EntryPoint    string   `long:"entrypoint" value-name:"\"\"" default-mask:"-" description:"Overwrite the default ENTRYPOINT of the image"`
-	RestartPolicy string   `long:"restart" default:"never" value-name:"\"\"" default-mask:"-" description:"Restart policy to apply when a container exits (never, onFailure, always)"`
+	RestartPolicy string   `long:"restart" default:"never" value-name:"\"\"" default-mask:"-" description:"Restart policy to apply when a container exits (never, onFailure[:max-retries], always)"`
 	LogDriver     string   `long:"log-driver" value-name:"\"\"" description:"Logging driver for Pod"`
 	LogOpts       []string `long:"log-opt" description:"Log driver options"`
-	Portmap       []string `long:"publish" value-name:"[]" default-mask:"-" description:"Publish a container's port to the host, format: --publish [tcp/udp:]hostPort:containerPort"`
+	Portmap       []string `long:"publish" value-name:"[]" default-mask:"-" description:"Publish a container's port to the host, format: --publish [tcp/udp:][hostPort:]containerPort, the host port is allocated by hyperd if it is omitted or 0"`
 	Labels        []string `long:"label" value-name:"[]" default-mask:"-" description:"Add labels for Pod, format: --label key=value"`
-	Volumes       []string `short:"v" long:"volume" value-name:"[]" default-mask:"-" description:"Mount host file/directory as a data file/volume, format: -v|--volume=[[hostDir:]containerDir[:options]]"`
+	Volumes       []string `short:"v" long:"volume" value-name:"[]" default-mask:"-" description:"Mount host file/directory or a named volume as a data file/volume, format: -v|--volume=[[hostDir|volumeName:]containerDir[:options]]"`
 }
 
 type CreateFlags struct {
@@ -226,7 +226,7 @@ func parseVolume(volStr string) (*apitype.UserVolume, *apitype.UserVolumeReferen
 		// -v container-dest
 		destPath = fields[0]
 	} else {
-		return nil, nil, fmt.Errorf("flag format should be like : --volume=[host-src:]container-dest[:rw|ro]")
+		return nil, nil, fmt.Errorf("flag format should be like : --volume=[host-src|volume-name:]container-dest[:rw|ro]")
 	}
 
 	if !strings.HasPrefix(destPath, "/") {
@@ -237,6 +237,10 @@ func parseVolume(volStr string) (*apitype.UserVolume, *apitype.UserVolumeReferen
 		// Set default volume driver and use destPath as volume Name
 		volDriver = ""
 		_, volName = filepath.Split(destPath)
+	} else if utils.IsDNSLabel(srcName) {
+		// cmd: -v volume-name:container-dest, reference a named volume
+		volDriver = "named"
+		volName = srcName
 	} else {
 		srcName, _ = filepath.Abs(srcName)
 		_, volName = filepath.Split(srcName)
@@ -273,8 +277,12 @@ func parsePortMapping(portmap string) (*apitype.PortMapping, error) {
 	)
 
 	fields := strings.Split(portmap, ":")
-	if len(fields) < 2 {
-		return nil, fmt.Errorf("flag needs host port and container port: --publish")
+	if len(fields) == 1 {
+		// the host port will be allocated by hyperd
+		tmp = &apitype.PortMapping{
+			Protocol:      "tcp",
+			ContainerPort: fields[0],
+		}
 	} else if len(fields) == 2 {
 		tmp = &apitype.PortMapping{
 			Protocol:      "tcp",
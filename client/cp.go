@@ -0,0 +1,105 @@
+package client
+
+import (
+	"archive/tar"
+	"bytes"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/docker/docker/pkg/archive"
+	gflag "github.com/jessevdk/go-flags"
+)
+
+func (cli *HyperClient) HyperCmdCp(args ...string) error {
+	var opts struct {
+		FollowLink bool `short:"L" long:"follow-link" default-mask:"-" description:"Always follow symbol link in SRC_PATH"`
+	}
+	var parser = gflag.NewParser(&opts, gflag.Default)
+	parser.Usage = "cp [OPTIONS] CONTAINER:SRC_PATH DEST_PATH|-\n       hyperctl cp [OPTIONS] SRC_PATH|- CONTAINER:DEST_PATH\n\nCopy files/folders between a container and the local filesystem,\nuse '-' to read a tar archive from stdin or write it to stdout"
+	args, err := parser.ParseArgs(args)
+	if err != nil {
+		if !strings.Contains(err.Error(), "Usage") {
+			return err
+		} else {
+			return nil
+		}
+	}
+	if len(args) != 2 {
+		return fmt.Errorf("\"cp\" requires exactly 2 arguments, please provide the source and destination.\n")
+	}
+
+	srcContainer, srcPath := splitCpArg(args[0])
+	dstContainer, dstPath := splitCpArg(args[1])
+	switch {
+	case srcContainer != "" && dstContainer == "":
+		return cli.copyFromContainer(srcContainer, srcPath, dstPath)
+	case srcContainer == "" && dstContainer != "":
+		return cli.copyToContainer(srcPath, dstContainer, dstPath, opts.FollowLink)
+	case srcContainer != "" && dstContainer != "":
+		return fmt.Errorf("copying between containers is not supported")
+	default:
+		return fmt.Errorf("must specify at least one container source")
+	}
+}
+
+// splitCpArg splits CONTAINER:PATH, a local path which contains a colon
+// could be specified as a relative or absolute path, i.e. ./file:name.
+func splitCpArg(arg string) (container, path string) {
+	if strings.HasPrefix(arg, "/") || strings.HasPrefix(arg, ".") {
+		return "", arg
+	}
+	parts := strings.SplitN(arg, ":", 2)
+	if len(parts) == 1 {
+		return "", arg
+	}
+	return parts[0], parts[1]
+}
+
+func (cli *HyperClient) copyFromContainer(container, srcPath, dstPath string) error {
+	content, err := cli.client.CopyFromContainer(container, srcPath)
+	if err != nil {
+		return err
+	}
+	defer content.Close()
+
+	if dstPath == "-" {
+		_, err = io.Copy(cli.out, content)
+		return err
+	}
+
+	// peek the header of the top entry to find out whether the source is
+	// a dir, which decides how the content is extracted.
+	block := make([]byte, 512)
+	if _, err = io.ReadFull(content, block); err != nil {
+		return fmt.Errorf("failed to read the copied content: %v", err)
+	}
+	hdr, err := tar.NewReader(bytes.NewReader(block)).Next()
+	if err != nil {
+		return fmt.Errorf("failed to read the copied content: %v", err)
+	}
+	srcInfo := archive.CopyInfo{
+		Path:   srcPath,
+		Exists: true,
+		IsDir:  hdr.Typeflag == tar.TypeDir,
+	}
+	return archive.CopyTo(io.MultiReader(bytes.NewReader(block), content), srcInfo, dstPath)
+}
+
+func (cli *HyperClient) copyToContainer(srcPath, container, dstPath string, followLink bool) error {
+	if srcPath == "-" {
+		return cli.client.CopyToContainer(container, dstPath, cli.in)
+	}
+
+	srcInfo, err := archive.CopyInfoSourcePath(srcPath, followLink)
+	if err != nil {
+		return err
+	}
+	content, err := archive.TarResource(srcInfo)
+	if err != nil {
+		return err
+	}
+	defer content.Close()
+
+	return cli.client.CopyToContainer(container, dstPath, content)
+}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/hyperhq/hyperd/client/api"
 	"github.com/hyperhq/runv/lib/term"
 
 	gflag "github.com/jessevdk/go-flags"
@@ -12,9 +13,13 @@ import (
 
 func (cli *HyperClient) HyperCmdExec(args ...string) error {
 	var opts struct {
-		Detach bool `short:"d" long:"detach" default-mask:"-" description:"Not Attach the stdin, stdout and stderr to the process"`
-		Tty    bool `short:"t" long:"tty" description:"Allocate a pseudo-TTY"`
-		VM     bool `short:"m" long:"vm" description:"Execute outside of any containers"`
+		Detach     bool     `short:"d" long:"detach" default-mask:"-" description:"Not Attach the stdin, stdout and stderr to the process"`
+		Tty        bool     `short:"t" long:"tty" description:"Allocate a pseudo-TTY"`
+		VM         bool     `short:"m" long:"vm" description:"Execute outside of any containers"`
+		Env        []string `short:"e" long:"env" value-name:"[]" default-mask:"-" description:"Set environment variables (KEY=VALUE)"`
+		User       string   `short:"u" long:"user" value-name:"\"\"" default-mask:"-" description:"Username or UID (format: <name|uid>[:<group|gid>])"`
+		Workdir    string   `short:"w" long:"workdir" value-name:"\"\"" default-mask:"-" description:"Working directory inside the container"`
+		Privileged bool     `long:"privileged" default-mask:"-" description:"Give extended privileges to the command"`
 	}
 	var parser = gflag.NewParser(&opts, gflag.Default|gflag.IgnoreUnknown|gflag.PassAfterNonOption)
 	parser.Usage = "exec [OPTIONS] POD|CONTAINER COMMAND [ARGS...]\n\nRun a command in a container or a Pod"
@@ -54,7 +59,20 @@ func (cli *HyperClient) HyperCmdExec(args ...string) error {
 		containerId = args[0]
 	}
 
-	execId, err := cli.client.CreateExec(containerId, command, opts.Tty)
+	execOpts := &api.ExecOptions{
+		Env:        opts.Env,
+		Workdir:    opts.Workdir,
+		Privileged: opts.Privileged,
+	}
+	if opts.User != "" {
+		parts := strings.SplitN(opts.User, ":", 2)
+		execOpts.User = parts[0]
+		if len(parts) == 2 {
+			execOpts.Group = parts[1]
+		}
+	}
+
+	execId, err := cli.client.CreateExec(containerId, command, opts.Tty, execOpts)
 	if err != nil {
 		return err
 	}
@@ -12,7 +12,9 @@ func (cli *HyperClient) HyperCmdHelp(args ...string) error {
 Command:
   attach                 Attach to the input/output of a specified container
   build                  Build an image from a Dockerfile
+  checkpoint             Checkpoint a running pod to a directory and stop it
   commit                 Create a new image from a container's changes
+  cp                     Copy files/folders between a container and the local filesystem
   create                 Create a pod or create a container in a pod
   exec                   Run a command in a specified container
   images                 List images
@@ -21,17 +23,23 @@ Command:
   load                   Load a image from STDIN or tar archive file
   login                  Register or log in to a Docker registry server
   logout                 Log out from a Docker registry server
+  network                Create, list, inspect or remove user-defined networks
+  nic                    List, hot-plug or unplug the network interfaces of a pod
   pause                  Pause a running pod
   ports                  Show or modify port mapping rules
   pull                   Pull an image from a Docker registry server
   push                   Push an image or a repository to a Docker registry server
+  restore                Restore a pod from a checkpoint directory
   rm                     Remove one or more pods or containers
   rmi                    Remove one or more images
   run                    Create a pod, and launch the new pod
   save                   Save one or more images to a tar archive (streamed to STDOUT by default)
   start                  Start a pod or container
+  stats                  Display a live stream of the resource usage of pods
   stop                   Stop a running pod or container
+  system                 Prune the resources left behind by removed or failed pods
   unpause                Unpause a paused pod
+  volume                 Manage named volumes and their snapshots, or resize a volume of a pod
 
 Help Options:
   -h, --help             Show this help message
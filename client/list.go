@@ -6,14 +6,16 @@ import (
 	"strings"
 	"text/tabwriter"
 
+	"github.com/hyperhq/hyperd/types"
 	gflag "github.com/jessevdk/go-flags"
 )
 
 func (cli *HyperClient) HyperCmdList(args ...string) error {
 	var opts struct {
-		Pod   string `short:"p" long:"pod" value-name:"\"\"" description:"only list the specified pod"`
-		VM    string `short:"m" long:"vm" value-name:"\"\"" description:"only list resources on the specified vm"`
-		Quiet bool   `short:"q" long:"quiet" value-name:"\"\"" description:"Quiet mode"`
+		Pod    string   `short:"p" long:"pod" value-name:"\"\"" description:"only list the specified pod"`
+		VM     string   `short:"m" long:"vm" value-name:"\"\"" description:"only list resources on the specified vm"`
+		Quiet  bool     `short:"q" long:"quiet" value-name:"\"\"" description:"Quiet mode"`
+		Filter []string `short:"f" long:"filter" value-name:"[]" description:"Filter the list with label=SELECTOR, status=STATUS or image=IMAGE, i.e. --filter 'label=app=web,env in (prod,qa)' --filter status=running"`
 	}
 
 	var parser = gflag.NewParser(&opts, gflag.Default|gflag.IgnoreUnknown)
@@ -37,7 +39,12 @@ func (cli *HyperClient) HyperCmdList(args ...string) error {
 		return fmt.Errorf("Error, the %s can not support %s list!", os.Args[0], item)
 	}
 
-	remoteInfo, err := cli.client.List(item, opts.Pod, opts.VM)
+	filter, err := parseListFilter(opts.Filter)
+	if err != nil {
+		return err
+	}
+
+	remoteInfo, err := cli.client.List(item, opts.Pod, opts.VM, filter)
 	if err != nil {
 		return err
 	}
@@ -98,7 +105,7 @@ func (cli *HyperClient) HyperCmdList(args ...string) error {
 
 	if item == "container" {
 		if !opts.Quiet {
-			fmt.Fprintln(w, "Container ID\tName\tPOD ID\tStatus")
+			fmt.Fprintln(w, "Container ID\tName\tPOD ID\tStatus\tRestarts")
 		}
 
 		for _, c := range containerResponse {
@@ -112,10 +119,39 @@ func (cli *HyperClient) HyperCmdList(args ...string) error {
 						name = name[1:]
 					}
 				}
-				fmt.Fprintf(w, "%s\t%s\t%s\t%s\n", fields[0], name, fields[2], fields[3])
+				restarts := "0"
+				if len(fields) > 4 {
+					restarts = fields[4]
+				}
+				fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\n", fields[0], name, fields[2], fields[3], restarts)
 			}
 		}
 	}
 	w.Flush()
 	return nil
 }
+
+func parseListFilter(args []string) (*types.ListFilter, error) {
+	var (
+		filter = &types.ListFilter{}
+		labels = []string{}
+	)
+	for _, arg := range args {
+		parts := strings.SplitN(arg, "=", 2)
+		if len(parts) != 2 || parts[1] == "" {
+			return nil, fmt.Errorf("invalid filter %q, should be KEY=VALUE", arg)
+		}
+		switch parts[0] {
+		case "label":
+			labels = append(labels, parts[1])
+		case "status":
+			filter.Status = append(filter.Status, parts[1])
+		case "image":
+			filter.Image = append(filter.Image, parts[1])
+		default:
+			return nil, fmt.Errorf("unsupported filter %q, only label, status and image are supported", parts[0])
+		}
+	}
+	filter.LabelSelector = strings.Join(labels, ",")
+	return filter, nil
+}
@@ -0,0 +1,116 @@
+package client
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/docker/go-units"
+	"github.com/hyperhq/hyperd/types"
+	gflag "github.com/jessevdk/go-flags"
+)
+
+func (cli *HyperClient) HyperCmdNetwork(args ...string) error {
+	var opts struct {
+		Subnet   string   `long:"subnet" value-name:"\"\"" default-mask:"-" description:"Subnet of the network in CIDR, i.e. 10.10.0.0/24 (required for create)"`
+		Gateway  string   `long:"gateway" value-name:"\"\"" default-mask:"-" description:"Gateway of the network, the first address of the subnet if not specified (only valid for create)"`
+		IpRange  string   `long:"ip-range" value-name:"\"\"" default-mask:"-" description:"Allocate the addresses from a sub-range of the subnet in CIDR (only valid for create)"`
+		Bridge   string   `short:"b" long:"bridge" value-name:"\"\"" default-mask:"-" description:"Bridge of the network, created if it does not exist (only valid for create)"`
+		Reserved []string `long:"reserved" value-name:"[]" default-mask:"-" description:"Addresses never allocated to the pods (only valid for create)"`
+		Labels   []string `short:"l" long:"label" value-name:"[]" default-mask:"-" description:"Add labels for the network, format: --label key=value (only valid for create)"`
+	}
+	var parser = gflag.NewParser(&opts, gflag.Default|gflag.IgnoreUnknown|gflag.PassAfterNonOption)
+	parser.Usage = "network create|ls|inspect|rm [OPTIONS] [NETWORK...]\n\nCreate, list, inspect or remove user-defined networks\n"
+
+	if len(args) == 0 {
+		parser.WriteHelp(cli.err)
+		return nil
+	}
+	cmd := args[0]
+
+	args, err := parser.ParseArgs(args[1:])
+	if err != nil {
+		if !strings.Contains(err.Error(), "Usage") {
+			return err
+		} else {
+			return nil
+		}
+	}
+
+	switch cmd {
+	case "create":
+		if len(args) != 1 {
+			return errors.New("need a network name as command parameter")
+		}
+		if opts.Subnet == "" {
+			return errors.New("need the subnet of the network")
+		}
+		req := &types.NetworkCreateRequest{
+			Name:     args[0],
+			Bridge:   opts.Bridge,
+			Subnet:   opts.Subnet,
+			Gateway:  opts.Gateway,
+			IpRange:  opts.IpRange,
+			Reserved: opts.Reserved,
+			Labels:   make(map[string]string),
+		}
+		for _, l := range opts.Labels {
+			label := strings.SplitN(l, "=", 2)
+			if len(label) != 2 {
+				return fmt.Errorf("Label '%s' is not in 'k=v' format", l)
+			}
+			req.Labels[label[0]] = label[1]
+		}
+		info, err := cli.client.CreateNetwork(req)
+		if err != nil {
+			return err
+		}
+		fmt.Fprintf(cli.out, "%s\n", info.Name)
+	case "ls":
+		nets, err := cli.client.ListNetworks()
+		if err != nil {
+			return err
+		}
+		w := tabwriter.NewWriter(cli.out, 20, 1, 3, ' ', 0)
+		fmt.Fprintln(w, "Name\tBridge\tSubnet\tGateway\tCreated\tLeases")
+		for _, n := range nets {
+			created := units.HumanDuration(time.Now().UTC().Sub(time.Unix(n.CreatedAt, 0))) + " ago"
+			fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%d\n", n.Name, n.Bridge, n.Subnet, n.Gateway, created, len(n.Leases))
+		}
+		w.Flush()
+	case "inspect":
+		if len(args) == 0 {
+			return errors.New("need at least one network name as command parameter")
+		}
+		nets := make([]*types.NetworkInfo, 0, len(args))
+		for _, name := range args {
+			info, err := cli.client.InspectNetwork(name)
+			if err != nil {
+				return err
+			}
+			nets = append(nets, info)
+		}
+		data, err := json.MarshalIndent(nets, "", "    ")
+		if err != nil {
+			return err
+		}
+		fmt.Fprintf(cli.out, "%s\n", data)
+	case "rm":
+		if len(args) == 0 {
+			return errors.New("need at least one network name as command parameter")
+		}
+		for _, name := range args {
+			if err := cli.client.RemoveNetwork(name); err != nil {
+				fmt.Fprintf(cli.err, "network %s delete failed: %v\n", name, err)
+			} else {
+				fmt.Fprintf(cli.out, "%s\n", name)
+			}
+		}
+	default:
+		parser.WriteHelp(cli.err)
+	}
+	return nil
+}
@@ -0,0 +1,82 @@
+package client
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/hyperhq/hyperd/types"
+	gflag "github.com/jessevdk/go-flags"
+)
+
+func (cli *HyperClient) HyperCmdNic(args ...string) error {
+	var opts struct {
+		Bridge   string `short:"b" long:"bridge" value-name:"\"\"" default-mask:"-" description:"Bridge to attach the interface to (only valid for add)"`
+		Ip       string `long:"ip" value-name:"\"\"" default-mask:"-" description:"IP address of the interface in CIDR notation, allocated from the bridge if omitted (only valid for add)"`
+		Ifname   string `long:"ifname" value-name:"\"\"" default-mask:"-" description:"Name of the interface in the pod, ethN if omitted (only valid for add)"`
+		Mac      string `long:"mac" value-name:"\"\"" default-mask:"-" description:"MAC address of the interface (only valid for add)"`
+		Gateway  string `long:"gateway" value-name:"\"\"" default-mask:"-" description:"IPv4 gateway routed through the interface (only valid for add)"`
+		Gateway6 string `long:"gateway6" value-name:"\"\"" default-mask:"-" description:"IPv6 gateway routed through the interface (only valid for add)"`
+		Network  string `short:"n" long:"network" value-name:"\"\"" default-mask:"-" description:"User-defined network to get the address from, instead of the bridge and IP (only valid for add)"`
+	}
+	var parser = gflag.NewParser(&opts, gflag.Default|gflag.IgnoreUnknown|gflag.PassAfterNonOption)
+	parser.Usage = "nic ls|add|rm [OPTIONS] POD [IFNAME]\n\nList, hot-plug or unplug the network interfaces of a Pod\n"
+
+	if len(args) == 0 {
+		parser.WriteHelp(cli.err)
+		return nil
+	}
+	cmd := args[0]
+
+	args, err := parser.ParseArgs(args[1:])
+	if err != nil {
+		if !strings.Contains(err.Error(), "Usage") {
+			return err
+		} else {
+			return nil
+		}
+	}
+
+	switch cmd {
+	case "ls":
+		if len(args) != 1 {
+			return errors.New("need a Pod Id as command parameter")
+		}
+		infs, err := cli.client.ListInterfaces(args[0])
+		if err != nil {
+			return err
+		}
+		w := tabwriter.NewWriter(cli.out, 20, 1, 3, ' ', 0)
+		fmt.Fprintln(w, "Name\tNetwork\tBridge\tIP\tMAC\tGateway")
+		for _, inf := range infs {
+			fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%s\n", inf.Ifname, inf.Network, inf.Bridge, inf.Ip, inf.Mac, strings.Trim(inf.Gateway+","+inf.Gateway6, ","))
+		}
+		w.Flush()
+	case "add":
+		if len(args) != 1 {
+			return errors.New("need a Pod Id as command parameter")
+		}
+		inf, err := cli.client.AddInterface(args[0], &types.UserInterface{
+			Bridge:   opts.Bridge,
+			Ip:       opts.Ip,
+			Ifname:   opts.Ifname,
+			Mac:      opts.Mac,
+			Gateway:  opts.Gateway,
+			Gateway6: opts.Gateway6,
+			Network:  opts.Network,
+		})
+		if err != nil {
+			return err
+		}
+		fmt.Fprintf(cli.out, "%s\n", inf.Ifname)
+	case "rm":
+		if len(args) != 2 {
+			return errors.New("need a Pod Id and an interface name as command parameters")
+		}
+		return cli.client.RemoveInterface(args[0], args[1])
+	default:
+		parser.WriteHelp(cli.err)
+	}
+	return nil
+}
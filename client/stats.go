@@ -0,0 +1,124 @@
+package client
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/docker/go-units"
+	"github.com/hyperhq/hyperd/types"
+	gflag "github.com/jessevdk/go-flags"
+)
+
+func (cli *HyperClient) HyperCmdStats(args ...string) error {
+	var opts struct {
+		Interval int  `short:"i" long:"interval" value-name:"1" default-mask:"-" description:"Seconds between the samples"`
+		NoStream bool `long:"no-stream" default-mask:"-" description:"Display the first sample and exit"`
+	}
+	var parser = gflag.NewParser(&opts, gflag.Default)
+	parser.Usage = "stats [OPTIONS] POD [POD...]\n\nDisplay a live stream of the resource usage of pods and their containers"
+	args, err := parser.ParseArgs(args)
+	if err != nil {
+		if !strings.Contains(err.Error(), "Usage") {
+			return err
+		} else {
+			return nil
+		}
+	}
+	if len(args) == 0 {
+		return fmt.Errorf("\"stats\" requires a minimum of 1 argument, please provide the pod ID.\n")
+	}
+
+	output, err := cli.client.PodStatsStream(args, opts.Interval)
+	if err != nil {
+		return err
+	}
+	defer output.Close()
+
+	var (
+		dec     = json.NewDecoder(output)
+		samples = make(map[string]*types.PodStatsStreamResponse)
+		pods    = []string{}
+		seen    = make(map[string]bool)
+	)
+	for _, id := range args {
+		if !seen[id] {
+			seen[id] = true
+			pods = append(pods, id)
+		}
+	}
+	for {
+		sample := &types.PodStatsStreamResponse{}
+		if err := dec.Decode(sample); err != nil {
+			if err == io.EOF {
+				return nil
+			}
+			return err
+		}
+		samples[sample.PodID] = sample
+
+		// wait for all the pods before the first display
+		if len(samples) < len(pods) {
+			continue
+		}
+		if cli.isTerminalOut && !opts.NoStream {
+			// clear the screen and move the cursor to the top left
+			fmt.Fprint(cli.out, "\033[2J\033[H")
+		}
+		cli.displayStats(pods, samples)
+		if opts.NoStream {
+			return nil
+		}
+	}
+}
+
+func (cli *HyperClient) displayStats(pods []string, samples map[string]*types.PodStatsStreamResponse) {
+	w := tabwriter.NewWriter(cli.out, 20, 1, 3, ' ', 0)
+	fmt.Fprintln(w, "POD/CONTAINER\tCPU %\tMEM USAGE / LIMIT\tMEM %\tNET RX/TX PER SEC\tBLOCK R/W PER SEC")
+	for _, id := range pods {
+		sample, ok := samples[id]
+		if !ok {
+			continue
+		}
+		if sample.Error != "" {
+			fmt.Fprintf(w, "%s\t--\t--\t--\t--\t%s\n", id, sample.Error)
+			continue
+		}
+		printStatsRates(w, id, sample.Rates)
+
+		containers := sample.ContainerRates
+		sort.Sort(containerRatesByName(containers))
+		for _, c := range containers {
+			name := c.ContainerName
+			if name == "" {
+				name = c.ContainerID
+			}
+			printStatsRates(w, "  "+strings.TrimPrefix(name, "/"), c.Rates)
+		}
+	}
+	w.Flush()
+}
+
+func printStatsRates(w io.Writer, name string, r *types.StatsRates) {
+	if r == nil {
+		r = &types.StatsRates{}
+	}
+	limit := "--"
+	if r.MemoryLimit > 0 {
+		limit = units.BytesSize(float64(r.MemoryLimit))
+	}
+	fmt.Fprintf(w, "%s\t%.2f%%\t%s / %s\t%.2f%%\t%s / %s\t%s / %s\n",
+		name, r.CpuPercent,
+		units.BytesSize(float64(r.MemoryUsage)), limit, r.MemoryPercent,
+		units.HumanSize(r.NetworkRxBytesPerSecond), units.HumanSize(r.NetworkTxBytesPerSecond),
+		units.HumanSize(r.BlockReadBytesPerSecond), units.HumanSize(r.BlockWriteBytesPerSecond))
+}
+
+type containerRatesByName []*types.ContainerStatsRates
+
+func (c containerRatesByName) Len() int           { return len(c) }
+func (c containerRatesByName) Less(i, j int) bool { return c[i].ContainerName < c[j].ContainerName }
+func (c containerRatesByName) Swap(i, j int)      { c[i], c[j] = c[j], c[i] }
@@ -0,0 +1,59 @@
+package client
+
+import (
+	"fmt"
+	"strings"
+	"text/tabwriter"
+
+	gflag "github.com/jessevdk/go-flags"
+)
+
+func (cli *HyperClient) HyperCmdSystem(args ...string) error {
+	var opts struct {
+		DryRun bool `long:"dry-run" default-mask:"-" description:"Only list the orphan resources without removing them"`
+	}
+	var parser = gflag.NewParser(&opts, gflag.Default|gflag.IgnoreUnknown)
+	parser.Usage = "system prune [OPTIONS]\n\nRemove the volumes, devices, hosts mounts and daemon db records left behind by removed or failed pods"
+
+	if len(args) == 0 {
+		parser.WriteHelp(cli.err)
+		return nil
+	}
+	cmd := args[0]
+
+	args, err := parser.ParseArgs(args[1:])
+	if err != nil {
+		if !strings.Contains(err.Error(), "Usage") {
+			return err
+		} else {
+			return nil
+		}
+	}
+
+	switch cmd {
+	case "prune":
+		orphans, err := cli.client.SystemPrune(opts.DryRun)
+		if err != nil {
+			return err
+		}
+		if len(orphans) == 0 {
+			fmt.Fprintln(cli.out, "No orphan resources found")
+			return nil
+		}
+		w := tabwriter.NewWriter(cli.out, 10, 1, 3, ' ', 0)
+		fmt.Fprintln(w, "Kind\tResource\tOwner\tStatus")
+		for _, o := range orphans {
+			status := "found"
+			if o.Removed {
+				status = "removed"
+			} else if o.Error != "" {
+				status = "failed: " + o.Error
+			}
+			fmt.Fprintf(w, "%s\t%s\t%s\t%s\n", o.Kind, o.Id, o.Owner, status)
+		}
+		w.Flush()
+	default:
+		parser.WriteHelp(cli.err)
+	}
+	return nil
+}
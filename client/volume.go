@@ -0,0 +1,224 @@
+package client
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/docker/go-units"
+	"github.com/hyperhq/hyperd/types"
+	gflag "github.com/jessevdk/go-flags"
+)
+
+func (cli *HyperClient) HyperCmdVolume(args ...string) error {
+	var opts struct {
+		Size   string   `short:"s" long:"size" value-name:"\"\"" default-mask:"-" description:"Size of the volume, i.e. 10G (for create, the storage driver default if not specified; required by resize)"`
+		Fstype string   `short:"t" long:"fstype" value-name:"\"\"" default-mask:"-" description:"Filesystem of the volume (only valid for create)"`
+		Labels []string `short:"l" long:"label" value-name:"[]" default-mask:"-" description:"Add labels for the volume or snapshot, format: --label key=value (only valid for create and clone)"`
+	}
+	var parser = gflag.NewParser(&opts, gflag.Default|gflag.IgnoreUnknown|gflag.PassAfterNonOption)
+	parser.Usage = "volume create|ls|inspect|rm [OPTIONS] [VOLUME...]\n  volume resize --size SIZE POD VOLUME\n  volume snapshot create|ls|restore|rm [OPTIONS] VOLUME [SNAPSHOT...]\n  volume clone [OPTIONS] VOLUME SNAPSHOT NAME\n\nCreate, list, inspect or remove named volumes, grow a volume of a pod, or manage the snapshots of named volumes\n"
+
+	if len(args) == 0 {
+		parser.WriteHelp(cli.err)
+		return nil
+	}
+	cmd := args[0]
+	args = args[1:]
+	sub := ""
+	if cmd == "snapshot" && len(args) > 0 {
+		sub, args = args[0], args[1:]
+	}
+
+	args, err := parser.ParseArgs(args)
+	if err != nil {
+		if !strings.Contains(err.Error(), "Usage") {
+			return err
+		} else {
+			return nil
+		}
+	}
+
+	switch cmd {
+	case "create":
+		if len(args) != 1 {
+			return errors.New("need a volume name as command parameter")
+		}
+		req := &types.VolumeCreateRequest{
+			Name:   args[0],
+			Fstype: opts.Fstype,
+		}
+		if opts.Size != "" {
+			if req.SizeBytes, err = units.RAMInBytes(opts.Size); err != nil {
+				return fmt.Errorf("invalid volume size %s: %v", opts.Size, err)
+			}
+		}
+		if req.Labels, err = parseVolumeLabels(opts.Labels); err != nil {
+			return err
+		}
+		info, err := cli.client.CreateVolume(req)
+		if err != nil {
+			return err
+		}
+		fmt.Fprintf(cli.out, "%s\n", info.Name)
+	case "ls":
+		vols, err := cli.client.ListVolumes()
+		if err != nil {
+			return err
+		}
+		w := tabwriter.NewWriter(cli.out, 20, 1, 3, ' ', 0)
+		fmt.Fprintln(w, "Name\tDriver\tSize\tFstype\tCreated\tPods")
+		for _, v := range vols {
+			size := "default"
+			if v.SizeBytes > 0 {
+				size = units.BytesSize(float64(v.SizeBytes))
+			}
+			created := units.HumanDuration(time.Now().UTC().Sub(time.Unix(v.CreatedAt, 0))) + " ago"
+			fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%d\n", v.Name, v.Driver, size, v.Fstype, created, len(v.Pods))
+		}
+		w.Flush()
+	case "inspect":
+		if len(args) == 0 {
+			return errors.New("need at least one volume name as command parameter")
+		}
+		vols := make([]*types.VolumeInfo, 0, len(args))
+		for _, name := range args {
+			info, err := cli.client.InspectVolume(name)
+			if err != nil {
+				return err
+			}
+			vols = append(vols, info)
+		}
+		data, err := json.MarshalIndent(vols, "", "    ")
+		if err != nil {
+			return err
+		}
+		fmt.Fprintf(cli.out, "%s\n", data)
+	case "rm":
+		if len(args) == 0 {
+			return errors.New("need at least one volume name as command parameter")
+		}
+		for _, name := range args {
+			if err := cli.client.RemoveVolume(name); err != nil {
+				fmt.Fprintf(cli.err, "volume %s delete failed: %v\n", name, err)
+			} else {
+				fmt.Fprintf(cli.out, "%s\n", name)
+			}
+		}
+	case "resize":
+		if len(args) != 2 {
+			return errors.New("need a pod and a volume name as command parameters")
+		}
+		if opts.Size == "" {
+			return errors.New("need the new size of the volume")
+		}
+		size, err := units.RAMInBytes(opts.Size)
+		if err != nil {
+			return fmt.Errorf("invalid volume size %s: %v", opts.Size, err)
+		}
+		if err = cli.client.ResizeVolume(args[0], args[1], size); err != nil {
+			return err
+		}
+		fmt.Fprintf(cli.out, "%s\n", args[1])
+	case "snapshot":
+		return cli.volumeSnapshot(parser, sub, args, opts.Labels)
+	case "clone":
+		if len(args) != 3 {
+			return errors.New("need a volume, a snapshot and a new volume name as command parameters")
+		}
+		req := &types.VolumeCloneRequest{
+			Volume:   args[0],
+			Snapshot: args[1],
+			Name:     args[2],
+		}
+		if req.Labels, err = parseVolumeLabels(opts.Labels); err != nil {
+			return err
+		}
+		info, err := cli.client.CloneVolume(req)
+		if err != nil {
+			return err
+		}
+		fmt.Fprintf(cli.out, "%s\n", info.Name)
+	default:
+		parser.WriteHelp(cli.err)
+	}
+	return nil
+}
+
+func (cli *HyperClient) volumeSnapshot(parser *gflag.Parser, cmd string, args, labels []string) error {
+	switch cmd {
+	case "create":
+		if len(args) != 2 {
+			return errors.New("need a volume and a snapshot name as command parameters")
+		}
+		req := &types.VolumeSnapshotRequest{
+			Volume: args[0],
+			Name:   args[1],
+		}
+		var err error
+		if req.Labels, err = parseVolumeLabels(labels); err != nil {
+			return err
+		}
+		snap, err := cli.client.SnapshotVolume(req)
+		if err != nil {
+			return err
+		}
+		fmt.Fprintf(cli.out, "%s\n", snap.Name)
+	case "ls":
+		if len(args) != 1 {
+			return errors.New("need a volume name as command parameter")
+		}
+		snaps, err := cli.client.ListVolumeSnapshots(args[0])
+		if err != nil {
+			return err
+		}
+		w := tabwriter.NewWriter(cli.out, 20, 1, 3, ' ', 0)
+		fmt.Fprintln(w, "Name\tVolume\tDriver\tSize\tCreated")
+		for _, s := range snaps {
+			size := "default"
+			if s.SizeBytes > 0 {
+				size = units.BytesSize(float64(s.SizeBytes))
+			}
+			created := units.HumanDuration(time.Now().UTC().Sub(time.Unix(s.CreatedAt, 0))) + " ago"
+			fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\n", s.Name, s.Volume, s.Driver, size, created)
+		}
+		w.Flush()
+	case "restore":
+		if len(args) != 2 {
+			return errors.New("need a volume and a snapshot name as command parameters")
+		}
+		if err := cli.client.RestoreVolume(args[0], args[1]); err != nil {
+			return err
+		}
+		fmt.Fprintf(cli.out, "%s\n", args[0])
+	case "rm":
+		if len(args) < 2 {
+			return errors.New("need a volume and at least one snapshot name as command parameters")
+		}
+		for _, name := range args[1:] {
+			if err := cli.client.RemoveVolumeSnapshot(args[0], name); err != nil {
+				fmt.Fprintf(cli.err, "snapshot %s delete failed: %v\n", name, err)
+			} else {
+				fmt.Fprintf(cli.out, "%s\n", name)
+			}
+		}
+	default:
+		parser.WriteHelp(cli.err)
+	}
+	return nil
+}
+
+func parseVolumeLabels(labels []string) (map[string]string, error) {
+	result := make(map[string]string)
+	for _, l := range labels {
+		label := strings.SplitN(l, "=", 2)
+		if len(label) != 2 {
+			return nil, fmt.Errorf("Label '%s' is not in 'k=v' format", l)
+		}
+		result[label[0]] = label[1]
+	}
+	return result, nil
+}
@@ -1,6 +1,7 @@
 package main
 
 import (
+	"crypto/tls"
 	"flag"
 	"fmt"
 	"os"
@@ -10,6 +11,7 @@ import (
 
 	"github.com/docker/docker/opts"
 	"github.com/docker/docker/pkg/reexec"
+	"github.com/docker/go-connections/tlsconfig"
 	"github.com/golang/glog"
 	"github.com/hyperhq/hyperd/daemon"
 	"github.com/hyperhq/hyperd/server"
@@ -111,7 +113,7 @@ func mainDaemon(opt *Options) {
 		}
 	}
 
-	daemon.InitDockerCfg(strings.Split(opt.Mirrors, ","), strings.Split(opt.InsecureRegistries, ","), c.StorageDriver, c.Root)
+	daemon.InitDockerCfg(strings.Split(opt.Mirrors, ","), strings.Split(opt.InsecureRegistries, ","), c.GraphDriver, c.Root)
 	d, err := daemon.NewDaemon(c)
 	if err != nil {
 		glog.Errorf("The hyperd create failed, %s", err.Error())
@@ -165,7 +167,29 @@ func mainDaemon(opt *Options) {
 
 	var rpcServer *serverrpc.ServerRPC = nil
 	if c.GRPCHost != "" {
-		rpcServer = serverrpc.NewServerRPC(d)
+		rpcConfig := &serverrpc.Config{
+			SocketGroup: c.GRPCSocketGroup,
+		}
+		if c.GRPCTLSCert != "" || c.GRPCTLSKey != "" {
+			tlsOptions := tlsconfig.Options{
+				CertFile: c.GRPCTLSCert,
+				KeyFile:  c.GRPCTLSKey,
+			}
+			// enforce mutual TLS if a CA is provided for client certificates
+			if c.GRPCTLSCACert != "" {
+				tlsOptions.CAFile = c.GRPCTLSCACert
+				tlsOptions.ClientAuth = tls.RequireAndVerifyClientCert
+			}
+			rpcConfig.TLSConfig, err = tlsconfig.Server(tlsOptions)
+			if err != nil {
+				glog.Errorf("failed to load TLS configuration for gRPC: %v", err)
+				return
+			}
+		} else if c.GRPCTLSCACert != "" {
+			glog.Errorf("gRPCTLSCACert requires gRPCTLSCert and gRPCTLSKey")
+			return
+		}
+		rpcServer = serverrpc.NewServerRPC(d, rpcConfig)
 
 		go func() {
 			err := rpcServer.Serve(c.GRPCHost)
@@ -0,0 +1,40 @@
+package daemon
+
+import (
+	"fmt"
+
+	"github.com/golang/glog"
+
+	"github.com/hyperhq/hyperd/daemon/pod"
+)
+
+func (daemon *Daemon) CheckpointPod(podId, dir string) error {
+	p, ok := daemon.PodList.Get(podId)
+	if !ok {
+		return fmt.Errorf("Can not get Pod info with pod ID(%s)", podId)
+	}
+
+	glog.Infof("Checkpoint pod %s to %s", podId, dir)
+	return p.Checkpoint(dir)
+}
+
+func (daemon *Daemon) RestorePod(dir string, coldBoot bool) (string, error) {
+	factory := pod.NewPodFactory(daemon.Factory, daemon.PodList, daemon.db, daemon.Storage, daemon.Daemon, daemon.DefaultLog, daemon.Events, daemon.podDNS)
+
+	glog.Infof("Restore pod from %s", dir)
+	p, err := pod.RestoreXPod(factory, dir, coldBoot)
+	if p != nil {
+		// the restored pod may reference named volumes
+		if rerr := daemon.restoreVolumeRefs(); rerr != nil {
+			glog.Warningf("failed to update volume references: %v", rerr)
+		}
+	}
+	if err != nil {
+		glog.Errorf("failed to restore pod from %s: %v", dir, err)
+		if p != nil {
+			return p.Id(), err
+		}
+		return "", err
+	}
+	return p.Id(), nil
+}
@@ -0,0 +1,32 @@
+package daemon
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/golang/glog"
+)
+
+func (daemon *Daemon) CopyFromContainer(container, path string) (io.ReadCloser, error) {
+	p, id, ok := daemon.PodList.GetByContainerIdOrName(container)
+	if !ok {
+		err := fmt.Errorf("cannot find container %s", container)
+		glog.Error(err)
+		return nil, err
+	}
+
+	glog.V(1).Infof("Copy %s from container %s", path, container)
+	return p.CopyFromContainer(id, path)
+}
+
+func (daemon *Daemon) CopyToContainer(container, path string, content io.Reader) error {
+	p, id, ok := daemon.PodList.GetByContainerIdOrName(container)
+	if !ok {
+		err := fmt.Errorf("cannot find container %s", container)
+		glog.Error(err)
+		return err
+	}
+
+	glog.V(1).Infof("Copy to %s of container %s", path, container)
+	return p.CopyToContainer(id, path, content)
+}
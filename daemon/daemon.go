@@ -6,9 +6,14 @@ import (
 	"os"
 	"path"
 	"strings"
+	"time"
 
 	"github.com/hyperhq/hyperd/daemon/daemondb"
+	"github.com/hyperhq/hyperd/daemon/events"
+	"github.com/hyperhq/hyperd/daemon/nameserver"
 	"github.com/hyperhq/hyperd/daemon/pod"
+	"github.com/hyperhq/hyperd/daemon/stats"
+	"github.com/hyperhq/hyperd/lib/metrics"
 	"github.com/hyperhq/hyperd/networking/portmapping"
 	apitypes "github.com/hyperhq/hyperd/types"
 	"github.com/hyperhq/hyperd/utils"
@@ -41,18 +46,36 @@ type Daemon struct {
 	Storage    Storage
 	Hypervisor string
 	DefaultLog *pod.GlobalLogConfig
+	Events     *events.Events
+
+	volumes  *namedVolumes
+	networks *userNetworks
+	statsHub *stats.Hub
+	dns      *nameserver.Server
+	podDNS   *pod.DNSConfig
+
+	// remove the orphans found during the restore instead of reporting them
+	pruneOnRestore bool
 }
 
 func (daemon *Daemon) Restore() error {
 	//try to migrate lagecy data first
 	err := pod.MigrateLagecyPersistentData(daemon.db, func() *pod.PodFactory {
-		return pod.NewPodFactory(daemon.Factory, daemon.PodList, daemon.db, daemon.Storage, daemon.Daemon, daemon.DefaultLog)
+		return pod.NewPodFactory(daemon.Factory, daemon.PodList, daemon.db, daemon.Storage, daemon.Daemon, daemon.DefaultLog, daemon.Events, daemon.podDNS)
 	})
 	if err != nil {
 		return err
 	}
 
+	// the bridges of the networks should be ready before the pods
+	if err = daemon.restoreNetworks(); err != nil {
+		glog.Errorf("failed to restore networks: %v", err)
+		return err
+	}
+
 	if daemon.GetPodNum() == 0 {
+		daemon.releaseStaleLeases()
+		daemon.pruneOrphans()
 		return nil
 	}
 
@@ -75,7 +98,7 @@ func (daemon *Daemon) Restore() error {
 		}
 
 		glog.V(1).Infof("reloading pod %s: %#v", layout.Id, layout)
-		fc := pod.NewPodFactory(daemon.Factory, daemon.PodList, daemon.db, daemon.Storage, daemon.Daemon, daemon.DefaultLog)
+		fc := pod.NewPodFactory(daemon.Factory, daemon.PodList, daemon.db, daemon.Storage, daemon.Daemon, daemon.DefaultLog, daemon.Events, daemon.podDNS)
 
 		p, err := pod.LoadXPod(fc, layout)
 		if err != nil {
@@ -88,6 +111,12 @@ func (daemon *Daemon) Restore() error {
 		}
 	}
 
+	daemon.releaseStaleLeases()
+	if err = daemon.restoreVolumeRefs(); err != nil {
+		return err
+	}
+
+	daemon.pruneOrphans()
 	return nil
 }
 
@@ -113,11 +142,17 @@ func NewDaemon(cfg *apitypes.HyperConfig) (*Daemon, error) {
 	}
 
 	daemon := &Daemon{
-		ID:      fmt.Sprintf("%d", os.Getpid()),
-		db:      db,
-		PodList: pod.NewPodList(),
-		Host:    cfg.Host,
+		ID:       fmt.Sprintf("%d", os.Getpid()),
+		db:       db,
+		PodList:  pod.NewPodList(),
+		Host:     cfg.Host,
+		Events:   events.New(events.DefaultRingSize),
+		volumes:  newNamedVolumes(),
+		networks: newUserNetworks(),
+
+		pruneOnRestore: cfg.PruneOnRestore,
 	}
+	daemon.statsHub = stats.NewHub(daemon.PodList.Get)
 
 	daemon.Daemon, err = docker.NewDaemon(dockerCfg, registryCfg)
 	if err != nil {
@@ -129,7 +164,7 @@ func NewDaemon(cfg *apitypes.HyperConfig) (*Daemon, error) {
 	if err != nil {
 		return nil, err
 	}
-	stor, err := StorageFactory(sysinfo, daemon.db)
+	stor, err := StorageFactory(cfg, sysinfo, daemon.db)
 	if err != nil {
 		return nil, err
 	}
@@ -148,6 +183,8 @@ func NewDaemon(cfg *apitypes.HyperConfig) (*Daemon, error) {
 
 	daemon.initDefaultLog(cfg)
 
+	metrics.Register(metrics.CollectorFunc(daemon.collectMetrics))
+
 	return daemon, nil
 }
 
@@ -246,9 +283,28 @@ func (daemon *Daemon) initNetworks(c *apitypes.HyperConfig) error {
 		glog.Error(err)
 		return err
 	}
-	if err := portmapping.Setup(network.BridgeIface, fmt.Sprintf("%s", addrs[0].IPNet), c.DisableIptables); err != nil {
+	if err := portmapping.Setup(network.BridgeIface, fmt.Sprintf("%s", addrs[0].IPNet), c.PortMappingBackend, c.DisableIptables); err != nil {
 		glog.Errorf("Setup portmapping failed: %v", err)
 	}
+	if c.BridgeIPv6 != "" {
+		if err := network.SetupIPv6(c.BridgeIPv6); err != nil {
+			glog.Errorf("failed to setup IPv6 of the configured bridge (%s): %v", network.BridgeIface, err)
+			return err
+		}
+		if err := portmapping.SetupIPv6(network.BridgeIPv6Net.String()); err != nil {
+			glog.Errorf("Setup IPv6 portmapping failed: %v", err)
+		}
+	}
+	if c.ProxyUDPTimeout > 0 {
+		portmapping.SetProxyUDPTimeout(time.Duration(c.ProxyUDPTimeout) * time.Second)
+	}
+	if c.HostPortRange != "" {
+		if err := portmapping.SetHostPortRange(c.HostPortRange); err != nil {
+			glog.Error(err)
+			return err
+		}
+	}
+	daemon.initDNS(c, addrs[0].IP)
 	return nil
 }
 
@@ -384,6 +440,9 @@ func (daemon *Daemon) Shutdown() error {
 	glog.V(0).Info("Shutdown all VMs")
 
 	daemon.Factory.CloseFactory()
+	if daemon.dns != nil {
+		daemon.dns.Stop()
+	}
 	daemon.db.Close()
 	glog.Flush()
 	return nil
@@ -58,6 +58,10 @@ func (d *DaemonDB) LagecyListPod() ([][]byte, error) {
 	})
 }
 
+func (d *DaemonDB) LagecyListP2C() ([][]byte, error) {
+	return d.PrefixListKey([]byte(POD_CONTAINER_PREFIX), nil)
+}
+
 func (d *DaemonDB) LagecyGetAllPods() chan *KVPair {
 	return d.PrefixList2Chan(prefixPod(), func(key []byte) bool {
 		return !strings.HasPrefix(string(key), POD_CONTAINER_PREFIX)
@@ -85,6 +89,79 @@ func (d *DaemonDB) DeletePodVolumes(podId string) error {
 	return d.PrefixDelete(prefixVolume(podId))
 }
 
+// ListAllPodVolumes gets the volume records of all the pods with their keys
+func (d *DaemonDB) ListAllPodVolumes() ([]*KVPair, error) {
+	return d.PrefixListPairs(prefixVolume(""), nil)
+}
+
+// Named Volumes
+func (d *DaemonDB) UpdateNamedVolume(name string, data []byte) error {
+	return d.Update(keyNamedVolume(name), data)
+}
+
+func (d *DaemonDB) GetNamedVolume(name string) ([]byte, error) {
+	return d.db.Get(keyNamedVolume(name), nil)
+}
+
+func (d *DaemonDB) ListNamedVolumes() ([][]byte, error) {
+	return d.PrefixList(prefixNamedVolume(), nil)
+}
+
+func (d *DaemonDB) DeleteNamedVolume(name string) error {
+	return d.db.Delete(keyNamedVolume(name), nil)
+}
+
+// Snapshots of Named Volumes
+func (d *DaemonDB) UpdateSnapshot(volume, name string, data []byte) error {
+	return d.Update(keySnapshot(volume, name), data)
+}
+
+func (d *DaemonDB) GetSnapshot(volume, name string) ([]byte, error) {
+	return d.db.Get(keySnapshot(volume, name), nil)
+}
+
+func (d *DaemonDB) ListSnapshots(volume string) ([][]byte, error) {
+	return d.PrefixList(prefixSnapshot(volume), nil)
+}
+
+func (d *DaemonDB) DeleteSnapshot(volume, name string) error {
+	return d.db.Delete(keySnapshot(volume, name), nil)
+}
+
+// ListAllSnapshots gets the snapshots of all the named volumes
+func (d *DaemonDB) ListAllSnapshots() ([][]byte, error) {
+	return d.PrefixList(prefixAllSnapshots(), nil)
+}
+
+// User-defined Networks
+func (d *DaemonDB) UpdateNetwork(name string, data []byte) error {
+	return d.Update(keyNetwork(name), data)
+}
+
+func (d *DaemonDB) GetNetwork(name string) ([]byte, error) {
+	return d.db.Get(keyNetwork(name), nil)
+}
+
+func (d *DaemonDB) ListNetworks() ([][]byte, error) {
+	return d.PrefixList(prefixNetwork(), nil)
+}
+
+func (d *DaemonDB) DeleteNetwork(name string) error {
+	return d.db.Delete(keyNetwork(name), nil)
+}
+
+func (d *DaemonDB) UpdateNetworkLease(name, ip string, data []byte) error {
+	return d.Update(keyNetworkLease(name, ip), data)
+}
+
+func (d *DaemonDB) ListNetworkLeases(name string) ([][]byte, error) {
+	return d.PrefixList(prefixNetworkLease(name), nil)
+}
+
+func (d *DaemonDB) DeleteNetworkLease(name, ip string) error {
+	return d.db.Delete(keyNetworkLease(name, ip), nil)
+}
+
 // POD to Containers (string to string list)
 func (d *DaemonDB) LagecyGetP2C(id string) ([]string, error) {
 	glog.V(3).Info("try get container list for pod ", id)
@@ -221,6 +298,22 @@ func (d *DaemonDB) PrefixListKey(prefix []byte, keyFilter KeyFilter) ([][]byte,
 	return results, err
 }
 
+func (d *DaemonDB) PrefixListPairs(prefix []byte, keyFilter KeyFilter) ([]*KVPair, error) {
+	var results []*KVPair
+	iter := d.db.NewIterator(util.BytesPrefix(prefix), nil)
+	for iter.Next() {
+		if keyFilter == nil || keyFilter(iter.Key()) {
+			results = append(results, &KVPair{
+				K: append([]byte{}, iter.Key()...),
+				V: append([]byte{}, iter.Value()...),
+			})
+		}
+	}
+	iter.Release()
+	err := iter.Error()
+	return results, err
+}
+
 func (d *DaemonDB) PrefixList2Chan(prefix []byte, keyFilter KeyFilter) chan *KVPair {
 	ch := make(chan *KVPair, 128)
 	if ch == nil {
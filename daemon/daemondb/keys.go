@@ -10,11 +10,20 @@ const (
 	POD_VM_KEY        = "vm-%s"
 	POD_CONTAINER_KEY = "pod-container-%s"
 	POD_VOLUME_KEY    = "vol-%s-%s"
+	NAMED_VOLUME_KEY  = "named-vol-%s"
+	NETWORK_KEY       = "network-%s"
+	NETWORK_LEASE_KEY = "net-lease-%s/%s"
+	SNAPSHOT_KEY      = "volume-snapshot-%s/%s"
 
 	POD_PREFIX           = "pod-"
 	POD_CONTAINER_PREFIX = "pod-container-"
 	POD_VOLUME_PREFIX    = "vol-%s"
 	POD_VM_PREFIX        = "vm-"
+	NAMED_VOLUME_PREFIX  = "named-vol-"
+	NETWORK_PREFIX       = "network-"
+	NETWORK_LEASE_PREFIX = "net-lease-%s/"
+	SNAPSHOT_PREFIX      = "volume-snapshot-%s/"
+	ALL_SNAPSHOTS_PREFIX = "volume-snapshot-"
 )
 
 //the id is a vm id
@@ -55,3 +64,47 @@ func prefixP2V() []byte {
 func prefixVolume(podId string) []byte {
 	return []byte(fmt.Sprintf(POD_VOLUME_PREFIX, podId))
 }
+
+// the name is the name of a named volume
+// and the db content is the volume info
+func keyNamedVolume(name string) []byte {
+	return []byte(fmt.Sprintf(NAMED_VOLUME_KEY, name))
+}
+
+func prefixNamedVolume() []byte {
+	return []byte(NAMED_VOLUME_PREFIX)
+}
+
+// the name is the name of a user-defined network
+// and the db content is the network info
+func keyNetwork(name string) []byte {
+	return []byte(fmt.Sprintf(NETWORK_KEY, name))
+}
+
+func prefixNetwork() []byte {
+	return []byte(NETWORK_PREFIX)
+}
+
+// the lease of the ip in the network, the network names are dns labels,
+// so the "/" keeps the leases of the networks apart.
+// the db content is the lease
+func keyNetworkLease(name, ip string) []byte {
+	return []byte(fmt.Sprintf(NETWORK_LEASE_KEY, name, ip))
+}
+
+func prefixNetworkLease(name string) []byte {
+	return []byte(fmt.Sprintf(NETWORK_LEASE_PREFIX, name))
+}
+
+// the snapshot of the named volume, the db content is the snapshot info
+func keySnapshot(volume, name string) []byte {
+	return []byte(fmt.Sprintf(SNAPSHOT_KEY, volume, name))
+}
+
+func prefixSnapshot(volume string) []byte {
+	return []byte(fmt.Sprintf(SNAPSHOT_PREFIX, volume))
+}
+
+func prefixAllSnapshots() []byte {
+	return []byte(ALL_SNAPSHOTS_PREFIX)
+}
@@ -0,0 +1,136 @@
+package daemon
+
+import (
+	"net"
+	"strings"
+
+	"github.com/golang/glog"
+	"github.com/hyperhq/hyperd/daemon/nameserver"
+	"github.com/hyperhq/hyperd/daemon/pod"
+	apitypes "github.com/hyperhq/hyperd/types"
+	"github.com/hyperhq/runv/hypervisor/network"
+)
+
+// dnsResolver resolves the names of the nameserver from the PodList
+type dnsResolver struct {
+	daemon *Daemon
+}
+
+// initDNS starts the DNS server on the bridge ip, the pods are not configured
+// with it if it failed to start. The search domains and the options of the
+// host are kept for the pods along with the pod domain.
+func (daemon *Daemon) initDNS(c *apitypes.HyperConfig, ip net.IP) {
+	if c.DisableDNS {
+		return
+	}
+
+	upstreams, err := nameserver.Upstreams("/etc/resolv.conf", ip.String())
+	if err != nil {
+		glog.Warningf("failed to read the nameservers of the host, the queries out of the pod domain are refused: %v", err)
+	}
+	s := nameserver.New(ip.String(), c.DNSDomain, upstreams, &dnsResolver{daemon: daemon})
+	if err = s.Start(); err != nil {
+		glog.Errorf("failed to start dns server on %s: %v", ip, err)
+		return
+	}
+	daemon.dns = s
+	glog.Infof("dns server of %s started on %s, upstreams: %v", s.Domain(), s.IP(), s.Upstreams())
+
+	search, options, err := nameserver.SearchOptions("/etc/resolv.conf")
+	if err != nil {
+		glog.Warningf("failed to read the search domains of the host: %v", err)
+	}
+	daemon.podDNS = &pod.DNSConfig{
+		Servers: []string{s.IP()},
+		Search:  append([]string{s.Domain()}, search...),
+		Options: options,
+	}
+}
+
+// getPod finds the pod by the lower cased name in the query
+func (r *dnsResolver) getPod(name string) *pod.XPod {
+	if p, ok := r.daemon.PodList.Get(name); ok {
+		return p
+	}
+	return r.daemon.PodList.Find(func(p *pod.XPod) bool {
+		return strings.ToLower(p.Id()) == name
+	})
+}
+
+func podIPs(p *pod.XPod) []net.IP {
+	var ips []net.IP
+	ip4, ip6 := p.Addresses()
+	for _, addr := range []string{ip4, ip6} {
+		if ip := net.ParseIP(addr); ip != nil {
+			ips = append(ips, ip)
+		}
+	}
+	return ips
+}
+
+func (r *dnsResolver) LookupPod(name string) ([]net.IP, bool) {
+	p := r.getPod(name)
+	if p == nil {
+		return nil, false
+	}
+	return podIPs(p), true
+}
+
+func (r *dnsResolver) LookupContainer(container, podName string) ([]net.IP, bool) {
+	p := r.getPod(podName)
+	if p == nil {
+		return nil, false
+	}
+	for _, name := range p.ContainerNames() {
+		if strings.ToLower(name) == container {
+			return podIPs(p), true
+		}
+	}
+	return nil, false
+}
+
+// LookupService gets the VIPs of the services with the name in all the
+// running pods.
+func (r *dnsResolver) LookupService(name string) ([]net.IP, bool) {
+	// the services are not got inside the PodList lock, which is taken by
+	// the pods with the resourceLock held
+	var pods []*pod.XPod
+	r.daemon.PodList.Foreach(func(p *pod.XPod) error {
+		pods = append(pods, p)
+		return nil
+	})
+
+	var (
+		ips   []net.IP
+		found bool
+	)
+	for _, p := range pods {
+		if !p.IsRunning() {
+			continue
+		}
+		srvs, _ := p.GetServices()
+		for _, srv := range srvs {
+			if srv.Name != name {
+				continue
+			}
+			found = true
+			if ip := net.ParseIP(srv.ServiceIP); ip != nil {
+				ips = append(ips, ip)
+			}
+		}
+	}
+	return ips, found
+}
+
+// Subnets returns the subnets of the default bridge and the user-defined
+// networks.
+func (r *dnsResolver) Subnets() []*net.IPNet {
+	subnets := []*net.IPNet{network.BridgeIPv4Net, network.BridgeIPv6Net}
+
+	r.daemon.networks.Lock()
+	defer r.daemon.networks.Unlock()
+	for _, un := range r.daemon.networks.nets {
+		subnets = append(subnets, un.subnet)
+	}
+	return subnets
+}
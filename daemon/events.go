@@ -0,0 +1,13 @@
+package daemon
+
+import (
+	"github.com/hyperhq/hyperd/daemon/events"
+	apitypes "github.com/hyperhq/hyperd/types"
+)
+
+// SubscribeEvents subscribes the lifecycle events of pods and containers,
+// the events after since (unix nano) kept in the event ring are returned
+// for replay.
+func (daemon *Daemon) SubscribeEvents(filter *events.Filter, since int64) ([]*apitypes.Event, <-chan *apitypes.Event, func()) {
+	return daemon.Events.Subscribe(filter, since)
+}
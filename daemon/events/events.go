@@ -0,0 +1,190 @@
+package events
+
+import (
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/golang/glog"
+	apitypes "github.com/hyperhq/hyperd/types"
+)
+
+const (
+	POD_CREATE         = "pod.create"
+	POD_START          = "pod.start"
+	POD_STOP           = "pod.stop"
+	POD_REMOVE         = "pod.remove"
+	CONTAINER_START    = "container.start"
+	CONTAINER_EXIT     = "container.exit"
+	EXEC_START         = "exec.start"
+	EXEC_EXIT          = "exec.exit"
+	SANDBOX_CRASH      = "sandbox.crash"
+	PORTMAPPING_ADD    = "portmapping.add"
+	PORTMAPPING_DELETE = "portmapping.delete"
+	INTERFACE_ADD      = "interface.add"
+	INTERFACE_REMOVE   = "interface.remove"
+
+	// DefaultRingSize is the number of the latest events kept for replay
+	DefaultRingSize = 1024
+	// events will be dropped for a subscriber if it can not catch up
+	subscriberBuffer = 256
+)
+
+// Filter selects the events delivered to a subscriber, an empty field
+// matches everything.
+type Filter struct {
+	PodIDs       []string
+	ContainerIDs []string
+	// a type matches either the exact event type or the prefix before the
+	// dot, i.e. "pod" matches all the pod events.
+	Types []string
+}
+
+func (f *Filter) Match(ev *apitypes.Event) bool {
+	if f == nil {
+		return true
+	}
+	if len(f.PodIDs) > 0 && !contains(f.PodIDs, ev.PodID) {
+		return false
+	}
+	if len(f.ContainerIDs) > 0 && !contains(f.ContainerIDs, ev.ContainerID) {
+		return false
+	}
+	if len(f.Types) > 0 {
+		matched := false
+		for _, t := range f.Types {
+			if t == ev.Type || strings.HasPrefix(ev.Type, t+".") {
+				matched = true
+				break
+			}
+		}
+		if !matched {
+			return false
+		}
+	}
+	return true
+}
+
+func contains(list []string, s string) bool {
+	for _, l := range list {
+		if l == s {
+			return true
+		}
+	}
+	return false
+}
+
+type subscriber struct {
+	filter *Filter
+	ch     chan *apitypes.Event
+}
+
+// Events is the event hub of the daemon. It keeps the latest events in a
+// bounded ring and broadcasts new events to the subscribers.
+type Events struct {
+	ring  []*apitypes.Event
+	next  int
+	full  bool
+	subs  map[*subscriber]struct{}
+	mutex sync.Mutex
+}
+
+func New(size int) *Events {
+	if size <= 0 {
+		size = DefaultRingSize
+	}
+	return &Events{
+		ring: make([]*apitypes.Event, size),
+		subs: make(map[*subscriber]struct{}),
+	}
+}
+
+// Publish records an event and delivers it to the subscribers. It never
+// blocks, the event is dropped for the subscribers which are not reading.
+func (e *Events) Publish(ev *apitypes.Event) {
+	if e == nil || ev == nil {
+		return
+	}
+	if ev.Timestamp == 0 {
+		ev.Timestamp = time.Now().UnixNano()
+	}
+
+	e.mutex.Lock()
+	defer e.mutex.Unlock()
+
+	e.ring[e.next] = ev
+	e.next = (e.next + 1) % len(e.ring)
+	if e.next == 0 {
+		e.full = true
+	}
+
+	for s := range e.subs {
+		if !s.filter.Match(ev) {
+			continue
+		}
+		select {
+		case s.ch <- ev:
+		default:
+			glog.Warningf("event subscriber is too slow, drop event %s of %s", ev.Type, ev.PodID)
+		}
+	}
+}
+
+// Log is a shortcut to Publish an event with the given fields.
+func (e *Events) Log(typ, podId, containerId, execId string, exitCode int, attributes map[string]string) {
+	e.Publish(&apitypes.Event{
+		Type:        typ,
+		PodID:       podId,
+		ContainerID: containerId,
+		ExecID:      execId,
+		ExitCode:    int32(exitCode),
+		Attributes:  attributes,
+	})
+}
+
+// Subscribe returns the buffered events happened after since (unix nano,
+// 0 means no replay), and a channel of the following events. The channel
+// is closed after cancel is called.
+func (e *Events) Subscribe(filter *Filter, since int64) ([]*apitypes.Event, <-chan *apitypes.Event, func()) {
+	s := &subscriber{
+		filter: filter,
+		ch:     make(chan *apitypes.Event, subscriberBuffer),
+	}
+
+	e.mutex.Lock()
+	var replay []*apitypes.Event
+	if since > 0 {
+		replay = e.history(filter, since)
+	}
+	e.subs[s] = struct{}{}
+	e.mutex.Unlock()
+
+	cancel := func() {
+		e.mutex.Lock()
+		defer e.mutex.Unlock()
+		if _, ok := e.subs[s]; ok {
+			delete(e.subs, s)
+			close(s.ch)
+		}
+	}
+	return replay, s.ch, cancel
+}
+
+// history should be called with the mutex held
+func (e *Events) history(filter *Filter, since int64) []*apitypes.Event {
+	var (
+		res   = []*apitypes.Event{}
+		start = 0
+		count = e.next
+	)
+	if e.full {
+		start, count = e.next, len(e.ring)
+	}
+	for i := 0; i < count; i++ {
+		ev := e.ring[(start+i)%len(e.ring)]
+		if ev.Timestamp > since && filter.Match(ev) {
+			res = append(res, ev)
+		}
+	}
+	return res
+}
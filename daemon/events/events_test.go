@@ -0,0 +1,106 @@
+package events
+
+import (
+	"reflect"
+	"testing"
+
+	apitypes "github.com/hyperhq/hyperd/types"
+)
+
+func TestFilterMatch(t *testing.T) {
+	ev := &apitypes.Event{Type: CONTAINER_EXIT, PodID: "pod-a", ContainerID: "c1"}
+
+	cases := []struct {
+		name   string
+		filter *Filter
+		match  bool
+	}{
+		{"nil filter", nil, true},
+		{"empty filter", &Filter{}, true},
+		{"pod", &Filter{PodIDs: []string{"pod-b", "pod-a"}}, true},
+		{"other pod", &Filter{PodIDs: []string{"pod-b"}}, false},
+		{"container", &Filter{ContainerIDs: []string{"c1"}}, true},
+		{"other container", &Filter{ContainerIDs: []string{"c2"}}, false},
+		{"exact type", &Filter{Types: []string{CONTAINER_EXIT}}, true},
+		{"type prefix", &Filter{Types: []string{"container"}}, true},
+		{"partial prefix", &Filter{Types: []string{"cont"}}, false},
+		{"other type", &Filter{Types: []string{"pod", EXEC_EXIT}}, false},
+		{"all fields", &Filter{PodIDs: []string{"pod-a"}, ContainerIDs: []string{"c1"}, Types: []string{"container"}}, true},
+		{"one field mismatch", &Filter{PodIDs: []string{"pod-a"}, ContainerIDs: []string{"c1"}, Types: []string{"pod"}}, false},
+	}
+	for _, c := range cases {
+		if got := c.filter.Match(ev); got != c.match {
+			t.Errorf("%s: match %v, expect %v", c.name, got, c.match)
+		}
+	}
+}
+
+func eventNames(evs []*apitypes.Event) []string {
+	res := []string{}
+	for _, ev := range evs {
+		res = append(res, ev.Type+"@"+ev.PodID)
+	}
+	return res
+}
+
+func TestReplay(t *testing.T) {
+	publish := func(e *Events, n int) {
+		for i := 1; i <= n; i++ {
+			e.Publish(&apitypes.Event{Type: POD_START, PodID: string('a' + rune(i-1)), Timestamp: int64(i)})
+		}
+	}
+
+	cases := []struct {
+		name   string
+		size   int
+		events int
+		since  int64
+		filter *Filter
+		expect []string
+	}{
+		{"no replay", 4, 3, 0, nil, nil},
+		{"negative since", 4, 3, -1, nil, nil},
+		{"since", 4, 3, 1, nil, []string{"pod.start@b", "pod.start@c"}},
+		{"since latest", 4, 3, 3, nil, []string{}},
+		{"ring full", 4, 4, 1, nil, []string{"pod.start@b", "pod.start@c", "pod.start@d"}},
+		{"ring wrapped", 4, 6, 1, nil, []string{"pod.start@c", "pod.start@d", "pod.start@e", "pod.start@f"}},
+		{"wrapped since", 4, 6, 4, nil, []string{"pod.start@e", "pod.start@f"}},
+		{"filtered", 4, 6, 1, &Filter{PodIDs: []string{"b", "d"}}, []string{"pod.start@d"}},
+	}
+	for _, c := range cases {
+		e := New(c.size)
+		publish(e, c.events)
+		replay, _, cancel := e.Subscribe(c.filter, c.since)
+		cancel()
+		if c.expect == nil {
+			if replay != nil {
+				t.Errorf("%s: unexpected replay %v", c.name, eventNames(replay))
+			}
+			continue
+		}
+		if got := eventNames(replay); !reflect.DeepEqual(got, c.expect) {
+			t.Errorf("%s: replay %v, expect %v", c.name, got, c.expect)
+		}
+	}
+}
+
+func TestSubscribe(t *testing.T) {
+	e := New(4)
+	_, ch, cancel := e.Subscribe(&Filter{Types: []string{"exec"}}, 0)
+
+	e.Log(POD_START, "p", "", "", 0, nil)
+	e.Log(EXEC_EXIT, "p", "c", "e", 3, nil)
+
+	ev := <-ch
+	if ev.Type != EXEC_EXIT || ev.ExitCode != 3 || ev.Timestamp == 0 {
+		t.Errorf("unexpected event %v", ev)
+	}
+
+	cancel()
+	cancel()
+	if _, ok := <-ch; ok {
+		t.Error("channel is not closed after cancel")
+	}
+	// publishing after cancel must not panic on the closed channel
+	e.Log(EXEC_START, "p", "c", "e", 0, nil)
+}
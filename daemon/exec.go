@@ -5,6 +5,7 @@ import (
 	"io"
 
 	"github.com/golang/glog"
+	"github.com/hyperhq/hyperd/daemon/pod"
 )
 
 func (daemon *Daemon) ExitCode(containerId, execId string) (int, error) {
@@ -22,7 +23,7 @@ func (daemon *Daemon) ExitCode(containerId, execId string) (int, error) {
 	return int(code), err
 }
 
-func (daemon *Daemon) CreateExec(containerId, cmd string, terminal bool) (string, error) {
+func (daemon *Daemon) CreateExec(containerId, cmd string, terminal bool, opts *pod.ExecOptions) (string, error) {
 
 	p, id, ok := daemon.PodList.GetByContainerIdOrName(containerId)
 	if !ok {
@@ -32,10 +33,10 @@ func (daemon *Daemon) CreateExec(containerId, cmd string, terminal bool) (string
 	}
 
 	glog.V(1).Infof("Create Exec for container %s", containerId)
-	return p.CreateExec(id, cmd, terminal)
+	return p.CreateExec(id, cmd, terminal, opts)
 }
 
-func (daemon *Daemon) StartExec(stdin io.ReadCloser, stdout io.WriteCloser, containerId, execId string) error {
+func (daemon *Daemon) StartExec(stdin io.ReadCloser, stdout io.WriteCloser, stderr io.Writer, containerId, execId string) error {
 	p, id, ok := daemon.PodList.GetByContainerIdOrName(containerId)
 	if !ok {
 		err := fmt.Errorf("cannot find container %s", containerId)
@@ -44,7 +45,7 @@ func (daemon *Daemon) StartExec(stdin io.ReadCloser, stdout io.WriteCloser, cont
 	}
 
 	glog.V(1).Infof("Start Exec for container %s", containerId)
-	return p.StartExec(stdin, stdout, id, execId)
+	return p.StartExec(stdin, stdout, stderr, id, execId)
 }
 
 func (daemon *Daemon) KillExec(containerId string, execId string, signal int64) error {
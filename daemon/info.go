@@ -22,6 +22,22 @@ func (daemon *Daemon) GetPodInfo(podName string) (*types.PodInfo, error) {
 	return p.Info()
 }
 
+// GetPodInfoWithVolumeUsage gets the pod info with the usage of the volumes,
+// which is only collected on request, as it may run commands in the sandbox.
+func (daemon *Daemon) GetPodInfoWithVolumeUsage(podName string) (*types.PodInfo, error) {
+	p, ok := daemon.PodList.Get(podName)
+	if !ok {
+		return &types.PodInfo{}, fmt.Errorf("Can not get Pod info with pod ID(%s)", podName)
+	}
+
+	info, err := p.Info()
+	if err != nil {
+		return info, err
+	}
+	p.UpdateVolumeUsage(info.Spec.Volumes)
+	return info, nil
+}
+
 func (daemon *Daemon) GetPodStats(podId string) (interface{}, error) {
 	var (
 		p  *pod.XPod
@@ -2,14 +2,102 @@ package daemon
 
 import (
 	"fmt"
+	"strings"
 
 	"github.com/hyperhq/hypercontainer-utils/hlog"
 	"github.com/hyperhq/hyperd/daemon/pod"
+	"github.com/hyperhq/hyperd/lib/selector"
 	apitypes "github.com/hyperhq/hyperd/types"
 )
 
 type pMatcher func(p *pod.XPod) (match, quit bool)
 
+// listFilter is the parsed apitypes.ListFilter, a nil listFilter matches
+// everything.
+type listFilter struct {
+	selector selector.Selector
+	status   map[string]bool
+	images   []string
+}
+
+func newListFilter(f *apitypes.ListFilter) (*listFilter, error) {
+	if f == nil || (f.LabelSelector == "" && len(f.Status) == 0 && len(f.Image) == 0) {
+		return nil, nil
+	}
+	sel, err := selector.Parse(f.LabelSelector)
+	if err != nil {
+		return nil, err
+	}
+	lf := &listFilter{
+		selector: sel,
+		images:   f.Image,
+	}
+	if len(f.Status) > 0 {
+		lf.status = make(map[string]bool, len(f.Status))
+		for _, s := range f.Status {
+			lf.status[strings.ToLower(s)] = true
+		}
+	}
+	return lf, nil
+}
+
+// imageMatches returns whether the image is the pattern, or the pattern is
+// the image without the tag or digest.
+func imageMatches(image, pattern string) bool {
+	if image == pattern {
+		return true
+	}
+	if i := strings.LastIndex(image, "@"); i >= 0 {
+		image = image[:i]
+	} else if i := strings.LastIndex(image, ":"); i > strings.LastIndex(image, "/") {
+		image = image[:i]
+	}
+	return image == pattern
+}
+
+func (f *listFilter) match(labels map[string]string, status string, images []string) bool {
+	if f == nil {
+		return true
+	}
+	if len(f.status) > 0 && !f.status[status] {
+		return false
+	}
+	if !f.selector.Empty() && !f.selector.Matches(labels) {
+		return false
+	}
+	if len(f.images) > 0 {
+		for _, pattern := range f.images {
+			for _, image := range images {
+				if imageMatches(image, pattern) {
+					return true
+				}
+			}
+		}
+		return false
+	}
+	return true
+}
+
+func (f *listFilter) matchPod(p *pod.XPod, status string) bool {
+	if f == nil {
+		return true
+	}
+	var images []string
+	if len(f.images) > 0 {
+		for _, cid := range p.ContainerIds() {
+			images = append(images, p.ContainerImage(cid))
+		}
+	}
+	return f.match(p.Labels(), status, images)
+}
+
+func (f *listFilter) matchContainer(p *pod.XPod, cid, status string) bool {
+	if f == nil {
+		return true
+	}
+	return f.match(p.ContainerLabels(cid), status, []string{p.ContainerImage(cid)})
+}
+
 func (daemon *Daemon) snapshotPodList(podId, vmId string) []*pod.XPod {
 	var (
 		pl = []*pod.XPod{}
@@ -43,15 +131,19 @@ func (daemon *Daemon) snapshotPodList(podId, vmId string) []*pod.XPod {
 	return pl
 }
 
-func (daemon *Daemon) ListContainers(podId, vmId string) ([]*apitypes.ContainerListResult, error) {
+func (daemon *Daemon) ListContainers(podId, vmId string, filter *apitypes.ListFilter) ([]*apitypes.ContainerListResult, error) {
 	var (
 		result = []*apitypes.ContainerListResult{}
 	)
+	f, err := newListFilter(filter)
+	if err != nil {
+		return nil, err
+	}
 	pl := daemon.snapshotPodList(podId, vmId)
 	for _, p := range pl {
 		for _, cid := range p.ContainerIds() {
 			status := p.ContainerBriefStatus(cid)
-			if status != nil {
+			if status != nil && f.matchContainer(p, cid, status.Status) {
 				result = append(result, status)
 			}
 		}
@@ -59,22 +151,30 @@ func (daemon *Daemon) ListContainers(podId, vmId string) ([]*apitypes.ContainerL
 	return result, nil
 }
 
-func (daemon *Daemon) ListPods(podId, vmId string) ([]*apitypes.PodListResult, error) {
+func (daemon *Daemon) ListPods(podId, vmId string, filter *apitypes.ListFilter) ([]*apitypes.PodListResult, error) {
+	f, err := newListFilter(filter)
+	if err != nil {
+		return nil, err
+	}
 	pl := daemon.snapshotPodList(podId, vmId)
 	result := make([]*apitypes.PodListResult, 0, len(pl))
 	for _, p := range pl {
-		if s := p.BriefStatus(); s != nil {
+		if s := p.BriefStatus(); s != nil && f.matchPod(p, s.Status) {
 			result = append(result, s)
 		}
 	}
 	return result, nil
 }
 
-func (daemon *Daemon) ListVMs(podId, vmId string) ([]*apitypes.VMListResult, error) {
+func (daemon *Daemon) ListVMs(podId, vmId string, filter *apitypes.ListFilter) ([]*apitypes.VMListResult, error) {
+	f, err := newListFilter(filter)
+	if err != nil {
+		return nil, err
+	}
 	pl := daemon.snapshotPodList(podId, vmId)
 	result := make([]*apitypes.VMListResult, 0, len(pl))
 	for _, p := range pl {
-		if s := p.SandboxBriefStatus(); s != nil {
+		if s := p.SandboxBriefStatus(); s != nil && f.matchPod(p, s.Status) {
 			result = append(result, s)
 		}
 	}
@@ -82,7 +182,7 @@ func (daemon *Daemon) ListVMs(podId, vmId string) ([]*apitypes.VMListResult, err
 	return result, nil
 }
 
-func (daemon *Daemon) List(item, podId, vmId string) (map[string][]string, error) {
+func (daemon *Daemon) List(item, podId, vmId string, filter *apitypes.ListFilter) (map[string][]string, error) {
 	var (
 		pl = []*pod.XPod{}
 
@@ -96,6 +196,11 @@ func (daemon *Daemon) List(item, podId, vmId string) (map[string][]string, error
 		return list, fmt.Errorf("Can not support %s list!", item)
 	}
 
+	f, err := newListFilter(filter)
+	if err != nil {
+		return list, err
+	}
+
 	pl = daemon.snapshotPodList(podId, vmId)
 
 	for _, p := range pl {
@@ -109,11 +214,20 @@ func (daemon *Daemon) List(item, podId, vmId string) (map[string][]string, error
 			if vm == "" {
 				continue
 			}
+			if s := p.SandboxBriefStatus(); s == nil || !f.matchPod(p, s.Status) {
+				continue
+			}
 			vmJsonResponse = append(vmJsonResponse, p.SandboxStatusString())
 		case "pod":
+			if !f.matchPod(p, p.BriefStatus().Status) {
+				continue
+			}
 			podJsonResponse = append(podJsonResponse, p.StatusString())
 		case "container":
 			for _, cid := range p.ContainerIds() {
+				if s := p.ContainerBriefStatus(cid); s == nil || !f.matchContainer(p, cid, s.Status) {
+					continue
+				}
 				status := p.ContainerStatusString(cid)
 				if status != "" {
 					containerJsonResponse = append(containerJsonResponse, status)
@@ -0,0 +1,203 @@
+package daemon
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/golang/glog"
+	"github.com/hyperhq/hyperd/daemon/pod"
+	"github.com/hyperhq/hyperd/lib/metrics"
+	"github.com/hyperhq/hyperd/networking/portmapping"
+	"github.com/hyperhq/runv/factory/cache"
+	runvtypes "github.com/hyperhq/runv/hypervisor/types"
+)
+
+// collectMetrics reports the series which are known only at scrape time,
+// i.e. the pod counts, the vm cache counters and the resource usage of the
+// running pods.
+func (daemon *Daemon) collectMetrics() []*metrics.Family {
+	pods := metrics.NewFamily("hyperd_pods", "Number of pods by state.", metrics.Gauge)
+	for s := pod.S_POD_NONE; s <= pod.S_POD_ERROR; s++ {
+		pods.Add(float64(daemon.PodList.CountStatus(s)), "state", s.String())
+	}
+
+	hits, misses := cache.Stats()
+	cacheHits := metrics.NewFamily("hyperd_vm_factory_cache_hits_total", "Number of vms taken from the vm factory cache without waiting.", metrics.Counter)
+	cacheHits.Add(float64(hits))
+	cacheMisses := metrics.NewFamily("hyperd_vm_factory_cache_misses_total", "Number of vm requests waiting for the vm factory cache to boot a vm.", metrics.Counter)
+	cacheMisses.Add(float64(misses))
+
+	families := append([]*metrics.Family{pods, cacheHits, cacheMisses}, collectProxyStats()...)
+	return append(families, daemon.collectPodStats()...)
+}
+
+// collectProxyStats reports the counters of the userland port mapping
+// proxies, there is none unless the userland backend is used.
+func collectProxyStats() []*metrics.Family {
+	stats := portmapping.ProxyStats()
+	if len(stats) == 0 {
+		return nil
+	}
+	active := metrics.NewFamily("hyperd_portmapping_proxy_active_connections", "Number of the active connections, or UDP sessions, of the userland proxies.", metrics.Gauge)
+	total := metrics.NewFamily("hyperd_portmapping_proxy_connections_total", "Number of the connections, or UDP sessions, accepted by the userland proxies.", metrics.Counter)
+	failed := metrics.NewFamily("hyperd_portmapping_proxy_failed_connections_total", "Number of the connections failed to forward to the container.", metrics.Counter)
+	bytes := metrics.NewFamily("hyperd_portmapping_proxy_bytes_total", "Bytes forwarded by the userland proxies, in is to the container.", metrics.Counter)
+	for _, s := range stats {
+		labels := []string{"protocol", s.Protocol, "frontend", s.Frontend, "backend", s.Backend}
+		active.Add(float64(s.ActiveConnections), labels...)
+		total.Add(float64(s.TotalConnections), labels...)
+		failed.Add(float64(s.FailedConnections), labels...)
+		bytes.Add(float64(s.BytesIn), append(labels, "direction", "in")...)
+		bytes.Add(float64(s.BytesOut), append(labels, "direction", "out")...)
+	}
+	return []*metrics.Family{active, total, failed, bytes}
+}
+
+// statsFamilies builds the per-pod families, the families are created on
+// their first sample.
+type statsFamilies struct {
+	families map[string]*metrics.Family
+	result   []*metrics.Family
+}
+
+func (sf *statsFamilies) add(name, help string, typ metrics.MetricType, value float64, labels []string, extra ...string) {
+	f, ok := sf.families[name]
+	if !ok {
+		f = metrics.NewFamily(name, help, typ)
+		sf.families[name] = f
+		sf.result = append(sf.result, f)
+	}
+	f.Add(value, append(append([]string{}, labels...), extra...)...)
+}
+
+func (daemon *Daemon) collectPodStats() []*metrics.Family {
+	var running []*pod.XPod
+	daemon.PodList.Foreach(func(p *pod.XPod) error {
+		if p.IsRunning() {
+			running = append(running, p)
+		}
+		return nil
+	})
+
+	sf := &statsFamilies{families: map[string]*metrics.Family{}}
+	all := podStatsWithin(running, podStatsTimeout, (*pod.XPod).Stats)
+	for i, p := range running {
+		stats := all[i]
+		if stats == nil {
+			continue
+		}
+		labels := podMetricLabels(p)
+		addResourceStats(sf, append(labels, "container", ""), stats.Cpu, stats.Memory, stats.Block, stats.Network, stats.Filesystem)
+		for _, cs := range stats.ContainersStats {
+			addResourceStats(sf, append(labels, "container", p.ContainerId2Name(cs.ContainerID)), cs.Cpu, cs.Memory, cs.Block, cs.Network, cs.Filesystem)
+		}
+	}
+	return sf.result
+}
+
+// podStatsTimeout bounds the time of a scrape waiting for the sandboxes
+const podStatsTimeout = 5 * time.Second
+
+// podStatsWithin gets the stats of the pods in parallel, the stats of a pod
+// not reported within the timeout are left nil, so that a stuck sandbox
+// does not block the scrape.
+func podStatsWithin(pods []*pod.XPod, timeout time.Duration, stats func(*pod.XPod) *runvtypes.PodStats) []*runvtypes.PodStats {
+	type result struct {
+		i     int
+		stats *runvtypes.PodStats
+	}
+	// buffered, so that the late ones do not block after the timeout
+	ch := make(chan result, len(pods))
+	for i, p := range pods {
+		go func(i int, p *pod.XPod) {
+			ch <- result{i, stats(p)}
+		}(i, p)
+	}
+
+	all := make([]*runvtypes.PodStats, len(pods))
+	reported := make([]bool, len(pods))
+	deadline := time.NewTimer(timeout)
+	defer deadline.Stop()
+	for n := 0; n < len(pods); n++ {
+		select {
+		case r := <-ch:
+			all[r.i] = r.stats
+			reported[r.i] = true
+		case <-deadline.C:
+			for i, p := range pods {
+				if !reported[i] {
+					glog.Warningf("stats of pod %s are not reported in %v, skip it in metrics", p.Id(), timeout)
+				}
+			}
+			return all
+		}
+	}
+	return all
+}
+
+// podMetricLabels returns the pod name and the pod labels, which are
+// prefixed with "label_", as the label pairs of the series of the pod.
+func podMetricLabels(p *pod.XPod) []string {
+	podLabels := p.Labels()
+	names := make([]string, 0, len(podLabels))
+	values := make(map[string]string, len(podLabels))
+	for k, v := range podLabels {
+		name := "label_" + metrics.SanitizeLabelName(k)
+		if _, ok := values[name]; !ok {
+			names = append(names, name)
+		}
+		values[name] = v
+	}
+	sort.Strings(names)
+
+	labels := []string{"pod", p.Id()}
+	for _, name := range names {
+		labels = append(labels, name, values[name])
+	}
+	return labels
+}
+
+func addResourceStats(sf *statsFamilies, labels []string, cpu runvtypes.CpuStats, mem runvtypes.MemoryStats, blk runvtypes.BlkioStats, net runvtypes.NetworkStats, fs []runvtypes.FsStats) {
+	const ns = 1e9
+
+	sf.add("hyperd_pod_cpu_usage_seconds_total", "Cumulative cpu time consumed.", metrics.Counter, float64(cpu.Usage.Total)/ns, labels)
+	sf.add("hyperd_pod_cpu_user_seconds_total", "Cumulative cpu time consumed in user space.", metrics.Counter, float64(cpu.Usage.User)/ns, labels)
+	sf.add("hyperd_pod_cpu_system_seconds_total", "Cumulative cpu time consumed in kernel space.", metrics.Counter, float64(cpu.Usage.System)/ns, labels)
+
+	sf.add("hyperd_pod_memory_usage_bytes", "Current memory usage.", metrics.Gauge, float64(mem.Usage), labels)
+	sf.add("hyperd_pod_memory_working_set_bytes", "Current working set memory.", metrics.Gauge, float64(mem.WorkingSet), labels)
+	sf.add("hyperd_pod_memory_failures_total", "Number of times the memory usage hits the limit.", metrics.Counter, float64(mem.Failcnt), labels)
+
+	for _, e := range blk.IoServiceBytesRecursive {
+		for op, v := range e.Stat {
+			sf.add("hyperd_pod_blkio_bytes_total", "Cumulative bytes transferred to and from the block devices.", metrics.Counter, float64(v), labels,
+				"device", fmt.Sprintf("%d:%d", e.Major, e.Minor), "operation", op)
+		}
+	}
+	for _, e := range blk.IoServicedRecursive {
+		for op, v := range e.Stat {
+			sf.add("hyperd_pod_blkio_operations_total", "Cumulative I/O operations on the block devices.", metrics.Counter, float64(v), labels,
+				"device", fmt.Sprintf("%d:%d", e.Major, e.Minor), "operation", op)
+		}
+	}
+
+	for _, i := range net.Interfaces {
+		sf.add("hyperd_pod_network_receive_bytes_total", "Cumulative bytes received.", metrics.Counter, float64(i.RxBytes), labels, "interface", i.Name)
+		sf.add("hyperd_pod_network_receive_packets_total", "Cumulative packets received.", metrics.Counter, float64(i.RxPackets), labels, "interface", i.Name)
+		sf.add("hyperd_pod_network_receive_errors_total", "Cumulative errors encountered while receiving.", metrics.Counter, float64(i.RxErrors), labels, "interface", i.Name)
+		sf.add("hyperd_pod_network_receive_packets_dropped_total", "Cumulative packets dropped while receiving.", metrics.Counter, float64(i.RxDropped), labels, "interface", i.Name)
+		sf.add("hyperd_pod_network_transmit_bytes_total", "Cumulative bytes transmitted.", metrics.Counter, float64(i.TxBytes), labels, "interface", i.Name)
+		sf.add("hyperd_pod_network_transmit_packets_total", "Cumulative packets transmitted.", metrics.Counter, float64(i.TxPackets), labels, "interface", i.Name)
+		sf.add("hyperd_pod_network_transmit_errors_total", "Cumulative errors encountered while transmitting.", metrics.Counter, float64(i.TxErrors), labels, "interface", i.Name)
+		sf.add("hyperd_pod_network_transmit_packets_dropped_total", "Cumulative packets dropped while transmitting.", metrics.Counter, float64(i.TxDropped), labels, "interface", i.Name)
+	}
+
+	for _, f := range fs {
+		sf.add("hyperd_pod_fs_limit_bytes", "Number of bytes that can be consumed on the filesystem.", metrics.Gauge, float64(f.Limit), labels, "device", f.Device)
+		sf.add("hyperd_pod_fs_usage_bytes", "Number of bytes consumed on the filesystem.", metrics.Gauge, float64(f.Usage), labels, "device", f.Device)
+		sf.add("hyperd_pod_fs_available_bytes", "Number of bytes available for non-root user on the filesystem.", metrics.Gauge, float64(f.Available), labels, "device", f.Device)
+		sf.add("hyperd_pod_fs_reads_total", "Cumulative reads completed on the filesystem.", metrics.Counter, float64(f.ReadsCompleted), labels, "device", f.Device)
+		sf.add("hyperd_pod_fs_writes_total", "Cumulative writes completed on the filesystem.", metrics.Counter, float64(f.WritesCompleted), labels, "device", f.Device)
+	}
+}
@@ -0,0 +1,37 @@
+package daemon
+
+import (
+	"testing"
+	"time"
+
+	"github.com/hyperhq/hyperd/daemon/pod"
+	runvtypes "github.com/hyperhq/runv/hypervisor/types"
+)
+
+func TestPodStatsWithin(t *testing.T) {
+	pods := []*pod.XPod{{}, {}, {}}
+	block := make(chan struct{})
+	defer close(block)
+
+	start := time.Now()
+	all := podStatsWithin(pods, 100*time.Millisecond, func(p *pod.XPod) *runvtypes.PodStats {
+		switch p {
+		case pods[0]:
+			return &runvtypes.PodStats{Cpu: runvtypes.CpuStats{Usage: runvtypes.CpuUsage{Total: 1}}}
+		case pods[1]:
+			// a stuck sandbox
+			<-block
+		}
+		return nil
+	})
+	if elapsed := time.Since(start); elapsed > 5*time.Second {
+		t.Fatalf("stuck pod blocks for %v", elapsed)
+	}
+	if len(all) != 3 || all[0] == nil || all[0].Cpu.Usage.Total != 1 || all[1] != nil || all[2] != nil {
+		t.Fatalf("unexpected stats %v", all)
+	}
+
+	if all = podStatsWithin(nil, time.Second, nil); len(all) != 0 {
+		t.Fatalf("unexpected stats of no pod %v", all)
+	}
+}
@@ -0,0 +1,260 @@
+// Package nameserver is the DNS server of hyperd on the bridge ip.
+//
+// It answers the names of the pods, containers and services under its
+// domain, and forwards the other queries to the upstream nameservers. Only
+// the queries from the subnets of the bridges are served, so that it is not
+// an open resolver to the other hosts.
+package nameserver
+
+import (
+	"io/ioutil"
+	"net"
+	"strings"
+	"time"
+
+	"github.com/golang/glog"
+	"github.com/miekg/dns"
+)
+
+const (
+	DefaultDomain = "hyper"
+
+	// the names of the pods change along with the pods, they are not cached
+	// for long by the resolvers in the pods
+	ttl            = 10
+	forwardTimeout = 5 * time.Second
+	// <service>.svc.<domain> is the name of a service VIP
+	serviceLabel = "svc"
+)
+
+// Resolver looks up the names under the domain, the names are lower cased.
+// A name found without any address is answered with no records rather than
+// NXDOMAIN.
+type Resolver interface {
+	LookupPod(pod string) ([]net.IP, bool)
+	LookupContainer(container, pod string) ([]net.IP, bool)
+	LookupService(service string) ([]net.IP, bool)
+	// Subnets returns the subnets of the bridges, the queries from the
+	// other sources are refused.
+	Subnets() []*net.IPNet
+}
+
+type Server struct {
+	ip string
+	// fqdn, such as "hyper."
+	domain    string
+	upstreams []string
+	resolver  Resolver
+	servers   []*dns.Server
+}
+
+// New creates the server of the domain on ip, the upstreams are "host:port"
+// of the nameservers.
+func New(ip, domain string, upstreams []string, r Resolver) *Server {
+	domain = strings.Trim(strings.ToLower(domain), ".")
+	if domain == "" {
+		domain = DefaultDomain
+	}
+	return &Server{
+		ip:        ip,
+		domain:    dns.Fqdn(domain),
+		upstreams: upstreams,
+		resolver:  r,
+	}
+}
+
+// Upstreams reads the nameservers from a resolv.conf, except the ones in
+// exclude, in "host:port".
+func Upstreams(resolvconf string, exclude ...string) ([]string, error) {
+	conf, err := dns.ClientConfigFromFile(resolvconf)
+	if err != nil {
+		return nil, err
+	}
+	var upstreams []string
+next:
+	for _, ns := range conf.Servers {
+		for _, e := range exclude {
+			if ns == e {
+				continue next
+			}
+		}
+		upstreams = append(upstreams, net.JoinHostPort(ns, conf.Port))
+	}
+	return upstreams, nil
+}
+
+// SearchOptions reads the search domains and the options from a
+// resolv.conf, a "domain" line is searched if there is no "search" line.
+func SearchOptions(resolvconf string) (search, options []string, err error) {
+	content, err := ioutil.ReadFile(resolvconf)
+	if err != nil {
+		return nil, nil, err
+	}
+	var domain []string
+	for _, line := range strings.Split(string(content), "\n") {
+		fields := strings.Fields(line)
+		if len(fields) < 2 {
+			continue
+		}
+		switch fields[0] {
+		case "search":
+			search = fields[1:]
+		case "domain":
+			domain = fields[1:2]
+		case "options":
+			options = append(options, fields[1:]...)
+		}
+	}
+	if search == nil {
+		search = domain
+	}
+	return search, options, nil
+}
+
+func (s *Server) IP() string {
+	return s.ip
+}
+
+// Domain returns the domain without the trailing dot
+func (s *Server) Domain() string {
+	return strings.TrimSuffix(s.domain, ".")
+}
+
+func (s *Server) Upstreams() []string {
+	return s.upstreams
+}
+
+// Start listens on port 53 of the ip for both udp and tcp
+func (s *Server) Start() error {
+	addr := net.JoinHostPort(s.ip, "53")
+	pc, err := net.ListenPacket("udp", addr)
+	if err != nil {
+		return err
+	}
+	l, err := net.Listen("tcp", addr)
+	if err != nil {
+		pc.Close()
+		return err
+	}
+
+	s.servers = []*dns.Server{
+		{PacketConn: pc, Handler: s},
+		{Listener: l, Handler: s},
+	}
+	for _, srv := range s.servers {
+		go func(srv *dns.Server) {
+			if err := srv.ActivateAndServe(); err != nil {
+				glog.Errorf("dns server on %s stopped: %v", addr, err)
+			}
+		}(srv)
+	}
+	return nil
+}
+
+func (s *Server) Stop() {
+	for _, srv := range s.servers {
+		srv.Shutdown()
+	}
+}
+
+// allowed tells whether the client is in the subnets of the bridges
+func (s *Server) allowed(addr net.Addr) bool {
+	var ip net.IP
+	switch a := addr.(type) {
+	case *net.UDPAddr:
+		ip = a.IP
+	case *net.TCPAddr:
+		ip = a.IP
+	default:
+		return false
+	}
+	for _, subnet := range s.resolver.Subnets() {
+		if subnet != nil && subnet.Contains(ip) {
+			return true
+		}
+	}
+	return false
+}
+
+func (s *Server) ServeDNS(w dns.ResponseWriter, req *dns.Msg) {
+	if !s.allowed(w.RemoteAddr()) {
+		glog.V(3).Infof("refuse dns query from %v", w.RemoteAddr())
+		resp := new(dns.Msg)
+		w.WriteMsg(resp.SetRcode(req, dns.RcodeRefused))
+		return
+	}
+	if len(req.Question) != 1 {
+		resp := new(dns.Msg)
+		w.WriteMsg(resp.SetRcodeFormatError(req))
+		return
+	}
+	q := req.Question[0]
+	name := strings.ToLower(q.Name)
+	if q.Qclass != dns.ClassINET || !dns.IsSubDomain(s.domain, name) {
+		s.forward(w, req)
+		return
+	}
+
+	resp := new(dns.Msg)
+	resp.SetReply(req)
+	resp.Authoritative = true
+	ips, found := s.lookup(dns.SplitDomainName(strings.TrimSuffix(name, s.domain)))
+	if !found {
+		resp.SetRcode(req, dns.RcodeNameError)
+		w.WriteMsg(resp)
+		return
+	}
+	hdr := dns.RR_Header{Name: q.Name, Class: dns.ClassINET, Ttl: ttl}
+	for _, ip := range ips {
+		switch ip4 := ip.To4(); {
+		case q.Qtype == dns.TypeA && ip4 != nil:
+			hdr.Rrtype = dns.TypeA
+			resp.Answer = append(resp.Answer, &dns.A{Hdr: hdr, A: ip4})
+		case q.Qtype == dns.TypeAAAA && ip4 == nil:
+			hdr.Rrtype = dns.TypeAAAA
+			resp.Answer = append(resp.Answer, &dns.AAAA{Hdr: hdr, AAAA: ip})
+		}
+	}
+	w.WriteMsg(resp)
+}
+
+// lookup resolves the labels under the domain, <pod>, <container>.<pod> and
+// <service>.svc
+func (s *Server) lookup(labels []string) ([]net.IP, bool) {
+	switch {
+	case len(labels) == 0:
+		return nil, true
+	case len(labels) == 1:
+		return s.resolver.LookupPod(labels[0])
+	case len(labels) == 2 && labels[1] == serviceLabel:
+		return s.resolver.LookupService(labels[0])
+	case len(labels) == 2:
+		return s.resolver.LookupContainer(labels[0], labels[1])
+	}
+	return nil, false
+}
+
+// forward sends the query to the upstreams in turn, over the same protocol
+// it is received.
+func (s *Server) forward(w dns.ResponseWriter, req *dns.Msg) {
+	c := &dns.Client{Timeout: forwardTimeout}
+	if _, ok := w.RemoteAddr().(*net.TCPAddr); ok {
+		c.Net = "tcp"
+	}
+	for _, ns := range s.upstreams {
+		resp, _, err := c.Exchange(req, ns)
+		if err != nil {
+			glog.V(1).Infof("failed to forward dns query %s to %s: %v", req.Question[0].Name, ns, err)
+			continue
+		}
+		w.WriteMsg(resp)
+		return
+	}
+
+	resp := new(dns.Msg)
+	rcode := dns.RcodeServerFailure
+	if len(s.upstreams) == 0 {
+		rcode = dns.RcodeRefused
+	}
+	w.WriteMsg(resp.SetRcode(req, rcode))
+}
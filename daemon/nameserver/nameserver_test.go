@@ -0,0 +1,272 @@
+package nameserver
+
+import (
+	"io/ioutil"
+	"net"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/miekg/dns"
+)
+
+type fakeResolver struct {
+	pods       map[string][]net.IP
+	containers map[string][]net.IP
+	services   map[string][]net.IP
+	subnets    []*net.IPNet
+}
+
+func (r *fakeResolver) LookupPod(pod string) ([]net.IP, bool) {
+	ips, ok := r.pods[pod]
+	return ips, ok
+}
+
+func (r *fakeResolver) LookupContainer(container, pod string) ([]net.IP, bool) {
+	ips, ok := r.containers[container+"."+pod]
+	return ips, ok
+}
+
+func (r *fakeResolver) LookupService(service string) ([]net.IP, bool) {
+	ips, ok := r.services[service]
+	return ips, ok
+}
+
+func (r *fakeResolver) Subnets() []*net.IPNet {
+	return r.subnets
+}
+
+// recorder is a dns.ResponseWriter keeping the response
+type recorder struct {
+	dns.ResponseWriter
+	remote net.Addr
+	msg    *dns.Msg
+}
+
+func (w *recorder) RemoteAddr() net.Addr {
+	return w.remote
+}
+
+func (w *recorder) WriteMsg(m *dns.Msg) error {
+	w.msg = m
+	return nil
+}
+
+func mustCIDR(s string) *net.IPNet {
+	_, n, err := net.ParseCIDR(s)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func newTestServer(upstreams []string) *Server {
+	r := &fakeResolver{
+		pods: map[string][]net.IP{
+			"web":     {net.ParseIP("192.168.123.2"), net.ParseIP("fd00::2")},
+			"stopped": nil,
+		},
+		containers: map[string][]net.IP{
+			"nginx.web": {net.ParseIP("192.168.123.2")},
+		},
+		services: map[string][]net.IP{
+			"db": {net.ParseIP("10.10.0.24"), net.ParseIP("10.10.0.25")},
+		},
+		subnets: []*net.IPNet{mustCIDR("192.168.123.0/24"), nil, mustCIDR("fd00::/64")},
+	}
+	return New("192.168.123.1", "Hyper.", upstreams, r)
+}
+
+func query(s *Server, from net.Addr, name string, qtype uint16) *dns.Msg {
+	req := new(dns.Msg)
+	req.SetQuestion(name, qtype)
+	w := &recorder{remote: from}
+	s.ServeDNS(w, req)
+	return w.msg
+}
+
+func answers(m *dns.Msg) []string {
+	var result []string
+	for _, rr := range m.Answer {
+		switch a := rr.(type) {
+		case *dns.A:
+			result = append(result, a.A.String())
+		case *dns.AAAA:
+			result = append(result, a.AAAA.String())
+		}
+	}
+	return result
+}
+
+func TestLookup(t *testing.T) {
+	s := newTestServer(nil)
+	client := &net.UDPAddr{IP: net.ParseIP("192.168.123.5"), Port: 3456}
+
+	cases := []struct {
+		name    string
+		qtype   uint16
+		rcode   int
+		answers []string
+	}{
+		{"web.hyper.", dns.TypeA, dns.RcodeSuccess, []string{"192.168.123.2"}},
+		{"WEB.Hyper.", dns.TypeA, dns.RcodeSuccess, []string{"192.168.123.2"}},
+		{"web.hyper.", dns.TypeAAAA, dns.RcodeSuccess, []string{"fd00::2"}},
+		{"nginx.web.hyper.", dns.TypeA, dns.RcodeSuccess, []string{"192.168.123.2"}},
+		{"nginx.web.hyper.", dns.TypeAAAA, dns.RcodeSuccess, nil},
+		{"db.svc.hyper.", dns.TypeA, dns.RcodeSuccess, []string{"10.10.0.24", "10.10.0.25"}},
+		{"stopped.hyper.", dns.TypeA, dns.RcodeSuccess, nil},
+		{"hyper.", dns.TypeA, dns.RcodeSuccess, nil},
+		{"web.hyper.", dns.TypeMX, dns.RcodeSuccess, nil},
+		{"nothing.hyper.", dns.TypeA, dns.RcodeNameError, nil},
+		{"redis.web.hyper.", dns.TypeA, dns.RcodeNameError, nil},
+		{"cache.svc.hyper.", dns.TypeA, dns.RcodeNameError, nil},
+		{"a.b.c.hyper.", dns.TypeA, dns.RcodeNameError, nil},
+		// out of the domain without upstreams
+		{"example.com.", dns.TypeA, dns.RcodeRefused, nil},
+		{"web.hyper.example.com.", dns.TypeA, dns.RcodeRefused, nil},
+	}
+	for _, c := range cases {
+		m := query(s, client, c.name, c.qtype)
+		if m.Rcode != c.rcode {
+			t.Errorf("%s %s: rcode %s, expect %s", c.name, dns.TypeToString[c.qtype], dns.RcodeToString[m.Rcode], dns.RcodeToString[c.rcode])
+			continue
+		}
+		if got := answers(m); !reflect.DeepEqual(got, c.answers) {
+			t.Errorf("%s %s: answers %v, expect %v", c.name, dns.TypeToString[c.qtype], got, c.answers)
+		}
+		if c.rcode != dns.RcodeRefused && !m.Authoritative {
+			t.Errorf("%s: the answer is not authoritative", c.name)
+		}
+	}
+}
+
+func TestRefuseOutsideSubnets(t *testing.T) {
+	s := newTestServer([]string{"127.0.0.1:1"})
+
+	for _, from := range []net.Addr{
+		&net.UDPAddr{IP: net.ParseIP("10.0.0.8"), Port: 53},
+		&net.TCPAddr{IP: net.ParseIP("fd01::8"), Port: 53},
+		&net.UnixAddr{Name: "/tmp/dns", Net: "unix"},
+	} {
+		for _, name := range []string{"web.hyper.", "example.com."} {
+			m := query(s, from, name, dns.TypeA)
+			if m.Rcode != dns.RcodeRefused || len(m.Answer) != 0 {
+				t.Errorf("query %s from %v is not refused: %s", name, from, m)
+			}
+		}
+	}
+
+	m := query(s, &net.TCPAddr{IP: net.ParseIP("fd00::8"), Port: 53}, "web.hyper.", dns.TypeA)
+	if m.Rcode != dns.RcodeSuccess {
+		t.Errorf("query from the IPv6 subnet is refused: %s", m)
+	}
+}
+
+func TestForward(t *testing.T) {
+	pc, err := net.ListenPacket("udp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	upstream := &dns.Server{
+		PacketConn: pc,
+		Handler: dns.HandlerFunc(func(w dns.ResponseWriter, req *dns.Msg) {
+			resp := new(dns.Msg)
+			resp.SetReply(req)
+			resp.Answer = append(resp.Answer, &dns.A{
+				Hdr: dns.RR_Header{Name: req.Question[0].Name, Rrtype: dns.TypeA, Class: dns.ClassINET, Ttl: 60},
+				A:   net.ParseIP("93.184.216.34"),
+			})
+			w.WriteMsg(resp)
+		}),
+	}
+	go upstream.ActivateAndServe()
+	defer upstream.Shutdown()
+
+	// the unreachable upstream is skipped
+	dead, err := net.ListenPacket("udp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	deadAddr := dead.LocalAddr().String()
+	dead.Close()
+
+	s := newTestServer([]string{deadAddr, pc.LocalAddr().String()})
+	m := query(s, &net.UDPAddr{IP: net.ParseIP("192.168.123.5"), Port: 3456}, "example.com.", dns.TypeA)
+	if m.Rcode != dns.RcodeSuccess {
+		t.Fatalf("forwarded query failed: %s", m)
+	}
+	if got := answers(m); !reflect.DeepEqual(got, []string{"93.184.216.34"}) {
+		t.Errorf("unexpected forwarded answers %v", got)
+	}
+
+	s = newTestServer([]string{deadAddr})
+	m = query(s, &net.UDPAddr{IP: net.ParseIP("192.168.123.5"), Port: 3456}, "example.com.", dns.TypeA)
+	if m.Rcode != dns.RcodeServerFailure {
+		t.Errorf("query with no upstream alive: rcode %s, expect SERVFAIL", dns.RcodeToString[m.Rcode])
+	}
+}
+
+func TestUpstreams(t *testing.T) {
+	dir, err := ioutil.TempDir("", "nameserver")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	resolvconf := filepath.Join(dir, "resolv.conf")
+	content := "search example.com\nnameserver 192.168.123.1\nnameserver 8.8.8.8\nnameserver 2001:4860:4860::8888\n"
+	if err = ioutil.WriteFile(resolvconf, []byte(content), 0644); err != nil {
+		t.Fatal(err)
+	}
+	upstreams, err := Upstreams(resolvconf, "192.168.123.1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	expect := []string{"8.8.8.8:53", "[2001:4860:4860::8888]:53"}
+	if !reflect.DeepEqual(upstreams, expect) {
+		t.Errorf("upstreams %v, expect %v", upstreams, expect)
+	}
+}
+
+func TestSearchOptions(t *testing.T) {
+	dir, err := ioutil.TempDir("", "nameserver")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	for _, c := range []struct {
+		content         string
+		search, options []string
+	}{
+		{
+			content: "# generated\nsearch example.com corp.example.com\nnameserver 8.8.8.8\noptions ndots:2 timeout:1\noptions rotate\n",
+			search:  []string{"example.com", "corp.example.com"},
+			options: []string{"ndots:2", "timeout:1", "rotate"},
+		},
+		{
+			content: "domain example.com\nnameserver 8.8.8.8\n",
+			search:  []string{"example.com"},
+		},
+		{
+			content: "domain example.com\nsearch corp.example.com\n",
+			search:  []string{"corp.example.com"},
+		},
+		{
+			content: "nameserver 8.8.8.8\n",
+		},
+	} {
+		resolvconf := filepath.Join(dir, "resolv.conf")
+		if err = ioutil.WriteFile(resolvconf, []byte(c.content), 0644); err != nil {
+			t.Fatal(err)
+		}
+		search, options, err := SearchOptions(resolvconf)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !reflect.DeepEqual(search, c.search) || !reflect.DeepEqual(options, c.options) {
+			t.Errorf("%q: search %v options %v, expect %v %v", c.content, search, options, c.search, c.options)
+		}
+	}
+}
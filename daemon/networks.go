@@ -0,0 +1,547 @@
+package daemon
+
+import (
+	"fmt"
+	"math/big"
+	"net"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/golang/glog"
+	"github.com/golang/protobuf/proto"
+	"github.com/hyperhq/hyperd/errors"
+	"github.com/hyperhq/hyperd/networking/portmapping"
+	apitypes "github.com/hyperhq/hyperd/types"
+	"github.com/hyperhq/hyperd/utils"
+	"github.com/hyperhq/runv/hypervisor/network"
+	"github.com/hyperhq/runv/hypervisor/network/ipallocator"
+)
+
+const (
+	// the bridge of a network is named with this prefix and the network
+	// name if it is not specified.
+	networkBridgePrefix = "hyn-"
+	// IFNAMSIZ - 1
+	maxBridgeNameLen = 15
+)
+
+// userNetwork is a user-defined network with its own address pool, the
+// leases are persisted one record per address.
+type userNetwork struct {
+	info      *apitypes.NetworkInfo
+	subnet    *net.IPNet
+	allocator *ipallocator.IPAllocator
+	// ip -> lease
+	leases map[string]*apitypes.NetworkLease
+}
+
+type userNetworks struct {
+	nets map[string]*userNetwork
+	sync.Mutex
+}
+
+func newUserNetworks() *userNetworks {
+	return &userNetworks{
+		nets: make(map[string]*userNetwork),
+	}
+}
+
+// newUserNetwork builds the pool of the network, in which the gateway and
+// the reserved addresses are never allocated.
+func newUserNetwork(info *apitypes.NetworkInfo) (*userNetwork, error) {
+	_, subnet, err := net.ParseCIDR(info.Subnet)
+	if err != nil {
+		return nil, fmt.Errorf("invalid subnet %s: %v", info.Subnet, err)
+	}
+	un := &userNetwork{
+		info:      info,
+		subnet:    subnet,
+		allocator: ipallocator.New(),
+		leases:    make(map[string]*apitypes.NetworkLease),
+	}
+	if info.IpRange != "" {
+		_, ipRange, err := net.ParseCIDR(info.IpRange)
+		if err != nil {
+			return nil, fmt.Errorf("invalid ip range %s: %v", info.IpRange, err)
+		}
+		if err = un.allocator.RegisterSubnet(subnet, ipRange); err != nil {
+			return nil, fmt.Errorf("invalid ip range %s: %v", info.IpRange, err)
+		}
+	}
+	// the addresses out of the ip range are not allocatable anyway
+	for _, addr := range append([]string{info.Gateway}, info.Reserved...) {
+		if ip := net.ParseIP(addr); ip != nil {
+			un.allocator.RequestIP(subnet, ip)
+		}
+	}
+	return un, nil
+}
+
+func (un *userNetwork) gatewayNet() *net.IPNet {
+	return &net.IPNet{IP: net.ParseIP(un.info.Gateway), Mask: un.subnet.Mask}
+}
+
+func (un *userNetwork) v6() bool {
+	return un.subnet.IP.To4() == nil
+}
+
+// setupBridge prepares the bridge of the network and the host rules of it
+func (un *userNetwork) setupBridge() error {
+	created, added, err := network.EnsureBridge(un.info.Bridge, un.gatewayNet())
+	if err != nil {
+		return err
+	}
+	un.info.BridgeCreated = un.info.BridgeCreated || created
+	un.info.GatewayAdded = un.info.GatewayAdded || added
+	return portmapping.SetupNetwork(un.info.Bridge, un.info.Subnet)
+}
+
+// releaseBridge removes the host rules of the network, and the bridge or the
+// gateway which hyperd has added to the existing bridge.
+func (un *userNetwork) releaseBridge() {
+	if err := portmapping.ReleaseNetwork(un.info.Bridge, un.info.Subnet); err != nil {
+		glog.Warningf("failed to remove the rules of network %s: %v", un.info.Name, err)
+	}
+	if un.info.BridgeCreated {
+		if err := network.DeleteBridge(un.info.Bridge); err != nil {
+			glog.Warningf("failed to delete bridge %s of network %s: %v", un.info.Bridge, un.info.Name, err)
+		}
+	} else if un.info.GatewayAdded {
+		if err := network.RemoveBridgeAddr(un.info.Bridge, un.gatewayNet()); err != nil {
+			glog.Warningf("failed to remove gateway %s from bridge %s of network %s: %v", un.info.Gateway, un.info.Bridge, un.info.Name, err)
+		}
+	}
+}
+
+// snapshot returns a copy of the network info with the current leases.
+func (un *userNetwork) snapshot() *apitypes.NetworkInfo {
+	info := proto.Clone(un.info).(*apitypes.NetworkInfo)
+	ips := make([]string, 0, len(un.leases))
+	for ip := range un.leases {
+		ips = append(ips, ip)
+	}
+	sort.Strings(ips)
+	for _, ip := range ips {
+		info.Leases = append(info.Leases, un.leases[ip])
+	}
+	return info
+}
+
+func (daemon *Daemon) saveNetwork(info *apitypes.NetworkInfo) error {
+	data, err := proto.Marshal(info)
+	if err != nil {
+		return err
+	}
+	return daemon.db.UpdateNetwork(info.Name, data)
+}
+
+func (daemon *Daemon) CreateNetwork(req *apitypes.NetworkCreateRequest) (*apitypes.NetworkInfo, error) {
+	if !utils.IsDNSLabel(req.Name) {
+		return nil, fmt.Errorf("network name should fullfil the pattern: %s, input name: %s", utils.Dns1123LabelFmt, req.Name)
+	}
+
+	_, subnet, err := net.ParseCIDR(req.Subnet)
+	if err != nil {
+		return nil, fmt.Errorf("invalid subnet %s: %v", req.Subnet, err)
+	}
+	if ones, bits := subnet.Mask.Size(); bits-ones < 2 {
+		return nil, fmt.Errorf("subnet %s is too small", req.Subnet)
+	}
+
+	info := &apitypes.NetworkInfo{
+		Name:      req.Name,
+		Bridge:    req.Bridge,
+		Subnet:    subnet.String(),
+		Gateway:   req.Gateway,
+		Labels:    req.Labels,
+		CreatedAt: time.Now().UTC().Unix(),
+	}
+	if info.Gateway == "" {
+		// the first address of the subnet
+		first, _ := ipallocator.NetworkRange(subnet)
+		info.Gateway = nextIP(first).String()
+	} else if gw := net.ParseIP(info.Gateway); gw == nil || !subnet.Contains(gw) {
+		return nil, fmt.Errorf("gateway %s is not in subnet %s", info.Gateway, info.Subnet)
+	}
+	if req.IpRange != "" {
+		_, ipRange, err := net.ParseCIDR(req.IpRange)
+		if err != nil {
+			return nil, fmt.Errorf("invalid ip range %s: %v", req.IpRange, err)
+		}
+		if !subnetContains(subnet, ipRange) {
+			return nil, fmt.Errorf("ip range %s is not in subnet %s", req.IpRange, info.Subnet)
+		}
+		info.IpRange = ipRange.String()
+	}
+	for _, addr := range req.Reserved {
+		ip := net.ParseIP(addr)
+		if ip == nil || !subnet.Contains(ip) {
+			return nil, fmt.Errorf("reserved address %s is not in subnet %s", addr, info.Subnet)
+		}
+		info.Reserved = append(info.Reserved, ip.String())
+	}
+	if info.Bridge == "" {
+		info.Bridge = networkBridgePrefix + req.Name
+		if len(info.Bridge) > maxBridgeNameLen {
+			info.Bridge = info.Bridge[:maxBridgeNameLen]
+		}
+	} else if len(info.Bridge) > maxBridgeNameLen {
+		return nil, fmt.Errorf("bridge name %s is too long", info.Bridge)
+	}
+	if info.Bridge == network.BridgeIface {
+		return nil, fmt.Errorf("bridge %s is the default bridge of hyperd", info.Bridge)
+	}
+	for _, n := range []*net.IPNet{network.BridgeIPv4Net, network.BridgeIPv6Net} {
+		if n != nil && subnetOverlaps(subnet, n) {
+			return nil, fmt.Errorf("subnet %s overlaps with the default bridge %s", info.Subnet, n)
+		}
+	}
+
+	un, err := newUserNetwork(info)
+	if err != nil {
+		return nil, err
+	}
+
+	daemon.networks.Lock()
+	defer daemon.networks.Unlock()
+
+	if _, ok := daemon.networks.nets[req.Name]; ok {
+		return nil, fmt.Errorf("network %s already exists", req.Name)
+	}
+	for _, other := range daemon.networks.nets {
+		if other.info.Bridge == info.Bridge {
+			return nil, fmt.Errorf("bridge %s is used by network %s", info.Bridge, other.info.Name)
+		}
+		if subnetOverlaps(subnet, other.subnet) {
+			return nil, fmt.Errorf("subnet %s overlaps with network %s (%s)", info.Subnet, other.info.Name, other.info.Subnet)
+		}
+	}
+
+	if err = un.setupBridge(); err != nil {
+		glog.Errorf("failed to setup bridge %s of network %s: %v", info.Bridge, req.Name, err)
+		un.releaseBridge()
+		return nil, err
+	}
+	if err = daemon.saveNetwork(info); err != nil {
+		glog.Errorf("failed to save network %s: %v", req.Name, err)
+		un.releaseBridge()
+		return nil, err
+	}
+	daemon.networks.nets[req.Name] = un
+
+	glog.V(1).Infof("network %s created on bridge %s with subnet %s", info.Name, info.Bridge, info.Subnet)
+	return un.snapshot(), nil
+}
+
+func (daemon *Daemon) ListNetworks() ([]*apitypes.NetworkInfo, error) {
+	daemon.networks.Lock()
+	defer daemon.networks.Unlock()
+
+	names := make([]string, 0, len(daemon.networks.nets))
+	for name := range daemon.networks.nets {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	result := make([]*apitypes.NetworkInfo, 0, len(names))
+	for _, name := range names {
+		result = append(result, daemon.networks.nets[name].snapshot())
+	}
+	return result, nil
+}
+
+func (daemon *Daemon) InspectNetwork(name string) (*apitypes.NetworkInfo, error) {
+	daemon.networks.Lock()
+	defer daemon.networks.Unlock()
+
+	un, ok := daemon.networks.nets[name]
+	if !ok {
+		return nil, fmt.Errorf("network %s not found", name)
+	}
+	return un.snapshot(), nil
+}
+
+func (daemon *Daemon) RemoveNetwork(name string) error {
+	daemon.networks.Lock()
+	defer daemon.networks.Unlock()
+
+	un, ok := daemon.networks.nets[name]
+	if !ok {
+		return fmt.Errorf("network %s not found", name)
+	}
+	if len(un.leases) > 0 {
+		pods := []string{}
+		seen := make(map[string]bool)
+		for _, l := range un.snapshot().Leases {
+			if !seen[l.PodID] {
+				seen[l.PodID] = true
+				pods = append(pods, l.PodID)
+			}
+		}
+		return fmt.Errorf("network %s is in use by pod(s) %s", name, strings.Join(pods, ", "))
+	}
+
+	if err := daemon.db.DeleteNetwork(name); err != nil {
+		glog.Errorf("failed to remove network %s: %v", name, err)
+		return err
+	}
+	delete(daemon.networks.nets, name)
+
+	un.releaseBridge()
+	return nil
+}
+
+// acquireNetworkAddrs allocates the addresses of the interfaces referencing
+// the user-defined networks, and fills in the bridge, ip and gateway of the
+// interfaces. The gateway of the network is set only if none of the other
+// interfaces of the pod, i.e. the specs and the existing ones, has a gateway
+// of the same family. It returns the leased addresses.
+func (daemon *Daemon) acquireNetworkAddrs(podId string, specs, existing []*apitypes.UserInterface) ([]string, error) {
+	var gw4, gw6 bool
+	for _, inf := range append(append([]*apitypes.UserInterface{}, specs...), existing...) {
+		if inf.Network != "" && inf.Ip == "" {
+			continue
+		}
+		// auto addressed from the default bridge
+		if inf.Ip == "" && inf.Bridge == "" {
+			gw4, gw6 = true, true
+		}
+		gw4 = gw4 || inf.Gateway != ""
+		gw6 = gw6 || inf.Gateway6 != ""
+	}
+
+	daemon.networks.Lock()
+	defer daemon.networks.Unlock()
+
+	acquired := []string{}
+	for _, inf := range specs {
+		if inf.Network == "" {
+			continue
+		}
+		ip, err := daemon.leaseNetworkAddr(podId, inf)
+		if err != nil {
+			daemon.releaseNetworkAddrsLocked(podId, acquired...)
+			return nil, err
+		}
+		acquired = append(acquired, ip)
+
+		un := daemon.networks.nets[inf.Network]
+		if un.v6() && !gw6 {
+			inf.Gateway6, gw6 = un.info.Gateway, true
+		} else if !un.v6() && !gw4 {
+			inf.Gateway, gw4 = un.info.Gateway, true
+		}
+	}
+	return acquired, nil
+}
+
+func (daemon *Daemon) leaseNetworkAddr(podId string, inf *apitypes.UserInterface) (string, error) {
+	un, ok := daemon.networks.nets[inf.Network]
+	if !ok {
+		return "", fmt.Errorf("network %s not found", inf.Network)
+	}
+	if inf.Bridge != "" {
+		return "", fmt.Errorf("interface %s could not specify both network and bridge", inf.Ifname)
+	}
+
+	var requested net.IP
+	if inf.Ip != "" {
+		if requested, _, _ = network.IpParser(inf.Ip); requested == nil || !un.subnet.Contains(requested) {
+			return "", fmt.Errorf("address %s is not in network %s (%s)", inf.Ip, un.info.Name, un.info.Subnet)
+		}
+	}
+	ip, err := un.allocator.RequestIP(un.subnet, requested)
+	if err != nil {
+		return "", fmt.Errorf("failed to allocate address from network %s: %v", un.info.Name, err)
+	}
+
+	lease := &apitypes.NetworkLease{
+		Ip:    ip.String(),
+		PodID: podId,
+	}
+	data, err := proto.Marshal(lease)
+	if err == nil {
+		err = daemon.db.UpdateNetworkLease(un.info.Name, lease.Ip, data)
+	}
+	if err != nil {
+		glog.Errorf("failed to save lease %s of network %s: %v", lease.Ip, un.info.Name, err)
+		un.allocator.ReleaseIP(un.subnet, ip)
+		return "", err
+	}
+	un.leases[lease.Ip] = lease
+
+	ones, _ := un.subnet.Mask.Size()
+	inf.Bridge = un.info.Bridge
+	inf.Ip = fmt.Sprintf("%s/%d", lease.Ip, ones)
+	glog.V(1).Infof("%s: leased %s from network %s", podId, lease.Ip, un.info.Name)
+	return lease.Ip, nil
+}
+
+// releaseNetworkAddrs releases the addresses leased to the pod, or all of
+// them if ips is empty.
+func (daemon *Daemon) releaseNetworkAddrs(podId string, ips ...string) {
+	daemon.networks.Lock()
+	defer daemon.networks.Unlock()
+
+	daemon.releaseNetworkAddrsLocked(podId, ips...)
+}
+
+func (daemon *Daemon) releaseNetworkAddrsLocked(podId string, ips ...string) {
+	selected := make(map[string]bool, len(ips))
+	for _, ip := range ips {
+		selected[ip] = true
+	}
+	for _, un := range daemon.networks.nets {
+		for ip, lease := range un.leases {
+			if lease.PodID != podId || (len(ips) > 0 && !selected[ip]) {
+				continue
+			}
+			if err := daemon.db.DeleteNetworkLease(un.info.Name, ip); err != nil {
+				glog.Warningf("failed to remove lease %s of network %s: %v", ip, un.info.Name, err)
+			}
+			un.allocator.ReleaseIP(un.subnet, net.ParseIP(ip))
+			delete(un.leases, ip)
+			glog.V(1).Infof("%s: released %s to network %s", podId, ip, un.info.Name)
+		}
+	}
+}
+
+// restoreNetworks loads the networks and their leases, and sets up the
+// bridges which may be gone with a host reboot.
+func (daemon *Daemon) restoreNetworks() error {
+	records, err := daemon.db.ListNetworks()
+	if err != nil {
+		return err
+	}
+
+	daemon.networks.Lock()
+	defer daemon.networks.Unlock()
+
+	for _, data := range records {
+		var info apitypes.NetworkInfo
+		if err := proto.Unmarshal(data, &info); err != nil {
+			glog.Warningf("skip invalid network record: %v", err)
+			continue
+		}
+		un, err := newUserNetwork(&info)
+		if err != nil {
+			glog.Warningf("skip invalid network %s: %v", info.Name, err)
+			continue
+		}
+		created, added := info.BridgeCreated, info.GatewayAdded
+		if err := un.setupBridge(); err != nil {
+			glog.Errorf("failed to setup bridge %s of network %s: %v", info.Bridge, info.Name, err)
+		}
+		// the bridge or the gateway has gone, e.g. after the host reboots
+		if info.BridgeCreated != created || info.GatewayAdded != added {
+			if err := daemon.saveNetwork(&info); err != nil {
+				glog.Warningf("failed to save network %s: %v", info.Name, err)
+			}
+		}
+
+		leases, err := daemon.db.ListNetworkLeases(info.Name)
+		if err != nil {
+			return err
+		}
+		for _, ld := range leases {
+			var lease apitypes.NetworkLease
+			if err := proto.Unmarshal(ld, &lease); err != nil {
+				glog.Warningf("skip invalid lease record of network %s: %v", info.Name, err)
+				continue
+			}
+			if _, err := un.allocator.RequestIP(un.subnet, net.ParseIP(lease.Ip)); err != nil {
+				glog.Warningf("failed to restore lease %s of network %s: %v", lease.Ip, info.Name, err)
+				continue
+			}
+			un.leases[lease.Ip] = &lease
+		}
+		daemon.networks.nets[info.Name] = un
+	}
+	return nil
+}
+
+// releaseStaleLeases releases the leases of the pods which do not exist any
+// longer, i.e. removed while the leases were being saved.
+func (daemon *Daemon) releaseStaleLeases() {
+	daemon.networks.Lock()
+	defer daemon.networks.Unlock()
+
+	stale := make(map[string]bool)
+	for _, un := range daemon.networks.nets {
+		for _, lease := range un.leases {
+			if _, ok := daemon.PodList.Get(lease.PodID); !ok {
+				stale[lease.PodID] = true
+			}
+		}
+	}
+	for podId := range stale {
+		daemon.releaseNetworkAddrsLocked(podId)
+	}
+}
+
+// AddInterface hot-plugs an interface to the running pod, the address is
+// leased from the network referenced by the interface, if any.
+func (daemon *Daemon) AddInterface(podId string, spec *apitypes.UserInterface) (*apitypes.UserInterface, error) {
+	p, ok := daemon.PodList.Get(podId)
+	if !ok {
+		return nil, errors.ErrPodNotFound.WithArgs(podId)
+	}
+
+	acquired, err := daemon.acquireNetworkAddrs(podId, []*apitypes.UserInterface{spec}, p.ListInterfaces())
+	if err != nil {
+		return nil, err
+	}
+	inf, err := p.AddInterface(spec)
+	if err != nil && len(acquired) > 0 {
+		daemon.releaseNetworkAddrs(podId, acquired...)
+	}
+	return inf, err
+}
+
+// RemoveInterface unplugs the interface from the running pod, and releases
+// the address leased from the network.
+func (daemon *Daemon) RemoveInterface(podId, ifname string) error {
+	p, ok := daemon.PodList.Get(podId)
+	if !ok {
+		return errors.ErrPodNotFound.WithArgs(podId)
+	}
+
+	var leased []string
+	for _, inf := range p.ListInterfaces() {
+		if inf.Ifname == ifname && inf.Network != "" {
+			for _, addr := range strings.Split(inf.Ip, ",") {
+				leased = append(leased, utils.StripPrefixLen(addr))
+			}
+		}
+	}
+	if err := p.RemoveInterface(ifname); err != nil {
+		return err
+	}
+	if len(leased) > 0 {
+		daemon.releaseNetworkAddrs(podId, leased...)
+	}
+	return nil
+}
+
+func nextIP(ip net.IP) net.IP {
+	v := big.NewInt(0).SetBytes(ip)
+	v.Add(v, big.NewInt(1))
+	b := v.Bytes()
+	// keep the length of the address
+	next := make(net.IP, len(ip))
+	copy(next[len(next)-len(b):], b)
+	return next
+}
+
+// subnetContains returns whether inner is in outer.
+func subnetContains(outer, inner *net.IPNet) bool {
+	outerOnes, _ := outer.Mask.Size()
+	innerOnes, _ := inner.Mask.Size()
+	return outer.Contains(inner.IP) && innerOnes >= outerOnes
+}
+
+func subnetOverlaps(a, b *net.IPNet) bool {
+	return a.Contains(b.IP) || b.Contains(a.IP)
+}
@@ -0,0 +1,159 @@
+package daemon
+
+import (
+	"net"
+	"testing"
+
+	apitypes "github.com/hyperhq/hyperd/types"
+)
+
+func mustCIDR(t *testing.T, s string) *net.IPNet {
+	_, n, err := net.ParseCIDR(s)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return n
+}
+
+// allocateAll drains the pool of the network
+func allocateAll(t *testing.T, un *userNetwork) map[string]bool {
+	ips := make(map[string]bool)
+	for {
+		ip, err := un.allocator.RequestIP(un.subnet, nil)
+		if err != nil {
+			return ips
+		}
+		if ips[ip.String()] {
+			t.Fatalf("address %s is allocated twice", ip)
+		}
+		ips[ip.String()] = true
+	}
+}
+
+func TestNewUserNetwork(t *testing.T) {
+	un, err := newUserNetwork(&apitypes.NetworkInfo{
+		Name:     "net",
+		Subnet:   "10.10.0.0/29",
+		Gateway:  "10.10.0.1",
+		Reserved: []string{"10.10.0.3", "10.10.1.1", "invalid"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if gw := un.gatewayNet().String(); gw != "10.10.0.1/29" {
+		t.Fatalf("unexpected gateway %s", gw)
+	}
+	if un.v6() {
+		t.Fatal("IPv4 network is IPv6")
+	}
+	ips := allocateAll(t, un)
+	for _, ip := range []string{"10.10.0.2", "10.10.0.4", "10.10.0.5", "10.10.0.6"} {
+		if !ips[ip] {
+			t.Errorf("address %s is not allocated", ip)
+		}
+	}
+	for _, ip := range []string{"10.10.0.0", "10.10.0.1", "10.10.0.3", "10.10.0.7"} {
+		if ips[ip] {
+			t.Errorf("address %s is allocated", ip)
+		}
+	}
+
+	un, err = newUserNetwork(&apitypes.NetworkInfo{
+		Name:    "range",
+		Subnet:  "10.20.0.0/24",
+		Gateway: "10.20.0.1",
+		IpRange: "10.20.0.8/30",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	ips = allocateAll(t, un)
+	if len(ips) == 0 {
+		t.Fatal("no address is allocated from the ip range")
+	}
+	ipRange := mustCIDR(t, "10.20.0.8/30")
+	for ip := range ips {
+		if !ipRange.Contains(net.ParseIP(ip)) {
+			t.Errorf("address %s is out of the ip range", ip)
+		}
+	}
+
+	un, err = newUserNetwork(&apitypes.NetworkInfo{
+		Name:    "v6",
+		Subnet:  "fd00:1::/120",
+		Gateway: "fd00:1::1",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !un.v6() {
+		t.Fatal("IPv6 network is not IPv6")
+	}
+	if ips = allocateAll(t, un); ips["fd00:1::1"] || !ips["fd00:1::2"] {
+		t.Fatalf("unexpected IPv6 addresses %v", ips)
+	}
+
+	for _, info := range []*apitypes.NetworkInfo{
+		{Name: "bad-subnet", Subnet: "10.0.0.0", Gateway: "10.0.0.1"},
+		{Name: "bad-range", Subnet: "10.0.0.0/24", Gateway: "10.0.0.1", IpRange: "10.0.0.0"},
+		{Name: "outside-range", Subnet: "10.0.0.0/24", Gateway: "10.0.0.1", IpRange: "10.1.0.0/28"},
+	} {
+		if _, err := newUserNetwork(info); err == nil {
+			t.Errorf("network %s is accepted", info.Name)
+		}
+	}
+}
+
+func TestNextIP(t *testing.T) {
+	cases := []struct {
+		ip, next string
+	}{
+		{"10.0.0.0", "10.0.0.1"},
+		{"10.0.0.255", "10.0.1.0"},
+		{"10.255.255.255", "11.0.0.0"},
+		{"fd00::", "fd00::1"},
+		{"fd00::ffff", "fd00::1:0"},
+	}
+	for _, c := range cases {
+		ip := net.ParseIP(c.ip)
+		next := nextIP(ip)
+		if next.String() != c.next {
+			t.Errorf("nextIP(%s) = %s, expect %s", c.ip, next, c.next)
+		}
+		if len(next) != len(ip) {
+			t.Errorf("nextIP(%s) has length %d, expect %d", c.ip, len(next), len(ip))
+		}
+	}
+	if next := nextIP(net.ParseIP("10.0.0.1").To4()); next.String() != "10.0.0.2" || len(next) != net.IPv4len {
+		t.Errorf("nextIP of 4 bytes address is %v", []byte(next))
+	}
+}
+
+func TestSubnetOverlaps(t *testing.T) {
+	cases := []struct {
+		a, b     string
+		overlaps bool
+		contains bool
+	}{
+		{"10.0.0.0/16", "10.0.1.0/24", true, true},
+		{"10.0.1.0/24", "10.0.0.0/16", true, false},
+		{"10.0.0.0/24", "10.0.0.0/24", true, true},
+		{"10.0.0.0/24", "10.0.1.0/24", false, false},
+		{"10.0.0.0/25", "10.0.0.128/25", false, false},
+		{"fd00::/64", "fd00::/80", true, true},
+		{"fd00::/64", "fd00:1::/64", false, false},
+		{"10.0.0.0/8", "fd00::/64", false, false},
+	}
+	for _, c := range cases {
+		a, b := mustCIDR(t, c.a), mustCIDR(t, c.b)
+		if o := subnetOverlaps(a, b); o != c.overlaps {
+			t.Errorf("subnetOverlaps(%s, %s) = %v, expect %v", c.a, c.b, o, c.overlaps)
+		}
+		if o := subnetOverlaps(b, a); o != c.overlaps {
+			t.Errorf("subnetOverlaps(%s, %s) = %v, expect %v", c.b, c.a, o, c.overlaps)
+		}
+		if in := subnetContains(a, b); in != c.contains {
+			t.Errorf("subnetContains(%s, %s) = %v, expect %v", c.a, c.b, in, c.contains)
+		}
+	}
+}
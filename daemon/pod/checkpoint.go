@@ -0,0 +1,407 @@
+package pod
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/hyperhq/hypercontainer-utils/hlog"
+	"github.com/hyperhq/hyperd/daemon/events"
+	"github.com/hyperhq/hyperd/types"
+	"github.com/hyperhq/runv/hypervisor"
+)
+
+/// Layout of a checkpoint directory:
+/// sandbox.state: the memory and device state saved by the hypervisor
+/// records/{key}: the persistent records of the pod, named by their db key,
+///                i.e. PL-{Pod.Id()}, PS-{Pod.Id()}, CX-{Container.Id()}...
+
+const (
+	CHECKPOINT_STATE_FILE  = "sandbox.state"
+	CHECKPOINT_RECORDS_DIR = "records"
+)
+
+var (
+	CheckpointTimeout = 5 * time.Minute
+)
+
+// Checkpoint() pauses the running pod, saves the sandbox state and the
+// persistent records of the pod to dir, and then stops the sandbox.
+func (p *XPod) Checkpoint(dir string) error {
+	if !p.IsRunning() {
+		err := fmt.Errorf("only running pod could be checkpointed")
+		p.Log(ERROR, err)
+		return err
+	}
+
+	records := filepath.Join(dir, CHECKPOINT_RECORDS_DIR)
+	if err := os.MkdirAll(records, 0700); err != nil {
+		p.Log(ERROR, "failed to create checkpoint dir %s: %v", dir, err)
+		return err
+	}
+
+	if err := p.Pause(); err != nil {
+		p.Log(ERROR, "failed to pause pod for checkpoint: %v", err)
+		return err
+	}
+
+	// Save() returns after the hypervisor reports the state is saved
+	statePath := filepath.Join(dir, CHECKPOINT_STATE_FILE)
+	err := p.protectedSandboxOperation(
+		func(sb *hypervisor.Vm) error {
+			return sb.Save(statePath)
+		},
+		CheckpointTimeout,
+		"save sandbox state")
+	if err != nil {
+		p.Log(ERROR, "failed to save sandbox state: %v", err)
+		os.Remove(statePath)
+		p.UnPause()
+		return err
+	}
+
+	p.resourceLock.Lock()
+	err = p.savePod()
+	if err == nil {
+		err = p.dumpRecords(records)
+	}
+	p.resourceLock.Unlock()
+	if err != nil {
+		p.Log(ERROR, "failed to write checkpoint records: %v", err)
+		p.UnPause()
+		return err
+	}
+
+	p.Log(INFO, "checkpoint saved to %s, stop the sandbox", dir)
+	p.statusLock.Lock()
+	p.status = S_POD_STOPPING
+	p.statusLock.Unlock()
+	p.ForceQuit()
+	if cleanup := p.waitStopDone(60, "checkpoint pod"); !cleanup {
+		p.Log(WARNING, "timeout while waiting pod stopped after checkpoint")
+	}
+	return nil
+}
+
+func (p *XPod) recordKeys() []string {
+	keys := []string{
+		fmt.Sprintf(LAYOUT_KEY_FMT, p.Id()),
+		fmt.Sprintf(SB_KEY_FMT, p.Id()),
+		fmt.Sprintf(PS_KEY_FMT, p.Id()),
+		fmt.Sprintf(PMETA_KEY_FMT, p.Id()),
+		fmt.Sprintf(PMAP_KEY_FMT, p.Id()),
+	}
+	for cid := range p.containers {
+		keys = append(keys, fmt.Sprintf(CX_KEY_FMT, cid))
+	}
+	for vid := range p.volumes {
+		keys = append(keys, fmt.Sprintf(VX_KEY_FMT, p.Id(), vid))
+	}
+	for inf := range p.interfaces {
+		keys = append(keys, fmt.Sprintf(IF_KEY_FMT, p.Id(), inf))
+	}
+	return keys
+}
+
+// dumpRecords() copies the persistent records of the pod from daemondb to
+// the checkpoint, it should be called with resourceLock held.
+func (p *XPod) dumpRecords(dir string) error {
+	for _, key := range p.recordKeys() {
+		v, err := p.factory.db.Get([]byte(key))
+		if err != nil {
+			p.Log(ERROR, "failed to read record %s: %v", key, err)
+			return err
+		}
+		if err = ioutil.WriteFile(filepath.Join(dir, key), v, 0600); err != nil {
+			p.Log(ERROR, "failed to write record %s: %v", key, err)
+			return err
+		}
+	}
+	return nil
+}
+
+// readRecords() reads the records dumped to the checkpoint in dir, and
+// returns them by their db key with the layout of the pod.
+func readRecords(dir string) (map[string][]byte, *types.PersistPodLayout, error) {
+	var (
+		records = filepath.Join(dir, CHECKPOINT_RECORDS_DIR)
+		layout  types.PersistPodLayout
+	)
+
+	files, err := ioutil.ReadDir(records)
+	if err != nil {
+		hlog.Log(ERROR, "failed to read checkpoint %s: %v", dir, err)
+		return nil, nil, err
+	}
+
+	data := make(map[string][]byte, len(files))
+	for _, f := range files {
+		if f.IsDir() {
+			continue
+		}
+		v, err := ioutil.ReadFile(filepath.Join(records, f.Name()))
+		if err != nil {
+			hlog.Log(ERROR, "failed to read checkpoint record %s: %v", f.Name(), err)
+			return nil, nil, err
+		}
+		if strings.HasPrefix(f.Name(), LAYOUT_KEY_PREFIX) {
+			if layout.Id != "" {
+				return nil, nil, fmt.Errorf("more than one pod in checkpoint %s", dir)
+			}
+			if err = proto.Unmarshal(v, &layout); err != nil {
+				hlog.Log(ERROR, "failed to decode layout in checkpoint %s: %v", dir, err)
+				return nil, nil, err
+			}
+		}
+		data[f.Name()] = v
+	}
+	if layout.Id == "" {
+		return nil, nil, fmt.Errorf("no pod layout found in checkpoint %s", dir)
+	}
+	return data, &layout, nil
+}
+
+// RestoreXPod() recreates the pod from the checkpoint in dir. The records of
+// the checkpoint replace the ones of the stopped pod with the same id if it
+// exists.
+//
+// The sandbox is resumed from the saved state, with the containers and the
+// processes running in it when the checkpoint was taken. If coldBoot is set,
+// the saved state is discarded and the pod is started from its records.
+func RestoreXPod(factory *PodFactory, dir string, coldBoot bool) (*XPod, error) {
+	statePath := filepath.Join(dir, CHECKPOINT_STATE_FILE)
+	resume := !coldBoot
+	if _, err := os.Stat(statePath); err != nil {
+		if !os.IsNotExist(err) {
+			hlog.Log(ERROR, "failed to check the sandbox state in checkpoint %s: %v", dir, err)
+			return nil, err
+		}
+		resume = false
+	}
+
+	data, layout, err := readRecords(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	// the sandbox record is for the resume only, the pod is loaded as a
+	// stopped one.
+	var sb types.SandboxPersistInfo
+	sbKey := fmt.Sprintf(SB_KEY_FMT, layout.Id)
+	if v, ok := data[sbKey]; ok {
+		if err = proto.Unmarshal(v, &sb); err != nil {
+			hlog.Log(ERROR, "failed to decode sandbox info in checkpoint %s: %v", dir, err)
+			return nil, err
+		}
+		delete(data, sbKey)
+	}
+	if resume && sb.Id == "" {
+		err = fmt.Errorf("no sandbox info found in checkpoint %s, restore it with cold boot", dir)
+		hlog.Log(ERROR, err)
+		return nil, err
+	}
+
+	if old, ok := factory.registry.Get(layout.Id); ok {
+		if !old.IsStopped() {
+			return nil, fmt.Errorf("pod %s exists and is not stopped", layout.Id)
+		}
+		hlog.Log(INFO, "replace stopped pod %s with checkpoint %s", layout.Id, dir)
+		factory.registry.Release(layout.Id)
+	}
+
+	for k, v := range data {
+		if err = factory.db.Update([]byte(k), v); err != nil {
+			hlog.Log(ERROR, "failed to restore record %s: %v", k, err)
+			return nil, err
+		}
+	}
+	if err = factory.db.Delete([]byte(sbKey)); err != nil {
+		hlog.Log(ERROR, "failed to remove the sandbox info of pod %s: %v", layout.Id, err)
+		return nil, err
+	}
+
+	p, err := LoadXPod(factory, layout)
+	if err != nil {
+		hlog.Log(ERROR, "failed to load pod %s from checkpoint: %v", layout.Id, err)
+		return nil, err
+	}
+
+	if resume {
+		p.Log(INFO, "resume the sandbox %s from checkpoint %s", sb.Id, dir)
+		if err = p.resume(&sb, statePath); err != nil {
+			p.Log(ERROR, "failed to resume restored pod: %v", err)
+			return p, err
+		}
+		return p, nil
+	}
+
+	p.Log(INFO, "cold boot the pod restored from checkpoint %s", dir)
+	if err = p.Start(); err != nil {
+		p.Log(ERROR, "failed to start restored pod: %v", err)
+		return p, err
+	}
+	return p, nil
+}
+
+// resume() launches the sandbox of the stopped pod from the state saved by
+// Checkpoint(). The resources of the pod are prepared on the host as they
+// were when the state was saved, and then the sandbox is restored with the
+// devices of them and unpaused.
+func (p *XPod) resume(sb *types.SandboxPersistInfo, statePath string) (err error) {
+	p.statusLock.Lock()
+	if p.status != S_POD_STOPPED {
+		p.statusLock.Unlock()
+		err = fmt.Errorf("only stopped pod could be resumed, pod is %v", p.status)
+		p.Log(ERROR, err)
+		return err
+	}
+	p.status = S_POD_STARTING
+	p.statusLock.Unlock()
+
+	stopped := func() {
+		p.statusLock.Lock()
+		p.status = S_POD_STOPPED
+		p.initCond.Broadcast()
+		p.statusLock.Unlock()
+	}
+
+	shareDir := sandboxShareDir(sb.Id)
+	p.resourceLock.Lock()
+	err = p.prepareResume(shareDir)
+	p.resourceLock.Unlock()
+	if err != nil {
+		stopped()
+		return err
+	}
+
+	sandbox, err := hypervisor.RestoreVm(sb.Id, sb.PersistInfo, statePath)
+	if err != nil {
+		p.Log(ERROR, "failed to restore sandbox %s: %v", sb.Id, err)
+		p.resourceLock.Lock()
+		p.releaseResume(shareDir)
+		p.resourceLock.Unlock()
+		stopped()
+		return err
+	}
+
+	// the sandbox is paused after restored, the stop of it is handled by
+	// waitVMStop() as the started ones from now on.
+	p.statusLock.Lock()
+	p.sandbox = sandbox
+	p.memHotplugged = sb.MemHotplugged
+	p.status = S_POD_PAUSED
+	p.statusLock.Unlock()
+	p.initCond.Broadcast()
+	go p.waitVMStop()
+
+	defer func() {
+		if err != nil {
+			p.statusLock.Lock()
+			p.status = S_POD_STOPPING
+			p.statusLock.Unlock()
+			p.ForceQuit()
+		}
+	}()
+	if err = p.UnPause(); err != nil {
+		return err
+	}
+	for _, c := range p.containers {
+		if err = c.associateToSandbox(); err != nil {
+			c.Log(ERROR, "failed to associate container to the resumed sandbox: %v", err)
+			return err
+		}
+	}
+
+	p.emitEvent(events.POD_START, "", "", 0, nil)
+	return p.saveSandbox()
+}
+
+// prepareResume() prepares the interfaces, port mappings, volumes and root
+// volumes of the pod on the host for the sandbox to be resumed, which shares
+// shareDir. The interfaces get the addresses they have in the saved state.
+// It should be called with resourceLock held.
+func (p *XPod) prepareResume(shareDir string) (err error) {
+	p.factory.hosts.Do()
+	defer func() {
+		if err != nil {
+			p.releaseResume(shareDir)
+		}
+	}()
+
+	for _, inf := range p.interfaces {
+		if err = inf.reserve(); err != nil {
+			return err
+		}
+		if p.containerIP == "" {
+			p.containerIP, p.containerIPv6 = inf.addresses()
+		}
+	}
+
+	if err = p.updateHosts(); err != nil {
+		p.Log(WARNING, "(ignored) failed to generate hosts file: %v", err)
+		err = nil
+	}
+
+	if err = p.initPortMapping(); err != nil {
+		p.Log(ERROR, "failed to setup port mappings: %v", err)
+		return err
+	}
+
+	for _, v := range p.volumes {
+		v.descript, err = ProbeExistingVolume(v.spec, shareDir)
+		if err != nil {
+			v.Log(ERROR, "volume probe/mount failed: %v", err)
+			return err
+		}
+		v.Lock()
+		v.status = S_VOLUME_INSERTED
+		v.Unlock()
+	}
+
+	for _, c := range p.containers {
+		root, err := p.factory.sd.PrepareContainer(c.descript.MountId, shareDir, c.spec.ReadOnly)
+		if err != nil {
+			c.Log(ERROR, "failed to prepare rootfs: %v", err)
+			return err
+		}
+		c.descript.RootVolume = root
+	}
+	return nil
+}
+
+// releaseResume() releases what prepareResume() has prepared for a sandbox
+// which is not resumed, it should be called with resourceLock held.
+func (p *XPod) releaseResume(shareDir string) {
+	if err := p.flushPortMapping(); err != nil {
+		p.Log(WARNING, "(ignored) flush port mappings failed: %v", err)
+	}
+	for _, c := range p.containers {
+		if c.descript == nil || c.descript.RootVolume == nil {
+			continue
+		}
+		if err := p.factory.sd.CleanupContainer(c.descript.MountId, shareDir); err != nil {
+			c.Log(WARNING, "(ignored) failed to umount root volume: %v", err)
+		}
+	}
+	for _, v := range p.volumes {
+		v.Lock()
+		inserted := v.status == S_VOLUME_INSERTED
+		v.status = S_VOLUME_CREATED
+		v.Unlock()
+		if inserted && v.descript != nil {
+			if err := UmountExistingVolume(v.descript.Fstype, v.descript.Source, shareDir); err != nil {
+				v.Log(WARNING, "(ignored) failed to umount volume: %v", err)
+			}
+		}
+	}
+	for _, inf := range p.interfaces {
+		inf.cleanup()
+	}
+	p.containerIP, p.containerIPv6 = "", ""
+	cleanupHosts(p.Id())
+	p.factory.hosts = HostsCreator(p.Id())
+}
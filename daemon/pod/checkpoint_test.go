@@ -0,0 +1,134 @@
+package pod
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/hyperhq/hyperd/daemon/daemondb"
+	"github.com/hyperhq/hyperd/types"
+)
+
+func TestDumpAndReadRecords(t *testing.T) {
+	tmp, err := ioutil.TempDir("", "checkpoint")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(tmp)
+
+	db, err := daemondb.NewDaemonDB(filepath.Join(tmp, "db"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+
+	layout, err := proto.Marshal(&types.PersistPodLayout{Id: "pod", Containers: []string{"c1"}, Volumes: []string{"v1"}, Interfaces: []string{"eth0"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	expect := map[string][]byte{
+		fmt.Sprintf(LAYOUT_KEY_FMT, "pod"):       layout,
+		fmt.Sprintf(SB_KEY_FMT, "pod"):           []byte("sandbox"),
+		fmt.Sprintf(PS_KEY_FMT, "pod"):           []byte("spec"),
+		fmt.Sprintf(PMETA_KEY_FMT, "pod"):        []byte("meta"),
+		fmt.Sprintf(PMAP_KEY_FMT, "pod"):         []byte("ports"),
+		fmt.Sprintf(CX_KEY_FMT, "c1"):            []byte("container"),
+		fmt.Sprintf(VX_KEY_FMT, "pod", "v1"):     []byte("volume"),
+		fmt.Sprintf(IF_KEY_FMT, "pod", "eth0"):   []byte("interface"),
+		fmt.Sprintf(CX_KEY_FMT, "other"):         []byte("other container"),
+		fmt.Sprintf(LAYOUT_KEY_FMT, "other-pod"): []byte("other layout"),
+	}
+	for k, v := range expect {
+		if err = db.Update([]byte(k), v); err != nil {
+			t.Fatal(err)
+		}
+	}
+	delete(expect, fmt.Sprintf(CX_KEY_FMT, "other"))
+	delete(expect, fmt.Sprintf(LAYOUT_KEY_FMT, "other-pod"))
+
+	p := &XPod{
+		name:       "pod",
+		containers: map[string]*Container{"c1": nil},
+		volumes:    map[string]*Volume{"v1": nil},
+		interfaces: map[string]*Interface{"eth0": nil},
+		factory:    &PodFactory{db: db},
+	}
+	dir := filepath.Join(tmp, "checkpoint")
+	if err = os.MkdirAll(filepath.Join(dir, CHECKPOINT_RECORDS_DIR), 0700); err != nil {
+		t.Fatal(err)
+	}
+	if err = p.dumpRecords(filepath.Join(dir, CHECKPOINT_RECORDS_DIR)); err != nil {
+		t.Fatal(err)
+	}
+
+	records, l, err := readRecords(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(records, expect) {
+		t.Errorf("records %v, expect %v", records, expect)
+	}
+	if l.Id != "pod" || !reflect.DeepEqual(l.Containers, []string{"c1"}) || !reflect.DeepEqual(l.Interfaces, []string{"eth0"}) {
+		t.Errorf("unexpected layout %v", l)
+	}
+
+	// a record missing from the db fails the dump
+	p.containers["c2"] = nil
+	if err = p.dumpRecords(filepath.Join(dir, CHECKPOINT_RECORDS_DIR)); err == nil {
+		t.Error("dump records of a container not in db succeeded")
+	}
+}
+
+func TestReadRecordsLayout(t *testing.T) {
+	layout := func(id string) []byte {
+		data, err := proto.Marshal(&types.PersistPodLayout{Id: id})
+		if err != nil {
+			t.Fatal(err)
+		}
+		return data
+	}
+
+	cases := []struct {
+		name    string
+		records map[string][]byte
+		expect  string
+	}{
+		{"one pod", map[string][]byte{"PL-a": layout("a"), "PS-a": []byte("spec")}, "a"},
+		{"no layout", map[string][]byte{"PS-a": []byte("spec")}, ""},
+		{"two pods", map[string][]byte{"PL-a": layout("a"), "PL-b": layout("b")}, ""},
+		{"bad layout", map[string][]byte{"PL-a": []byte("bad layout")}, ""},
+	}
+	for _, c := range cases {
+		dir, err := ioutil.TempDir("", "checkpoint")
+		if err != nil {
+			t.Fatal(err)
+		}
+		records := filepath.Join(dir, CHECKPOINT_RECORDS_DIR)
+		if err = os.MkdirAll(records, 0700); err != nil {
+			t.Fatal(err)
+		}
+		for k, v := range c.records {
+			if err = ioutil.WriteFile(filepath.Join(records, k), v, 0600); err != nil {
+				t.Fatal(err)
+			}
+		}
+
+		_, l, err := readRecords(dir)
+		os.RemoveAll(dir)
+		if c.expect == "" {
+			if err == nil {
+				t.Errorf("%s: read records succeeded with layout %v", c.name, l)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%s: %v", c.name, err)
+		} else if l.Id != c.expect {
+			t.Errorf("%s: layout of %s, expect %s", c.name, l.Id, c.expect)
+		}
+	}
+}
@@ -6,6 +6,7 @@ import (
 	"io"
 	"os"
 	"path/filepath"
+	"strconv"
 	"strings"
 	"sync"
 	"syscall"
@@ -20,6 +21,7 @@ import (
 	"github.com/docker/engine-api/types/strslice"
 
 	"github.com/hyperhq/hypercontainer-utils/hlog"
+	"github.com/hyperhq/hyperd/daemon/events"
 	"github.com/hyperhq/hyperd/errors"
 	apitypes "github.com/hyperhq/hyperd/types"
 	"github.com/hyperhq/hyperd/utils"
@@ -41,6 +43,9 @@ const (
 	S_CONTAINER_STOPPING
 )
 
+// the cfs period used when only the cpu quota of a container is specified
+const DEFAULT_CPU_PERIOD = 100000
+
 type ContainerStatus struct {
 	State      ContainerState
 	CreatedAt  time.Time
@@ -49,6 +54,12 @@ type ContainerStatus struct {
 	ExitCode   int
 	Killed     bool
 
+	// RestartCount is the number of times the container has been restarted
+	// by its restart policy, and restartBackoff is the delay applied before
+	// the last restart.
+	RestartCount   int
+	restartBackoff time.Duration
+
 	sync.RWMutex
 	stateChanged *sync.Cond
 }
@@ -61,6 +72,7 @@ type Container struct {
 	spec     *apitypes.UserContainer
 	descript *runv.ContainerDescription
 	status   *ContainerStatus
+	health   *ContainerHealth
 	streams  *StreamConfig
 
 	logger    LogStatus
@@ -83,6 +95,7 @@ func newContainer(p *XPod, spec *apitypes.UserContainer, create bool) (*Containe
 		p:      p,
 		spec:   spec,
 		status: newContainerStatus(),
+		health: newContainerHealth(),
 	}
 	c.updateLogPrefix()
 	if err := c.init(create); err != nil {
@@ -149,6 +162,7 @@ func (c *Container) Info() *apitypes.Container {
 		VolumeMounts:    make([]*apitypes.VolumeMount, 0, len(c.spec.Volumes)),
 		Tty:             c.spec.Tty,
 		ImagePullPolicy: "",
+		Resource:        c.spec.Resource,
 	}
 	for _, port := range c.spec.Ports {
 		cinfo.Ports = append(cinfo.Ports, &apitypes.ContainerPort{
@@ -222,6 +236,10 @@ func (c *Container) InfoStatus() *apitypes.ContainerStatus {
 		s.Phase = "running"
 		s.Running.StartedAt = c.status.StartedAt.Format(time.RFC3339)
 	}
+	s.RestartCount = int32(c.status.RestartCount)
+	if c.hasProbe() {
+		s.Health = c.health.Info()
+	}
 	c.Log(DEBUG, "retrive info %#v from status %#v", s, c.status)
 	return s
 }
@@ -253,6 +271,8 @@ func (c *Container) start() error {
 	}
 	c.Log(DEBUG, "container started")
 	c.status.Running(time.Now())
+	c.startProbes()
+	c.p.emitEvent(events.CONTAINER_START, c.Id(), "", 0, nil)
 
 	return nil
 }
@@ -328,6 +348,7 @@ func (c *Container) BriefStatus() (s *apitypes.ContainerListResult) {
 		ContainerID:   c.Id(),
 		ContainerName: c.SpecName(),
 		PodID:         c.p.Id(),
+		RestartCount:  int32(c.status.RestartCount),
 	}
 	switch c.status.State {
 	case S_CONTAINER_NONE, S_CONTAINER_CREATING:
@@ -351,7 +372,7 @@ func (c *Container) BriefStatus() (s *apitypes.ContainerListResult) {
 
 func (c *Container) StatusString() string {
 	s := c.BriefStatus()
-	return strings.Join([]string{s.ContainerID, s.ContainerName, s.PodID, s.Status}, ":")
+	return strings.Join([]string{s.ContainerID, s.ContainerName, s.PodID, s.Status, strconv.Itoa(int(s.RestartCount))}, ":")
 }
 
 func (c *Container) GetExitCode() (uint8, error) {
@@ -568,6 +589,19 @@ func (c *Container) describeContainer(cjson *dockertypes.ContainerJSON) (*runv.C
 		})
 	}
 
+	if r := c.spec.Resource; r != nil {
+		cdesc.Resources = &runv.ContainerResources{
+			CpuShares:   r.CpuShares,
+			CpuQuota:    r.CpuQuota,
+			CpuPeriod:   r.CpuPeriod,
+			MemoryLimit: int64(r.Memory) << 20,
+			PidsLimit:   r.PidsLimit,
+		}
+		if r.CpuQuota > 0 && r.CpuPeriod == 0 {
+			cdesc.Resources.CpuPeriod = DEFAULT_CPU_PERIOD
+		}
+	}
+
 	if c.spec.StopSignal != "" {
 		cdesc.StopSignal = c.spec.StopSignal
 	}
@@ -670,7 +704,6 @@ func (c *Container) mergeVolumes(cjson *dockertypes.ContainerJSON) {
 
 func (c *Container) configEtcHosts() {
 	var (
-		hostsVolumeName = "etchosts-volume"
 		hostsVolumePath = ""
 		hostsPath       = "/etc/hosts"
 	)
@@ -757,7 +790,7 @@ func (c *Container) configDNS() {
 		fileId     = c.p.Id() + "-resolvconf"
 	)
 
-	if len(c.p.globalSpec.Dns) > 0 {
+	if len(c.p.globalSpec.Dns) > 0 || c.p.useDaemonDNS(c.p.globalSpec) {
 		c.Log(DEBUG, "Already has DNS config, bypass DNS insert")
 		return
 	}
@@ -931,6 +964,10 @@ func (c *Container) associateToSandbox() error {
 
 	c.startLogging()
 
+	if alive {
+		c.startProbes()
+	}
+
 	return nil
 }
 
@@ -1071,6 +1108,11 @@ func (c *Container) waitFinish(timeout int) {
 
 	if firstStop {
 		c.Log(INFO, "clean up container")
+		c.stopProbes()
+		c.status.RLock()
+		exitCode := c.status.ExitCode
+		c.status.RUnlock()
+		c.p.emitEvent(events.CONTAINER_EXIT, c.Id(), "", exitCode, nil)
 
 		//reset streams and loggers, in case restart may use them.
 		oldStreams := c.streams
@@ -1089,6 +1131,8 @@ func (c *Container) waitFinish(timeout int) {
 				oldLogger.Close()
 			}
 		})
+
+		c.scheduleRestart()
 	}
 }
 
@@ -0,0 +1,291 @@
+package pod
+
+import (
+	"archive/tar"
+	"bytes"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/docker/docker/pkg/archive"
+	"github.com/docker/docker/pkg/symlink"
+	"github.com/hyperhq/hyperd/storage"
+	"github.com/hyperhq/runv/hypervisor"
+)
+
+/// The files are copied in and out of a container as tar streams.
+///
+/// If the rootfs of the container is a dir (aufs, overlay, vbox...), it is
+/// mounted on the host under the share dir of the sandbox, the tar stream is
+/// packed or unpacked there, and the owner and permission of the entries are
+/// applied as storage.WriteFile does for the injected files.
+///
+/// Otherwise the regular files are transferred one by one with the file
+/// operations of hyperstart, the owner and permission of the tar entries are
+/// not applied in this case.
+///
+/// The path in the container follows the rules of cp: if it is an existing
+/// dir, the content is put under it, otherwise the top entry of the content
+/// is renamed to it.
+
+var (
+	CopyTimeout = 60 * time.Second
+)
+
+func (p *XPod) copyTarget(cid string) (*Container, error) {
+	if !p.IsAlive() || p.sandbox == nil {
+		err := fmt.Errorf("only files in running pod could be copied, current %v", p.status)
+		p.Log(ERROR, err)
+		return nil, err
+	}
+	c, ok := p.containers[cid]
+	if !ok {
+		err := fmt.Errorf("container %s not exist", cid)
+		p.Log(ERROR, err)
+		return nil, err
+	}
+	if !c.IsAlive() || c.descript == nil || c.descript.RootVolume == nil || c.descript.RootVolume.Fstype == "" {
+		err := fmt.Errorf("container %s is not ready in sandbox", cid)
+		c.Log(ERROR, err)
+		return nil, err
+	}
+	return c, nil
+}
+
+// hostRootfs() returns the dir where the rootfs of the container is mounted
+// on the host, or "" if the rootfs is a block device.
+func (c *Container) hostRootfs() string {
+	if c.descript.RootVolume.Fstype != "dir" {
+		return ""
+	}
+	return filepath.Join(c.p.sandboxShareDir(), c.descript.MountId, "rootfs")
+}
+
+// resolveInRootfs() returns the host path of path in the container, the
+// symlinks of the parent dirs are evaluated inside the rootfs.
+func resolveInRootfs(root, path string) (string, error) {
+	path = filepath.Join("/", path)
+	if path == "/" {
+		return root, nil
+	}
+	dir, err := symlink.FollowSymlinkInScope(filepath.Join(root, filepath.Dir(path)), root)
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(dir, filepath.Base(path)), nil
+}
+
+// CopyFromContainer() returns a tar stream of the file or dir at path in the
+// container, the top entry of the stream is named with the base of path.
+func (p *XPod) CopyFromContainer(cid, path string) (io.ReadCloser, error) {
+	c, err := p.copyTarget(cid)
+	if err != nil {
+		return nil, err
+	}
+
+	if root := c.hostRootfs(); root != "" {
+		src, err := resolveInRootfs(root, path)
+		if err == nil {
+			_, err = os.Lstat(src)
+		}
+		if err != nil {
+			c.Log(ERROR, "failed to find %s in rootfs: %v", path, err)
+			return nil, err
+		}
+		name := filepath.Base(filepath.Join("/", path))
+		if name == "/" {
+			name = filepath.Base(root)
+		}
+		c.Log(DEBUG, "copy %s from rootfs %s", path, root)
+		return archive.TarResourceRebase(src, name)
+	}
+
+	var data []byte
+	err = p.protectedSandboxOperation(
+		func(sb *hypervisor.Vm) error {
+			var err error
+			data, err = sb.ReadFile(cid, path)
+			return err
+		},
+		CopyTimeout,
+		fmt.Sprintf("read file %s of container %s", path, cid))
+	if err != nil {
+		c.Log(ERROR, "failed to read file %s: %v", path, err)
+		return nil, err
+	}
+
+	buf := &bytes.Buffer{}
+	tw := tar.NewWriter(buf)
+	hdr := &tar.Header{
+		Name:     filepath.Base(path),
+		Mode:     0644,
+		Size:     int64(len(data)),
+		ModTime:  time.Now(),
+		Typeflag: tar.TypeReg,
+	}
+	if err = tw.WriteHeader(hdr); err == nil {
+		if _, err = tw.Write(data); err == nil {
+			err = tw.Close()
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+	return ioutil.NopCloser(buf), nil
+}
+
+// CopyToContainer() extracts the tar stream content to path in the container.
+func (p *XPod) CopyToContainer(cid, path string, content io.Reader) error {
+	c, err := p.copyTarget(cid)
+	if err != nil {
+		return err
+	}
+	if c.spec.ReadOnly {
+		err = fmt.Errorf("the rootfs of container %s is read only", cid)
+		c.Log(ERROR, err)
+		return err
+	}
+
+	if root := c.hostRootfs(); root != "" {
+		c.Log(DEBUG, "copy to %s in rootfs %s", path, root)
+		return untarToRootfs(content, root, path)
+	}
+
+	dir, rename := filepath.Join("/", path), ""
+	if !strings.HasSuffix(path, "/") {
+		dir, rename = filepath.Dir(dir), filepath.Base(dir)
+	}
+	tr := tar.NewReader(content)
+	for {
+		hdr, err := tr.Next()
+		if err == io.EOF {
+			break
+		} else if err != nil {
+			return err
+		}
+		target := filepath.Join(dir, rebaseEntry(hdr.Name, rename))
+		switch hdr.Typeflag {
+		case tar.TypeDir:
+			continue
+		case tar.TypeReg, tar.TypeRegA:
+		default:
+			err = fmt.Errorf("only regular files could be copied into container %s, %s is not", cid, hdr.Name)
+			c.Log(ERROR, err)
+			return err
+		}
+
+		data, err := ioutil.ReadAll(tr)
+		if err != nil {
+			return err
+		}
+		err = p.protectedSandboxOperation(
+			func(sb *hypervisor.Vm) error {
+				return sb.WriteFile(cid, target, data)
+			},
+			CopyTimeout,
+			fmt.Sprintf("write file %s of container %s", target, cid))
+		if err != nil {
+			c.Log(ERROR, "failed to write file %s: %v", target, err)
+			return err
+		}
+	}
+	return nil
+}
+
+// rebaseEntry() replaces the top dir of the entry name with newBase
+func rebaseEntry(name, newBase string) string {
+	name = strings.TrimPrefix(filepath.Clean("/"+name), "/")
+	if newBase == "" || name == "" {
+		return name
+	}
+	parts := strings.SplitN(name, "/", 2)
+	if len(parts) == 1 {
+		return newBase
+	}
+	return filepath.Join(newBase, parts[1])
+}
+
+func untarToRootfs(content io.Reader, root, path string) error {
+	dst, err := resolveInRootfs(root, path)
+	if err != nil {
+		return err
+	}
+	rename := ""
+	if fi, err := os.Stat(dst); err != nil || !fi.IsDir() {
+		if strings.HasSuffix(path, "/") {
+			return fmt.Errorf("destination dir %s does not exist", path)
+		}
+		dst, rename = filepath.Dir(dst), filepath.Base(dst)
+	}
+
+	// the path of dst in the container
+	dst = filepath.Join("/", strings.TrimPrefix(dst, root))
+
+	tr := tar.NewReader(content)
+	for {
+		hdr, err := tr.Next()
+		if err == io.EOF {
+			return nil
+		} else if err != nil {
+			return err
+		}
+		name := rebaseEntry(hdr.Name, rename)
+		if name == "" {
+			continue
+		}
+		target, err := resolveInRootfs(root, filepath.Join(dst, name))
+		if err != nil {
+			return err
+		}
+		if hdr.Typeflag == tar.TypeLink {
+			hdr.Linkname = filepath.Join(dst, rebaseEntry(hdr.Linkname, rename))
+		}
+		if err = extractEntry(tr, hdr, root, target); err != nil {
+			return fmt.Errorf("failed to extract %s: %v", hdr.Name, err)
+		}
+	}
+}
+
+func extractEntry(tr io.Reader, hdr *tar.Header, root, target string) error {
+	mode := int(hdr.FileInfo().Mode().Perm())
+	if fi, err := os.Lstat(target); err == nil && !(fi.IsDir() && hdr.Typeflag == tar.TypeDir) {
+		if err = os.RemoveAll(target); err != nil {
+			return err
+		}
+	}
+
+	switch hdr.Typeflag {
+	case tar.TypeDir:
+		if err := os.MkdirAll(target, os.FileMode(mode)); err != nil {
+			return err
+		}
+		if err := os.Chown(target, hdr.Uid, hdr.Gid); err != nil {
+			return err
+		}
+		return os.Chmod(target, os.FileMode(mode))
+	case tar.TypeReg, tar.TypeRegA:
+		if err := storage.WriteFile(tr, target, mode, hdr.Uid, hdr.Gid); err != nil {
+			return err
+		}
+		// the permission of the created file is masked by the umask
+		return os.Chmod(target, os.FileMode(mode))
+	case tar.TypeSymlink:
+		if err := os.Symlink(hdr.Linkname, target); err != nil {
+			return err
+		}
+		return os.Lchown(target, hdr.Uid, hdr.Gid)
+	case tar.TypeLink:
+		// the link name has been rebased to the path in the container
+		src, err := resolveInRootfs(root, hdr.Linkname)
+		if err != nil {
+			return err
+		}
+		return os.Link(src, target)
+	default:
+		return fmt.Errorf("unsupported entry type %c", hdr.Typeflag)
+	}
+}
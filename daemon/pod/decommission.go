@@ -9,6 +9,7 @@ import (
 
 	dockertypes "github.com/docker/engine-api/types"
 
+	"github.com/hyperhq/hyperd/daemon/events"
 	"github.com/hyperhq/hyperd/utils"
 	"github.com/hyperhq/runv/hypervisor"
 )
@@ -80,6 +81,7 @@ func (p *XPod) Remove(force bool) error {
 
 	//remove pod(including all containers/volumes/interfaces) in daemondb
 	p.removeFromDB()
+	p.emitEvent(events.POD_REMOVE, "", "", 0, nil)
 
 	if p.DelayDeleteOn() {
 		p.Log(DEBUG, "should wait periodical clean up")
@@ -276,6 +278,9 @@ func (p *XPod) RemoveContainer(id string) error {
 	}
 	p.factory.registry.ReleaseContainer(id, c.SpecName())
 	delete(p.containers, id)
+	if err := p.updateHosts(); err != nil {
+		p.Log(WARNING, "(ignored) failed to remove container %s from hosts file: %v", id, err)
+	}
 
 	//remove volumes from daemondb
 	for _, vName := range removedVols {
@@ -540,9 +545,11 @@ func (p *XPod) cleanup() {
 	if p.status == S_POD_STOPPED || p.status == S_POD_NONE {
 		p.statusLock.Unlock()
 		return
-	} else {
-		p.status = S_POD_STOPPING
+	} else if p.status != S_POD_STOPPING {
+		// the sandbox exited without a stop request
+		p.emitEvent(events.SANDBOX_CRASH, "", "", 0, nil)
 	}
+	p.status = S_POD_STOPPING
 	p.statusLock.Unlock()
 
 	err := p.decommissionResources()
@@ -566,6 +573,7 @@ func (p *XPod) cleanup() {
 	p.statusLock.Unlock()
 
 	p.Log(INFO, "pod stopped")
+	p.emitEvent(events.POD_STOP, "", "", 0, nil)
 	select {
 	case p.stoppedChan <- true:
 	default:
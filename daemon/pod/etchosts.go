@@ -7,9 +7,14 @@ import (
 	"io/ioutil"
 	"os"
 	"path"
+	"sort"
+	"strings"
 	"syscall"
 
+	"github.com/docker/docker/pkg/mount"
 	"github.com/golang/glog"
+	"github.com/hyperhq/hyperd/storage"
+	apitypes "github.com/hyperhq/hyperd/types"
 	"github.com/hyperhq/hyperd/utils"
 )
 
@@ -37,6 +42,12 @@ var (
 	}
 
 	defaultHostsFilename = "hosts"
+
+	// the volume binding the hosts file to /etc/hosts of the containers
+	hostsVolumeName = "etchosts-volume"
+
+	// the address of the pod hostname if the pod has no IP
+	loopbackHostIP = "127.0.1.1"
 )
 
 func generateDefaultHosts() ([]byte, error) {
@@ -51,6 +62,36 @@ func generateDefaultHosts() ([]byte, error) {
 	return content.Bytes(), nil
 }
 
+// generateHosts generates the hosts file of a pod, the pod hostname and the
+// container names are resolved to the pod IPs, followed by the extra hosts.
+func generateHosts(ip, ip6 string, names []string, extra []*apitypes.HostAlias) ([]byte, error) {
+	content := bytes.NewBuffer(nil)
+	records := append([]Record{}, defaultHosts...)
+
+	if len(names) > 0 {
+		hosts := strings.Join(names, " ")
+		if ip == "" && ip6 == "" {
+			ip = loopbackHostIP
+		}
+		if ip != "" {
+			records = append(records, Record{Hosts: hosts, IP: ip})
+		}
+		if ip6 != "" {
+			records = append(records, Record{Hosts: hosts, IP: ip6})
+		}
+	}
+	for _, h := range extra {
+		records = append(records, Record{Hosts: strings.Join(h.Hostnames, " "), IP: h.Ip})
+	}
+
+	for _, r := range records {
+		if _, err := r.WriteTo(content); err != nil {
+			return nil, err
+		}
+	}
+	return content.Bytes(), nil
+}
+
 func HostsCreator(pod string) *utils.Initializer {
 	return utils.NewInitializer(func() {
 		prepareHosts(pod)
@@ -90,6 +131,72 @@ func prepareHosts(podID string) (string, error) {
 	return hostsPath, nil
 }
 
+// updateHosts regenerates the hosts file from the hostname, IPs, containers
+// and extra hosts of the pod, it is called with the resourceLock held. The
+// hosts file is only updated after it is prepared for the started pod.
+func (p *XPod) updateHosts() error {
+	_, hostsPath := HostsPath(p.Id())
+	if _, err := os.Stat(hostsPath); err != nil {
+		return nil
+	}
+
+	names := []string{p.globalSpec.Hostname}
+	seen := map[string]bool{p.globalSpec.Hostname: true}
+	cnames := make([]string, 0, len(p.containers))
+	for _, c := range p.containers {
+		if n := c.SpecName(); n != "" && !seen[n] {
+			seen[n] = true
+			cnames = append(cnames, n)
+		}
+	}
+	sort.Strings(cnames)
+	names = append(names, cnames...)
+
+	content, err := generateHosts(p.containerIP, p.containerIPv6, names, p.globalSpec.ExtraHosts)
+	if err != nil {
+		return err
+	}
+	if err = rewriteHosts(hostsPath, content); err != nil {
+		p.Log(ERROR, "failed to update hosts file: %v", err)
+		return err
+	}
+	// the share dir of the sandbox binds the replaced file, the new one is
+	// bound over it for the running containers
+	if v, ok := p.volumes[hostsVolumeName]; ok && v.descript != nil && v.descript.Fstype == "dir" {
+		if err = storage.RebindVFSVolume(hostsPath, v.descript.Source, p.sandboxShareDir()); err != nil {
+			p.Log(ERROR, "failed to bind the updated hosts file: %v", err)
+			return err
+		}
+	}
+	p.Log(DEBUG, "hosts file updated with %v", names)
+	return nil
+}
+
+// rewriteHosts replaces the hosts file atomically with a new file renamed
+// to its path, a reader sees either the old or the new content.
+func rewriteHosts(hostsPath string, content []byte) error {
+	f, err := ioutil.TempFile(path.Dir(hostsPath), "."+defaultHostsFilename)
+	if err != nil {
+		return err
+	}
+	defer os.Remove(f.Name())
+
+	_, err = f.Write(content)
+	if err == nil {
+		err = f.Chmod(0644)
+	}
+	if err == nil {
+		err = f.Sync()
+	}
+	if cerr := f.Close(); err == nil {
+		err = cerr
+	}
+	if err != nil {
+		return err
+	}
+	return os.Rename(f.Name(), hostsPath)
+}
+
 func cleanupHosts(podID string) error {
 	var hostsDir = path.Join(utils.HYPER_ROOT, "hosts", podID)
 	var hostsPath = path.Join(hostsDir, defaultHostsFilename)
@@ -113,3 +220,16 @@ func cleanupHosts(podID string) error {
 
 	return nil
 }
+
+// RemoveHosts unmounts and removes the hosts dir of a pod, which may be left
+// behind by a pod failed to start or stop.
+func RemoveHosts(podID string) error {
+	hostsDir, _ := HostsPath(podID)
+	if mounted, _ := mount.Mounted(hostsDir); mounted {
+		if err := syscall.Unmount(hostsDir, syscall.MNT_DETACH); err != nil {
+			glog.Warningf("unmount %s failed, %v", hostsDir, err)
+			return err
+		}
+	}
+	return os.RemoveAll(hostsDir)
+}
@@ -0,0 +1,105 @@
+package pod
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	apitypes "github.com/hyperhq/hyperd/types"
+)
+
+func TestGenerateHosts(t *testing.T) {
+	defaults, err := generateDefaultHosts()
+	if err != nil {
+		t.Fatal(err)
+	}
+	extra := []*apitypes.HostAlias{
+		{Ip: "10.0.0.1", Hostnames: []string{"db", "db.local"}},
+		{Ip: "fd00::1", Hostnames: []string{"cache"}},
+	}
+
+	for _, c := range []struct {
+		name    string
+		ip, ip6 string
+		names   []string
+		extra   []*apitypes.HostAlias
+		expect  string
+	}{
+		{
+			name:   "no names",
+			expect: "",
+		},
+		{
+			name:   "ipv4",
+			ip:     "192.168.123.2",
+			names:  []string{"pod", "web", "worker"},
+			expect: "192.168.123.2\tpod web worker\n",
+		},
+		{
+			name:   "dual stack",
+			ip:     "192.168.123.2",
+			ip6:    "fd00:123::2",
+			names:  []string{"pod", "web"},
+			expect: "192.168.123.2\tpod web\nfd00:123::2\tpod web\n",
+		},
+		{
+			name:   "ipv6 only",
+			ip6:    "fd00:123::2",
+			names:  []string{"pod"},
+			expect: "fd00:123::2\tpod\n",
+		},
+		{
+			name:   "no ip",
+			names:  []string{"pod", "web"},
+			expect: loopbackHostIP + "\tpod web\n",
+		},
+		{
+			name:   "extra hosts",
+			ip:     "192.168.123.2",
+			names:  []string{"pod"},
+			extra:  extra,
+			expect: "192.168.123.2\tpod\n10.0.0.1\tdb db.local\nfd00::1\tcache\n",
+		},
+	} {
+		content, err := generateHosts(c.ip, c.ip6, c.names, c.extra)
+		if err != nil {
+			t.Errorf("%s: %v", c.name, err)
+			continue
+		}
+		if !strings.HasPrefix(string(content), string(defaults)) {
+			t.Errorf("%s: default hosts are missing:\n%s", c.name, content)
+			continue
+		}
+		if got := string(content[len(defaults):]); got != c.expect {
+			t.Errorf("%s: got records %q, expect %q", c.name, got, c.expect)
+		}
+	}
+}
+
+func TestRewriteHosts(t *testing.T) {
+	tmp, err := ioutil.TempDir("", "hosts")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(tmp)
+
+	hostsPath := filepath.Join(tmp, defaultHostsFilename)
+	if err = ioutil.WriteFile(hostsPath, []byte("127.0.0.1\tlocalhost\n192.168.123.2\tpod web worker\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	content := []byte("127.0.0.1\tlocalhost\n")
+	if err = rewriteHosts(hostsPath, content); err != nil {
+		t.Fatal(err)
+	}
+	if got, err := ioutil.ReadFile(hostsPath); err != nil || string(got) != string(content) {
+		t.Fatalf("unexpected hosts file %q: %v", got, err)
+	}
+	if fi, err := os.Stat(hostsPath); err != nil || fi.Mode().Perm() != 0644 {
+		t.Fatalf("unexpected mode of hosts file: %v, %v", fi, err)
+	}
+	if files, err := ioutil.ReadDir(tmp); err != nil || len(files) != 1 {
+		t.Fatalf("temp files are left: %v, %v", files, err)
+	}
+}
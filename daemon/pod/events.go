@@ -0,0 +1,14 @@
+package pod
+
+import (
+	"github.com/hyperhq/hyperd/daemon/events"
+)
+
+// emitEvent publishes a lifecycle event of the pod to the daemon event hub.
+func (p *XPod) emitEvent(typ, cid, execId string, exitCode int, attributes map[string]string) {
+	var ev *events.Events
+	if p.factory != nil {
+		ev = p.factory.events
+	}
+	ev.Log(typ, p.Id(), cid, execId, exitCode, attributes)
+}
@@ -4,24 +4,40 @@ import (
 	"encoding/json"
 	"fmt"
 	"io"
+	"strings"
 	"syscall"
 	"time"
 
 	"github.com/docker/docker/pkg/stdcopy"
 
 	"github.com/hyperhq/hypercontainer-utils/hlog"
+	"github.com/hyperhq/hyperd/daemon/events"
 	"github.com/hyperhq/hyperd/utils"
 	"github.com/hyperhq/runv/api"
 	"github.com/hyperhq/runv/hypervisor"
 )
 
+// ExecOptions are the optional settings of an exec, which override the
+// ones of the container.
+type ExecOptions struct {
+	// Envs are KEY=VALUE pairs added to the env of the container
+	Envs       []string
+	User       string
+	Group      string
+	Workdir    string
+	Privileged bool
+}
+
 type Exec struct {
 	Id        string
 	Container string
 	Cmds      []string
 	Terminal  bool
+	Options   ExecOptions
 	ExitCode  uint8
 
+	// the internal execs, such as the exec probes, emit no events
+	internal  bool
 	logPrefix string
 	finChan   chan bool
 }
@@ -34,7 +50,11 @@ func (e *Exec) Log(level hlog.LogLevel, args ...interface{}) {
 	hlog.HLog(level, e, 1, args...)
 }
 
-func (p *XPod) CreateExec(containerId, cmds string, terminal bool) (string, error) {
+func (p *XPod) CreateExec(containerId, cmds string, terminal bool, opts *ExecOptions) (string, error) {
+	return p.createExec(containerId, cmds, terminal, opts, false)
+}
+
+func (p *XPod) createExec(containerId, cmds string, terminal bool, opts *ExecOptions, internal bool) (string, error) {
 	c, ok := p.containers[containerId]
 	if !ok {
 		err := fmt.Errorf("no container available for exec %s", cmds)
@@ -51,6 +71,21 @@ func (p *XPod) CreateExec(containerId, cmds string, terminal bool) (string, erro
 	if err := json.Unmarshal([]byte(cmds), &command); err != nil {
 		return "", err
 	}
+	if opts == nil {
+		opts = &ExecOptions{}
+	}
+	for _, e := range opts.Envs {
+		if strings.Index(e, "=") <= 0 {
+			err := fmt.Errorf("invalid env %q for exec %s, should be KEY=VALUE", e, cmds)
+			p.Log(ERROR, err)
+			return "", err
+		}
+	}
+	if opts.Group != "" && opts.User == "" {
+		err := fmt.Errorf("group %s of exec %s is specified without user", opts.Group, cmds)
+		p.Log(ERROR, err)
+		return "", err
+	}
 
 	execId := fmt.Sprintf("exec-%s", utils.RandStr(10, "alpha"))
 
@@ -60,7 +95,9 @@ func (p *XPod) CreateExec(containerId, cmds string, terminal bool) (string, erro
 		Id:        execId,
 		Cmds:      command,
 		Terminal:  terminal,
+		Options:   *opts,
 		ExitCode:  255,
+		internal:  internal,
 		logPrefix: fmt.Sprintf("Pod[%s] Con[%s] Exec[%s] ", p.Id(), containerId[:12], execId),
 		finChan:   make(chan bool, 1),
 	}
@@ -84,7 +121,9 @@ type writeCloser struct {
 	io.Closer
 }
 
-func (p *XPod) StartExec(stdin io.ReadCloser, stdout io.WriteCloser, containerId, execId string) error {
+// StartExec() starts the exec with the streams. The stderr of a non-tty exec
+// is multiplexed into stdout with stdcopy if stderr is nil.
+func (p *XPod) StartExec(stdin io.ReadCloser, stdout io.WriteCloser, stderr io.Writer, containerId, execId string) error {
 	c, ok := p.containers[containerId]
 	if !ok {
 		err := fmt.Errorf("no container %s available for exec %s", containerId, execId)
@@ -108,7 +147,9 @@ func (p *XPod) StartExec(stdin io.ReadCloser, stdout io.WriteCloser, containerId
 		Stdout: stdout,
 	}
 
-	if !es.Terminal && stdout != nil {
+	if !es.Terminal && stderr != nil {
+		tty.Stderr = stderr
+	} else if !es.Terminal && stdout != nil {
 		tty.Stderr = stdcopy.NewStdWriter(stdout, stdcopy.Stderr)
 		tty.Stdout = &writeCloser{stdcopy.NewStdWriter(stdout, stdcopy.Stdout), stdout}
 	}
@@ -139,6 +180,9 @@ func (p *XPod) StartExec(stdin io.ReadCloser, stdout io.WriteCloser, containerId
 
 		es.Log(DEBUG, "exec terminated at %v with code %d", r.FinishedAt, r.Code)
 		es.ExitCode = uint8(r.Code)
+		if !es.internal {
+			p.emitEvent(events.EXEC_EXIT, es.Container, es.Id, r.Code, nil)
+		}
 		select {
 		case es.finChan <- true:
 			es.Log(DEBUG, "wake exec stopped chan")
@@ -147,27 +191,46 @@ func (p *XPod) StartExec(stdin io.ReadCloser, stdout io.WriteCloser, containerId
 		}
 	}(es)
 
-	var envs []string
+	var (
+		envs      []string
+		overrides = make(map[string]bool)
+	)
+	for _, e := range es.Options.Envs {
+		overrides[e[:strings.Index(e, "=")]] = true
+	}
 	for e, v := range c.descript.Envs {
-		envs = append(envs, fmt.Sprintf("%s=%s", e, v))
+		if !overrides[e] {
+			envs = append(envs, fmt.Sprintf("%s=%s", e, v))
+		}
 	}
+	envs = append(envs, es.Options.Envs...)
 
 	process := &api.Process{
-		Container: es.Container,
-		Id:        es.Id,
-		Terminal:  es.Terminal,
-		Args:      es.Cmds,
-		Envs:      envs,
-		Workdir:   c.descript.Workdir,
+		Container:  es.Container,
+		Id:         es.Id,
+		Terminal:   es.Terminal,
+		Args:       es.Cmds,
+		Envs:       envs,
+		Workdir:    c.descript.Workdir,
+		Privileged: es.Options.Privileged,
+	}
+	if es.Options.Workdir != "" {
+		process.Workdir = es.Options.Workdir
 	}
 
-	if c.descript.UGI != nil {
+	if es.Options.User != "" {
+		process.User = es.Options.User
+		process.Group = es.Options.Group
+	} else if c.descript.UGI != nil {
 		process.User = c.descript.UGI.User
 		process.Group = c.descript.UGI.Group
 		process.AdditionalGroup = c.descript.UGI.AdditionalGroups
 	}
 
 	err := p.sandbox.AddProcess(process, tty)
+	if err == nil && !es.internal {
+		p.emitEvent(events.EXEC_START, es.Container, es.Id, 0, nil)
+	}
 
 	<-wReader.wait
 	return err
@@ -9,6 +9,7 @@ import (
 
 	"github.com/hyperhq/hypercontainer-utils/hlog"
 	"github.com/hyperhq/hyperd/daemon/daemondb"
+	"github.com/hyperhq/hyperd/daemon/events"
 	apitypes "github.com/hyperhq/hyperd/types"
 	"github.com/hyperhq/hyperd/utils"
 	runv "github.com/hyperhq/runv/api"
@@ -38,6 +39,14 @@ type GlobalLogConfig struct {
 	PodIdInPath bool
 }
 
+// DNSConfig is the nameserver given to the sandboxes of the pods without
+// their own dns or resolv.conf.
+type DNSConfig struct {
+	Servers []string
+	Search  []string
+	Options []string
+}
+
 type PodFactory struct {
 	sd         PodStorage
 	registry   *PodList
@@ -47,6 +56,8 @@ type PodFactory struct {
 	hosts      *utils.Initializer
 	logCfg     *GlobalLogConfig
 	logCreator logger.Creator
+	events     *events.Events
+	dns        *DNSConfig
 }
 
 type LogStatus struct {
@@ -55,7 +66,7 @@ type LogStatus struct {
 	LogPath string
 }
 
-func NewPodFactory(vmFactory factory.Factory, registry *PodList, db *daemondb.DaemonDB, sd PodStorage, eng ContainerEngine, logCfg *GlobalLogConfig) *PodFactory {
+func NewPodFactory(vmFactory factory.Factory, registry *PodList, db *daemondb.DaemonDB, sd PodStorage, eng ContainerEngine, logCfg *GlobalLogConfig, ev *events.Events, dns *DNSConfig) *PodFactory {
 	return &PodFactory{
 		sd:        sd,
 		db:        db,
@@ -64,6 +75,8 @@ func NewPodFactory(vmFactory factory.Factory, registry *PodList, db *daemondb.Da
 		vmFactory: vmFactory,
 		hosts:     nil,
 		logCfg:    logCfg,
+		events:    ev,
+		dns:       dns,
 	}
 }
 
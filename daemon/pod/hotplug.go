@@ -0,0 +1,174 @@
+package pod
+
+import (
+	"fmt"
+	"runtime"
+	"sync/atomic"
+	"syscall"
+	"time"
+
+	apitypes "github.com/hyperhq/hyperd/types"
+	"github.com/hyperhq/runv/hypervisor"
+)
+
+// the hypervisor drivers which support cpu and memory hotplug
+var hotplugDrivers = map[string]bool{
+	"qemu":    true,
+	"libvirt": true,
+}
+
+func hostMemory() int {
+	var info syscall.Sysinfo_t
+	if err := syscall.Sysinfo(&info); err != nil {
+		return 0
+	}
+	return int(uint64(info.Totalram) * uint64(info.Unit) >> 20)
+}
+
+// validateResources() checks the new resources against the current ones of
+// the pod and the limits of the host and the hypervisor.
+func (p *XPod) validateResources(res *apitypes.UserResource) error {
+	cur := p.globalSpec.Resource
+	if res.Vcpu < 0 || res.Memory < 0 {
+		return fmt.Errorf("invalid resource: vcpu %d, memory %d", res.Vcpu, res.Memory)
+	}
+	if res.Vcpu > 0 {
+		if res.Vcpu < cur.Vcpu {
+			return fmt.Errorf("can not reduce vcpu from %d to %d", cur.Vcpu, res.Vcpu)
+		}
+		if max := runtime.NumCPU(); int(res.Vcpu) > max {
+			return fmt.Errorf("vcpu %d exceeds the cpu number of host %d", res.Vcpu, max)
+		}
+		if int(res.Vcpu) > hypervisor.DefaultMaxCpus {
+			return fmt.Errorf("vcpu %d exceeds the max vcpu of sandbox %d", res.Vcpu, hypervisor.DefaultMaxCpus)
+		}
+	}
+	if res.Memory > 0 {
+		if res.Memory < cur.Memory {
+			return fmt.Errorf("can not reduce memory from %dMB to %dMB", cur.Memory, res.Memory)
+		}
+		if max := hostMemory(); max > 0 && int(res.Memory) > max {
+			return fmt.Errorf("memory %dMB exceeds the memory of host %dMB", res.Memory, max)
+		}
+		if int(res.Memory) > hypervisor.DefaultMaxMem {
+			return fmt.Errorf("memory %dMB exceeds the max memory of sandbox %dMB", res.Memory, hypervisor.DefaultMaxMem)
+		}
+	}
+	return nil
+}
+
+// UpdateResources() changes the vcpu number and memory size of the pod. The
+// resources of a running pod are hot-added to the sandbox, and a stopped pod
+// will get them in the next start. Resources can not be reduced.
+func (p *XPod) UpdateResources(res *apitypes.UserResource) error {
+	if res == nil {
+		return fmt.Errorf("no resource specified")
+	}
+
+	p.resourceLock.Lock()
+	defer p.resourceLock.Unlock()
+
+	if err := p.validateResources(res); err != nil {
+		p.Log(ERROR, "update resources failed: %v", err)
+		return err
+	}
+
+	var (
+		cur    = p.globalSpec.Resource
+		vcpu   = cur.Vcpu
+		memory = cur.Memory
+	)
+	if res.Vcpu > 0 {
+		vcpu = res.Vcpu
+	}
+	if res.Memory > 0 {
+		memory = res.Memory
+	}
+	if vcpu == cur.Vcpu && memory == cur.Memory {
+		return nil
+	}
+
+	if p.IsRunning() {
+		// the hot-added resources could not be removed, the ones added
+		// before a failure are kept in the spec, so that the pod gets the
+		// same resources in the next start
+		applied, err := p.hotplugResources(int(vcpu), int(memory))
+		if err != nil {
+			if applied.Vcpu != cur.Vcpu || applied.Memory != cur.Memory {
+				if serr := p.setResources(applied); serr != nil {
+					p.Log(ERROR, "failed to save the hot-added resources: %v", serr)
+				}
+			}
+			return err
+		}
+	} else if !p.IsStopped() {
+		err := fmt.Errorf("pod is not running or stopped, can not update resources")
+		p.Log(ERROR, err)
+		return err
+	}
+
+	return p.setResources(&apitypes.UserResource{Vcpu: vcpu, Memory: memory})
+}
+
+// setResources() saves the resources of the pod in its spec.
+func (p *XPod) setResources(res *apitypes.UserResource) error {
+	cur := p.globalSpec.Resource
+	p.Log(INFO, "update resources from (vcpu %d, memory %dMB) to (vcpu %d, memory %dMB)", cur.Vcpu, cur.Memory, res.Vcpu, res.Memory)
+	p.globalSpec.Resource = res
+	if p.info != nil && p.info.Spec != nil {
+		p.info.Spec.Vcpu = res.Vcpu
+		p.info.Spec.Memory = res.Memory
+	}
+	return p.saveGlobalSpec()
+}
+
+// hotplugResources() adds the vcpus and memory to the sandbox, and returns
+// the resources of the sandbox after it, which are the ones added before a
+// failure if the hotplug failed.
+func (p *XPod) hotplugResources(vcpu, memory int) (*apitypes.UserResource, error) {
+	cur := p.globalSpec.Resource
+	driver := hypervisor.HDriver.Name()
+	if !hotplugDrivers[driver] {
+		err := fmt.Errorf("hypervisor driver %s does not support cpu/memory hotplug", driver)
+		p.Log(ERROR, err)
+		return cur, err
+	}
+	if memory != int(cur.Memory) && p.memHotplugged {
+		err := fmt.Errorf("memory of the sandbox has been hot-added, could not be changed again until the pod restarted")
+		p.Log(ERROR, err)
+		return cur, err
+	}
+
+	// the operation may go on after a timeout, the applied resources are
+	// read atomically
+	appliedVcpu, appliedMemory := cur.Vcpu, cur.Memory
+	err := p.protectedSandboxOperation(
+		func(sb *hypervisor.Vm) error {
+			if err := sb.SetCpus(vcpu); err != nil {
+				return fmt.Errorf("failed to hotplug vcpu: %v", err)
+			}
+			atomic.StoreInt32(&appliedVcpu, int32(vcpu))
+			if memory > sb.Mem {
+				if err := sb.AddMem(memory); err != nil {
+					return fmt.Errorf("failed to hotplug memory: %v", err)
+				}
+				p.memHotplugged = true
+			}
+			atomic.StoreInt32(&appliedMemory, int32(memory))
+			return sb.OnlineCpuMem()
+		},
+		time.Second*30,
+		"hotplug cpu and memory")
+	applied := &apitypes.UserResource{
+		Vcpu:   atomic.LoadInt32(&appliedVcpu),
+		Memory: atomic.LoadInt32(&appliedMemory),
+	}
+	// keep memHotplugged over the restarts of the daemon, the memory may
+	// have been added even if the hotplug failed
+	serr := p.saveSandbox()
+	if err != nil {
+		p.Log(ERROR, "hotplug resources failed: %v", err)
+		return applied, err
+	}
+	return applied, serr
+}
@@ -0,0 +1,45 @@
+package pod
+
+import (
+	"runtime"
+	"testing"
+
+	apitypes "github.com/hyperhq/hyperd/types"
+	"github.com/hyperhq/runv/hypervisor"
+)
+
+func TestValidateResources(t *testing.T) {
+	p := &XPod{globalSpec: &apitypes.UserPod{Resource: &apitypes.UserResource{Vcpu: 1, Memory: 128}}}
+	maxVcpu := runtime.NumCPU()
+	if maxVcpu > hypervisor.DefaultMaxCpus {
+		maxVcpu = hypervisor.DefaultMaxCpus
+	}
+
+	for _, c := range []struct {
+		name string
+		res  *apitypes.UserResource
+		fail bool
+	}{
+		{name: "unchanged", res: &apitypes.UserResource{}},
+		{name: "same", res: &apitypes.UserResource{Vcpu: 1, Memory: 128}},
+		{name: "add memory", res: &apitypes.UserResource{Memory: 256}},
+		{name: "max vcpu", res: &apitypes.UserResource{Vcpu: int32(maxVcpu)}},
+		{name: "negative vcpu", res: &apitypes.UserResource{Vcpu: -1}, fail: true},
+		{name: "negative memory", res: &apitypes.UserResource{Memory: -128}, fail: true},
+		{name: "reduce memory", res: &apitypes.UserResource{Memory: 64}, fail: true},
+		{name: "exceed vcpu", res: &apitypes.UserResource{Vcpu: int32(maxVcpu + 1)}, fail: true},
+		{name: "exceed memory", res: &apitypes.UserResource{Memory: hypervisor.DefaultMaxMem + 1}, fail: true},
+	} {
+		err := p.validateResources(c.res)
+		if c.fail && err == nil {
+			t.Errorf("%s: expect an error", c.name)
+		} else if !c.fail && err != nil {
+			t.Errorf("%s: %v", c.name, err)
+		}
+	}
+
+	p.globalSpec.Resource = &apitypes.UserResource{Vcpu: 2, Memory: 128}
+	if err := p.validateResources(&apitypes.UserResource{Vcpu: 1}); err == nil {
+		t.Error("vcpu is reduced")
+	}
+}
@@ -0,0 +1,25 @@
+package pod
+
+import (
+	"time"
+
+	"github.com/hyperhq/hyperd/lib/metrics"
+)
+
+var sandboxStartLatency = metrics.NewHistogramVec(
+	"hyperd_sandbox_start_duration_seconds",
+	"Time spent on booting and initializing a sandbox, by result.",
+	[]float64{.1, .25, .5, 1, 2.5, 5, 10, 30, 60, 120, 300},
+	"result")
+
+func init() {
+	metrics.Register(sandboxStartLatency)
+}
+
+func observeSandboxStart(start time.Time, err *error) {
+	result := "succeeded"
+	if *err != nil {
+		result = "failed"
+	}
+	sandboxStartLatency.Observe(time.Since(start).Seconds(), result)
+}
@@ -2,8 +2,12 @@ package pod
 
 import (
 	"fmt"
+	"sort"
+	"strings"
 
 	"github.com/hyperhq/hypercontainer-utils/hlog"
+	"github.com/hyperhq/hyperd/daemon/events"
+	"github.com/hyperhq/hyperd/errors"
 	apitypes "github.com/hyperhq/hyperd/types"
 	runv "github.com/hyperhq/runv/api"
 	"github.com/hyperhq/runv/hypervisor/network"
@@ -57,7 +61,7 @@ func (inf *Interface) prepare() error {
 			Bridge: setting.Bridge,
 			Ip:     setting.IPAddress,
 			Mac:    setting.Mac,
-			Gw:     setting.Gateway,
+			Gw:     joinGateways(setting.Gateway, setting.Gateway6),
 		}
 		return nil
 	}
@@ -69,19 +73,56 @@ func (inf *Interface) prepare() error {
 		Bridge:  inf.spec.Bridge,
 		Ip:      inf.spec.Ip,
 		Mac:     inf.spec.Mac,
-		Gw:      inf.spec.Gateway,
+		Gw:      joinGateways(inf.spec.Gateway, inf.spec.Gateway6),
 		TapName: inf.spec.Ifname,
 	}
 
 	return nil
 }
 
+// addresses returns the first IPv4 and IPv6 addresses of the interface,
+// without the prefix length.
+func (inf *Interface) addresses() (ip4, ip6 string) {
+	if inf.descript == nil {
+		return "", ""
+	}
+	for _, addr := range strings.Split(inf.descript.Ip, ",") {
+		ip, _, err := network.IpParser(strings.TrimSpace(addr))
+		if err != nil || ip == nil {
+			continue
+		}
+		if ip.To4() != nil {
+			if ip4 == "" {
+				ip4 = ip.String()
+			}
+		} else if ip6 == "" {
+			ip6 = ip.String()
+		}
+	}
+	return ip4, ip6
+}
+
+// joinGateways returns the gateways in the form of InterfaceDescription.Gw
+func joinGateways(gw, gw6 string) string {
+	if gw6 == "" {
+		return gw
+	}
+	if gw == "" {
+		return gw6
+	}
+	return gw + "," + gw6
+}
+
 func (inf *Interface) add() error {
 	if inf.descript == nil || inf.descript.Ip == "" {
 		err := fmt.Errorf("interfice has not ready %#v", inf.descript)
 		inf.Log(ERROR, err)
 		return err
 	}
+	if inf.p.sandbox == nil {
+		inf.Log(ERROR, "failed to add NIC: no sandbox")
+		return errors.ErrSandboxNotExist
+	}
 	err := inf.p.sandbox.AddNic(inf.descript)
 	if err != nil {
 		inf.Log(ERROR, "failed to add NIC: %v", err)
@@ -89,6 +130,21 @@ func (inf *Interface) add() error {
 	return err
 }
 
+// reserve() allocates the address of the interface again, which is in the
+// saved state of a sandbox to be resumed.
+func (inf *Interface) reserve() error {
+	if inf.spec.Ip != "" || inf.descript == nil || inf.descript.Ip == "" {
+		return nil
+	}
+
+	inf.Log(DEBUG, "reserve IP address: %s", inf.descript.Ip)
+	if err := network.ReserveAddr(inf.descript.Ip); err != nil {
+		inf.Log(ERROR, "failed to reserve IP %s: %v", inf.descript.Ip, err)
+		return err
+	}
+	return nil
+}
+
 func (inf *Interface) cleanup() error {
 	if inf.spec.Ip != "" || inf.descript == nil || inf.descript.Ip == "" {
 		return nil
@@ -101,3 +157,216 @@ func (inf *Interface) cleanup() error {
 	}
 	return err
 }
+
+// Info returns the spec of the interface with the assigned addresses
+func (inf *Interface) Info() *apitypes.UserInterface {
+	info := &apitypes.UserInterface{
+		Ifname:   inf.spec.Ifname,
+		Bridge:   inf.spec.Bridge,
+		Ip:       inf.spec.Ip,
+		Mac:      inf.spec.Mac,
+		Gateway:  inf.spec.Gateway,
+		Gateway6: inf.spec.Gateway6,
+		Network:  inf.spec.Network,
+	}
+	if inf.descript != nil {
+		info.Bridge = inf.descript.Bridge
+		info.Ip = inf.descript.Ip
+		info.Mac = inf.descript.Mac
+		info.Gateway, info.Gateway6 = network.SplitGateways(inf.descript.Gw)
+	}
+	return info
+}
+
+func (p *XPod) ListInterfaces() []*apitypes.UserInterface {
+	p.resourceLock.Lock()
+	defer p.resourceLock.Unlock()
+
+	return p.interfaceInfos()
+}
+
+// Addresses returns the IPv4 and IPv6 addresses of the pod if it is running,
+// they are the first ones of the interfaces.
+func (p *XPod) Addresses() (ip4, ip6 string) {
+	if !p.IsRunning() {
+		return "", ""
+	}
+	p.resourceLock.Lock()
+	defer p.resourceLock.Unlock()
+
+	return p.containerIP, p.containerIPv6
+}
+
+func (p *XPod) interfaceInfos() []*apitypes.UserInterface {
+	names := make([]string, 0, len(p.interfaces))
+	for name := range p.interfaces {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	infos := make([]*apitypes.UserInterface, 0, len(names))
+	for _, name := range names {
+		infos = append(infos, p.interfaces[name].Info())
+	}
+	return infos
+}
+
+// AddInterface hot-plugs a NIC to the running pod, and returns the interface
+// with the assigned addresses. The interface is kept over the restarts of
+// the pod.
+func (p *XPod) AddInterface(spec *apitypes.UserInterface) (*apitypes.UserInterface, error) {
+	if spec == nil {
+		return nil, fmt.Errorf("no interface specified")
+	}
+	if !p.IsRunning() {
+		err := fmt.Errorf("interface could be added to running pod only")
+		p.Log(ERROR, err)
+		return nil, err
+	}
+
+	p.resourceLock.Lock()
+	defer p.resourceLock.Unlock()
+
+	inf, err := p.insertInterface(spec)
+	if err != nil {
+		return nil, err
+	}
+	p.resetPodIP()
+	p.persistInterfaces(inf)
+
+	info := inf.Info()
+	p.emitEvent(events.INTERFACE_ADD, "", "", 0, map[string]string{"ifname": info.Ifname, "ip": info.Ip})
+	inf.Log(INFO, "interface added: %s", info.Ip)
+	return info, nil
+}
+
+// insertInterface adds the interface to the sandbox and the pod, it is called
+// with the resourceLock held. Nothing of the interface is left in the pod,
+// and its allocated address is released, if it failed.
+func (p *XPod) insertInterface(spec *apitypes.UserInterface) (*Interface, error) {
+	if spec.Ifname == "" {
+		for i := len(p.interfaces); ; i++ {
+			name := fmt.Sprintf("eth%d", i)
+			if _, ok := p.interfaces[name]; !ok {
+				spec.Ifname = name
+				break
+			}
+		}
+	}
+	if _, ok := p.interfaces[spec.Ifname]; ok {
+		err := fmt.Errorf("interface %s already exists", spec.Ifname)
+		p.Log(ERROR, err)
+		return nil, err
+	}
+	if err := p.validateInterface(spec); err != nil {
+		p.Log(ERROR, err)
+		return nil, err
+	}
+
+	inf := newInterface(p, spec)
+	if err := inf.prepare(); err != nil {
+		return nil, err
+	}
+	if err := inf.add(); err != nil {
+		inf.cleanup()
+		return nil, err
+	}
+	if spec.Gateway != "" || spec.Gateway6 != "" {
+		if err := p.sandbox.AddRoute(); err != nil {
+			inf.Log(WARNING, "failed to add the routes of the gateway: %v", err)
+		}
+	}
+
+	p.interfaces[spec.Ifname] = inf
+	p.globalSpec.Interfaces = append(p.globalSpec.Interfaces, spec)
+	return inf, nil
+}
+
+// validateInterface checks the new interface against the existing ones,
+// only one interface could have the gateway of each family.
+func (p *XPod) validateInterface(spec *apitypes.UserInterface) error {
+	if spec.Ip == "" && spec.Bridge != "" {
+		return fmt.Errorf("if configured a bridge, must specify the IP address")
+	}
+	for _, inf := range p.interfaces {
+		if spec.Gateway != "" && inf.spec.Gateway != "" {
+			return fmt.Errorf("interface %s already configured Gateway", inf.spec.Ifname)
+		}
+		if spec.Gateway6 != "" && inf.spec.Gateway6 != "" {
+			return fmt.Errorf("interface %s already configured IPv6 Gateway", inf.spec.Ifname)
+		}
+	}
+	return nil
+}
+
+// RemoveInterface unplugs a NIC from the running pod, the interface of the
+// port mappings could not be removed.
+func (p *XPod) RemoveInterface(ifname string) error {
+	if !p.IsRunning() {
+		err := fmt.Errorf("interface could be removed from running pod only")
+		p.Log(ERROR, err)
+		return err
+	}
+
+	p.resourceLock.Lock()
+	defer p.resourceLock.Unlock()
+
+	inf, ok := p.interfaces[ifname]
+	if !ok {
+		err := fmt.Errorf("interface %s not found", ifname)
+		p.Log(ERROR, err)
+		return err
+	}
+	if ip4, _ := inf.addresses(); ip4 != "" && ip4 == p.containerIP {
+		err := fmt.Errorf("interface %s is the primary interface of the pod", ifname)
+		p.Log(ERROR, err)
+		return err
+	}
+
+	if err := p.sandbox.DeleteNic(inf.descript.Id); err != nil {
+		inf.Log(ERROR, "failed to remove NIC: %v", err)
+		return err
+	}
+	inf.cleanup()
+
+	delete(p.interfaces, ifname)
+	for i, spec := range p.globalSpec.Interfaces {
+		if spec.Ifname == ifname {
+			p.globalSpec.Interfaces = append(p.globalSpec.Interfaces[:i], p.globalSpec.Interfaces[i+1:]...)
+			break
+		}
+	}
+	if err := inf.removeFromDB(); err != nil {
+		inf.Log(WARNING, "failed to remove interface from db: %v", err)
+	}
+	p.resetPodIP()
+	p.persistInterfaces(nil)
+
+	p.emitEvent(events.INTERFACE_REMOVE, "", "", 0, map[string]string{"ifname": ifname})
+	inf.Log(INFO, "interface removed")
+	return nil
+}
+
+// persistInterfaces saves the new interface, if any, and the layout and the
+// spec of the pod, the errors are ignored as the sandbox has been changed.
+func (p *XPod) persistInterfaces(inf *Interface) {
+	if inf != nil {
+		if err := inf.saveInterface(); err != nil {
+			inf.Log(WARNING, "failed to persist interface: %v", err)
+		}
+	}
+	if err := p.saveLayout(); err != nil {
+		p.Log(WARNING, "failed to persist pod layout: %v", err)
+	}
+	if err := p.saveGlobalSpec(); err != nil {
+		p.Log(WARNING, "failed to persist pod spec: %v", err)
+	}
+}
+
+// resetPodIP makes the pod ip refreshed from the sandbox on the next query
+func (p *XPod) resetPodIP() {
+	p.statusLock.Lock()
+	defer p.statusLock.Unlock()
+	if p.info != nil && p.info.Status != nil {
+		p.info.Status.PodIP = nil
+	}
+}
@@ -0,0 +1,144 @@
+package pod
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"testing"
+
+	"github.com/hyperhq/hyperd/daemon/daemondb"
+	"github.com/hyperhq/hyperd/types"
+	runv "github.com/hyperhq/runv/api"
+)
+
+func newInterfaceTestPod(t *testing.T) (*XPod, func()) {
+	tmp, err := ioutil.TempDir("", "interfaces")
+	if err != nil {
+		t.Fatal(err)
+	}
+	db, err := daemondb.NewDaemonDB(filepath.Join(tmp, "db"))
+	if err != nil {
+		os.RemoveAll(tmp)
+		t.Fatal(err)
+	}
+	p := &XPod{
+		name:       "pod",
+		factory:    &PodFactory{db: db},
+		globalSpec: &types.UserPod{Id: "pod"},
+		containers: map[string]*Container{},
+		volumes:    map[string]*Volume{},
+		interfaces: map[string]*Interface{},
+	}
+	return p, func() {
+		db.Close()
+		os.RemoveAll(tmp)
+	}
+}
+
+func TestPersistInterfaces(t *testing.T) {
+	p, cleanup := newInterfaceTestPod(t)
+	defer cleanup()
+
+	for _, spec := range []*types.UserInterface{
+		{Ifname: "eth0", Bridge: "hyper0", Ip: "192.168.123.2/24", Gateway: "192.168.123.1"},
+		{Ifname: "eth1", Bridge: "br1", Ip: "10.1.0.2/24"},
+	} {
+		inf := newInterface(p, spec)
+		if err := inf.prepare(); err != nil {
+			t.Fatal(err)
+		}
+		p.interfaces[spec.Ifname] = inf
+		p.globalSpec.Interfaces = append(p.globalSpec.Interfaces, spec)
+		p.persistInterfaces(inf)
+	}
+
+	var layout types.PersistPodLayout
+	if err := loadMessage(p.factory.db, fmt.Sprintf(LAYOUT_KEY_FMT, "pod"), &layout, nil, "layout"); err != nil {
+		t.Fatal(err)
+	}
+	sort.Strings(layout.Interfaces)
+	if !reflect.DeepEqual(layout.Interfaces, []string{"eth0", "eth1"}) {
+		t.Fatalf("unexpected interfaces in layout %v", layout.Interfaces)
+	}
+	spec, err := loadGloabalSpec(p.factory.db, "pod")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(spec.Interfaces) != 2 || spec.Interfaces[1].Ifname != "eth1" || spec.Interfaces[1].Bridge != "br1" {
+		t.Fatalf("unexpected interfaces in spec %v", spec.Interfaces)
+	}
+
+	// the interfaces are loaded back as they were saved
+	loaded := &XPod{name: "pod", factory: p.factory, interfaces: map[string]*Interface{}}
+	for _, id := range layout.Interfaces {
+		if err = loaded.loadInterface(id); err != nil {
+			t.Fatal(err)
+		}
+	}
+	for name, inf := range p.interfaces {
+		l, ok := loaded.interfaces[name]
+		if !ok {
+			t.Fatalf("interface %s is not loaded", name)
+		}
+		if !reflect.DeepEqual(l.Info(), inf.Info()) || !reflect.DeepEqual(l.descript, inf.descript) {
+			t.Fatalf("interface %s is loaded as %v %v, expect %v %v", name, l.Info(), l.descript, inf.Info(), inf.descript)
+		}
+	}
+
+	// a removed interface is gone from the db
+	inf := p.interfaces["eth1"]
+	if err = inf.removeFromDB(); err != nil {
+		t.Fatal(err)
+	}
+	delete(p.interfaces, "eth1")
+	p.globalSpec.Interfaces = p.globalSpec.Interfaces[:1]
+	p.persistInterfaces(nil)
+
+	if err = loadMessage(p.factory.db, fmt.Sprintf(LAYOUT_KEY_FMT, "pod"), &layout, nil, "layout"); err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(layout.Interfaces, []string{"eth0"}) {
+		t.Fatalf("unexpected interfaces in layout after removal %v", layout.Interfaces)
+	}
+	if err = loaded.loadInterface("eth1"); err == nil {
+		t.Fatal("removed interface is loaded")
+	}
+}
+
+func TestInsertInterfaceFailure(t *testing.T) {
+	p, cleanup := newInterfaceTestPod(t)
+	defer cleanup()
+
+	eth0 := newInterface(p, &types.UserInterface{Ifname: "eth0", Bridge: "hyper0", Ip: "192.168.123.2/24", Gateway: "192.168.123.1"})
+	eth0.descript = &runv.InterfaceDescription{Id: "eth0", Name: "eth0", Ip: "192.168.123.2/24", Gw: "192.168.123.1"}
+	p.interfaces["eth0"] = eth0
+	p.globalSpec.Interfaces = []*types.UserInterface{eth0.spec}
+
+	for _, c := range []struct {
+		name string
+		spec *types.UserInterface
+	}{
+		{name: "existing name", spec: &types.UserInterface{Ifname: "eth0", Bridge: "br1", Ip: "10.1.0.2/24"}},
+		{name: "second gateway", spec: &types.UserInterface{Ifname: "eth1", Bridge: "br1", Ip: "10.1.0.2/24", Gateway: "10.1.0.1"}},
+		{name: "bridge without ip", spec: &types.UserInterface{Ifname: "eth1", Bridge: "br1"}},
+		// the pod has no sandbox, the NIC could not be added
+		{name: "failed insert", spec: &types.UserInterface{Bridge: "br1", Ip: "10.1.0.2/24"}},
+	} {
+		if _, err := p.insertInterface(c.spec); err == nil {
+			t.Fatalf("%s: interface is inserted", c.name)
+		}
+		if len(p.interfaces) != 1 || p.interfaces["eth0"] != eth0 {
+			t.Fatalf("%s: interfaces are changed: %v", c.name, p.interfaces)
+		}
+		if len(p.globalSpec.Interfaces) != 1 || p.globalSpec.Interfaces[0] != eth0.spec {
+			t.Fatalf("%s: interfaces in spec are changed: %v", c.name, p.globalSpec.Interfaces)
+		}
+	}
+
+	if err := p.loadInterface("eth1"); err == nil {
+		t.Fatal("failed interface is persisted")
+	}
+}
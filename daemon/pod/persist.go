@@ -8,6 +8,7 @@ import (
 	"github.com/hyperhq/hypercontainer-utils/hlog"
 	"github.com/hyperhq/hyperd/daemon/daemondb"
 	"github.com/hyperhq/hyperd/types"
+	"github.com/hyperhq/hyperd/utils"
 )
 
 /// Layout of Persistent Info of a Pod:
@@ -72,6 +73,57 @@ func LoadAllPods(db *daemondb.DaemonDB) chan *types.PersistPodLayout {
 	return ch
 }
 
+// PersistKey is a key of the persistent info and the pod it belongs to
+type PersistKey struct {
+	Key string
+	Pod string
+}
+
+type podMessage interface {
+	proto.Message
+	GetPod() string
+}
+
+// ListPersistKeys lists the keys of the persistent info of all the pods,
+// including the ones left behind by the pods without layout.
+func ListPersistKeys(db *daemondb.DaemonDB) ([]*PersistKey, error) {
+	var keys []*PersistKey
+
+	// the pod id is the suffix of the key
+	for _, format := range []string{LAYOUT_KEY_FMT, SB_KEY_FMT, PS_KEY_FMT, PMETA_KEY_FMT, PMAP_KEY_FMT} {
+		prefix := strings.TrimSuffix(format, "%s")
+		list, err := db.PrefixListKey([]byte(prefix), nil)
+		if err != nil {
+			return nil, err
+		}
+		for _, k := range list {
+			keys = append(keys, &PersistKey{Key: string(k), Pod: strings.TrimPrefix(string(k), prefix)})
+		}
+	}
+
+	// the pod id is in the persistent info of containers, volumes and interfaces
+	messages := map[string]func() podMessage{
+		CX_KEY_FMT: func() podMessage { return &types.PersistContainer{} },
+		VX_KEY_FMT: func() podMessage { return &types.PersistVolume{} },
+		IF_KEY_FMT: func() podMessage { return &types.PersistInterface{} },
+	}
+	for format, msg := range messages {
+		list, err := db.PrefixListPairs([]byte(strings.SplitN(format, "%s", 2)[0]), nil)
+		if err != nil {
+			return nil, err
+		}
+		for _, kv := range list {
+			m := msg()
+			if err = proto.Unmarshal(kv.V, m); err != nil {
+				hlog.Log(WARNING, "failed to decode %s: %v", string(kv.K), err)
+				continue
+			}
+			keys = append(keys, &PersistKey{Key: string(kv.K), Pod: m.GetPod()})
+		}
+	}
+	return keys, nil
+}
+
 func LoadXPod(factory *PodFactory, layout *types.PersistPodLayout) (*XPod, error) {
 	spec, err := loadGloabalSpec(factory.db, layout.Id)
 	if err != nil {
@@ -147,6 +199,7 @@ func LoadXPod(factory *PodFactory, layout *types.PersistPodLayout) (*XPod, error
 				return nil, err
 			}
 		}
+		p.restorePortMapping()
 	}
 
 	// don't need to reserve name again, because this is load
@@ -327,9 +380,10 @@ func (p *XPod) removePodMetaFromDB() error {
 
 func (p *XPod) savePortMapping() error {
 	pm := &types.PersistPortmappings{
-		Pod:          p.Id(),
-		ContainerIP:  p.containerIP,
-		PortMappings: p.portMappings,
+		Pod:           p.Id(),
+		ContainerIP:   p.containerIP,
+		ContainerIPv6: p.containerIPv6,
+		PortMappings:  p.portMappings,
 	}
 	return saveMessage(p.factory.db, fmt.Sprintf(PMAP_KEY_FMT, p.Id()), pm, p, "port mappings")
 }
@@ -340,7 +394,9 @@ func (p *XPod) loadPortMapping() error {
 	if err != nil {
 		return err
 	}
-	p.containerIP = pm.ContainerIP
+	// the address was saved in CIDR by the earlier versions
+	p.containerIP = utils.StripPrefixLen(pm.ContainerIP)
+	p.containerIPv6 = pm.ContainerIPv6
 	p.portMappings = pm.PortMappings
 	return nil
 }
@@ -350,11 +406,15 @@ func (p *XPod) removePortMappingFromDB() error {
 }
 
 func (c *Container) saveContainer() error {
+	c.status.RLock()
+	restartCount := c.status.RestartCount
+	c.status.RUnlock()
 	cx := &types.PersistContainer{
-		Id:       c.Id(),
-		Pod:      c.p.Id(),
-		Spec:     c.spec,
-		Descript: c.descript,
+		Id:           c.Id(),
+		Pod:          c.p.Id(),
+		Spec:         c.spec,
+		Descript:     c.descript,
+		RestartCount: int32(restartCount),
 	}
 	return saveMessage(c.p.factory.db, fmt.Sprintf(CX_KEY_FMT, c.Id()), cx, c, "container info")
 }
@@ -370,6 +430,7 @@ func (p *XPod) loadContainer(id string) error {
 		p.Log(ERROR, "failed to reload container %s from spec: %v", id, err)
 		return err
 	}
+	c.status.RestartCount = int(cx.RestartCount)
 	err = p.factory.registry.ReserveContainer(c.Id(), c.SpecName(), p.Id())
 	if err != nil {
 		p.Log(ERROR, "failed to register name of container %s (%s) during load", c.Id(), c.SpecName(), err)
@@ -451,6 +512,7 @@ func (p *XPod) saveSandbox() error {
 	defer p.statusLock.RUnlock()
 	if !stop_status[p.status] {
 		sb.Id = p.sandbox.Id
+		sb.MemHotplugged = p.memHotplugged
 		sb.PersistInfo, err = p.sandbox.Dump()
 		if err != nil {
 			hlog.HLog(ERROR, p, 2, "failed to dump sandbox %s: %v", sb.Id, err)
@@ -467,6 +529,7 @@ func (p *XPod) loadSandbox() error {
 	if err != nil {
 		return err
 	}
+	p.memHotplugged = sb.MemHotplugged
 	return p.reconnectSandbox(sb.Id, sb.PersistInfo)
 }
 
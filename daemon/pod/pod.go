@@ -39,6 +39,26 @@ const (
 	S_POD_ERROR    // failed to stop/remove...
 )
 
+func (s PodState) String() string {
+	switch s {
+	case S_POD_NONE:
+		return "none"
+	case S_POD_STARTING:
+		return "starting"
+	case S_POD_RUNNING:
+		return "running"
+	case S_POD_PAUSED:
+		return "paused"
+	case S_POD_STOPPED:
+		return "stopped"
+	case S_POD_STOPPING:
+		return "stopping"
+	case S_POD_ERROR:
+		return "error"
+	}
+	return fmt.Sprintf("unknown(%d)", int32(s))
+}
+
 // XPod is the Pod keeper, or, the App View of a sandbox. All API for Pod operations or Container operations should be
 // provided by this struct.
 type XPod struct {
@@ -54,19 +74,22 @@ type XPod struct {
 	globalSpec *apitypes.UserPod
 
 	// stateful resources:
-	containers   map[string]*Container
-	volumes      map[string]*Volume
-	interfaces   map[string]*Interface
-	services     *Services
-	containerIP  string // only for doing portMapping
-	portMappings []*apitypes.PortMapping
-	labels       map[string]string
-	resourceLock *sync.Mutex
+	containers    map[string]*Container
+	volumes       map[string]*Volume
+	interfaces    map[string]*Interface
+	services      *Services
+	containerIP   string // only for doing portMapping
+	containerIPv6 string
+	portMappings  []*apitypes.PortMapping
+	labels        map[string]string
+	resourceLock  *sync.Mutex
 
 	prestartExecs [][]string
 
 	sandbox *hypervisor.Vm
 	factory *PodFactory
+	// the sandbox is booted with only one memory slot for hotplug
+	memHotplugged bool
 
 	info       *apitypes.PodInfo
 	status     PodState
@@ -253,6 +276,37 @@ func (p *XPod) ContainerStatusString(cid string) string {
 	return ""
 }
 
+// Labels() returns a copy of the labels of the pod
+func (p *XPod) Labels() map[string]string {
+	p.statusLock.RLock()
+	defer p.statusLock.RUnlock()
+
+	labels := make(map[string]string, len(p.labels))
+	for k, v := range p.labels {
+		labels[k] = v
+	}
+	return labels
+}
+
+// ContainerLabels() returns the labels of the pod overridden by the ones of
+// the container
+func (p *XPod) ContainerLabels(cid string) map[string]string {
+	labels := p.Labels()
+	if c, ok := p.containers[cid]; ok {
+		for k, v := range c.spec.Labels {
+			labels[k] = v
+		}
+	}
+	return labels
+}
+
+func (p *XPod) ContainerImage(cid string) string {
+	if c, ok := p.containers[cid]; ok {
+		return c.spec.Image
+	}
+	return ""
+}
+
 func (p *XPod) ContainerHasTty(cid string) bool {
 	if c, ok := p.containers[cid]; ok {
 		return c.HasTty()
@@ -272,6 +326,9 @@ func (p *XPod) Info() (*apitypes.PodInfo, error) {
 	}
 
 	p.updatePodInfo()
+	// out of the statusLock, which should not be held before the resourceLock
+	p.info.Status.PortMappings = p.ListPortMappings()
+	p.info.Spec.Interfaces = p.ListInterfaces()
 
 	return p.info, nil
 }
@@ -298,7 +355,8 @@ func (p *XPod) Stats() *runvtypes.PodStats {
 
 	p.statusLock.Lock()
 	if p.sandbox == nil {
-		ch <- nil
+		p.statusLock.Unlock()
+		return nil
 	}
 	go func(sb *hypervisor.Vm) {
 		ch <- sb.Stats()
@@ -528,6 +586,9 @@ func (p *XPod) WaitContainer(cid string, second int) (int, error) {
 }
 
 func (p *XPod) RenameContainer(cid, name string) error {
+	p.resourceLock.Lock()
+	defer p.resourceLock.Unlock()
+
 	var err error
 	c, ok := p.containers[cid]
 	if !ok {
@@ -542,5 +603,8 @@ func (p *XPod) RenameContainer(cid, name string) error {
 		return err
 	}
 	p.Log(INFO, "rename container from %s to %s", old, name)
+	if err = p.updateHosts(); err != nil {
+		p.Log(WARNING, "(ignored) failed to rename container in hosts file: %v", err)
+	}
 	return nil
 }
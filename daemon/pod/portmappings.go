@@ -2,7 +2,10 @@ package pod
 
 import (
 	"fmt"
+	"strings"
+
 	"github.com/hyperhq/hypercontainer-utils/hlog"
+	"github.com/hyperhq/hyperd/daemon/events"
 	"github.com/hyperhq/hyperd/networking/portmapping"
 	apitypes "github.com/hyperhq/hyperd/types"
 )
@@ -24,6 +27,19 @@ func translatePortMapping(spec []*apitypes.PortMapping) ([]*portmapping.PortMapp
 	return pms, nil
 }
 
+// applyAllocatedPorts writes the host ports allocated by SetupPortMaps back
+// to the spec, pms are translated from spec in order.
+func applyAllocatedPorts(spec []*apitypes.PortMapping, pms []*portmapping.PortMapping) bool {
+	applied := false
+	for i, entry := range spec {
+		if entry.AutoHostPort() && i < len(pms) && !pms[i].AutoHostPort() {
+			entry.HostPort = pms[i].FromPorts.String()
+			applied = true
+		}
+	}
+	return applied
+}
+
 func (p *XPod) initPortMapping() error {
 	if p.containerIP != "" && len(p.portMappings) > 0 {
 		pms, err := translatePortMapping(p.portMappings)
@@ -42,13 +58,40 @@ func (p *XPod) initPortMapping() error {
 			p.Log(ERROR, "failed to setup port mappings: %v", err)
 			return err
 		}
+		if err = portmapping.SetupPortMaps6(p.containerIPv6, pms); err != nil {
+			p.Log(ERROR, "failed to setup IPv6 port mappings: %v", err)
+			portmapping.ReleasePortMaps(p.containerIP, nil, pms)
+			return err
+		}
 		if len(preExec) > 0 {
 			p.prestartExecs = append(p.prestartExecs, preExec...)
 		}
+		if applyAllocatedPorts(p.portMappings, pms) {
+			p.Log(INFO, "host ports allocated: %v", p.portMappings)
+			if err = p.savePortMapping(); err != nil {
+				p.Log(WARNING, "failed to persist the allocated host ports")
+			}
+		}
 	}
 	return nil
 }
 
+// restorePortMapping rebuilds the host rules of the port mappings of a
+// running pod, which may have been flushed while hyperd was down.
+func (p *XPod) restorePortMapping() {
+	if p.containerIP == "" || len(p.portMappings) == 0 {
+		return
+	}
+	pms, err := translatePortMapping(p.portMappings)
+	if err != nil {
+		p.Log(ERROR, "failed to parse port mappings: %v", err)
+		return
+	}
+	if err = portmapping.RestorePortMaps(p.containerIP, p.containerIPv6, pms); err != nil {
+		p.Log(ERROR, "failed to restore port mappings: %v", err)
+	}
+}
+
 func (p *XPod) flushPortMapping() error {
 	if p.containerIP != "" && len(p.portMappings) > 0 {
 		pms, err := translatePortMapping(p.portMappings)
@@ -56,6 +99,10 @@ func (p *XPod) flushPortMapping() error {
 			hlog.Log(ERROR, err)
 			return err
 		}
+		if err = portmapping.ReleasePortMaps6(p.containerIPv6, pms); err != nil {
+			p.Log(ERROR, "release IPv6 port mappings failed: %v", err)
+			return err
+		}
 		_, err = portmapping.ReleasePortMaps(p.containerIP, nil, pms)
 		if err != nil {
 			p.Log(ERROR, "release port mappings failed: %v", err)
@@ -95,6 +142,12 @@ func (p *XPod) AddPortMapping(spec []*apitypes.PortMapping) error {
 		p.Log(ERROR, "failed to apply port mapping rules: %v", err)
 		return err
 	}
+	if err = portmapping.SetupPortMaps6(p.containerIPv6, pms); err != nil {
+		p.Log(ERROR, "failed to apply IPv6 port mapping rules: %v", err)
+		portmapping.ReleasePortMaps(p.containerIP, extPrefix, pms)
+		return err
+	}
+	applyAllocatedPorts(spec, pms)
 	if len(preExec) > 0 {
 		p.prestartExecs = append(p.prestartExecs, preExec...)
 		if p.sandbox != nil {
@@ -112,6 +165,7 @@ func (p *XPod) AddPortMapping(spec []*apitypes.PortMapping) error {
 	copy(all, spec)
 	copy(all[len(spec):], p.portMappings)
 	p.portMappings = all
+	p.emitEvent(events.PORTMAPPING_ADD, "", "", 0, portMappingAttributes(spec))
 
 	err = p.savePortMapping()
 	if err != nil {
@@ -167,6 +221,10 @@ func (p *XPod) removePortMapping(tbr []*apitypes.PortMapping, eq portMappingComp
 		len(p.globalSpec.PortmappingWhiteLists.ExternalNetworks) > 0 {
 		extPrefix = p.globalSpec.PortmappingWhiteLists.ExternalNetworks
 	}
+	if err = portmapping.ReleasePortMaps6(p.containerIPv6, act); err != nil {
+		p.Log(ERROR, "failed to clean up IPv6 rules: %v", err)
+		return err
+	}
 	postExec, err := portmapping.ReleasePortMaps(p.containerIP, extPrefix, act)
 	if err != nil {
 		p.Log(ERROR, "failed to clean up rules: %v", err)
@@ -186,6 +244,7 @@ func (p *XPod) removePortMapping(tbr []*apitypes.PortMapping, eq portMappingComp
 	}
 
 	p.portMappings = other
+	p.emitEvent(events.PORTMAPPING_DELETE, "", "", 0, portMappingAttributes(rm))
 	err = p.savePortMapping()
 	if err != nil {
 		p.Log(WARNING, "failed to persist removed portmapping rules")
@@ -215,3 +274,11 @@ func (p *XPod) ListPortMappings() []*apitypes.PortMapping {
 	p.resourceLock.Unlock()
 	return res
 }
+
+func portMappingAttributes(spec []*apitypes.PortMapping) map[string]string {
+	rules := make([]string, 0, len(spec))
+	for _, pm := range spec {
+		rules = append(rules, fmt.Sprintf("%s:%s->%s", pm.Protocol, pm.HostPort, pm.ContainerPort))
+	}
+	return map[string]string{"rules": strings.Join(rules, ",")}
+}
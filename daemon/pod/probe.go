@@ -0,0 +1,351 @@
+package pod
+
+import (
+	"bytes"
+	"crypto/tls"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"syscall"
+	"time"
+
+	"github.com/docker/docker/pkg/stdcopy"
+
+	apitypes "github.com/hyperhq/hyperd/types"
+	"github.com/hyperhq/runv/hypervisor"
+)
+
+const (
+	HEALTH_STARTING  = "starting"
+	HEALTH_HEALTHY   = "healthy"
+	HEALTH_UNHEALTHY = "unhealthy"
+
+	// default values of the probe settings, same as kubernetes
+	DEFAULT_PROBE_PERIOD            = 10
+	DEFAULT_PROBE_TIMEOUT           = 1
+	DEFAULT_PROBE_FAILURE_THRESHOLD = 3
+	DEFAULT_PROBE_SUCCESS_THRESHOLD = 1
+
+	maxProbeOutput = 1024
+)
+
+// ContainerHealth keeps the latest results of the liveness and readiness
+// probes of a container.
+type ContainerHealth struct {
+	Status        string
+	Ready         bool
+	FailingStreak int
+	LastProbe     time.Time
+	LastOutput    string
+
+	stop chan struct{}
+	sync.RWMutex
+}
+
+func newContainerHealth() *ContainerHealth {
+	return &ContainerHealth{}
+}
+
+func (h *ContainerHealth) Info() *apitypes.HealthStatus {
+	h.RLock()
+	defer h.RUnlock()
+	s := &apitypes.HealthStatus{
+		Status:        h.Status,
+		Ready:         h.Ready,
+		FailingStreak: int32(h.FailingStreak),
+		LastOutput:    h.LastOutput,
+	}
+	if !h.LastProbe.IsZero() {
+		s.LastProbe = h.LastProbe.Format(time.RFC3339)
+	}
+	return s
+}
+
+func (c *Container) hasProbe() bool {
+	return c.spec.LivenessProbe != nil || c.spec.ReadinessProbe != nil
+}
+
+// startProbes() launches the probe loops of a running container, the loops
+// quit when stopProbes() is called as the container exited.
+func (c *Container) startProbes() {
+	if !c.hasProbe() {
+		return
+	}
+
+	c.health.Lock()
+	if c.health.stop != nil {
+		c.health.Unlock()
+		return
+	}
+	stop := make(chan struct{})
+	c.health.stop = stop
+	c.health.FailingStreak = 0
+	c.health.Status = ""
+	if c.spec.LivenessProbe != nil {
+		c.health.Status = HEALTH_STARTING
+	}
+	c.health.Ready = c.spec.ReadinessProbe == nil
+	c.health.Unlock()
+
+	if c.spec.LivenessProbe != nil {
+		go c.probeLoop(c.spec.LivenessProbe, true, stop)
+	}
+	if c.spec.ReadinessProbe != nil {
+		go c.probeLoop(c.spec.ReadinessProbe, false, stop)
+	}
+	c.Log(DEBUG, "container probes started")
+}
+
+func (c *Container) stopProbes() {
+	c.health.Lock()
+	if c.health.stop != nil {
+		close(c.health.stop)
+		c.health.stop = nil
+	}
+	c.health.Ready = false
+	c.health.Unlock()
+}
+
+func probeSettings(probe *apitypes.UserProbe) (period, timeout time.Duration, failure, success int) {
+	period, timeout = DEFAULT_PROBE_PERIOD*time.Second, DEFAULT_PROBE_TIMEOUT*time.Second
+	failure, success = DEFAULT_PROBE_FAILURE_THRESHOLD, DEFAULT_PROBE_SUCCESS_THRESHOLD
+	if probe.PeriodSeconds > 0 {
+		period = time.Duration(probe.PeriodSeconds) * time.Second
+	}
+	if probe.TimeoutSeconds > 0 {
+		timeout = time.Duration(probe.TimeoutSeconds) * time.Second
+	}
+	if probe.FailureThreshold > 0 {
+		failure = int(probe.FailureThreshold)
+	}
+	if probe.SuccessThreshold > 0 {
+		success = int(probe.SuccessThreshold)
+	}
+	return
+}
+
+func (c *Container) probeLoop(probe *apitypes.UserProbe, liveness bool, stop <-chan struct{}) {
+	var (
+		kind                           = "readiness"
+		period, timeout, failure, succ = probeSettings(probe)
+		failures, successes            int
+	)
+	if liveness {
+		kind = "liveness"
+	}
+
+	if probe.InitialDelaySeconds > 0 {
+		select {
+		case <-time.After(time.Duration(probe.InitialDelaySeconds) * time.Second):
+		case <-stop:
+			return
+		}
+	}
+
+	ticker := time.NewTicker(period)
+	defer ticker.Stop()
+
+	for {
+		ok, output := c.probe(probe, timeout)
+		if ok {
+			failures, successes = 0, successes+1
+		} else {
+			failures, successes = failures+1, 0
+			c.Log(DEBUG, "%s probe failed (%d/%d): %s", kind, failures, failure, output)
+		}
+
+		c.health.Lock()
+		select {
+		case <-stop:
+			// the result is staled as the container has exited
+			c.health.Unlock()
+			return
+		default:
+		}
+		c.health.LastProbe = time.Now()
+		c.health.LastOutput = output
+		if liveness {
+			c.health.FailingStreak = failures
+			if failures >= failure {
+				c.health.Status = HEALTH_UNHEALTHY
+			} else if successes >= succ {
+				c.health.Status = HEALTH_HEALTHY
+			}
+		} else {
+			if failures >= failure {
+				c.health.Ready = false
+			} else if successes >= succ {
+				c.health.Ready = true
+			}
+		}
+		c.health.Unlock()
+
+		if liveness && failures >= failure {
+			c.Log(WARNING, "liveness probe failed %d times, kill the container", failures)
+			c.killUnhealthy()
+			return
+		}
+
+		select {
+		case <-ticker.C:
+		case <-stop:
+			return
+		}
+	}
+}
+
+// killUnhealthy() kills the container without tagging it as killed by user,
+// and then the restart policy will take effect as the container exits.
+func (c *Container) killUnhealthy() {
+	err := c.p.protectedSandboxOperation(
+		func(sb *hypervisor.Vm) error {
+			return sb.KillContainer(c.Id(), syscall.SIGKILL)
+		},
+		time.Second*5,
+		fmt.Sprintf("Kill unhealthy container %s", c.Id()))
+	if err != nil {
+		c.Log(ERROR, "failed to kill unhealthy container: %v", err)
+	}
+}
+
+func (c *Container) probe(probe *apitypes.UserProbe, timeout time.Duration) (bool, string) {
+	switch {
+	case probe.Exec != nil:
+		return c.execProbe(probe.Exec.Command, timeout)
+	case probe.TcpSocket != nil:
+		return c.tcpProbe(int(probe.TcpSocket.Port), timeout)
+	case probe.HttpGet != nil:
+		return c.httpProbe(probe.HttpGet, timeout)
+	}
+	return false, "no probe handler"
+}
+
+// probeOutput collects the output of exec probe, which may be written by the
+// stream goroutines of the sandbox.
+type probeOutput struct {
+	buf bytes.Buffer
+	sync.Mutex
+}
+
+func (o *probeOutput) Write(p []byte) (int, error) {
+	o.Lock()
+	defer o.Unlock()
+	return o.buf.Write(p)
+}
+
+func (o *probeOutput) Close() error {
+	return nil
+}
+
+func (o *probeOutput) String() string {
+	o.Lock()
+	raw := o.buf.Bytes()
+	o.Unlock()
+
+	output := &bytes.Buffer{}
+	if _, err := stdcopy.StdCopy(output, output, bytes.NewReader(raw)); err != nil {
+		return string(raw)
+	}
+	return output.String()
+}
+
+func truncateProbeOutput(out string) string {
+	out = strings.TrimSpace(out)
+	if len(out) > maxProbeOutput {
+		out = out[:maxProbeOutput]
+	}
+	return out
+}
+
+// execProbe() runs the probe command inside the container through the exec
+// path of the pod, and regards exit code 0 as success.
+func (c *Container) execProbe(cmd []string, timeout time.Duration) (bool, string) {
+	cmds, err := json.Marshal(cmd)
+	if err != nil {
+		return false, err.Error()
+	}
+	execId, err := c.p.createExec(c.Id(), string(cmds), false, nil, true)
+	if err != nil {
+		return false, err.Error()
+	}
+	defer c.p.DeleteExec(c.Id(), execId)
+
+	var (
+		stdin, stdinWriter = io.Pipe()
+		stdout             = &probeOutput{}
+		done               = make(chan error, 1)
+	)
+	// the probe reads nothing, stdin is closed once the exec finishes
+	defer stdinWriter.Close()
+	go func() {
+		done <- c.p.StartExec(stdin, stdout, nil, c.Id(), execId)
+	}()
+
+	select {
+	case err = <-done:
+		if err != nil {
+			return false, err.Error()
+		}
+	case <-time.After(timeout):
+		c.p.KillExec(execId, int64(syscall.SIGKILL))
+		return false, fmt.Sprintf("exec probe timeout after %v", timeout)
+	}
+
+	code, err := c.p.GetExecExitCode(c.Id(), execId)
+	if err != nil {
+		return false, err.Error()
+	}
+
+	return code == 0, truncateProbeOutput(stdout.String())
+}
+
+// tcpProbe() and httpProbe() access the container from host through the pod IP
+func (c *Container) tcpProbe(port int, timeout time.Duration) (bool, string) {
+	if c.p.containerIP == "" {
+		return false, "pod has no IP address"
+	}
+	conn, err := net.DialTimeout("tcp", net.JoinHostPort(c.p.containerIP, strconv.Itoa(port)), timeout)
+	if err != nil {
+		return false, err.Error()
+	}
+	conn.Close()
+	return true, ""
+}
+
+func (c *Container) httpProbe(probe *apitypes.UserProbeHTTPGet, timeout time.Duration) (bool, string) {
+	if c.p.containerIP == "" {
+		return false, "pod has no IP address"
+	}
+	scheme := strings.ToLower(probe.Scheme)
+	if scheme == "" {
+		scheme = "http"
+	}
+	path := probe.Path
+	if !strings.HasPrefix(path, "/") {
+		path = "/" + path
+	}
+	url := fmt.Sprintf("%s://%s%s", scheme, net.JoinHostPort(c.p.containerIP, strconv.Itoa(int(probe.Port))), path)
+
+	client := &http.Client{
+		Timeout: timeout,
+		Transport: &http.Transport{
+			TLSClientConfig:   &tls.Config{InsecureSkipVerify: true},
+			DisableKeepAlives: true,
+		},
+	}
+	rsp, err := client.Get(url)
+	if err != nil {
+		return false, err.Error()
+	}
+	defer rsp.Body.Close()
+
+	body, _ := ioutil.ReadAll(io.LimitReader(rsp.Body, maxProbeOutput))
+	output := truncateProbeOutput(fmt.Sprintf("%s %s", rsp.Status, string(body)))
+	return rsp.StatusCode >= 200 && rsp.StatusCode < 400, output
+}
@@ -3,10 +3,12 @@ package pod
 import (
 	"fmt"
 	"path/filepath"
+	"strings"
 	"sync"
 	"time"
 
 	"github.com/hyperhq/hypercontainer-utils/hlog"
+	"github.com/hyperhq/hyperd/daemon/events"
 	"github.com/hyperhq/hyperd/errors"
 	apitypes "github.com/hyperhq/hyperd/types"
 	"github.com/hyperhq/hyperd/utils"
@@ -71,6 +73,7 @@ func CreateXPod(factory *PodFactory, spec *apitypes.UserPod) (*XPod, error) {
 		return nil, err
 	}
 
+	p.emitEvent(events.POD_CREATE, "", "", 0, nil)
 	return p, nil
 }
 
@@ -181,6 +184,11 @@ func (p *XPod) doContainerCreate(c *apitypes.UserContainer) (string, error) {
 		p.Log(ERROR, "error during save sandbox: %v", err)
 		return "", err
 	}
+	if p.IsAlive() {
+		if err = p.updateHosts(); err != nil {
+			p.Log(WARNING, "(ignored) failed to add container %s to hosts file: %v", pc.SpecName(), err)
+		}
+	}
 	return pc.Id(), nil
 }
 
@@ -239,10 +247,63 @@ func (p *XPod) Start() error {
 		return err
 	}
 
+	p.emitEvent(events.POD_START, "", "", 0, nil)
 	return p.saveSandbox()
 }
 
-func (p *XPod) createSandbox(spec *apitypes.UserPod) error {
+// useDaemonDNS tells whether the pod is given the nameserver of the daemon,
+// which is not for a pod with its own dns or resolv.conf file.
+func (p *XPod) useDaemonDNS(spec *apitypes.UserPod) bool {
+	if p.factory.dns == nil || len(spec.Dns) > 0 {
+		return false
+	}
+	for _, f := range spec.Files {
+		if f.Uri == "file:///etc/resolv.conf" {
+			return false
+		}
+	}
+	return true
+}
+
+// sandboxDNS gets the dns of the sandbox. The nameserver of the daemon is
+// not saved in the spec, so that a pod follows the daemon across restarts,
+// its search domains and options follow the ones of the pod.
+func (p *XPod) sandboxDNS(spec *apitypes.UserPod) (dns, search, options []string) {
+	if !p.useDaemonDNS(spec) {
+		return spec.Dns, spec.DnsSearch, spec.DnsOptions
+	}
+	dns = p.factory.dns.Servers
+	search = append([]string{}, spec.DnsSearch...)
+	for _, s := range p.factory.dns.Search {
+		if !contains(search, s) {
+			search = append(search, s)
+		}
+	}
+	options = append([]string{}, spec.DnsOptions...)
+	keys := make([]string, 0, len(options))
+	for _, o := range options {
+		keys = append(keys, strings.SplitN(o, ":", 2)[0])
+	}
+	for _, o := range p.factory.dns.Options {
+		if !contains(keys, strings.SplitN(o, ":", 2)[0]) {
+			options = append(options, o)
+		}
+	}
+	return dns, search, options
+}
+
+func contains(list []string, s string) bool {
+	for _, l := range list {
+		if l == s {
+			return true
+		}
+	}
+	return false
+}
+
+func (p *XPod) createSandbox(spec *apitypes.UserPod) (err error) {
+	defer observeSandboxStart(time.Now(), &err)
+
 	//in the future, here
 	sandbox, err := startSandbox(p.factory.vmFactory, int(spec.Resource.Vcpu), int(spec.Resource.Memory), "", "")
 	if err != nil {
@@ -254,11 +315,12 @@ func (p *XPod) createSandbox(spec *apitypes.UserPod) error {
 		return errors.ErrSandboxNotExist
 	}
 
+	dns, search, options := p.sandboxDNS(spec)
 	config := &runv.SandboxConfig{
 		Hostname:   spec.Hostname,
-		Dns:        spec.Dns,
-		DnsOptions: spec.DnsOptions,
-		DnsSearch:  spec.DnsSearch,
+		Dns:        dns,
+		DnsOptions: options,
+		DnsSearch:  search,
 		Neighbors: &runv.NeighborNetworks{
 			InternalNetworks: spec.PortmappingWhiteLists.InternalNetworks,
 			ExternalNetworks: spec.PortmappingWhiteLists.ExternalNetworks,
@@ -266,6 +328,7 @@ func (p *XPod) createSandbox(spec *apitypes.UserPod) error {
 	}
 
 	p.sandbox = sandbox
+	p.memHotplugged = false
 	p.status = S_POD_STARTING
 
 	go p.waitVMStop()
@@ -419,10 +482,14 @@ func (p *XPod) prepareResources() error {
 			return err
 		}
 		if p.containerIP == "" {
-			p.containerIP = inf.descript.Ip
+			p.containerIP, p.containerIPv6 = inf.addresses()
 		}
 	}
 
+	if err := p.updateHosts(); err != nil {
+		p.Log(WARNING, "(ignored) failed to generate hosts file: %v", err)
+	}
+
 	err = p.initPortMapping()
 	if err != nil {
 		p.Log(ERROR, "failed to initial setup port mappings: %v", err)
@@ -509,7 +576,11 @@ func (p *XPod) sandboxShareDir() string {
 		// the /dev/null is not a dir, then, can not create or open it
 		return "/dev/null/no-such-dir"
 	}
-	return filepath.Join(hypervisor.BaseDir, p.sandbox.Id, hypervisor.ShareDirTag)
+	return sandboxShareDir(p.sandbox.Id)
+}
+
+func sandboxShareDir(id string) string {
+	return filepath.Join(hypervisor.BaseDir, id, hypervisor.ShareDirTag)
 }
 
 func (p *XPod) waitPodRun(activity string) error {
@@ -0,0 +1,74 @@
+package pod
+
+import (
+	"reflect"
+	"testing"
+
+	apitypes "github.com/hyperhq/hyperd/types"
+)
+
+func TestSandboxDNS(t *testing.T) {
+	dns := &DNSConfig{
+		Servers: []string{"192.168.123.1"},
+		Search:  []string{"hyper", "example.com"},
+		Options: []string{"ndots:2", "rotate"},
+	}
+
+	for _, c := range []struct {
+		name                     string
+		dns                      *DNSConfig
+		spec                     *apitypes.UserPod
+		servers, search, options []string
+	}{
+		{
+			name:    "daemon dns",
+			dns:     dns,
+			spec:    &apitypes.UserPod{},
+			servers: []string{"192.168.123.1"},
+			search:  []string{"hyper", "example.com"},
+			options: []string{"ndots:2", "rotate"},
+		},
+		{
+			name: "merged with the pod",
+			dns:  dns,
+			spec: &apitypes.UserPod{
+				DnsSearch:  []string{"corp.example.com", "example.com"},
+				DnsOptions: []string{"ndots:5"},
+			},
+			servers: []string{"192.168.123.1"},
+			search:  []string{"corp.example.com", "example.com", "hyper"},
+			options: []string{"ndots:5", "rotate"},
+		},
+		{
+			name: "pod dns",
+			dns:  dns,
+			spec: &apitypes.UserPod{
+				Dns:       []string{"8.8.8.8"},
+				DnsSearch: []string{"example.com"},
+			},
+			servers: []string{"8.8.8.8"},
+			search:  []string{"example.com"},
+		},
+		{
+			name: "pod resolv.conf",
+			dns:  dns,
+			spec: &apitypes.UserPod{
+				Files: []*apitypes.UserFile{{Name: "resolv", Uri: "file:///etc/resolv.conf"}},
+			},
+		},
+		{
+			name:    "no daemon dns",
+			spec:    &apitypes.UserPod{DnsOptions: []string{"ndots:5"}},
+			options: []string{"ndots:5"},
+		},
+	} {
+		p := &XPod{factory: &PodFactory{dns: c.dns}}
+		servers, search, options := p.sandboxDNS(c.spec)
+		if !reflect.DeepEqual(servers, c.servers) || !reflect.DeepEqual(search, c.search) || !reflect.DeepEqual(options, c.options) {
+			t.Errorf("%s: got %v %v %v, expect %v %v %v", c.name, servers, search, options, c.servers, c.search, c.options)
+		}
+	}
+	if dns.Search[0] != "hyper" || len(dns.Search) != 2 || len(dns.Options) != 2 {
+		t.Errorf("daemon dns is changed: %v", dns)
+	}
+}
@@ -0,0 +1,127 @@
+package pod
+
+import (
+	"time"
+
+	apitypes "github.com/hyperhq/hyperd/types"
+)
+
+var (
+	// RestartBackoffBase is the delay before the first restart of a container,
+	// the delay doubles on every following restart until RestartBackoffMax.
+	RestartBackoffBase = time.Second
+	RestartBackoffMax  = 5 * time.Minute
+	// a container ran longer than RestartBackoffReset is regarded as recovered,
+	// and the backoff starts from RestartBackoffBase again.
+	RestartBackoffReset = 10 * time.Minute
+)
+
+func (c *Container) restartPolicy() (string, int) {
+	policy := c.spec.RestartPolicy
+	if policy == "" {
+		policy = c.p.globalSpec.RestartPolicy
+	}
+	name, retry, err := apitypes.ParseRestartPolicy(policy)
+	if err != nil {
+		c.Log(WARNING, "ignore invalid restart policy: %v", err)
+		return apitypes.RESTART_POLICY_NEVER, 0
+	}
+	return name, retry
+}
+
+// shouldRestart() decides whether the exited container should be restarted
+// according to its restart policy. A container stopped or killed by user
+// won't be restarted whatever the policy is.
+func (c *Container) shouldRestart() bool {
+	if !c.p.IsRunning() {
+		return false
+	}
+
+	policy, retry := c.restartPolicy()
+
+	c.status.RLock()
+	defer c.status.RUnlock()
+
+	if c.status.State != S_CONTAINER_CREATED || c.status.Killed {
+		return false
+	}
+
+	switch policy {
+	case apitypes.RESTART_POLICY_ALWAYS:
+		return true
+	case apitypes.RESTART_POLICY_ONFAILURE:
+		if c.status.ExitCode == 0 {
+			return false
+		}
+		if retry > 0 && c.status.RestartCount >= retry {
+			c.Log(INFO, "container reached max restart count %d, give up", retry)
+			return false
+		}
+		return true
+	}
+	return false
+}
+
+// nextRestartDelay() returns the exponential backoff delay of the next restart
+func (cs *ContainerStatus) nextRestartDelay() time.Duration {
+	cs.Lock()
+	defer cs.Unlock()
+
+	if cs.restartBackoff == 0 || cs.FinishedAt.Sub(cs.StartedAt) >= RestartBackoffReset {
+		cs.restartBackoff = RestartBackoffBase
+	} else {
+		cs.restartBackoff *= 2
+		if cs.restartBackoff > RestartBackoffMax {
+			cs.restartBackoff = RestartBackoffMax
+		}
+	}
+	return cs.restartBackoff
+}
+
+func (c *Container) scheduleRestart() {
+	if !c.shouldRestart() {
+		return
+	}
+	delay := c.status.nextRestartDelay()
+	c.Log(INFO, "container will be restarted in %v", delay)
+	cid := c.Id()
+	time.AfterFunc(delay, func() {
+		c.p.restartContainer(cid)
+	})
+}
+
+// restartContainer() is the restart supervisor of the pod, it is fired by
+// the exited containers which should be restarted by the restart policy.
+func (p *XPod) restartContainer(cid string) {
+	p.resourceLock.Lock()
+	defer p.resourceLock.Unlock()
+
+	c, ok := p.containers[cid]
+	if !ok {
+		p.Log(DEBUG, "container %s has been removed, cancel restart", cid)
+		return
+	}
+
+	// the pod or container may be stopped or started by user during the backoff
+	if !c.shouldRestart() {
+		c.Log(DEBUG, "container does not need restart now, state: %v", c.CurrentState())
+		return
+	}
+
+	c.status.Lock()
+	c.status.RestartCount++
+	count := c.status.RestartCount
+	c.status.Unlock()
+
+	c.Log(INFO, "restart container (restart count: %d)", count)
+	if err := c.start(); err != nil {
+		c.Log(ERROR, "failed to restart container: %v", err)
+	}
+
+	if err := c.saveContainer(); err != nil {
+		c.Log(ERROR, "failed to save restart count: %v", err)
+	}
+	if err := p.saveSandbox(); err != nil {
+		p.Log(ERROR, "failed to save sandbox after restart container: %v", err)
+	}
+}
@@ -0,0 +1,220 @@
+package pod
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hyperhq/hyperd/storage"
+	dm "github.com/hyperhq/hyperd/storage/devicemapper"
+	apitypes "github.com/hyperhq/hyperd/types"
+	"github.com/hyperhq/runv/hypervisor"
+)
+
+// the hypervisor drivers which could grow the disk of a running sandbox
+var diskResizeDrivers = map[string]bool{
+	"qemu": true,
+}
+
+// VolumeResizer is implemented by the pod storages which could grow the
+// volumes created by them. The filesystem on a block volume is left to the
+// caller.
+type VolumeResizer interface {
+	ResizeVolume(spec *apitypes.UserVolume, size uint64) error
+}
+
+func (v *Volume) isBlock() bool {
+	return v.spec.Format == "raw" || v.spec.Format == "qcow2"
+}
+
+func (v *Volume) fstype() string {
+	if v.spec.Fstype != "" {
+		return v.spec.Fstype
+	}
+	if v.descript != nil && v.descript.Fstype != "" {
+		return v.descript.Fstype
+	}
+	fstype, err := dm.ProbeFsType(v.spec.Source)
+	if err != nil {
+		return storage.DEFAULT_VOL_FS
+	}
+	return fstype
+}
+
+// ResizeVolume() grows a volume created by the storage driver to size bytes.
+// The filesystem of a block volume is grown in the sandbox if the volume is
+// inserted into a running pod, or on the host otherwise. Volumes can not be
+// shrunk.
+func (p *XPod) ResizeVolume(name string, size int64) error {
+	p.resourceLock.Lock()
+	defer p.resourceLock.Unlock()
+
+	v, ok := p.volumes[name]
+	if !ok {
+		err := fmt.Errorf("volume %s not found", name)
+		p.Log(ERROR, err)
+		return err
+	}
+	if size <= 0 || size < v.spec.SizeBytes {
+		err := fmt.Errorf("can not resize volume %s from %d to %d bytes", name, v.spec.SizeBytes, size)
+		v.Log(ERROR, err)
+		return err
+	}
+	rs, ok := p.factory.sd.(VolumeResizer)
+	if !ok {
+		err := fmt.Errorf("storage driver %s does not support volume resize", p.factory.sd.Type())
+		v.Log(ERROR, err)
+		return err
+	}
+
+	online := false
+	if v.isBlock() {
+		if !p.IsRunning() && !p.IsStopped() {
+			err := fmt.Errorf("pod is not running or stopped, can not resize volume")
+			v.Log(ERROR, err)
+			return err
+		}
+		switch v.getStatus() {
+		case S_VOLUME_INSERTED:
+			online = true
+		case S_VOLUME_INSERTING:
+			err := fmt.Errorf("volume is being inserted, can not be resized")
+			v.Log(ERROR, err)
+			return err
+		}
+		if driver := hypervisor.HDriver.Name(); online && !diskResizeDrivers[driver] {
+			err := fmt.Errorf("hypervisor driver %s does not support volume resize", driver)
+			v.Log(ERROR, err)
+			return err
+		}
+	}
+
+	if err := rs.ResizeVolume(v.spec, uint64(size)); err != nil {
+		v.Log(ERROR, "resize volume failed: %v", err)
+		return err
+	}
+	v.Log(INFO, "resize volume from %d to %d bytes", v.spec.SizeBytes, size)
+	v.spec.SizeBytes = size
+	if err := v.saveVolume(); err != nil {
+		return err
+	}
+
+	if !v.isBlock() {
+		return nil
+	}
+	if !online {
+		return storage.GrowFilesystem(v.spec.Source, v.fstype())
+	}
+	return p.growSandboxVolume(v, size)
+}
+
+func (p *XPod) growSandboxVolume(v *Volume, size int64) error {
+	fstype := v.fstype()
+	err := p.protectedSandboxOperation(
+		func(sb *hypervisor.Vm) error {
+			if err := sb.ResizeVolume(v.spec.Name, size); err != nil {
+				return fmt.Errorf("failed to resize disk: %v", err)
+			}
+			dev, err := sb.VolumeDevice(v.spec.Name)
+			if err != nil {
+				return err
+			}
+			grow := fmt.Sprintf("resize2fs /dev/%s", dev)
+			if fstype == "xfs" {
+				grow = fmt.Sprintf("xfs_growfs %s", guestMountPoint(dev))
+			}
+			cmd := fmt.Sprintf("echo 1 > /sys/block/%s/device/rescan; %s", dev, grow)
+			if _, stderr, err := sb.HyperstartExecSync([]string{"sh", "-c", cmd}, nil); err != nil {
+				return fmt.Errorf("failed to grow filesystem: %v, %s", err, stderr)
+			}
+			return nil
+		},
+		time.Second*30,
+		fmt.Sprintf("resize volume %s", v.spec.Name))
+	if err != nil {
+		v.Log(ERROR, "grow volume in sandbox failed: %v", err)
+	}
+	return err
+}
+
+// guestMountPoint is a shell expression of the first mount point of a
+// device in the sandbox.
+func guestMountPoint(dev string) string {
+	return fmt.Sprintf(`"$(awk '$1=="/dev/%s" {print $2; exit}' /proc/mounts)"`, dev)
+}
+
+// UpdateVolumeUsage() fills the size, used and available bytes of the
+// volumes. The directory volumes are measured on the host, and the block
+// volumes are only measured when they are inserted into the running sandbox.
+func (p *XPod) UpdateVolumeUsage(vols []*apitypes.PodVolume) {
+	p.resourceLock.Lock()
+	defer p.resourceLock.Unlock()
+
+	for _, pv := range vols {
+		v, ok := p.volumes[pv.Name]
+		if !ok {
+			continue
+		}
+		var (
+			size, used, avail uint64
+			err               error
+		)
+		if v.spec.Format == "vfs" {
+			size, used, avail, err = storage.VFSVolumeUsage(v.spec.Source, v.spec.SizeBytes > 0)
+		} else if v.isBlock() && p.IsRunning() && v.getStatus() == S_VOLUME_INSERTED {
+			size, used, avail, err = p.sandboxVolumeUsage(v)
+		} else {
+			continue
+		}
+		if err != nil {
+			v.Log(DEBUG, "failed to get volume usage: %v", err)
+			continue
+		}
+		if pv.SizeBytes == 0 {
+			pv.SizeBytes = int64(size)
+		}
+		pv.UsedBytes = int64(used)
+		pv.AvailableBytes = int64(avail)
+	}
+}
+
+func (p *XPod) sandboxVolumeUsage(v *Volume) (size, used, avail uint64, err error) {
+	err = p.protectedSandboxOperation(
+		func(sb *hypervisor.Vm) error {
+			dev, err := sb.VolumeDevice(v.spec.Name)
+			if err != nil {
+				return err
+			}
+			stdout, stderr, err := sb.HyperstartExecSync([]string{"sh", "-c", "df -k " + guestMountPoint(dev)}, nil)
+			if err != nil {
+				return fmt.Errorf("df failed: %v, %s", err, stderr)
+			}
+			if size, used, avail, err = parseDf(string(stdout)); err != nil {
+				return fmt.Errorf("unexpected df output: %s", stdout)
+			}
+			return nil
+		},
+		time.Second*10,
+		fmt.Sprintf("get usage of volume %s", v.spec.Name))
+	return size, used, avail, err
+}
+
+// parseDf gets the size, used and available bytes from the output of
+// "df -k" on one mount point. A long filesystem name is wrapped onto its
+// own line, so the lines below the header are joined.
+func parseDf(out string) (size, used, avail uint64, err error) {
+	// Filesystem 1K-blocks Used Available Use% Mounted on
+	lines := strings.Split(strings.TrimSpace(out), "\n")
+	fields := strings.Fields(strings.Join(lines[1:], " "))
+	if len(fields) < 4 {
+		return 0, 0, 0, fmt.Errorf("too few fields")
+	}
+	var kb [3]uint64
+	for i := range kb {
+		if kb[i], err = strconv.ParseUint(fields[i+1], 10, 64); err != nil {
+			return 0, 0, 0, err
+		}
+	}
+	return kb[0] << 10, kb[1] << 10, kb[2] << 10, nil
+}
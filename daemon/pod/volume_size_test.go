@@ -0,0 +1,44 @@
+package pod
+
+import (
+	"testing"
+)
+
+func TestParseDf(t *testing.T) {
+	for _, c := range []struct {
+		out               string
+		size, used, avail uint64
+		fail              bool
+	}{
+		{
+			out: "Filesystem           1K-blocks      Used Available Use% Mounted on\n" +
+				"/dev/sdb               1032088      1284    978376   0% /tmp/hyper/shared/vol\n",
+			size: 1032088 << 10, used: 1284 << 10, avail: 978376 << 10,
+		},
+		{
+			// a long device name is wrapped by busybox df
+			out: "Filesystem           1K-blocks      Used Available Use% Mounted on\n" +
+				"/dev/mapper/hyper-volume\n" +
+				"                        999320      2564    927944   0% /data\n",
+			size: 999320 << 10, used: 2564 << 10, avail: 927944 << 10,
+		},
+		{out: "", fail: true},
+		{out: "Filesystem           1K-blocks      Used Available Use% Mounted on\n", fail: true},
+		{out: "df: /data: No such file or directory\n", fail: true},
+	} {
+		size, used, avail, err := parseDf(c.out)
+		if c.fail {
+			if err == nil {
+				t.Errorf("parse %q: expect an error", c.out)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("parse %q: %v", c.out, err)
+			continue
+		}
+		if size != c.size || used != c.used || avail != c.avail {
+			t.Errorf("parse %q: got %d/%d/%d, expect %d/%d/%d", c.out, size, used, avail, c.size, c.used, c.avail)
+		}
+	}
+}
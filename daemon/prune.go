@@ -0,0 +1,306 @@
+package daemon
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/golang/glog"
+	"github.com/golang/protobuf/proto"
+	"github.com/hyperhq/hyperd/daemon/daemondb"
+	"github.com/hyperhq/hyperd/daemon/pod"
+	"github.com/hyperhq/hyperd/storage"
+	apitypes "github.com/hyperhq/hyperd/types"
+	"github.com/hyperhq/hyperd/utils"
+)
+
+// the kinds of the orphan resources
+const (
+	orphanDBKey    = "dbkey"
+	orphanDevice   = "device"
+	orphanVolume   = "volume"
+	orphanSnapshot = "snapshot"
+	orphanHosts    = "hosts"
+)
+
+// pruner collects the resources which are not owned by any pod or named
+// volume, and removes them unless in dry-run mode.
+type pruner struct {
+	dryRun bool
+	// the unrecorded devices can only be told from the ones being created
+	// by the pods when the daemon is restoring
+	restoring bool
+
+	// the pods in daemondb or being created
+	pods map[string]bool
+	// the sources of the named volumes
+	named map[string]bool
+
+	orphans []*apitypes.OrphanResource
+}
+
+func (pr *pruner) found(kind, id, owner string, remove func() error) {
+	o := &apitypes.OrphanResource{
+		Kind:  kind,
+		Id:    id,
+		Owner: owner,
+	}
+	if !pr.dryRun {
+		if err := remove(); err != nil {
+			glog.Warningf("failed to remove orphan %s %s: %v", kind, id, err)
+			o.Error = err.Error()
+		} else {
+			glog.V(1).Infof("orphan %s %s of %s removed", kind, id, owner)
+			o.Removed = true
+		}
+	}
+	pr.orphans = append(pr.orphans, o)
+}
+
+// ownsFile tells whether a volume file or dir named "<owner>-<name>" in the
+// volumes dir of a storage driver is owned by a pod or a named volume.
+func (pr *pruner) ownsFile(source string) bool {
+	base := filepath.Base(source)
+	if strings.HasPrefix(base, namedVolumeOwner+"-") {
+		return pr.named[source]
+	}
+	for id := range pr.pods {
+		if strings.HasPrefix(base, id+"-") {
+			return true
+		}
+	}
+	return false
+}
+
+// storagePruner is implemented by the Storage backends which keep the
+// volumes or snapshots somewhere other than the vfs volume root.
+type storagePruner interface {
+	pruneVolumes(pr *pruner) error
+}
+
+// Prune finds the volumes, devices, hosts mounts and daemondb keys left
+// behind by the removed or failed pods and named volumes, and removes them
+// unless dryRun.
+func (daemon *Daemon) Prune(dryRun bool) ([]*apitypes.OrphanResource, error) {
+	return daemon.prune(dryRun, false)
+}
+
+// pruneOrphans looks for the orphans during the daemon restore, when no pod
+// is being created. The orphans are only reported unless PruneOrphansOnRestore
+// is configured, an unexpected daemondb content should not cost the data.
+func (daemon *Daemon) pruneOrphans() {
+	orphans, err := daemon.prune(!daemon.pruneOnRestore, true)
+	if err != nil {
+		glog.Warningf("failed to prune orphans: %v", err)
+	}
+	if len(orphans) == 0 {
+		return
+	}
+	if daemon.pruneOnRestore {
+		glog.Infof("%d orphan resources left by the pods and volumes are pruned", len(orphans))
+		return
+	}
+	for _, o := range orphans {
+		glog.Infof("found orphan %s %s of %q", o.Kind, o.Id, o.Owner)
+	}
+	glog.Infof("%d orphan resources left by the pods and volumes are found, run \"hyperctl system prune\" to remove them", len(orphans))
+}
+
+func (daemon *Daemon) prune(dryRun, restoring bool) ([]*apitypes.OrphanResource, error) {
+	// the named volumes are not created or removed during the pruning
+	daemon.volumes.Lock()
+	defer daemon.volumes.Unlock()
+
+	pr := &pruner{
+		dryRun:    dryRun,
+		restoring: restoring,
+		pods:      make(map[string]bool),
+		named:     make(map[string]bool),
+	}
+
+	layouts, err := pod.ListAllPods(daemon.db)
+	if err != nil {
+		return nil, err
+	}
+	for _, k := range layouts {
+		pr.pods[strings.TrimPrefix(string(k), pod.LAYOUT_KEY_PREFIX)] = true
+	}
+	daemon.PodList.Foreach(func(p *pod.XPod) error {
+		pr.pods[p.Id()] = true
+		return nil
+	})
+
+	records, err := daemon.db.ListNamedVolumes()
+	if err != nil {
+		return nil, err
+	}
+	volumes := make(map[string]bool, len(records))
+	for _, data := range records {
+		var info apitypes.VolumeInfo
+		if err := proto.Unmarshal(data, &info); err != nil {
+			glog.Warningf("skip invalid volume record: %v", err)
+			continue
+		}
+		volumes[info.Name] = true
+		pr.named[info.Source] = true
+	}
+
+	steps := []func() error{
+		func() error { return pruneVFSVolumes(pr) },
+		func() error { return pruneHosts(pr) },
+		func() error { return daemon.prunePodKeys(pr) },
+		func() error { return daemon.pruneSnapshotKeys(pr, volumes) },
+	}
+	if sp, ok := daemon.Storage.(storagePruner); ok {
+		// the storage driver may look up the pod keys of the volumes
+		steps = append([]func() error{func() error { return sp.pruneVolumes(pr) }}, steps...)
+	}
+	for _, step := range steps {
+		if e := step(); e != nil {
+			glog.Errorf("prune failed: %v", e)
+			err = e
+		}
+	}
+	return pr.orphans, err
+}
+
+// listDir lists the names in dir, a dir not existing is empty.
+func listDir(dir string) ([]string, error) {
+	entries, err := ioutil.ReadDir(dir)
+	if os.IsNotExist(err) {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+	names := make([]string, 0, len(entries))
+	for _, e := range entries {
+		names = append(names, e.Name())
+	}
+	return names, nil
+}
+
+// pruneVFSVolumes removes the vfs volumes of the pods and named volumes
+// which are gone, they are created by the directory based drivers in
+// <root>/<pod id or named-volumes>/<volume>.
+func pruneVFSVolumes(pr *pruner) error {
+	owners, err := listDir(storage.DEFAULT_VFS_VOL_ROOT)
+	if err != nil {
+		return err
+	}
+	for _, owner := range owners {
+		if owner != namedVolumeOwner && pr.pods[owner] {
+			continue
+		}
+		dir := filepath.Join(storage.DEFAULT_VFS_VOL_ROOT, owner)
+		vols, err := listDir(dir)
+		if err != nil {
+			glog.Warningf("failed to list volumes in %s: %v", dir, err)
+			continue
+		}
+		for _, v := range vols {
+			source := filepath.Join(dir, v)
+			if owner == namedVolumeOwner && pr.named[source] {
+				continue
+			}
+			pr.found(orphanVolume, source, owner, func() error {
+				return storage.RemoveVFSVolume(source)
+			})
+		}
+		if owner != namedVolumeOwner && !pr.dryRun {
+			os.Remove(dir)
+		}
+	}
+	return nil
+}
+
+// pruneHosts unmounts and removes the hosts dirs of the pods which are gone
+func pruneHosts(pr *pruner) error {
+	root := filepath.Join(utils.HYPER_ROOT, "hosts")
+	ids, err := listDir(root)
+	if err != nil {
+		return err
+	}
+	for _, id := range ids {
+		if pr.pods[id] {
+			continue
+		}
+		podId := id
+		pr.found(orphanHosts, filepath.Join(root, podId), podId, func() error {
+			return pod.RemoveHosts(podId)
+		})
+	}
+	return nil
+}
+
+// prunePodKeys removes the persistent info of the pods without layout, and
+// the lagecy keys of the pods which are not migrated.
+func (daemon *Daemon) prunePodKeys(pr *pruner) error {
+	keys, err := pod.ListPersistKeys(daemon.db)
+	if err != nil {
+		return err
+	}
+	for _, k := range keys {
+		if pr.pods[k.Pod] {
+			continue
+		}
+		key := k.Key
+		pr.found(orphanDBKey, key, k.Pod, func() error {
+			return daemon.db.Delete([]byte(key))
+		})
+	}
+
+	lagecy, err := daemon.db.LagecyListPod()
+	if err != nil {
+		return err
+	}
+	for _, k := range lagecy {
+		id := strings.TrimPrefix(string(k), daemondb.POD_PREFIX)
+		if pr.pods[id] {
+			continue
+		}
+		pr.found(orphanDBKey, string(k), id, func() error {
+			return daemon.db.LagecyDeletePod(id)
+		})
+	}
+
+	p2c, err := daemon.db.LagecyListP2C()
+	if err != nil {
+		return err
+	}
+	for _, k := range p2c {
+		id := strings.TrimPrefix(string(k), daemondb.POD_CONTAINER_PREFIX)
+		if pr.pods[id] {
+			continue
+		}
+		pr.found(orphanDBKey, string(k), id, func() error {
+			return daemon.db.LagecyDeleteP2C(id)
+		})
+	}
+	return nil
+}
+
+// pruneSnapshotKeys removes the snapshot info of the named volumes which
+// are gone.
+func (daemon *Daemon) pruneSnapshotKeys(pr *pruner, volumes map[string]bool) error {
+	records, err := daemon.db.ListAllSnapshots()
+	if err != nil {
+		return err
+	}
+	for _, data := range records {
+		var snap apitypes.VolumeSnapshotInfo
+		if err := proto.Unmarshal(data, &snap); err != nil {
+			glog.Warningf("skip invalid snapshot record: %v", err)
+			continue
+		}
+		if volumes[snap.Volume] {
+			continue
+		}
+		volume, name := snap.Volume, snap.Name
+		pr.found(orphanDBKey, fmt.Sprintf(daemondb.SNAPSHOT_KEY, volume, name), volume, func() error {
+			return daemon.db.DeleteSnapshot(volume, name)
+		})
+	}
+	return nil
+}
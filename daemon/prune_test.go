@@ -0,0 +1,33 @@
+package daemon
+
+import (
+	"testing"
+)
+
+func TestPrunerOwnsFile(t *testing.T) {
+	pr := &pruner{
+		pods: map[string]bool{
+			"pod-abc": true,
+		},
+		named: map[string]bool{
+			"/vols/named-volumes-data": true,
+		},
+	}
+	cases := []struct {
+		source string
+		owned  bool
+	}{
+		{"/vols/pod-abc-vol1", true},
+		{"/vols/pod-abcd-vol1", false},
+		{"/vols/pod-xyz-vol1", false},
+		{"/vols/named-volumes-data", true},
+		{"/vols/named-volumes-gone", false},
+		{"/other/named-volumes-data", false},
+		{"/vols/pod-abc", false},
+	}
+	for _, c := range cases {
+		if owned := pr.ownsFile(c.source); owned != c.owned {
+			t.Errorf("ownsFile(%s) = %v, expect %v", c.source, owned, c.owned)
+		}
+	}
+}
@@ -14,6 +14,5 @@ func (daemon *Daemon) ContainerRename(oldname, newname string) error {
 		return err
 	}
 
-	p.RenameContainer(id, newname)
-	return nil
+	return p.RenameContainer(id, newname)
 }
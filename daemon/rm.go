@@ -32,6 +32,8 @@ func (daemon *Daemon) RemovePod(podId string) (int, string, error) {
 	}
 
 	p.Remove(true)
+	daemon.releaseNamedVolumes(podId)
+	daemon.releaseNetworkAddrs(podId)
 
 	return code, cause, err
 }
@@ -44,5 +46,10 @@ func (daemon *Daemon) RemoveContainer(nameOrId string) error {
 		return err
 	}
 
-	return p.RemoveContainer(id)
+	if err := p.RemoveContainer(id); err != nil {
+		return err
+	}
+	// the named volumes only mounted by the removed container are released
+	daemon.syncNamedVolumeRefs(p)
+	return nil
 }
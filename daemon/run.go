@@ -27,11 +27,21 @@ func (daemon *Daemon) CreatePod(podId string, podSpec *apitypes.UserPod) (*pod.X
 		return nil, err
 	}
 
-	factory := pod.NewPodFactory(daemon.Factory, daemon.PodList, daemon.db, daemon.Storage, daemon.Daemon, daemon.DefaultLog)
+	if _, err := daemon.acquireNamedVolumes(podSpec.Id, namedVolumeSpecs(podSpec.Volumes, podSpec.Containers)); err != nil {
+		return nil, err
+	}
+	if _, err := daemon.acquireNetworkAddrs(podSpec.Id, podSpec.Interfaces, nil); err != nil {
+		daemon.releaseNamedVolumes(podSpec.Id)
+		return nil, err
+	}
+
+	factory := pod.NewPodFactory(daemon.Factory, daemon.PodList, daemon.db, daemon.Storage, daemon.Daemon, daemon.DefaultLog, daemon.Events, daemon.podDNS)
 
 	p, err := pod.CreateXPod(factory, podSpec)
 	if err != nil {
 		glog.Errorf("%s: failed to add pod: %v", podSpec.Id, err)
+		daemon.releaseNamedVolumes(podSpec.Id)
+		daemon.releaseNetworkAddrs(podSpec.Id)
 		return nil, err
 	}
 
@@ -55,6 +65,19 @@ func (daemon *Daemon) StartPod(podId string) error {
 	return err
 }
 
+func (daemon *Daemon) UpdatePodResources(podId string, res *apitypes.UserResource) error {
+	p, ok := daemon.PodList.Get(podId)
+	if !ok {
+		return fmt.Errorf("The pod(%s) can not be found", podId)
+	}
+	if res == nil {
+		return fmt.Errorf("no resource to be updated for pod %s", podId)
+	}
+
+	glog.V(1).Infof("Update resources of pod %s: vcpu %d, memory %d", podId, res.Vcpu, res.Memory)
+	return p.UpdateResources(res)
+}
+
 func (daemon *Daemon) WaitContainer(cid string, second int) (int, error) {
 	p, id, ok := daemon.PodList.GetByContainerIdOrName(cid)
 	if !ok {
@@ -13,6 +13,7 @@ import (
 	"github.com/golang/glog"
 	"github.com/hyperhq/hyperd/engine"
 	"github.com/hyperhq/hyperd/errors"
+	"github.com/hyperhq/hyperd/lib/metrics"
 	"github.com/hyperhq/hyperd/lib/sysinfo"
 	"github.com/hyperhq/hyperd/libmoby/distribution"
 	apitypes "github.com/hyperhq/hyperd/types"
@@ -77,7 +78,21 @@ func (daemon *Daemon) CreateContainerInPod(podId string, spec *apitypes.UserCont
 		return "", fmt.Errorf("The pod(%s) can not be found", podId)
 	}
 
-	return p.ContainerCreate(spec)
+	acquired, err := daemon.acquireNamedVolumes(podId, namedVolumeSpecs(nil, []*apitypes.UserContainer{spec}))
+	if err != nil {
+		return "", err
+	}
+
+	id, err := p.ContainerCreate(spec)
+	if err != nil {
+		if len(acquired) > 0 {
+			daemon.releaseNamedVolumes(podId, acquired...)
+		}
+		return "", err
+	}
+	// the container may mount a named volume of the pod released before
+	daemon.syncNamedVolumeRefs(p)
+	return id, nil
 }
 
 func (daemon *Daemon) StartContainer(containerId string) error {
@@ -195,7 +210,19 @@ func (daemon *Daemon) CmdSystemVersion() *engine.Env {
 	return v
 }
 
-func (daemon *Daemon) CmdGetPodInfo(podName string) (interface{}, error) {
+func (daemon *Daemon) CmdSystemPrune(dryRun bool) ([]*apitypes.OrphanResource, error) {
+	glog.V(1).Infof("Prune orphan resources, dry run: %v", dryRun)
+	return daemon.Prune(dryRun)
+}
+
+func (daemon *Daemon) CmdMetrics() []*metrics.Family {
+	return metrics.DefaultRegistry.Gather()
+}
+
+func (daemon *Daemon) CmdGetPodInfo(podName string, volumeUsage bool) (interface{}, error) {
+	if volumeUsage {
+		return daemon.GetPodInfoWithVolumeUsage(podName)
+	}
 	return daemon.GetPodInfo(podName)
 }
 
@@ -207,8 +234,8 @@ func (daemon *Daemon) CmdGetContainerInfo(name string) (interface{}, error) {
 	return daemon.GetContainerInfo(name)
 }
 
-func (daemon *Daemon) CmdList(item, podId, vmId string) (*engine.Env, error) {
-	list, err := daemon.List(item, podId, vmId)
+func (daemon *Daemon) CmdList(item, podId, vmId string, filter *apitypes.ListFilter) (*engine.Env, error) {
+	list, err := daemon.List(item, podId, vmId, filter)
 	if err != nil {
 		return nil, err
 	}
@@ -332,6 +359,19 @@ func (daemon *Daemon) CmdAddPortMappings(podId string, req []byte) (*engine.Env,
 	return &engine.Env{}, nil
 }
 
+func (daemon *Daemon) CmdUpdatePodResources(podId string, req []byte) (*engine.Env, error) {
+	var res apitypes.UserResource
+	if err := json.Unmarshal(req, &res); err != nil {
+		return nil, errors.ErrBadJsonFormat.WithArgs(err)
+	}
+
+	if err := daemon.UpdatePodResources(podId, &res); err != nil {
+		return nil, err
+	}
+
+	return &engine.Env{}, nil
+}
+
 func (daemon *Daemon) CmdDeletePortMappings(podId string, req []byte) (*engine.Env, error) {
 	p, ok := daemon.PodList.Get(podId)
 	if !ok {
@@ -352,6 +392,45 @@ func (daemon *Daemon) CmdDeletePortMappings(podId string, req []byte) (*engine.E
 	return &engine.Env{}, nil
 }
 
+// pod level network interfaces API
+func (daemon *Daemon) CmdListInterfaces(podId string) (*engine.Env, error) {
+	p, ok := daemon.PodList.Get(podId)
+	if !ok {
+		return nil, errors.ErrPodNotFound.WithArgs(podId)
+	}
+
+	v := &engine.Env{}
+	v.SetJson("interfaces", p.ListInterfaces())
+
+	return v, nil
+}
+
+func (daemon *Daemon) CmdAddInterface(podId string, req []byte) (*engine.Env, error) {
+	var spec apitypes.UserInterface
+	err := json.Unmarshal(req, &spec)
+	if err != nil {
+		return nil, errors.ErrBadJsonFormat.WithArgs(err)
+	}
+
+	inf, err := daemon.AddInterface(podId, &spec)
+	if err != nil {
+		return nil, err
+	}
+
+	v := &engine.Env{}
+	v.SetJson("interface", inf)
+
+	return v, nil
+}
+
+func (daemon *Daemon) CmdRemoveInterface(podId, ifname string) (*engine.Env, error) {
+	if err := daemon.RemoveInterface(podId, ifname); err != nil {
+		return nil, err
+	}
+
+	return &engine.Env{}, nil
+}
+
 func (daemon *Daemon) CmdImageDelete(name string, force, prune bool) ([]*apitypes.ImageDelete, error) {
 	list, err := daemon.Daemon.ImageDelete(name, force, prune)
 	if err != nil {
@@ -546,3 +625,116 @@ func (daemon *Daemon) CmdUnpausePod(podId string) error {
 	glog.V(1).Infof("Unpause pod %s", podId)
 	return daemon.UnpausePod(podId)
 }
+
+func (daemon *Daemon) CmdCheckpointPod(podId, dir string) error {
+	glog.V(1).Infof("Checkpoint pod %s to %s", podId, dir)
+	return daemon.CheckpointPod(podId, dir)
+}
+
+func (daemon *Daemon) CmdRestorePod(dir string, coldBoot bool) (*engine.Env, error) {
+	podId, err := daemon.RestorePod(dir, coldBoot)
+	if err != nil {
+		return nil, err
+	}
+
+	v := &engine.Env{}
+	v.Set("ID", podId)
+	v.SetInt("Code", 0)
+	v.Set("Cause", "")
+	return v, nil
+}
+
+func (daemon *Daemon) CmdCreateVolume(data []byte) (*apitypes.VolumeInfo, error) {
+	var req apitypes.VolumeCreateRequest
+	if err := json.Unmarshal(data, &req); err != nil {
+		return nil, errors.ErrBadJsonFormat.WithArgs(err)
+	}
+
+	glog.V(1).Infof("Create volume %s", req.Name)
+	return daemon.CreateVolume(&req)
+}
+
+func (daemon *Daemon) CmdListVolumes() ([]*apitypes.VolumeInfo, error) {
+	return daemon.ListVolumes()
+}
+
+func (daemon *Daemon) CmdInspectVolume(name string) (*apitypes.VolumeInfo, error) {
+	return daemon.InspectVolume(name)
+}
+
+func (daemon *Daemon) CmdRemoveVolume(name string) error {
+	glog.V(1).Infof("Remove volume %s", name)
+	return daemon.RemoveVolume(name)
+}
+
+func (daemon *Daemon) CmdResizeVolume(data []byte) error {
+	var req apitypes.VolumeResizeRequest
+	if err := json.Unmarshal(data, &req); err != nil {
+		return errors.ErrBadJsonFormat.WithArgs(err)
+	}
+
+	glog.V(1).Infof("Resize volume %s of pod %s to %d bytes", req.Volume, req.PodID, req.SizeBytes)
+	return daemon.ResizeVolume(&req)
+}
+
+func (daemon *Daemon) CmdSnapshotVolume(data []byte) (*apitypes.VolumeSnapshotInfo, error) {
+	var req apitypes.VolumeSnapshotRequest
+	if err := json.Unmarshal(data, &req); err != nil {
+		return nil, errors.ErrBadJsonFormat.WithArgs(err)
+	}
+
+	glog.V(1).Infof("Snapshot volume %s as %s", req.Volume, req.Name)
+	return daemon.SnapshotVolume(&req)
+}
+
+func (daemon *Daemon) CmdListVolumeSnapshots(volume string) ([]*apitypes.VolumeSnapshotInfo, error) {
+	return daemon.ListVolumeSnapshots(volume)
+}
+
+func (daemon *Daemon) CmdRemoveVolumeSnapshot(volume, name string) error {
+	glog.V(1).Infof("Remove snapshot %s of volume %s", name, volume)
+	return daemon.RemoveVolumeSnapshot(volume, name)
+}
+
+func (daemon *Daemon) CmdRestoreVolume(data []byte) error {
+	var req apitypes.VolumeRestoreRequest
+	if err := json.Unmarshal(data, &req); err != nil {
+		return errors.ErrBadJsonFormat.WithArgs(err)
+	}
+
+	glog.V(1).Infof("Restore volume %s to snapshot %s", req.Volume, req.Snapshot)
+	return daemon.RestoreVolume(req.Volume, req.Snapshot)
+}
+
+func (daemon *Daemon) CmdCloneVolume(data []byte) (*apitypes.VolumeInfo, error) {
+	var req apitypes.VolumeCloneRequest
+	if err := json.Unmarshal(data, &req); err != nil {
+		return nil, errors.ErrBadJsonFormat.WithArgs(err)
+	}
+
+	glog.V(1).Infof("Clone volume %s from snapshot %s of %s", req.Name, req.Snapshot, req.Volume)
+	return daemon.CloneVolume(&req)
+}
+
+func (daemon *Daemon) CmdCreateNetwork(data []byte) (*apitypes.NetworkInfo, error) {
+	var req apitypes.NetworkCreateRequest
+	if err := json.Unmarshal(data, &req); err != nil {
+		return nil, errors.ErrBadJsonFormat.WithArgs(err)
+	}
+
+	glog.V(1).Infof("Create network %s", req.Name)
+	return daemon.CreateNetwork(&req)
+}
+
+func (daemon *Daemon) CmdListNetworks() ([]*apitypes.NetworkInfo, error) {
+	return daemon.ListNetworks()
+}
+
+func (daemon *Daemon) CmdInspectNetwork(name string) (*apitypes.NetworkInfo, error) {
+	return daemon.InspectNetwork(name)
+}
+
+func (daemon *Daemon) CmdRemoveNetwork(name string) error {
+	glog.V(1).Infof("Remove network %s", name)
+	return daemon.RemoveNetwork(name)
+}
@@ -12,6 +12,9 @@ func (daemon *Daemon) AddService(podId string, srvs []*apitypes.UserService) err
 		return fmt.Errorf("The pod(%s) can not be found, please create it first", podId)
 	}
 
+	if err := apitypes.ValidateServiceNames(srvs); err != nil {
+		return err
+	}
 	return p.AddService(srvs)
 }
 
@@ -21,6 +24,9 @@ func (daemon *Daemon) UpdateService(podId string, srvs []*apitypes.UserService)
 		return fmt.Errorf("The pod(%s) can not be found, please create it first", podId)
 	}
 
+	if err := apitypes.ValidateServiceNames(srvs); err != nil {
+		return err
+	}
 	return p.UpdateService(srvs)
 }
 
@@ -0,0 +1,225 @@
+package daemon
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/golang/glog"
+	"github.com/golang/protobuf/proto"
+	apitypes "github.com/hyperhq/hyperd/types"
+	"github.com/hyperhq/hyperd/utils"
+)
+
+// namedVolumeSpec is the volume spec the storage driver created the named
+// volume with.
+func namedVolumeSpec(info *apitypes.VolumeInfo) *apitypes.UserVolume {
+	return &apitypes.UserVolume{
+		Name:      info.Name,
+		Source:    info.Source,
+		Format:    info.Format,
+		Fstype:    info.Fstype,
+		SizeBytes: info.SizeBytes,
+	}
+}
+
+func (daemon *Daemon) getSnapshot(volume, name string) (*apitypes.VolumeSnapshotInfo, error) {
+	data, err := daemon.db.GetSnapshot(volume, name)
+	if err != nil {
+		return nil, fmt.Errorf("snapshot %s of volume %s not found", name, volume)
+	}
+	var snap apitypes.VolumeSnapshotInfo
+	if err = proto.Unmarshal(data, &snap); err != nil {
+		glog.Errorf("failed to decode snapshot %s of volume %s: %v", name, volume, err)
+		return nil, err
+	}
+	return &snap, nil
+}
+
+// SnapshotVolume takes a snapshot of the named volume, the volume should not
+// be used by any pods.
+func (daemon *Daemon) SnapshotVolume(req *apitypes.VolumeSnapshotRequest) (*apitypes.VolumeSnapshotInfo, error) {
+	if !utils.IsDNSLabel(req.Name) {
+		return nil, fmt.Errorf("snapshot name should fullfil the pattern: %s, input name: %s", utils.Dns1123LabelFmt, req.Name)
+	}
+
+	daemon.volumes.Lock()
+	defer daemon.volumes.Unlock()
+
+	info, err := daemon.getNamedVolume(req.Volume)
+	if err != nil {
+		return nil, err
+	}
+	// the pods may be writing to the volume, the snapshot would be taken
+	// from an inconsistent filesystem.
+	if pods := daemon.volumes.pods(req.Volume); len(pods) > 0 {
+		return nil, fmt.Errorf("volume %s is in use by pod(s) %s", req.Volume, strings.Join(pods, ", "))
+	}
+	if _, err = daemon.db.GetSnapshot(req.Volume, req.Name); err == nil {
+		return nil, fmt.Errorf("snapshot %s of volume %s already exists", req.Name, req.Volume)
+	}
+
+	spec := namedVolumeSpec(info)
+	if err = daemon.Storage.VolumeSnapshot(namedVolumeOwner, spec, req.Name); err != nil {
+		glog.Errorf("failed to snapshot volume %s: %v", req.Volume, err)
+		return nil, err
+	}
+
+	snap := &apitypes.VolumeSnapshotInfo{
+		Name:      req.Name,
+		Volume:    req.Volume,
+		Driver:    daemon.Storage.Type(),
+		SizeBytes: info.SizeBytes,
+		Labels:    req.Labels,
+		CreatedAt: time.Now().UTC().Unix(),
+	}
+	data, err := proto.Marshal(snap)
+	if err == nil {
+		err = daemon.db.UpdateSnapshot(req.Volume, req.Name, data)
+	}
+	if err != nil {
+		glog.Errorf("failed to save snapshot %s of volume %s: %v", req.Name, req.Volume, err)
+		daemon.Storage.VolumeSnapshotRemove(namedVolumeOwner, spec, req.Name)
+		return nil, err
+	}
+
+	glog.V(1).Infof("snapshot %s of volume %s created", req.Name, req.Volume)
+	return snap, nil
+}
+
+// ListVolumeSnapshots gets the snapshots of the named volume, the ones lost
+// by the storage driver are not listed.
+func (daemon *Daemon) ListVolumeSnapshots(volume string) ([]*apitypes.VolumeSnapshotInfo, error) {
+	daemon.volumes.Lock()
+	defer daemon.volumes.Unlock()
+
+	info, err := daemon.getNamedVolume(volume)
+	if err != nil {
+		return nil, err
+	}
+	records, err := daemon.db.ListSnapshots(volume)
+	if err != nil {
+		return nil, err
+	}
+	result := make([]*apitypes.VolumeSnapshotInfo, 0, len(records))
+	if len(records) == 0 {
+		return result, nil
+	}
+
+	names, err := daemon.Storage.VolumeSnapshotList(namedVolumeOwner, namedVolumeSpec(info))
+	if err != nil {
+		return nil, err
+	}
+	existing := make(map[string]bool, len(names))
+	for _, n := range names {
+		existing[n] = true
+	}
+	for _, data := range records {
+		var snap apitypes.VolumeSnapshotInfo
+		if err := proto.Unmarshal(data, &snap); err != nil {
+			glog.Warningf("skip invalid snapshot record: %v", err)
+			continue
+		}
+		if !existing[snap.Name] {
+			glog.Warningf("snapshot %s of volume %s is missing in the storage driver", snap.Name, volume)
+			continue
+		}
+		result = append(result, &snap)
+	}
+	return result, nil
+}
+
+func (daemon *Daemon) RemoveVolumeSnapshot(volume, name string) error {
+	daemon.volumes.Lock()
+	defer daemon.volumes.Unlock()
+
+	info, err := daemon.getNamedVolume(volume)
+	if err != nil {
+		return err
+	}
+	if _, err = daemon.getSnapshot(volume, name); err != nil {
+		return err
+	}
+	if err = daemon.Storage.VolumeSnapshotRemove(namedVolumeOwner, namedVolumeSpec(info), name); err != nil {
+		glog.Errorf("failed to remove snapshot %s of volume %s: %v", name, volume, err)
+		return err
+	}
+	return daemon.db.DeleteSnapshot(volume, name)
+}
+
+// RestoreVolume rolls the named volume back to the snapshot, the volume
+// should not be used by any pods.
+func (daemon *Daemon) RestoreVolume(volume, snapshot string) error {
+	daemon.volumes.Lock()
+	defer daemon.volumes.Unlock()
+
+	info, err := daemon.getNamedVolume(volume)
+	if err != nil {
+		return err
+	}
+	if pods := daemon.volumes.pods(volume); len(pods) > 0 {
+		return fmt.Errorf("volume %s is in use by pod(s) %s", volume, strings.Join(pods, ", "))
+	}
+	if _, err = daemon.getSnapshot(volume, snapshot); err != nil {
+		return err
+	}
+	if err = daemon.Storage.VolumeRestore(namedVolumeOwner, namedVolumeSpec(info), snapshot); err != nil {
+		glog.Errorf("failed to restore volume %s to snapshot %s: %v", volume, snapshot, err)
+		return err
+	}
+
+	glog.V(1).Infof("volume %s restored to snapshot %s", volume, snapshot)
+	return nil
+}
+
+// CloneVolume creates a named volume from the snapshot of another one
+func (daemon *Daemon) CloneVolume(req *apitypes.VolumeCloneRequest) (*apitypes.VolumeInfo, error) {
+	if !utils.IsDNSLabel(req.Name) {
+		return nil, fmt.Errorf("volume name should fullfil the pattern: %s, input name: %s", utils.Dns1123LabelFmt, req.Name)
+	}
+
+	daemon.volumes.Lock()
+	defer daemon.volumes.Unlock()
+
+	origin, err := daemon.getNamedVolume(req.Volume)
+	if err != nil {
+		return nil, err
+	}
+	if _, err = daemon.getSnapshot(req.Volume, req.Snapshot); err != nil {
+		return nil, err
+	}
+	if _, err = daemon.db.GetNamedVolume(req.Name); err == nil {
+		return nil, fmt.Errorf("volume %s already exists", req.Name)
+	}
+
+	target := &apitypes.UserVolume{
+		Name: req.Name,
+	}
+	if err = daemon.Storage.VolumeClone(namedVolumeOwner, namedVolumeSpec(origin), req.Snapshot, target); err != nil {
+		glog.Errorf("failed to clone volume %s from snapshot %s of %s: %v", req.Name, req.Snapshot, req.Volume, err)
+		return nil, err
+	}
+
+	info := &apitypes.VolumeInfo{
+		Name:      req.Name,
+		Driver:    daemon.Storage.Type(),
+		Source:    target.Source,
+		Format:    target.Format,
+		Fstype:    target.Fstype,
+		SizeBytes: target.SizeBytes,
+		Labels:    req.Labels,
+		CreatedAt: time.Now().UTC().Unix(),
+	}
+	data, err := proto.Marshal(info)
+	if err == nil {
+		err = daemon.db.UpdateNamedVolume(req.Name, data)
+	}
+	if err != nil {
+		glog.Errorf("failed to save volume %s: %v", req.Name, err)
+		daemon.removeVolumeStorage(info)
+		return nil, err
+	}
+
+	glog.V(1).Infof("volume %s cloned from snapshot %s of %s", req.Name, req.Snapshot, req.Volume)
+	return info, nil
+}
@@ -0,0 +1,63 @@
+package daemon
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/hyperhq/hyperd/daemon/daemondb"
+	apitypes "github.com/hyperhq/hyperd/types"
+)
+
+func TestSnapshotVolumeInUse(t *testing.T) {
+	tmp, err := ioutil.TempDir("", "snapshots")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(tmp)
+
+	db, err := daemondb.NewDaemonDB(filepath.Join(tmp, "db"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+
+	data, err := proto.Marshal(&apitypes.VolumeInfo{Name: "data", Source: "/vol/data", Format: "raw", Fstype: "ext4"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = db.UpdateNamedVolume("data", data); err != nil {
+		t.Fatal(err)
+	}
+	snap, err := proto.Marshal(&apitypes.VolumeSnapshotInfo{Name: "s1", Volume: "data"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = db.UpdateSnapshot("data", "s1", snap); err != nil {
+		t.Fatal(err)
+	}
+
+	// the storage is not touched when the volume is refused
+	daemon := &Daemon{db: db, volumes: newNamedVolumes()}
+	daemon.volumes.ref("data", "pod1")
+
+	if _, err = daemon.SnapshotVolume(&apitypes.VolumeSnapshotRequest{Volume: "data", Name: "s2"}); err == nil || !strings.Contains(err.Error(), "pod1") {
+		t.Fatalf("snapshot of volume in use: %v", err)
+	}
+	if _, err = db.GetSnapshot("data", "s2"); err == nil {
+		t.Fatal("snapshot of volume in use is recorded")
+	}
+	if err = daemon.RestoreVolume("data", "s1"); err == nil || !strings.Contains(err.Error(), "pod1") {
+		t.Fatalf("restore of volume in use: %v", err)
+	}
+
+	if _, err = daemon.SnapshotVolume(&apitypes.VolumeSnapshotRequest{Volume: "data", Name: "Bad_Name"}); err == nil {
+		t.Fatal("invalid snapshot name is accepted")
+	}
+	if _, err = daemon.SnapshotVolume(&apitypes.VolumeSnapshotRequest{Volume: "none", Name: "s2"}); err == nil {
+		t.Fatal("snapshot of unknown volume is taken")
+	}
+}
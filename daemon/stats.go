@@ -0,0 +1,13 @@
+package daemon
+
+import (
+	"time"
+
+	"github.com/hyperhq/hyperd/daemon/stats"
+)
+
+// SubscribePodStats subscribes the stats samples of the pods, which are
+// taken every interval by the samplers shared among the subscribers.
+func (daemon *Daemon) SubscribePodStats(podIDs []string, interval time.Duration) (<-chan *stats.Sample, func(), error) {
+	return daemon.statsHub.Subscribe(podIDs, interval)
+}
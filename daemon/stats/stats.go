@@ -0,0 +1,359 @@
+// Package stats samples the resource usage of the running pods for the
+// streaming stats API.
+//
+// There is at most one sampler per pod, which samples at the shortest
+// interval requested by its subscribers and fans the samples out to all of
+// them. The sampler stops once the last subscriber has gone.
+package stats
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/golang/glog"
+	"github.com/hyperhq/hyperd/daemon/pod"
+	apitypes "github.com/hyperhq/hyperd/types"
+	runvtypes "github.com/hyperhq/runv/hypervisor/types"
+)
+
+const (
+	DefaultInterval = time.Second
+	MinInterval     = 500 * time.Millisecond
+
+	// samples will be dropped for a subscriber if it can not catch up
+	subscriberBuffer = 16
+)
+
+// Sample is the resource usage of a pod at a point in time, Rates are
+// computed against the previous sample of the pod.
+type Sample struct {
+	PodID          string
+	Timestamp      time.Time
+	Stats          *runvtypes.PodStats
+	Rates          *apitypes.StatsRates
+	ContainerRates []*apitypes.ContainerStatsRates
+	// Err is set if the pod could not be sampled
+	Err error
+}
+
+// Response returns the sample in api types, the cumulative counters are
+// left to the caller.
+func (s *Sample) Response() *apitypes.PodStatsStreamResponse {
+	resp := &apitypes.PodStatsStreamResponse{
+		PodID:          s.PodID,
+		Timestamp:      s.Timestamp.UnixNano(),
+		Rates:          s.Rates,
+		ContainerRates: s.ContainerRates,
+	}
+	if s.Err != nil {
+		resp.Error = s.Err.Error()
+	}
+	return resp
+}
+
+type subscriber struct {
+	interval time.Duration
+	ch       chan *Sample
+	last     map[string]time.Time
+	samplers map[string]*sampler
+	closed   bool
+}
+
+type sampler struct {
+	podID    string
+	interval time.Duration
+	subs     map[*subscriber]struct{}
+	stop     chan struct{}
+}
+
+// Hub keeps the samplers of the pods.
+type Hub struct {
+	lookup   func(podID string) (*pod.XPod, bool)
+	samplers map[string]*sampler
+	mutex    sync.Mutex
+}
+
+func NewHub(lookup func(podID string) (*pod.XPod, bool)) *Hub {
+	return &Hub{
+		lookup:   lookup,
+		samplers: make(map[string]*sampler),
+	}
+}
+
+// Subscribe returns a channel of the samples of the pods, which are taken
+// every interval. The channel is closed after cancel is called, or all of
+// the pods have been removed.
+func (h *Hub) Subscribe(podIDs []string, interval time.Duration) (<-chan *Sample, func(), error) {
+	if len(podIDs) == 0 {
+		return nil, nil, fmt.Errorf("no pod to sample")
+	}
+	if interval == 0 {
+		interval = DefaultInterval
+	} else if interval < MinInterval {
+		interval = MinInterval
+	}
+	for _, id := range podIDs {
+		if _, ok := h.lookup(id); !ok {
+			return nil, nil, fmt.Errorf("pod %s not found", id)
+		}
+	}
+
+	sub := &subscriber{
+		interval: interval,
+		ch:       make(chan *Sample, subscriberBuffer*len(podIDs)),
+		last:     make(map[string]time.Time),
+		samplers: make(map[string]*sampler),
+	}
+
+	h.mutex.Lock()
+	for _, id := range podIDs {
+		if _, ok := sub.samplers[id]; ok {
+			continue
+		}
+		s, ok := h.samplers[id]
+		if !ok {
+			s = &sampler{
+				podID: id,
+				subs:  make(map[*subscriber]struct{}),
+				stop:  make(chan struct{}),
+			}
+			h.samplers[id] = s
+			go h.run(s)
+		}
+		s.subs[sub] = struct{}{}
+		s.resetInterval()
+		sub.samplers[id] = s
+	}
+	h.mutex.Unlock()
+
+	cancel := func() {
+		h.mutex.Lock()
+		defer h.mutex.Unlock()
+		for _, s := range sub.samplers {
+			h.unsubscribeLocked(s, sub)
+		}
+	}
+	return sub.ch, cancel, nil
+}
+
+func (s *sampler) resetInterval() {
+	s.interval = 0
+	for sub := range s.subs {
+		if s.interval == 0 || sub.interval < s.interval {
+			s.interval = sub.interval
+		}
+	}
+}
+
+func (h *Hub) unsubscribeLocked(s *sampler, sub *subscriber) {
+	delete(s.subs, sub)
+	delete(sub.samplers, s.podID)
+	if len(sub.samplers) == 0 && !sub.closed {
+		sub.closed = true
+		close(sub.ch)
+	}
+	if len(s.subs) > 0 {
+		s.resetInterval()
+		return
+	}
+	if h.samplers[s.podID] == s {
+		delete(h.samplers, s.podID)
+	}
+	close(s.stop)
+}
+
+func (h *Hub) run(s *sampler) {
+	var (
+		prev  *runvtypes.PodStats
+		timer = time.NewTimer(0)
+	)
+	defer timer.Stop()
+
+	for {
+		select {
+		case <-s.stop:
+			return
+		case <-timer.C:
+		}
+
+		sample := &Sample{
+			PodID:     s.podID,
+			Timestamp: time.Now(),
+		}
+		p, ok := h.lookup(s.podID)
+		if !ok {
+			sample.Err = fmt.Errorf("pod %s has been removed", s.podID)
+			h.mutex.Lock()
+			for sub := range s.subs {
+				delete(sub.last, s.podID)
+				sub.send(sample, 0)
+				h.unsubscribeLocked(s, sub)
+			}
+			h.mutex.Unlock()
+			return
+		}
+
+		var cur *runvtypes.PodStats
+		if p.IsRunning() {
+			cur = p.Stats()
+		}
+		if cur == nil {
+			sample.Err = fmt.Errorf("pod %s is not running", s.podID)
+		} else {
+			stampStats(cur, sample.Timestamp)
+		}
+		if cur != nil && prev != nil {
+			sample.Stats = cur
+			computeRates(p, prev, cur, sample)
+		}
+
+		h.mutex.Lock()
+		interval := s.interval
+		// the first sample of a pod is only the base of the rates
+		if sample.Err != nil || sample.Stats != nil {
+			for sub := range s.subs {
+				sub.send(sample, interval)
+			}
+		}
+		h.mutex.Unlock()
+
+		if prev == nil && cur != nil && interval > time.Second {
+			interval = time.Second
+		}
+		prev = cur
+		timer.Reset(interval)
+	}
+}
+
+// send delivers the sample if the interval of the subscriber has elapsed
+// since the last delivery, it never blocks.
+func (sub *subscriber) send(sample *Sample, samplerInterval time.Duration) {
+	if last, ok := sub.last[sample.PodID]; ok && sample.Timestamp.Sub(last) < sub.interval-samplerInterval/2 {
+		return
+	}
+	select {
+	case sub.ch <- sample:
+		sub.last[sample.PodID] = sample.Timestamp
+	default:
+		glog.Warningf("stats subscriber is too slow, drop sample of %s", sample.PodID)
+	}
+}
+
+// stampStats sets the timestamps which are not reported by the sandbox
+func stampStats(s *runvtypes.PodStats, at time.Time) {
+	if s.Timestamp.IsZero() {
+		s.Timestamp = at
+	}
+	for i := range s.ContainersStats {
+		if s.ContainersStats[i].Timestamp.IsZero() {
+			s.ContainersStats[i].Timestamp = s.Timestamp
+		}
+	}
+}
+
+type usage struct {
+	at       time.Time
+	cpu      uint64
+	memory   uint64
+	blkRead  uint64
+	blkWrite uint64
+	netRx    uint64
+	netTx    uint64
+}
+
+func newUsage(at time.Time, cpu runvtypes.CpuStats, mem runvtypes.MemoryStats, blk runvtypes.BlkioStats, net runvtypes.NetworkStats) *usage {
+	u := &usage{
+		at:     at,
+		cpu:    cpu.Usage.Total,
+		memory: mem.Usage,
+	}
+	for _, e := range blk.IoServiceBytesRecursive {
+		u.blkRead += e.Stat["Read"]
+		u.blkWrite += e.Stat["Write"]
+	}
+	for _, i := range net.Interfaces {
+		u.netRx += i.RxBytes
+		u.netTx += i.TxBytes
+	}
+	return u
+}
+
+func podUsage(s *runvtypes.PodStats) *usage {
+	return newUsage(s.Timestamp, s.Cpu, s.Memory, s.Block, s.Network)
+}
+
+func containerUsage(s *runvtypes.ContainerStats) *usage {
+	return newUsage(s.Timestamp, s.Cpu, s.Memory, s.Block, s.Network)
+}
+
+// rates returns the rates between prev and cur, a decreased counter is
+// considered to be reset and its rate is 0.
+func rates(prev, cur *usage, memLimit uint64) *apitypes.StatsRates {
+	r := &apitypes.StatsRates{
+		MemoryUsage: cur.memory,
+		MemoryLimit: memLimit,
+	}
+	if memLimit > 0 {
+		r.MemoryPercent = float64(cur.memory) * 100 / float64(memLimit)
+	}
+	elapsed := cur.at.Sub(prev.at)
+	if elapsed <= 0 {
+		return r
+	}
+	perSecond := func(p, c uint64) float64 {
+		if c < p {
+			return 0
+		}
+		return float64(c-p) / elapsed.Seconds()
+	}
+	if cur.cpu >= prev.cpu {
+		r.CpuPercent = float64(cur.cpu-prev.cpu) * 100 / float64(elapsed.Nanoseconds())
+	}
+	r.BlockReadBytesPerSecond = perSecond(prev.blkRead, cur.blkRead)
+	r.BlockWriteBytesPerSecond = perSecond(prev.blkWrite, cur.blkWrite)
+	r.NetworkRxBytesPerSecond = perSecond(prev.netRx, cur.netRx)
+	r.NetworkTxBytesPerSecond = perSecond(prev.netTx, cur.netTx)
+	return r
+}
+
+func computeRates(p *pod.XPod, prev, cur *runvtypes.PodStats, sample *Sample) {
+	// the memory limits in MB, a container without limit is bounded by
+	// the pod memory
+	var (
+		podMemory int32
+		limits    = make(map[string]int32)
+	)
+	if info, err := p.Info(); err == nil && info.Spec != nil {
+		podMemory = info.Spec.Memory
+		for _, c := range info.Spec.Containers {
+			if c.Resource != nil && c.Resource.Memory > 0 {
+				limits[c.ContainerID] = c.Resource.Memory
+			}
+		}
+	}
+
+	sample.Rates = rates(podUsage(prev), podUsage(cur), uint64(podMemory)<<20)
+
+	prevContainers := make(map[string]*runvtypes.ContainerStats, len(prev.ContainersStats))
+	for i := range prev.ContainersStats {
+		prevContainers[prev.ContainersStats[i].ContainerID] = &prev.ContainersStats[i]
+	}
+	for i := range cur.ContainersStats {
+		cs := &cur.ContainersStats[i]
+		pcs, ok := prevContainers[cs.ContainerID]
+		if !ok {
+			// a new container, its rates are available from the next sample
+			pcs = cs
+		}
+		memory, ok := limits[cs.ContainerID]
+		if !ok {
+			memory = podMemory
+		}
+		sample.ContainerRates = append(sample.ContainerRates, &apitypes.ContainerStatsRates{
+			ContainerID:   cs.ContainerID,
+			ContainerName: p.ContainerId2Name(cs.ContainerID),
+			Rates:         rates(containerUsage(pcs), containerUsage(cs), uint64(memory)<<20),
+		})
+	}
+}
@@ -0,0 +1,166 @@
+package stats
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/hyperhq/hyperd/daemon/pod"
+)
+
+func TestRates(t *testing.T) {
+	at := time.Unix(1000, 0)
+	base := &usage{at: at, cpu: 1e9, memory: 64 << 20, blkRead: 1000, blkWrite: 2000, netRx: 3000, netTx: 4000}
+
+	cases := []struct {
+		name     string
+		cur      usage
+		memLimit uint64
+		expect   [6]float64 // cpu%, mem%, blk read/write, net rx/tx per second
+	}{
+		{
+			name:     "one second",
+			cur:      usage{at: at.Add(time.Second), cpu: 1.5e9, memory: 64 << 20, blkRead: 2000, blkWrite: 2000, netRx: 4000, netTx: 8000},
+			memLimit: 128 << 20,
+			expect:   [6]float64{50, 50, 1000, 0, 1000, 4000},
+		},
+		{
+			name:   "two seconds",
+			cur:    usage{at: at.Add(2 * time.Second), cpu: 3e9, memory: 64 << 20, blkRead: 3000, blkWrite: 6000, netRx: 3000, netTx: 4000},
+			expect: [6]float64{100, 0, 1000, 2000, 0, 0},
+		},
+		{
+			name:     "counters reset",
+			cur:      usage{at: at.Add(time.Second), cpu: 1e8, memory: 32 << 20, blkRead: 10, blkWrite: 20, netRx: 30, netTx: 4500},
+			memLimit: 128 << 20,
+			expect:   [6]float64{0, 25, 0, 0, 0, 500},
+		},
+		{
+			name:     "no time elapsed",
+			cur:      usage{at: at, cpu: 2e9, memory: 128 << 20, blkRead: 2000, blkWrite: 3000, netRx: 4000, netTx: 5000},
+			memLimit: 128 << 20,
+			expect:   [6]float64{0, 100, 0, 0, 0, 0},
+		},
+	}
+	for _, c := range cases {
+		r := rates(base, &c.cur, c.memLimit)
+		got := [6]float64{r.CpuPercent, r.MemoryPercent, r.BlockReadBytesPerSecond, r.BlockWriteBytesPerSecond, r.NetworkRxBytesPerSecond, r.NetworkTxBytesPerSecond}
+		for i := range got {
+			if math.Abs(got[i]-c.expect[i]) > 1e-6 {
+				t.Errorf("%s: rates %v, expect %v", c.name, got, c.expect)
+				break
+			}
+		}
+		if r.MemoryUsage != c.cur.memory || r.MemoryLimit != c.memLimit {
+			t.Errorf("%s: memory %d/%d, expect %d/%d", c.name, r.MemoryUsage, r.MemoryLimit, c.cur.memory, c.memLimit)
+		}
+	}
+}
+
+func TestSendInterval(t *testing.T) {
+	at := time.Unix(1000, 0)
+
+	cases := []struct {
+		name            string
+		interval        time.Duration
+		samplerInterval time.Duration
+		after           []time.Duration // the samples since the first one
+		delivered       int
+	}{
+		{"same interval", time.Second, time.Second, []time.Duration{0, time.Second, 2 * time.Second}, 3},
+		// a slower subscriber gets every other sample of a 500ms sampler
+		{"fan out", time.Second, 500 * time.Millisecond, []time.Duration{0, 500 * time.Millisecond, time.Second, 1500 * time.Millisecond, 2 * time.Second}, 3},
+		// the sampler jitters a bit behind the interval
+		{"jitter", time.Second, time.Second, []time.Duration{0, 990 * time.Millisecond, 1980 * time.Millisecond}, 3},
+		{"too early", 2 * time.Second, 500 * time.Millisecond, []time.Duration{0, 500 * time.Millisecond, time.Second}, 1},
+	}
+	for _, c := range cases {
+		sub := &subscriber{
+			interval: c.interval,
+			ch:       make(chan *Sample, len(c.after)),
+			last:     make(map[string]time.Time),
+		}
+		for _, d := range c.after {
+			sub.send(&Sample{PodID: "pod", Timestamp: at.Add(d)}, c.samplerInterval)
+		}
+		if len(sub.ch) != c.delivered {
+			t.Errorf("%s: %d samples delivered, expect %d", c.name, len(sub.ch), c.delivered)
+		}
+	}
+
+	// the samples are dropped rather than blocking a full subscriber
+	sub := &subscriber{interval: MinInterval, ch: make(chan *Sample), last: make(map[string]time.Time)}
+	sub.send(&Sample{PodID: "pod", Timestamp: at}, MinInterval)
+	if _, ok := sub.last["pod"]; ok {
+		t.Error("a dropped sample is recorded as delivered")
+	}
+}
+
+func TestHubSubscribe(t *testing.T) {
+	h := NewHub(func(id string) (*pod.XPod, bool) {
+		return nil, id == "pod"
+	})
+
+	if _, _, err := h.Subscribe(nil, 0); err == nil {
+		t.Error("subscribe without pods succeeded")
+	}
+	if _, _, err := h.Subscribe([]string{"pod", "nothing"}, 0); err == nil {
+		t.Error("subscribe to a nonexistent pod succeeded")
+	}
+
+	// the sampler is not running, the subscribers are attached to it
+	s := &sampler{
+		podID: "pod",
+		subs:  make(map[*subscriber]struct{}),
+		stop:  make(chan struct{}),
+	}
+	h.samplers["pod"] = s
+
+	cases := []struct {
+		interval time.Duration
+		sampler  time.Duration
+	}{
+		{3 * time.Second, 3 * time.Second},
+		{0, DefaultInterval},
+		{time.Millisecond, MinInterval},
+	}
+	var (
+		chans   []<-chan *Sample
+		cancels []func()
+	)
+	for _, c := range cases {
+		ch, cancel, err := h.Subscribe([]string{"pod", "pod"}, c.interval)
+		if err != nil {
+			t.Fatal(err)
+		}
+		chans = append(chans, ch)
+		cancels = append(cancels, cancel)
+		if s.interval != c.sampler {
+			t.Errorf("subscribe at %v: sampler interval %v, expect %v", c.interval, s.interval, c.sampler)
+		}
+	}
+	if len(h.samplers) != 1 || len(s.subs) != len(cases) {
+		t.Fatalf("%d samplers with %d subscribers, expect 1 with %d", len(h.samplers), len(s.subs), len(cases))
+	}
+
+	// the interval goes back to the shortest of the remaining subscribers
+	for i, expect := range []time.Duration{DefaultInterval, 3 * time.Second, 0} {
+		cancels[len(cancels)-1-i]()
+		if _, ok := <-chans[len(chans)-1-i]; ok {
+			t.Errorf("channel %d is not closed after cancel", len(chans)-1-i)
+		}
+		if expect > 0 && s.interval != expect {
+			t.Errorf("sampler interval %v after cancel, expect %v", s.interval, expect)
+		}
+	}
+	select {
+	case <-s.stop:
+	default:
+		t.Error("sampler is not stopped after the last subscriber has gone")
+	}
+	if len(h.samplers) != 0 {
+		t.Errorf("%d samplers left", len(h.samplers))
+	}
+	// cancel is idempotent
+	cancels[0]()
+}
@@ -9,8 +9,10 @@ import (
 	"math/rand"
 	"os"
 	"path/filepath"
+	"sort"
 	"strconv"
 	"strings"
+	"sync"
 	"syscall"
 	"time"
 
@@ -40,22 +42,97 @@ type Storage interface {
 	InjectFile(src io.Reader, containerId, target, baseDir string, perm, uid, gid int) error
 	CreateVolume(podId string, spec *apitypes.UserVolume) error
 	RemoveVolume(podId string, record []byte) error
+
+	// copy-on-write snapshots of the volumes created by CreateVolume, the
+	// clone is created for the podId like CreateVolume.
+	VolumeSnapshot(podId string, spec *apitypes.UserVolume, snapshot string) error
+	VolumeSnapshotList(podId string, spec *apitypes.UserVolume) ([]string, error)
+	VolumeSnapshotRemove(podId string, spec *apitypes.UserVolume, snapshot string) error
+	VolumeRestore(podId string, spec *apitypes.UserVolume, snapshot string) error
+	VolumeClone(podId string, spec *apitypes.UserVolume, snapshot string, target *apitypes.UserVolume) error
+}
+
+// isBlockVolumeStorage tells whether the volumes of the Storage backend are
+// block devices, which could be formatted with the fstype of the spec.
+func isBlockVolumeStorage(s Storage) bool {
+	switch s.(type) {
+	case *DevMapperStorage, *RawBlockStorage:
+		return true
+	}
+	return false
+}
+
+// StorageCreator is the factory of a Storage backend, it gets the info of
+// the docker daemon and the daemon db for persisting the backend data.
+type StorageCreator func(*dockertypes.Info, *daemondb.DaemonDB) (Storage, error)
+
+var (
+	storageDrivers = map[string]StorageCreator{
+		"devicemapper": DMFactory,
+		"aufs":         AufsFactory,
+		"overlay":      OverlayFsFactory,
+		"btrfs":        BtrfsFactory,
+		"rawblock":     RawBlockFactory,
+		"vbox":         VBoxStorageFactory,
+	}
+	storageLock sync.RWMutex
+)
+
+// RegisterStorageDriver registers a Storage backend by name, an out-of-tree
+// implementation could call it in its init() to be selectable by the
+// StorageDriver item of the hyperd config.
+func RegisterStorageDriver(name string, c StorageCreator) error {
+	storageLock.Lock()
+	defer storageLock.Unlock()
+
+	if name == "" || c == nil {
+		return fmt.Errorf("invalid storage driver registration: %q", name)
+	}
+	if _, ok := storageDrivers[name]; ok {
+		return fmt.Errorf("storage driver %q is already registered", name)
+	}
+	storageDrivers[name] = c
+	return nil
 }
 
-var StorageDrivers map[string]func(*dockertypes.Info, *daemondb.DaemonDB) (Storage, error) = map[string]func(*dockertypes.Info, *daemondb.DaemonDB) (Storage, error){
-	"devicemapper": DMFactory,
-	"aufs":         AufsFactory,
-	"overlay":      OverlayFsFactory,
-	"btrfs":        BtrfsFactory,
-	"rawblock":     RawBlockFactory,
-	"vbox":         VBoxStorageFactory,
+// GetStorageDriver returns the creator of the storage driver by name
+func GetStorageDriver(name string) (StorageCreator, error) {
+	storageLock.RLock()
+	defer storageLock.RUnlock()
+
+	if c, ok := storageDrivers[name]; ok {
+		return c, nil
+	}
+	return nil, fmt.Errorf("storage driver %q is not registered", name)
 }
 
-func StorageFactory(sysinfo *dockertypes.Info, db *daemondb.DaemonDB) (Storage, error) {
-	if factory, ok := StorageDrivers[sysinfo.Driver]; ok {
-		return factory(sysinfo, db)
+// StorageDriverNames returns the names of all the registered storage drivers
+func StorageDriverNames() []string {
+	storageLock.RLock()
+	defer storageLock.RUnlock()
+
+	names := make([]string, 0, len(storageDrivers))
+	for name := range storageDrivers {
+		names = append(names, name)
 	}
-	return nil, fmt.Errorf("hyperd can not support docker's backing storage: %s", sysinfo.Driver)
+	sort.Strings(names)
+	return names
+}
+
+// StorageFactory creates the storage backend selected by the StorageDriver
+// config item, and falls back to the one named after the graph driver of
+// docker if it is not configured.
+func StorageFactory(cfg *apitypes.HyperConfig, sysinfo *dockertypes.Info, db *daemondb.DaemonDB) (Storage, error) {
+	name := sysinfo.Driver
+	if cfg != nil && cfg.StorageDriver != "" {
+		name = cfg.StorageDriver
+	}
+	factory, err := GetStorageDriver(name)
+	if err != nil {
+		return nil, fmt.Errorf("hyperd can not support backing storage %s (docker graph driver: %s), available drivers: %s",
+			name, sysinfo.Driver, strings.Join(StorageDriverNames(), ", "))
+	}
+	return factory(sysinfo, db)
 }
 
 type DevMapperStorage struct {
@@ -185,15 +262,30 @@ func (dms *DevMapperStorage) getPersistedId(podId, volName string) (int, error)
 	return dev_id, nil
 }
 
-func (dms *DevMapperStorage) CreateVolume(podId string, spec *apitypes.UserVolume) error {
-	var err error
-
+func (dms *DevMapperStorage) volumeDeviceName(podId, volName string) string {
 	// kernel dm has limitation of 128 bytes on device name length
 	// include/uapi/linux/dm-ioctl.h#L16
 	// #define DM_NAME_LEN 128
 	// Use sha256 so it is fixed 64 bytes
-	chksum := sha256.Sum256([]byte(podId + spec.Name))
-	deviceName := fmt.Sprintf("%s-%s", dms.VolPoolName, hex.EncodeToString(chksum[:sha256.Size]))
+	chksum := sha256.Sum256([]byte(podId + volName))
+	return fmt.Sprintf("%s-%s", dms.VolPoolName, hex.EncodeToString(chksum[:sha256.Size]))
+}
+
+func (dms *DevMapperStorage) CreateVolume(podId string, spec *apitypes.UserVolume) error {
+	var (
+		err  error
+		mkfs = storage.DEFAULT_VOL_MKFS
+		size = uint64(spec.SizeBytes)
+	)
+
+	if size == 0 {
+		size = uint64(storage.DEFAULT_DM_VOL_SIZE)
+	}
+	if spec.Fstype != "" {
+		mkfs = "mkfs." + spec.Fstype
+	}
+
+	deviceName := dms.volumeDeviceName(podId, spec.Name)
 	dev_id, _ := dms.getPersistedId(podId, deviceName)
 	glog.Infof("DeviceID is %d for %s of pod %s container %s", dev_id, deviceName, podId, spec.Name)
 
@@ -205,7 +297,7 @@ func (dms *DevMapperStorage) CreateVolume(podId string, spec *apitypes.UserVolum
 		}
 		dev_id_str := strconv.Itoa(dev_id)
 
-		err = dm.CreateVolume(dms.VolPoolName, deviceName, dev_id_str, storage.DEFAULT_VOL_MKFS, storage.DEFAULT_DM_VOL_SIZE, restore)
+		err = dm.CreateVolume(dms.VolPoolName, deviceName, dev_id_str, mkfs, int(size), restore)
 		if err != nil && !restore && strings.Contains(err.Error(), "failed: File exists") {
 			glog.V(1).Infof("retry for dev_id #%d creating collision: %v", dev_id, err)
 			continue
@@ -240,6 +332,13 @@ func (dms *DevMapperStorage) CreateVolume(podId string, spec *apitypes.UserVolum
 	return nil
 }
 
+func (dms *DevMapperStorage) ResizeVolume(spec *apitypes.UserVolume, size uint64) error {
+	if !strings.HasPrefix(spec.Source, filepath.Join("/dev/mapper/", dms.VolPoolName)+"-") {
+		return fmt.Errorf("volume %s is not created by %s", spec.Name, dms.Type())
+	}
+	return dm.ResizeVolume(filepath.Base(spec.Source), int(size))
+}
+
 func (dms *DevMapperStorage) RemoveVolume(podId string, record []byte) error {
 	fields := strings.SplitN(string(record), ":", 2)
 	if len(fields) == 1 {
@@ -272,6 +371,8 @@ func (dms *DevMapperStorage) randDevId() int {
 }
 
 type AufsStorage struct {
+	noVolumeSnapshot
+
 	rootPath string
 }
 
@@ -332,7 +433,7 @@ func (a *AufsStorage) InjectFile(src io.Reader, containerId, target, baseDir str
 }
 
 func (a *AufsStorage) CreateVolume(podId string, spec *apitypes.UserVolume) error {
-	volName, err := storage.CreateVFSVolume(podId, spec.Name)
+	volName, err := storage.CreateVFSVolume(podId, spec.Name, uint64(spec.SizeBytes))
 	if err != nil {
 		return err
 	}
@@ -342,11 +443,17 @@ func (a *AufsStorage) CreateVolume(podId string, spec *apitypes.UserVolume) erro
 	return nil
 }
 
+func (a *AufsStorage) ResizeVolume(spec *apitypes.UserVolume, size uint64) error {
+	return resizeVFSVolume(spec, size)
+}
+
 func (a *AufsStorage) RemoveVolume(podId string, record []byte) error {
 	return nil
 }
 
 type OverlayFsStorage struct {
+	noVolumeSnapshot
+
 	rootPath string
 }
 
@@ -404,7 +511,7 @@ func (o *OverlayFsStorage) InjectFile(src io.Reader, mountId, target, baseDir st
 }
 
 func (o *OverlayFsStorage) CreateVolume(podId string, spec *apitypes.UserVolume) error {
-	volName, err := storage.CreateVFSVolume(podId, spec.Name)
+	volName, err := storage.CreateVFSVolume(podId, spec.Name, uint64(spec.SizeBytes))
 	if err != nil {
 		return err
 	}
@@ -414,6 +521,10 @@ func (o *OverlayFsStorage) CreateVolume(podId string, spec *apitypes.UserVolume)
 	return nil
 }
 
+func (o *OverlayFsStorage) ResizeVolume(spec *apitypes.UserVolume, size uint64) error {
+	return resizeVFSVolume(spec, size)
+}
+
 func (o *OverlayFsStorage) RemoveVolume(podId string, record []byte) error {
 	return nil
 }
@@ -484,10 +595,31 @@ func (s *BtrfsStorage) InjectFile(src io.Reader, mountId, target, baseDir string
 	return storage.FsInjectFile(src, mountId, target, filepath.Dir(s.subvolumesDirID(mountId)), perm, uid, gid)
 }
 
+func (s *BtrfsStorage) volumesDir() string {
+	return filepath.Join(s.RootPath(), "volumes")
+}
+
+// CreateVolume creates the volume as a subvolume, so that it could be
+// snapshotted and limited by a qgroup.
 func (s *BtrfsStorage) CreateVolume(podId string, spec *apitypes.UserVolume) error {
-	volName, err := storage.CreateVFSVolume(podId, spec.Name)
-	if err != nil {
-		return err
+	volName := filepath.Join(s.volumesDir(), fmt.Sprintf("%s-%s", podId, spec.Name))
+	if _, err := os.Stat(volName); os.IsNotExist(err) {
+		if err = os.MkdirAll(s.volumesDir(), 0700); err != nil {
+			return err
+		}
+		if err = storage.CreateSubvolume(volName); err != nil {
+			return err
+		}
+		if spec.SizeBytes > 0 {
+			err = storage.SetVFSVolumeQuota(volName, uint64(spec.SizeBytes))
+		}
+		if err == nil {
+			err = os.Chmod(volName, os.FileMode(0777))
+		}
+		if err != nil {
+			storage.DeleteSubvolume(volName)
+			return err
+		}
 	}
 	spec.Source = volName
 	spec.Format = "vfs"
@@ -495,8 +627,19 @@ func (s *BtrfsStorage) CreateVolume(podId string, spec *apitypes.UserVolume) err
 	return nil
 }
 
+func (s *BtrfsStorage) ResizeVolume(spec *apitypes.UserVolume, size uint64) error {
+	if filepath.Dir(spec.Source) == s.volumesDir() {
+		return storage.ResizeVFSVolume(spec.Source, uint64(spec.SizeBytes), size)
+	}
+	return resizeVFSVolume(spec, size)
+}
+
 func (s *BtrfsStorage) RemoveVolume(podId string, record []byte) error {
-	return nil
+	volName := volumeFile(s.volumesDir(), podId, record)
+	if _, err := os.Stat(volName); err != nil {
+		return nil
+	}
+	return storage.DeleteSubvolume(volName)
 }
 
 type RawBlockStorage struct {
@@ -519,7 +662,7 @@ func (s *RawBlockStorage) RootPath() string {
 }
 
 func (s *RawBlockStorage) Init(c *apitypes.HyperConfig) error {
-	if err := os.MkdirAll(filepath.Join(s.RootPath(), "volumes"), 0700); err != nil {
+	if err := os.MkdirAll(s.volumesDir(), 0700); err != nil {
 		return err
 	}
 	return nil
@@ -554,21 +697,47 @@ func (s *RawBlockStorage) InjectFile(src io.Reader, mountId, target, baseDir str
 }
 
 func (s *RawBlockStorage) CreateVolume(podId string, spec *apitypes.UserVolume) error {
-	block := filepath.Join(s.RootPath(), "volumes", fmt.Sprintf("%s-%s", podId, spec.Name))
-	if err := rawblock.CreateBlock(block, "xfs", "", uint64(storage.DEFAULT_DM_VOL_SIZE)); err != nil {
+	fstype := "xfs"
+	if spec.Fstype != "" {
+		fstype = spec.Fstype
+	}
+	size := uint64(spec.SizeBytes)
+	if size == 0 {
+		size = uint64(storage.DEFAULT_DM_VOL_SIZE)
+	}
+	block := filepath.Join(s.volumesDir(), fmt.Sprintf("%s-%s", podId, spec.Name))
+	if err := rawblock.CreateBlock(block, fstype, "", size); err != nil {
 		return err
 	}
 	spec.Source = block
-	spec.Fstype = "xfs"
+	spec.Fstype = fstype
 	spec.Format = "raw"
 	return nil
 }
 
+func (s *RawBlockStorage) ResizeVolume(spec *apitypes.UserVolume, size uint64) error {
+	if filepath.Dir(spec.Source) != s.volumesDir() {
+		return fmt.Errorf("volume %s is not created by %s", spec.Name, s.Type())
+	}
+	return rawblock.GrowBlock(spec.Source, size)
+}
+
+func (s *RawBlockStorage) volumesDir() string {
+	return filepath.Join(s.RootPath(), "volumes")
+}
+
 func (s *RawBlockStorage) RemoveVolume(podId string, record []byte) error {
+	block := volumeFile(s.volumesDir(), podId, record)
+	if err := os.Remove(block); err != nil && !os.IsNotExist(err) {
+		glog.Errorf("failed to remove volume %s: %v", block, err)
+		return err
+	}
 	return nil
 }
 
 type VBoxStorage struct {
+	noVolumeSnapshot
+
 	rootPath string
 }
 
@@ -618,7 +787,7 @@ func (v *VBoxStorage) InjectFile(src io.Reader, containerId, target, rootDir str
 }
 
 func (v *VBoxStorage) CreateVolume(podId string, spec *apitypes.UserVolume) error {
-	volName, err := storage.CreateVFSVolume(podId, spec.Name)
+	volName, err := storage.CreateVFSVolume(podId, spec.Name, uint64(spec.SizeBytes))
 	if err != nil {
 		return err
 	}
@@ -628,6 +797,29 @@ func (v *VBoxStorage) CreateVolume(podId string, spec *apitypes.UserVolume) erro
 	return nil
 }
 
+func (v *VBoxStorage) ResizeVolume(spec *apitypes.UserVolume, size uint64) error {
+	return resizeVFSVolume(spec, size)
+}
+
 func (v *VBoxStorage) RemoveVolume(podId string, record []byte) error {
 	return nil
 }
+
+// volumeFile is the path of a volume file or subvolume in dir, the record
+// is the base name of the volume source or the name of the volume.
+func volumeFile(dir, podId string, record []byte) string {
+	name := string(record)
+	if !strings.HasPrefix(name, podId+"-") {
+		name = fmt.Sprintf("%s-%s", podId, name)
+	}
+	return filepath.Join(dir, name)
+}
+
+// resizeVFSVolume grows the quota of a vfs volume created by the directory
+// based drivers.
+func resizeVFSVolume(spec *apitypes.UserVolume, size uint64) error {
+	if spec.Format != "vfs" || !strings.HasPrefix(spec.Source, storage.DEFAULT_VFS_VOL_ROOT+"/") {
+		return fmt.Errorf("volume %s is not created by the storage driver", spec.Name)
+	}
+	return storage.ResizeVFSVolume(spec.Source, uint64(spec.SizeBytes), size)
+}
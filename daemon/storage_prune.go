@@ -0,0 +1,162 @@
+package daemon
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/golang/glog"
+	"github.com/hyperhq/hyperd/daemon/daemondb"
+	"github.com/hyperhq/hyperd/storage"
+	dm "github.com/hyperhq/hyperd/storage/devicemapper"
+)
+
+// podVolumeRecord is a "<name>:<dev_id>" record of a devicemapper volume or
+// snapshot, the owner is a pod, the named volumes or the volume device of
+// the snapshot.
+type podVolumeRecord struct {
+	owner  string
+	name   string
+	record []byte
+}
+
+func podVolumeRecords(db *daemondb.DaemonDB) ([]*podVolumeRecord, error) {
+	pairs, err := db.ListAllPodVolumes()
+	if err != nil {
+		return nil, err
+	}
+	prefix := fmt.Sprintf(daemondb.POD_VOLUME_PREFIX, "")
+	records := make([]*podVolumeRecord, 0, len(pairs))
+	for _, kv := range pairs {
+		key := string(kv.K)
+		fields := strings.SplitN(string(kv.V), ":", 2)
+		if len(fields) != 2 || !strings.HasSuffix(key, "-"+fields[0]) {
+			glog.Warningf("skip invalid volume record %s", key)
+			continue
+		}
+		records = append(records, &podVolumeRecord{
+			owner:  strings.TrimSuffix(strings.TrimPrefix(key, prefix), "-"+fields[0]),
+			name:   fields[0],
+			record: kv.V,
+		})
+	}
+	return records, nil
+}
+
+// pruneVolumes deletes the thin devices of the pods and named volumes which
+// are gone. During the daemon restore, the mapped volumes without records,
+// i.e. the ones failed to be formatted, are deleted as well. The devices of
+// the named volumes are never deleted, whatever the records say.
+func (dms *DevMapperStorage) pruneVolumes(pr *pruner) error {
+	records, err := podVolumeRecords(dms.db)
+	if err != nil {
+		return err
+	}
+
+	recorded := make(map[string]bool, len(records))
+	for _, r := range records {
+		recorded[r.name] = true
+		source := filepath.Join("/dev/mapper/", r.name)
+		if pr.named[source] {
+			continue
+		}
+		kind := orphanDevice
+		switch {
+		case strings.HasPrefix(r.owner, dmSnapshotOwner("")):
+			if pr.named[filepath.Join("/dev/mapper/", strings.TrimPrefix(r.owner, dmSnapshotOwner("")))] {
+				continue
+			}
+			// snapshots are never mapped
+			kind, source = orphanSnapshot, ""
+		case pr.pods[r.owner]:
+			continue
+		}
+		r := r
+		pr.found(kind, r.name, r.owner, func() error {
+			if source != "" {
+				if err := dm.UnmapVolume(source); err != nil {
+					return err
+				}
+			}
+			return dms.RemoveVolume(r.owner, r.record)
+		})
+	}
+
+	if !pr.restoring {
+		return nil
+	}
+	mapped, err := dm.ListVolumes(dms.VolPoolName)
+	if err != nil {
+		return err
+	}
+	for _, name := range mapped {
+		if recorded[name] || pr.named[filepath.Join("/dev/mapper/", name)] {
+			continue
+		}
+		volName := name
+		pr.found(orphanDevice, volName, "", func() error {
+			devId, err := dm.VolumeDeviceId(volName)
+			if err != nil {
+				return err
+			}
+			if err = dm.UnmapVolume(filepath.Join("/dev/mapper/", volName)); err != nil {
+				return err
+			}
+			return dm.DeleteVolume(dms.DmPoolData, devId)
+		})
+	}
+	return nil
+}
+
+func (s *BtrfsStorage) pruneVolumes(pr *pruner) error {
+	return pruneVolumeFiles(pr, s.volumesDir(), filepath.Join(s.RootPath(), "snapshots"), storage.DeleteSubvolume)
+}
+
+func (s *RawBlockStorage) pruneVolumes(pr *pruner) error {
+	return pruneVolumeFiles(pr, s.volumesDir(), filepath.Join(s.RootPath(), "snapshots"), os.Remove)
+}
+
+// pruneVolumeFiles removes the volumes in volumesDir which are not owned, and
+// the snapshots in snapshotsDir of the volumes which are gone.
+func pruneVolumeFiles(pr *pruner, volumesDir, snapshotsDir string, remove func(string) error) error {
+	vols, err := listDir(volumesDir)
+	if err != nil {
+		return err
+	}
+	for _, v := range vols {
+		source := filepath.Join(volumesDir, v)
+		if pr.ownsFile(source) {
+			continue
+		}
+		pr.found(orphanVolume, source, "", func() error {
+			return remove(source)
+		})
+	}
+
+	bases, err := listDir(snapshotsDir)
+	if err != nil {
+		return err
+	}
+	for _, base := range bases {
+		if pr.named[filepath.Join(volumesDir, base)] {
+			continue
+		}
+		dir := filepath.Join(snapshotsDir, base)
+		snaps, err := storage.ListSnapshots(dir)
+		if err != nil {
+			glog.Warningf("failed to list snapshots in %s: %v", dir, err)
+			continue
+		}
+		for _, snap := range snaps {
+			path := filepath.Join(dir, snap)
+			pr.found(orphanSnapshot, path, base, func() error {
+				return remove(path)
+			})
+		}
+		if !pr.dryRun {
+			os.Remove(dir)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,145 @@
+package daemon
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"testing"
+
+	"github.com/hyperhq/hyperd/daemon/daemondb"
+)
+
+func TestPodVolumeRecords(t *testing.T) {
+	tmp, err := ioutil.TempDir("", "prune")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(tmp)
+
+	db, err := daemondb.NewDaemonDB(filepath.Join(tmp, "db"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+
+	for _, r := range []struct{ owner, vol, record string }{
+		{"pod-1", "hyper-volume-pod-1-v1", "hyper-volume-pod-1-v1:10"},
+		{namedVolumeOwner, "hyper-volume-data", "hyper-volume-data:11"},
+		{dmSnapshotOwner("hyper-volume-data"), "hyper-volume-data-snap", "hyper-volume-data-snap:12"},
+		{"pod-2", "v2", "bad-record"},
+		{"pod-2", "v3", "other:13"},
+	} {
+		if err := db.UpdatePodVolume(r.owner, r.vol, []byte(r.record)); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	records, err := podVolumeRecords(db)
+	if err != nil {
+		t.Fatal(err)
+	}
+	got := map[string]podVolumeRecord{}
+	for _, r := range records {
+		got[r.name] = *r
+	}
+	expect := map[string]podVolumeRecord{
+		"hyper-volume-pod-1-v1":  {owner: "pod-1", record: []byte("hyper-volume-pod-1-v1:10")},
+		"hyper-volume-data":      {owner: namedVolumeOwner, record: []byte("hyper-volume-data:11")},
+		"hyper-volume-data-snap": {owner: dmSnapshotOwner("hyper-volume-data"), record: []byte("hyper-volume-data-snap:12")},
+	}
+	if len(got) != len(expect) {
+		t.Fatalf("unexpected records %v", got)
+	}
+	for name, e := range expect {
+		r := got[name]
+		if r.owner != e.owner || string(r.record) != string(e.record) {
+			t.Errorf("record of %s is %q of %q, expect %q of %q", name, r.record, r.owner, e.record, e.owner)
+		}
+	}
+}
+
+func TestPruneVolumeFiles(t *testing.T) {
+	tmp, err := ioutil.TempDir("", "prune")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(tmp)
+
+	volumesDir := filepath.Join(tmp, "volumes")
+	snapshotsDir := filepath.Join(tmp, "snapshots")
+	files := []string{
+		"volumes/pod-1-v1",
+		"volumes/pod-2-v1",
+		"volumes/named-volumes-kept",
+		"volumes/named-volumes-gone",
+		"snapshots/named-volumes-kept/s1",
+		"snapshots/named-volumes-gone/s1",
+		"snapshots/named-volumes-gone/s2",
+	}
+	for _, f := range files {
+		path := filepath.Join(tmp, f)
+		if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := ioutil.WriteFile(path, nil, 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	orphans := []string{
+		"volumes/pod-2-v1",
+		"volumes/named-volumes-gone",
+		"snapshots/named-volumes-gone/s1",
+		"snapshots/named-volumes-gone/s2",
+	}
+
+	for _, dryRun := range []bool{true, false} {
+		pr := &pruner{
+			dryRun: dryRun,
+			pods:   map[string]bool{"pod-1": true},
+			named:  map[string]bool{filepath.Join(volumesDir, "named-volumes-kept"): true},
+		}
+		if err := pruneVolumeFiles(pr, volumesDir, snapshotsDir, os.Remove); err != nil {
+			t.Fatal(err)
+		}
+
+		found := []string{}
+		for _, o := range pr.orphans {
+			if o.Removed == dryRun {
+				t.Errorf("orphan %s removed: %v, dry run: %v", o.Id, o.Removed, dryRun)
+			}
+			rel, _ := filepath.Rel(tmp, o.Id)
+			found = append(found, rel)
+		}
+		sort.Strings(found)
+		sort.Strings(orphans)
+		if len(found) != len(orphans) {
+			t.Fatalf("found orphans %v, expect %v", found, orphans)
+		}
+		for i := range found {
+			if found[i] != orphans[i] {
+				t.Fatalf("found orphans %v, expect %v", found, orphans)
+			}
+		}
+
+		for _, f := range files {
+			_, err := os.Stat(filepath.Join(tmp, f))
+			removed := !dryRun && contains(orphans, f)
+			if removed != os.IsNotExist(err) {
+				t.Errorf("%s is removed: %v, expect %v, dry run: %v", f, os.IsNotExist(err), removed, dryRun)
+			}
+		}
+	}
+	if _, err := os.Stat(filepath.Join(snapshotsDir, "named-volumes-gone")); !os.IsNotExist(err) {
+		t.Errorf("snapshots dir of the removed volume is kept: %v", err)
+	}
+}
+
+func contains(list []string, s string) bool {
+	for _, e := range list {
+		if e == s {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,386 @@
+package daemon
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/golang/glog"
+	"github.com/hyperhq/hyperd/storage"
+	dm "github.com/hyperhq/hyperd/storage/devicemapper"
+	apitypes "github.com/hyperhq/hyperd/types"
+)
+
+var errSnapshotNotSupported = errors.New("volume snapshot is not supported by the storage driver")
+
+// noVolumeSnapshot is embedded by the Storage backends without cheap
+// copy-on-write primitives.
+type noVolumeSnapshot struct{}
+
+func (noVolumeSnapshot) VolumeSnapshot(podId string, spec *apitypes.UserVolume, snapshot string) error {
+	return errSnapshotNotSupported
+}
+
+func (noVolumeSnapshot) VolumeSnapshotList(podId string, spec *apitypes.UserVolume) ([]string, error) {
+	return nil, errSnapshotNotSupported
+}
+
+func (noVolumeSnapshot) VolumeSnapshotRemove(podId string, spec *apitypes.UserVolume, snapshot string) error {
+	return errSnapshotNotSupported
+}
+
+func (noVolumeSnapshot) VolumeRestore(podId string, spec *apitypes.UserVolume, snapshot string) error {
+	return errSnapshotNotSupported
+}
+
+func (noVolumeSnapshot) VolumeClone(podId string, spec *apitypes.UserVolume, snapshot string, target *apitypes.UserVolume) error {
+	return errSnapshotNotSupported
+}
+
+func volumeSize(spec *apitypes.UserVolume) int {
+	if spec.SizeBytes > 0 {
+		return int(spec.SizeBytes)
+	}
+	return storage.DEFAULT_DM_VOL_SIZE
+}
+
+// The snapshots of a devicemapper volume are thin devices in the volume
+// pool, their device ids are recorded under the owner derived from the
+// device name of the volume.
+func dmSnapshotOwner(deviceName string) string {
+	return "snapshot-" + deviceName
+}
+
+func (dms *DevMapperStorage) volumeDevice(podId string, spec *apitypes.UserVolume) (string, int, error) {
+	if !strings.HasPrefix(spec.Source, filepath.Join("/dev/mapper/", dms.VolPoolName)+"-") {
+		return "", 0, fmt.Errorf("volume %s is not created by %s", spec.Name, dms.Type())
+	}
+	deviceName := filepath.Base(spec.Source)
+	devId, err := dms.getPersistedId(podId, deviceName)
+	if err == nil && devId <= 0 {
+		err = fmt.Errorf("cannot find the device of volume %s", spec.Name)
+	}
+	return deviceName, devId, err
+}
+
+func (dms *DevMapperStorage) snapshotDevice(deviceName, snapshot string) (int, error) {
+	devId, err := dms.getPersistedId(dmSnapshotOwner(deviceName), snapshot)
+	if err == nil && devId <= 0 {
+		err = fmt.Errorf("snapshot %s not found", snapshot)
+	}
+	return devId, err
+}
+
+// createSnapshotDevice creates a thin device from origin with a random id
+func (dms *DevMapperStorage) createSnapshotDevice(origin string, originId int) (int, error) {
+	for {
+		devId := dms.randDevId()
+		err := dm.CreateSnapshot(dms.VolPoolName, origin, strconv.Itoa(devId), strconv.Itoa(originId))
+		if err != nil && strings.Contains(err.Error(), "failed: File exists") {
+			glog.V(1).Infof("retry for dev_id #%d creating collision: %v", devId, err)
+			continue
+		}
+		return devId, err
+	}
+}
+
+func (dms *DevMapperStorage) VolumeSnapshot(podId string, spec *apitypes.UserVolume, snapshot string) error {
+	deviceName, volId, err := dms.volumeDevice(podId, spec)
+	if err != nil {
+		return err
+	}
+	if _, err = dms.snapshotDevice(deviceName, snapshot); err == nil {
+		return fmt.Errorf("snapshot %s already exists", snapshot)
+	}
+
+	snapId, err := dms.createSnapshotDevice(deviceName, volId)
+	if err != nil {
+		return err
+	}
+	glog.V(1).Infof("snapshot %s (%d) of volume %s created", snapshot, snapId, spec.Name)
+	return dms.db.UpdatePodVolume(dmSnapshotOwner(deviceName), snapshot, []byte(fmt.Sprintf("%s:%d", snapshot, snapId)))
+}
+
+func (dms *DevMapperStorage) VolumeSnapshotList(podId string, spec *apitypes.UserVolume) ([]string, error) {
+	deviceName, _, err := dms.volumeDevice(podId, spec)
+	if err != nil {
+		return nil, err
+	}
+	records, err := dms.db.ListPodVolumes(dmSnapshotOwner(deviceName))
+	if err != nil {
+		return nil, err
+	}
+	snapshots := make([]string, 0, len(records))
+	for _, r := range records {
+		snapshots = append(snapshots, strings.Split(string(r), ":")[0])
+	}
+	return snapshots, nil
+}
+
+func (dms *DevMapperStorage) VolumeSnapshotRemove(podId string, spec *apitypes.UserVolume, snapshot string) error {
+	deviceName, _, err := dms.volumeDevice(podId, spec)
+	if err != nil {
+		return err
+	}
+	snapId, err := dms.snapshotDevice(deviceName, snapshot)
+	if err != nil {
+		return err
+	}
+	if err = dm.DeleteVolume(dms.DmPoolData, snapId); err != nil {
+		return err
+	}
+	return dms.db.DeletePodVolume(dmSnapshotOwner(deviceName), snapshot)
+}
+
+// VolumeRestore replaces the thin device of the volume with a new snapshot
+// of the snapshot, the old device is deleted after the volume is switched.
+func (dms *DevMapperStorage) VolumeRestore(podId string, spec *apitypes.UserVolume, snapshot string) error {
+	deviceName, volId, err := dms.volumeDevice(podId, spec)
+	if err != nil {
+		return err
+	}
+	snapId, err := dms.snapshotDevice(deviceName, snapshot)
+	if err != nil {
+		return err
+	}
+
+	devId, err := dms.createSnapshotDevice("", snapId)
+	if err != nil {
+		return err
+	}
+	if err = dm.UnmapVolume(spec.Source); err != nil {
+		dm.DeleteVolume(dms.DmPoolData, devId)
+		return err
+	}
+	err = dms.db.UpdatePodVolume(podId, deviceName, []byte(fmt.Sprintf("%s:%d", deviceName, devId)))
+	if err == nil {
+		err = dm.CreateVolume(dms.VolPoolName, deviceName, strconv.Itoa(devId), "", volumeSize(spec), true)
+	}
+	if err != nil {
+		glog.Errorf("failed to switch volume %s to device %d, roll back to device %d: %v", spec.Name, devId, volId, err)
+		dms.rollbackVolumeRestore(podId, deviceName, spec, volId, devId)
+		return err
+	}
+	if err = dm.DeleteVolume(dms.DmPoolData, volId); err != nil {
+		glog.Warningf("failed to delete the replaced device %d of volume %s: %v", volId, spec.Name, err)
+	}
+	glog.V(1).Infof("volume %s restored to snapshot %s", spec.Name, snapshot)
+	return nil
+}
+
+// rollbackVolumeRestore maps the original device volId of the volume again
+// and restores its record, the new device devId is deleted.
+func (dms *DevMapperStorage) rollbackVolumeRestore(podId, deviceName string, spec *apitypes.UserVolume, volId, devId int) {
+	// the new device may have been mapped
+	if _, err := os.Stat(spec.Source); err == nil {
+		if err = dm.UnmapVolume(spec.Source); err != nil {
+			glog.Errorf("failed to unmap device %d of volume %s: %v", devId, spec.Name, err)
+		}
+	}
+	if err := dm.CreateVolume(dms.VolPoolName, deviceName, strconv.Itoa(volId), "", volumeSize(spec), true); err != nil {
+		glog.Errorf("failed to map the original device %d of volume %s: %v", volId, spec.Name, err)
+	}
+	if err := dms.db.UpdatePodVolume(podId, deviceName, []byte(fmt.Sprintf("%s:%d", deviceName, volId))); err != nil {
+		glog.Errorf("failed to restore the record of volume %s: %v", spec.Name, err)
+	}
+	if err := dm.DeleteVolume(dms.DmPoolData, devId); err != nil {
+		glog.Warningf("failed to delete device %d: %v", devId, err)
+	}
+}
+
+func (dms *DevMapperStorage) VolumeClone(podId string, spec *apitypes.UserVolume, snapshot string, target *apitypes.UserVolume) error {
+	deviceName, _, err := dms.volumeDevice(podId, spec)
+	if err != nil {
+		return err
+	}
+	snapId, err := dms.snapshotDevice(deviceName, snapshot)
+	if err != nil {
+		return err
+	}
+
+	targetName := dms.volumeDeviceName(podId, target.Name)
+	if id, _ := dms.getPersistedId(podId, targetName); id > 0 {
+		return fmt.Errorf("volume %s already exists", target.Name)
+	}
+	devId, err := dms.createSnapshotDevice("", snapId)
+	if err != nil {
+		return err
+	}
+	if err = dm.CreateVolume(dms.VolPoolName, targetName, strconv.Itoa(devId), "", volumeSize(spec), true); err != nil {
+		dm.DeleteVolume(dms.DmPoolData, devId)
+		return err
+	}
+	if err = dms.db.UpdatePodVolume(podId, targetName, []byte(fmt.Sprintf("%s:%d", targetName, devId))); err != nil {
+		glog.Errorf("failed to save the record of volume %s: %v", target.Name, err)
+		if e := dm.UnmapVolume(filepath.Join("/dev/mapper/", targetName)); e != nil {
+			glog.Warningf("failed to unmap device %d of volume %s: %v", devId, target.Name, e)
+		} else if e = dm.DeleteVolume(dms.DmPoolData, devId); e != nil {
+			glog.Warningf("failed to delete device %d: %v", devId, e)
+		}
+		return err
+	}
+
+	target.Source = filepath.Join("/dev/mapper/", targetName)
+	target.Format = "raw"
+	target.Fstype = spec.Fstype
+	target.SizeBytes = spec.SizeBytes
+	return nil
+}
+
+// The snapshots of a btrfs volume are read-only snapshots of the volume
+// subvolume.
+func (s *BtrfsStorage) snapshotDir(podId string, spec *apitypes.UserVolume) (string, error) {
+	if filepath.Dir(spec.Source) != s.volumesDir() {
+		return "", fmt.Errorf("volume %s is not created by %s", spec.Name, s.Type())
+	}
+	return filepath.Join(s.RootPath(), "snapshots", filepath.Base(spec.Source)), nil
+}
+
+func (s *BtrfsStorage) VolumeSnapshot(podId string, spec *apitypes.UserVolume, snapshot string) error {
+	dir, err := s.snapshotDir(podId, spec)
+	if err != nil {
+		return err
+	}
+	snap := filepath.Join(dir, snapshot)
+	if _, err = os.Stat(snap); err == nil {
+		return fmt.Errorf("snapshot %s already exists", snapshot)
+	}
+	return storage.SnapshotSubvolume(spec.Source, snap, true)
+}
+
+func (s *BtrfsStorage) VolumeSnapshotList(podId string, spec *apitypes.UserVolume) ([]string, error) {
+	dir, err := s.snapshotDir(podId, spec)
+	if err != nil {
+		return nil, err
+	}
+	return storage.ListSnapshots(dir)
+}
+
+func (s *BtrfsStorage) VolumeSnapshotRemove(podId string, spec *apitypes.UserVolume, snapshot string) error {
+	dir, err := s.snapshotDir(podId, spec)
+	if err != nil {
+		return err
+	}
+	return storage.DeleteSubvolume(filepath.Join(dir, snapshot))
+}
+
+func (s *BtrfsStorage) VolumeRestore(podId string, spec *apitypes.UserVolume, snapshot string) error {
+	dir, err := s.snapshotDir(podId, spec)
+	if err != nil {
+		return err
+	}
+	restored := spec.Source + ".restore"
+	if err = storage.SnapshotSubvolume(filepath.Join(dir, snapshot), restored, false); err != nil {
+		return err
+	}
+	if spec.SizeBytes > 0 {
+		err = storage.SetVFSVolumeQuota(restored, uint64(spec.SizeBytes))
+	}
+	if err == nil {
+		err = storage.DeleteSubvolume(spec.Source)
+	}
+	if err != nil {
+		storage.DeleteSubvolume(restored)
+		return err
+	}
+	return os.Rename(restored, spec.Source)
+}
+
+func (s *BtrfsStorage) VolumeClone(podId string, spec *apitypes.UserVolume, snapshot string, target *apitypes.UserVolume) error {
+	dir, err := s.snapshotDir(podId, spec)
+	if err != nil {
+		return err
+	}
+	volName := filepath.Join(s.volumesDir(), fmt.Sprintf("%s-%s", podId, target.Name))
+	if _, err = os.Stat(volName); err == nil {
+		return fmt.Errorf("volume %s already exists", target.Name)
+	}
+	if err = storage.SnapshotSubvolume(filepath.Join(dir, snapshot), volName, false); err != nil {
+		return err
+	}
+	if spec.SizeBytes > 0 {
+		if err = storage.SetVFSVolumeQuota(volName, uint64(spec.SizeBytes)); err != nil {
+			storage.DeleteSubvolume(volName)
+			return err
+		}
+	}
+
+	target.Source = volName
+	target.Format = "vfs"
+	target.Fstype = "dir"
+	target.SizeBytes = spec.SizeBytes
+	return nil
+}
+
+// The snapshots of a rawblock volume are reflinked copies of the block file.
+func (s *RawBlockStorage) snapshotDir(podId string, spec *apitypes.UserVolume) (string, error) {
+	if filepath.Dir(spec.Source) != s.volumesDir() {
+		return "", fmt.Errorf("volume %s is not created by %s", spec.Name, s.Type())
+	}
+	return filepath.Join(s.RootPath(), "snapshots", filepath.Base(spec.Source)), nil
+}
+
+func (s *RawBlockStorage) VolumeSnapshot(podId string, spec *apitypes.UserVolume, snapshot string) error {
+	dir, err := s.snapshotDir(podId, spec)
+	if err != nil {
+		return err
+	}
+	snap := filepath.Join(dir, snapshot)
+	if _, err = os.Stat(snap); err == nil {
+		return fmt.Errorf("snapshot %s already exists", snapshot)
+	}
+	return storage.ReflinkFile(spec.Source, snap)
+}
+
+func (s *RawBlockStorage) VolumeSnapshotList(podId string, spec *apitypes.UserVolume) ([]string, error) {
+	dir, err := s.snapshotDir(podId, spec)
+	if err != nil {
+		return nil, err
+	}
+	return storage.ListSnapshots(dir)
+}
+
+func (s *RawBlockStorage) VolumeSnapshotRemove(podId string, spec *apitypes.UserVolume, snapshot string) error {
+	dir, err := s.snapshotDir(podId, spec)
+	if err != nil {
+		return err
+	}
+	return os.Remove(filepath.Join(dir, snapshot))
+}
+
+func (s *RawBlockStorage) VolumeRestore(podId string, spec *apitypes.UserVolume, snapshot string) error {
+	dir, err := s.snapshotDir(podId, spec)
+	if err != nil {
+		return err
+	}
+	restored := spec.Source + ".restore"
+	if err = storage.ReflinkFile(filepath.Join(dir, snapshot), restored); err != nil {
+		os.Remove(restored)
+		return err
+	}
+	return os.Rename(restored, spec.Source)
+}
+
+func (s *RawBlockStorage) VolumeClone(podId string, spec *apitypes.UserVolume, snapshot string, target *apitypes.UserVolume) error {
+	dir, err := s.snapshotDir(podId, spec)
+	if err != nil {
+		return err
+	}
+	block := filepath.Join(s.volumesDir(), fmt.Sprintf("%s-%s", podId, target.Name))
+	if _, err = os.Stat(block); err == nil {
+		return fmt.Errorf("volume %s already exists", target.Name)
+	}
+	if err = storage.ReflinkFile(filepath.Join(dir, snapshot), block); err != nil {
+		os.Remove(block)
+		return err
+	}
+
+	target.Source = block
+	target.Format = "raw"
+	target.Fstype = spec.Fstype
+	target.SizeBytes = spec.SizeBytes
+	return nil
+}
@@ -0,0 +1,184 @@
+// Package storagetest is the conformance test suite of the hyperd storage
+// backends. An implementation of daemon.Storage could run the suite against a
+// tmpfs or a loop device with:
+//
+//	storagetest.Run(t, &storagetest.Fixture{Storage: s, SharedDir: dir, CreateMount: create})
+package storagetest
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"syscall"
+	"testing"
+
+	"github.com/hyperhq/hyperd/daemon"
+	apitypes "github.com/hyperhq/hyperd/types"
+)
+
+// Fixture is the environment of the storage under test.
+type Fixture struct {
+	Storage daemon.Storage
+	// SharedDir is the share dir of the sandbox, the container rootfs will
+	// be mounted under it.
+	SharedDir string
+	// CreateMount creates a writable container layer of the mount id, which
+	// is what docker graph driver does before a container is prepared.
+	CreateMount func(mountId string) error
+	// RemoveMount removes the layer created by CreateMount, optional.
+	RemoveMount func(mountId string) error
+	// ReadFile reads a file in the container layer to verify the injected
+	// files, optional.
+	ReadFile func(mountId, path string) ([]byte, error)
+}
+
+const (
+	testPodId   = "storagetest-pod"
+	testVolume  = "storagetest-vol"
+	testContent = "hello hyper storage\n"
+)
+
+// Run runs all the conformance tests of the Storage
+func Run(t *testing.T, f *Fixture) {
+	if f.Storage == nil || f.CreateMount == nil {
+		t.Fatal("storage and CreateMount are required by the conformance tests")
+	}
+	t.Run("PrepareContainer", func(t *testing.T) { CheckPrepareContainer(t, f, false) })
+	t.Run("PrepareContainerReadonly", func(t *testing.T) { CheckPrepareContainer(t, f, true) })
+	t.Run("InjectFile", func(t *testing.T) { CheckInjectFile(t, f) })
+	t.Run("Volume", func(t *testing.T) { CheckVolume(t, f) })
+}
+
+func (f *Fixture) newMount(t *testing.T, mountId string) func() {
+	if err := f.CreateMount(mountId); err != nil {
+		t.Fatalf("failed to create mount %s: %v", mountId, err)
+	}
+	return func() {
+		if f.RemoveMount == nil {
+			return
+		}
+		if err := f.RemoveMount(mountId); err != nil {
+			t.Errorf("failed to remove mount %s: %v", mountId, err)
+		}
+	}
+}
+
+// CheckPrepareContainer checks the rootfs description of a container
+func CheckPrepareContainer(t *testing.T, f *Fixture, readonly bool) {
+	mountId := fmt.Sprintf("storagetest-prepare-%v", readonly)
+	defer f.newMount(t, mountId)()
+
+	vol, err := f.Storage.PrepareContainer(mountId, f.SharedDir, readonly)
+	if err != nil {
+		t.Fatalf("%s: prepare container failed: %v", f.Storage.Type(), err)
+	}
+	if vol == nil {
+		t.Fatalf("%s: prepare container returned no rootfs", f.Storage.Type())
+	}
+	if vol.Source == "" || vol.Fstype == "" {
+		t.Errorf("%s: incomplete rootfs description %#v", f.Storage.Type(), vol)
+	}
+	if vol.ReadOnly != readonly {
+		t.Errorf("%s: rootfs readonly should be %v, got %v", f.Storage.Type(), readonly, vol.ReadOnly)
+	}
+
+	if err = f.Storage.CleanupContainer(mountId, f.SharedDir); err != nil {
+		t.Errorf("%s: cleanup container failed: %v", f.Storage.Type(), err)
+	}
+}
+
+// CheckInjectFile checks a file could be injected into the container layer
+func CheckInjectFile(t *testing.T, f *Fixture) {
+	mountId := "storagetest-inject"
+	target := "/etc/storagetest"
+	defer f.newMount(t, mountId)()
+
+	err := f.Storage.InjectFile(strings.NewReader(testContent), mountId, target, f.SharedDir, 0644, 0, 0)
+	if err != nil {
+		t.Fatalf("%s: inject file failed: %v", f.Storage.Type(), err)
+	}
+
+	if f.ReadFile == nil {
+		return
+	}
+	content, err := f.ReadFile(mountId, target)
+	if err != nil {
+		t.Fatalf("%s: failed to read the injected file: %v", f.Storage.Type(), err)
+	}
+	if string(content) != testContent {
+		t.Errorf("%s: injected file mismatch, expect %q, got %q", f.Storage.Type(), testContent, string(content))
+	}
+}
+
+// CheckVolume checks a volume could be created and then removed
+func CheckVolume(t *testing.T, f *Fixture) {
+	spec := &apitypes.UserVolume{
+		Name: testVolume,
+	}
+	if err := f.Storage.CreateVolume(testPodId, spec); err != nil {
+		t.Fatalf("%s: create volume failed: %v", f.Storage.Type(), err)
+	}
+	if spec.Source == "" || spec.Format == "" {
+		t.Errorf("%s: volume source and format should be filled, got %#v", f.Storage.Type(), spec)
+	}
+	if err := f.Storage.RemoveVolume(testPodId, []byte(spec.Name)); err != nil {
+		t.Errorf("%s: remove volume failed: %v", f.Storage.Type(), err)
+	}
+}
+
+// RequireRoot skips the test if it is not run by root
+func RequireRoot(t *testing.T) {
+	if os.Getuid() != 0 {
+		t.Skip("storage conformance tests require root privilege")
+	}
+}
+
+// MountTmpfs mounts a tmpfs for the test, the returned func unmounts it.
+func MountTmpfs(t *testing.T, size string) (string, func()) {
+	RequireRoot(t)
+	dir, err := ioutil.TempDir("", "storagetest-tmpfs-")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = syscall.Mount("tmpfs", dir, "tmpfs", 0, "size="+size); err != nil {
+		os.RemoveAll(dir)
+		t.Skipf("can not mount tmpfs: %v", err)
+	}
+	return dir, func() {
+		syscall.Unmount(dir, syscall.MNT_DETACH)
+		os.RemoveAll(dir)
+	}
+}
+
+// NewLoopDevice attaches a sparse file of size bytes to a loop device, the
+// returned func detaches the device and removes the file.
+func NewLoopDevice(t *testing.T, size int64) (string, func()) {
+	RequireRoot(t)
+	dir, err := ioutil.TempDir("", "storagetest-loop-")
+	if err != nil {
+		t.Fatal(err)
+	}
+	img := filepath.Join(dir, "disk.img")
+	f, err := os.Create(img)
+	if err == nil {
+		err = f.Truncate(size)
+		f.Close()
+	}
+	if err != nil {
+		os.RemoveAll(dir)
+		t.Fatal(err)
+	}
+	out, err := exec.Command("losetup", "-f", "--show", img).Output()
+	if err != nil {
+		os.RemoveAll(dir)
+		t.Skipf("can not setup loop device: %v", err)
+	}
+	dev := strings.TrimSpace(string(out))
+	return dev, func() {
+		exec.Command("losetup", "-d", dev).Run()
+		os.RemoveAll(dir)
+	}
+}
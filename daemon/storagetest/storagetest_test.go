@@ -0,0 +1,133 @@
+//go:build linux
+// +build linux
+
+package storagetest
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	dockertypes "github.com/docker/engine-api/types"
+	"github.com/hyperhq/hyperd/daemon"
+	"github.com/hyperhq/hyperd/storage"
+	"github.com/hyperhq/hyperd/utils"
+)
+
+var (
+	_ daemon.Storage = (*daemon.OverlayFsStorage)(nil)
+	_ daemon.Storage = (*daemon.AufsStorage)(nil)
+)
+
+// requireFs skips the test if the kernel does not support the filesystem
+func requireFs(t *testing.T, fs string) {
+	data, err := ioutil.ReadFile("/proc/filesystems")
+	if err != nil {
+		t.Skipf("can not read the supported filesystems: %v", err)
+	}
+	for _, line := range strings.Split(string(data), "\n") {
+		fields := strings.Fields(line)
+		if len(fields) > 0 && fields[len(fields)-1] == fs {
+			return
+		}
+	}
+	t.Skipf("%s is not supported by the kernel", fs)
+}
+
+// the vfs volumes are not removed by the aufs and overlay storages
+func removeVFSVolumes() {
+	os.RemoveAll(filepath.Join(storage.DEFAULT_VFS_VOL_ROOT, testPodId))
+}
+
+func TestOverlayFsStorage(t *testing.T) {
+	requireFs(t, "overlay")
+	root, cleanup := MountTmpfs(t, "16m")
+	defer cleanup()
+	defer removeVFSVolumes()
+
+	hyperRoot := utils.HYPER_ROOT
+	utils.HYPER_ROOT = root
+	s, err := daemon.OverlayFsFactory(nil, nil)
+	utils.HYPER_ROOT = hyperRoot
+	if err != nil {
+		t.Fatal(err)
+	}
+	layers := s.RootPath()
+
+	// the layout of the docker overlay graph driver, the container layer
+	// is on top of its init layer
+	Run(t, &Fixture{
+		Storage:   s,
+		SharedDir: filepath.Join(root, "shared"),
+		CreateMount: func(mountId string) error {
+			for _, dir := range []string{
+				filepath.Join(layers, mountId+"-init", "root"),
+				filepath.Join(layers, mountId, "upper"),
+				filepath.Join(layers, mountId, "work"),
+			} {
+				if err := os.MkdirAll(dir, 0755); err != nil {
+					return err
+				}
+			}
+			return ioutil.WriteFile(filepath.Join(layers, mountId, "lower-id"), []byte(mountId+"-init"), 0644)
+		},
+		RemoveMount: func(mountId string) error {
+			os.RemoveAll(filepath.Join(layers, mountId+"-init"))
+			return os.RemoveAll(filepath.Join(layers, mountId))
+		},
+		ReadFile: func(mountId, path string) ([]byte, error) {
+			return ioutil.ReadFile(filepath.Join(layers, mountId, "upper", path))
+		},
+	})
+}
+
+func TestAufsStorage(t *testing.T) {
+	requireFs(t, "aufs")
+	root, cleanup := MountTmpfs(t, "16m")
+	defer cleanup()
+	defer removeVFSVolumes()
+
+	layers := filepath.Join(root, "aufs")
+	s, err := daemon.AufsFactory(&dockertypes.Info{
+		DriverStatus: [][2]string{{"Root Dir", layers}},
+	}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// the layout of the docker aufs graph driver, the container layer is
+	// on top of its init layer
+	Run(t, &Fixture{
+		Storage:   s,
+		SharedDir: filepath.Join(root, "shared"),
+		CreateMount: func(mountId string) error {
+			for _, dir := range []string{
+				filepath.Join(layers, "diff", mountId+"-init"),
+				filepath.Join(layers, "diff", mountId),
+				filepath.Join(layers, "layers"),
+			} {
+				if err := os.MkdirAll(dir, 0755); err != nil {
+					return err
+				}
+			}
+			if err := ioutil.WriteFile(filepath.Join(layers, "layers", mountId+"-init"), nil, 0644); err != nil {
+				return err
+			}
+			return ioutil.WriteFile(filepath.Join(layers, "layers", mountId), []byte(mountId+"-init\n"), 0644)
+		},
+		RemoveMount: func(mountId string) error {
+			for _, id := range []string{mountId, mountId + "-init"} {
+				os.RemoveAll(filepath.Join(layers, "layers", id))
+				if err := os.RemoveAll(filepath.Join(layers, "diff", id)); err != nil {
+					return err
+				}
+			}
+			return nil
+		},
+		ReadFile: func(mountId, path string) ([]byte, error) {
+			return ioutil.ReadFile(filepath.Join(layers, "diff", mountId, path))
+		},
+	})
+}
@@ -0,0 +1,429 @@
+package daemon
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/golang/glog"
+	"github.com/golang/protobuf/proto"
+	"github.com/hyperhq/hyperd/daemon/pod"
+	"github.com/hyperhq/hyperd/errors"
+	apitypes "github.com/hyperhq/hyperd/types"
+	"github.com/hyperhq/hyperd/utils"
+)
+
+const (
+	// a pod references a named volume with a volume of this format, and the
+	// name of the named volume as the source.
+	NAMED_VOLUME_FORMAT = "named"
+
+	// the named volumes are created in the storage driver on behalf of
+	// this owner instead of a pod.
+	namedVolumeOwner = "named-volumes"
+)
+
+// namedVolumes keeps the references of the named volumes, which are not
+// persisted but rebuilt from the pods during restore.
+type namedVolumes struct {
+	// volume name -> the referencing pods
+	refs map[string]map[string]bool
+	sync.Mutex
+}
+
+func newNamedVolumes() *namedVolumes {
+	return &namedVolumes{
+		refs: make(map[string]map[string]bool),
+	}
+}
+
+// ref takes a reference of the pod on the volume, it returns false if the
+// pod has referenced it.
+func (nv *namedVolumes) ref(name, podId string) bool {
+	if _, ok := nv.refs[name]; !ok {
+		nv.refs[name] = make(map[string]bool)
+	}
+	if nv.refs[name][podId] {
+		return false
+	}
+	nv.refs[name][podId] = true
+	return true
+}
+
+// unref drops the reference of the pod on the volume, it returns false if
+// the pod did not reference it.
+func (nv *namedVolumes) unref(name, podId string) bool {
+	if !nv.refs[name][podId] {
+		return false
+	}
+	delete(nv.refs[name], podId)
+	if len(nv.refs[name]) == 0 {
+		delete(nv.refs, name)
+	}
+	return true
+}
+
+// others gets the pods other than podId which reference the volume
+func (nv *namedVolumes) others(name, podId string) []string {
+	pods := []string{}
+	for _, p := range nv.pods(name) {
+		if p != podId {
+			pods = append(pods, p)
+		}
+	}
+	return pods
+}
+
+// sync updates the references of the pod on the volumes, the pod references
+// a volume as long as its source is in mounted.
+func (nv *namedVolumes) sync(podId string, vols []*apitypes.VolumeInfo, mounted map[string]bool) {
+	for _, v := range vols {
+		if mounted[v.Source] {
+			nv.ref(v.Name, podId)
+		} else if nv.unref(v.Name, podId) {
+			glog.V(1).Infof("volume %s is not mounted by pod %s any more", v.Name, podId)
+		}
+	}
+}
+
+// namesOf gets the named volumes referenced by the pod
+func (nv *namedVolumes) namesOf(podId string) []string {
+	names := []string{}
+	for name, pods := range nv.refs {
+		if pods[podId] {
+			names = append(names, name)
+		}
+	}
+	return names
+}
+
+func (nv *namedVolumes) pods(name string) []string {
+	pods := make([]string, 0, len(nv.refs[name]))
+	for p := range nv.refs[name] {
+		pods = append(pods, p)
+	}
+	sort.Strings(pods)
+	return pods
+}
+
+func (daemon *Daemon) getNamedVolume(name string) (*apitypes.VolumeInfo, error) {
+	data, err := daemon.db.GetNamedVolume(name)
+	if err != nil {
+		return nil, fmt.Errorf("volume %s not found", name)
+	}
+	var info apitypes.VolumeInfo
+	if err = proto.Unmarshal(data, &info); err != nil {
+		glog.Errorf("failed to decode volume %s: %v", name, err)
+		return nil, err
+	}
+	return &info, nil
+}
+
+func (daemon *Daemon) CreateVolume(req *apitypes.VolumeCreateRequest) (*apitypes.VolumeInfo, error) {
+	if !utils.IsDNSLabel(req.Name) {
+		return nil, fmt.Errorf("volume name should fullfil the pattern: %s, input name: %s", utils.Dns1123LabelFmt, req.Name)
+	}
+	if req.SizeBytes < 0 {
+		return nil, fmt.Errorf("invalid volume size %d", req.SizeBytes)
+	}
+
+	daemon.volumes.Lock()
+	defer daemon.volumes.Unlock()
+
+	if _, err := daemon.db.GetNamedVolume(req.Name); err == nil {
+		return nil, fmt.Errorf("volume %s already exists", req.Name)
+	}
+
+	spec := &apitypes.UserVolume{
+		Name:      req.Name,
+		Fstype:    req.Fstype,
+		SizeBytes: req.SizeBytes,
+	}
+	var err error
+	if req.Fstype != "" && req.Fstype != "dir" && !isBlockVolumeStorage(daemon.Storage) {
+		err = fmt.Errorf("storage driver %s does not support fstype %s", daemon.Storage.Type(), req.Fstype)
+	} else {
+		err = daemon.Storage.CreateVolume(namedVolumeOwner, spec)
+	}
+	if err != nil {
+		glog.Errorf("failed to create volume %s: %v", req.Name, err)
+		return nil, err
+	}
+
+	info := &apitypes.VolumeInfo{
+		Name:      req.Name,
+		Driver:    daemon.Storage.Type(),
+		Source:    spec.Source,
+		Format:    spec.Format,
+		Fstype:    spec.Fstype,
+		SizeBytes: req.SizeBytes,
+		Labels:    req.Labels,
+		CreatedAt: time.Now().UTC().Unix(),
+	}
+	data, err := proto.Marshal(info)
+	if err == nil {
+		err = daemon.db.UpdateNamedVolume(req.Name, data)
+	}
+	if err != nil {
+		glog.Errorf("failed to save volume %s: %v", req.Name, err)
+		daemon.removeVolumeStorage(info)
+		return nil, err
+	}
+
+	glog.V(1).Infof("volume %s created by %s at %s", info.Name, info.Driver, info.Source)
+	return info, nil
+}
+
+func (daemon *Daemon) ListVolumes() ([]*apitypes.VolumeInfo, error) {
+	records, err := daemon.db.ListNamedVolumes()
+	if err != nil {
+		return nil, err
+	}
+
+	daemon.volumes.Lock()
+	defer daemon.volumes.Unlock()
+
+	result := make([]*apitypes.VolumeInfo, 0, len(records))
+	for _, data := range records {
+		var info apitypes.VolumeInfo
+		if err := proto.Unmarshal(data, &info); err != nil {
+			glog.Warningf("skip invalid volume record: %v", err)
+			continue
+		}
+		info.Pods = daemon.volumes.pods(info.Name)
+		result = append(result, &info)
+	}
+	return result, nil
+}
+
+func (daemon *Daemon) InspectVolume(name string) (*apitypes.VolumeInfo, error) {
+	daemon.volumes.Lock()
+	defer daemon.volumes.Unlock()
+
+	info, err := daemon.getNamedVolume(name)
+	if err != nil {
+		return nil, err
+	}
+	info.Pods = daemon.volumes.pods(name)
+	return info, nil
+}
+
+func (daemon *Daemon) RemoveVolume(name string) error {
+	daemon.volumes.Lock()
+	defer daemon.volumes.Unlock()
+
+	info, err := daemon.getNamedVolume(name)
+	if err != nil {
+		return err
+	}
+	if pods := daemon.volumes.pods(name); len(pods) > 0 {
+		return fmt.Errorf("volume %s is in use by pod(s) %s", name, strings.Join(pods, ", "))
+	}
+	if snaps, err := daemon.db.ListSnapshots(name); err == nil && len(snaps) > 0 {
+		return fmt.Errorf("volume %s has %d snapshot(s), remove them first", name, len(snaps))
+	}
+
+	if err = daemon.removeVolumeStorage(info); err != nil {
+		return err
+	}
+	delete(daemon.volumes.refs, name)
+	return daemon.db.DeleteNamedVolume(name)
+}
+
+// ResizeVolume grows a volume of the pod, the size of the named volume
+// backing it is updated as well.
+func (daemon *Daemon) ResizeVolume(req *apitypes.VolumeResizeRequest) error {
+	p, ok := daemon.PodList.Get(req.PodID)
+	if !ok {
+		return errors.ErrPodNotFound.WithArgs(req.PodID)
+	}
+
+	daemon.volumes.Lock()
+	defer daemon.volumes.Unlock()
+
+	if err := p.ResizeVolume(req.Volume, req.SizeBytes); err != nil {
+		return err
+	}
+
+	info, err := p.Info()
+	if err != nil {
+		return nil
+	}
+	for _, v := range info.Spec.Volumes {
+		if v.Name != req.Volume {
+			continue
+		}
+		for _, name := range daemon.volumes.namesOf(p.Id()) {
+			nv, err := daemon.getNamedVolume(name)
+			if err != nil || nv.Source != v.Source {
+				continue
+			}
+			nv.SizeBytes = req.SizeBytes
+			data, err := proto.Marshal(nv)
+			if err == nil {
+				err = daemon.db.UpdateNamedVolume(name, data)
+			}
+			if err != nil {
+				glog.Errorf("failed to save the size of volume %s: %v", name, err)
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func (daemon *Daemon) removeVolumeStorage(info *apitypes.VolumeInfo) error {
+	// the devicemapper driver finds the device by its name, the file based
+	// drivers find the volume by the base name, and the others ignore it.
+	if err := daemon.Storage.RemoveVolume(namedVolumeOwner, []byte(filepath.Base(info.Source))); err != nil {
+		glog.Errorf("failed to remove volume %s: %v", info.Name, err)
+		return err
+	}
+	// the directory and file based drivers leave the data on the host
+	if !strings.HasPrefix(info.Source, "/dev/") {
+		if err := os.RemoveAll(info.Source); err != nil {
+			glog.Errorf("failed to remove volume %s data %s: %v", info.Name, info.Source, err)
+			return err
+		}
+	}
+	return nil
+}
+
+// acquireNamedVolumes resolves the named volumes referenced by the volume
+// specs to the underlying volumes, and takes references on them for the pod.
+// It returns the volumes newly referenced by the pod.
+func (daemon *Daemon) acquireNamedVolumes(podId string, specs []*apitypes.UserVolume) ([]string, error) {
+	daemon.volumes.Lock()
+	defer daemon.volumes.Unlock()
+
+	resolved := make(map[*apitypes.UserVolume]*apitypes.VolumeInfo)
+	for _, v := range specs {
+		if v.Format != NAMED_VOLUME_FORMAT {
+			continue
+		}
+		info, err := daemon.getNamedVolume(v.Source)
+		if err != nil {
+			return nil, err
+		}
+		// the filesystem of a block volume is mounted read-write by the
+		// sandbox of each pod, it would be corrupted if shared.
+		if info.Format != "vfs" {
+			if pods := daemon.volumes.others(info.Name, podId); len(pods) > 0 {
+				return nil, fmt.Errorf("volume %s of format %s is in use by pod(s) %s, only the directory volumes could be shared", info.Name, info.Format, strings.Join(pods, ", "))
+			}
+		}
+		resolved[v] = info
+	}
+
+	acquired := []string{}
+	for v, info := range resolved {
+		v.Source = info.Source
+		v.Format = info.Format
+		v.Fstype = info.Fstype
+		v.SizeBytes = info.SizeBytes
+		if daemon.volumes.ref(info.Name, podId) {
+			acquired = append(acquired, info.Name)
+		}
+	}
+	return acquired, nil
+}
+
+// releaseNamedVolumes drops the references of the pod on the named volumes,
+// or on all the volumes if names is empty.
+func (daemon *Daemon) releaseNamedVolumes(podId string, names ...string) {
+	daemon.volumes.Lock()
+	defer daemon.volumes.Unlock()
+
+	if len(names) == 0 {
+		for name := range daemon.volumes.refs {
+			names = append(names, name)
+		}
+	}
+	for _, name := range names {
+		daemon.volumes.unref(name, podId)
+	}
+}
+
+// restoreVolumeRefs rebuilds the references of the named volumes from the
+// volumes of the loaded pods.
+func (daemon *Daemon) restoreVolumeRefs() error {
+	vols, err := daemon.ListVolumes()
+	if err != nil {
+		return err
+	}
+	sources := make(map[string]string, len(vols))
+	for _, v := range vols {
+		sources[v.Source] = v.Name
+	}
+
+	daemon.volumes.Lock()
+	defer daemon.volumes.Unlock()
+
+	return daemon.PodList.Foreach(func(p *pod.XPod) error {
+		info, err := p.Info()
+		if err != nil {
+			return nil
+		}
+		for source := range mountedVolumeSources(info) {
+			if name, ok := sources[source]; ok {
+				daemon.volumes.ref(name, p.Id())
+			}
+		}
+		return nil
+	})
+}
+
+// syncNamedVolumeRefs updates the references of the pod after its containers
+// changed, a named volume is referenced by the pod as long as it is mounted
+// by a container of the pod.
+func (daemon *Daemon) syncNamedVolumeRefs(p *pod.XPod) {
+	info, err := p.Info()
+	if err != nil {
+		return
+	}
+	vols, err := daemon.ListVolumes()
+	if err != nil {
+		glog.Warningf("failed to update the named volumes of pod %s: %v", p.Id(), err)
+		return
+	}
+
+	daemon.volumes.Lock()
+	defer daemon.volumes.Unlock()
+	daemon.volumes.sync(p.Id(), vols, mountedVolumeSources(info))
+}
+
+// mountedVolumeSources gets the sources of the pod volumes which are mounted
+// by the containers.
+func mountedVolumeSources(info *apitypes.PodInfo) map[string]bool {
+	mounted := make(map[string]bool)
+	for _, c := range info.Spec.Containers {
+		for _, m := range c.VolumeMounts {
+			mounted[m.Name] = true
+		}
+	}
+	sources := make(map[string]bool)
+	for _, v := range info.Spec.Volumes {
+		if mounted[v.Name] {
+			sources[v.Source] = true
+		}
+	}
+	return sources
+}
+
+// namedVolumeSpecs collects the volume specs of the pod and the containers,
+// which may reference named volumes.
+func namedVolumeSpecs(vols []*apitypes.UserVolume, containers []*apitypes.UserContainer) []*apitypes.UserVolume {
+	specs := append([]*apitypes.UserVolume{}, vols...)
+	for _, c := range containers {
+		for _, ref := range c.Volumes {
+			if ref.Detail != nil {
+				specs = append(specs, ref.Detail)
+			}
+		}
+	}
+	return specs
+}
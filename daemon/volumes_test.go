@@ -0,0 +1,164 @@
+package daemon
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/hyperhq/hyperd/daemon/daemondb"
+	apitypes "github.com/hyperhq/hyperd/types"
+)
+
+func TestNamedVolumesRef(t *testing.T) {
+	nv := newNamedVolumes()
+
+	if !nv.ref("vol", "pod1") {
+		t.Fatal("first reference of pod1 is not taken")
+	}
+	if nv.ref("vol", "pod1") {
+		t.Fatal("second reference of pod1 is taken")
+	}
+	if !nv.ref("vol", "pod2") {
+		t.Fatal("reference of pod2 is not taken")
+	}
+	if pods := nv.pods("vol"); !reflect.DeepEqual(pods, []string{"pod1", "pod2"}) {
+		t.Fatalf("unexpected pods %v", pods)
+	}
+	if pods := nv.others("vol", "pod1"); !reflect.DeepEqual(pods, []string{"pod2"}) {
+		t.Fatalf("unexpected other pods %v", pods)
+	}
+	if names := nv.namesOf("pod2"); !reflect.DeepEqual(names, []string{"vol"}) {
+		t.Fatalf("unexpected names %v", names)
+	}
+
+	if !nv.unref("vol", "pod1") {
+		t.Fatal("reference of pod1 is not dropped")
+	}
+	if nv.unref("vol", "pod1") {
+		t.Fatal("reference of pod1 is dropped twice")
+	}
+	if nv.unref("none", "pod1") {
+		t.Fatal("reference on unknown volume is dropped")
+	}
+	if !nv.unref("vol", "pod2") {
+		t.Fatal("reference of pod2 is not dropped")
+	}
+	if _, ok := nv.refs["vol"]; ok {
+		t.Fatal("unreferenced volume is kept")
+	}
+}
+
+func TestNamedVolumesSync(t *testing.T) {
+	nv := newNamedVolumes()
+	nv.ref("kept", "pod")
+	nv.ref("released", "pod")
+	nv.ref("released", "other")
+
+	vols := []*apitypes.VolumeInfo{
+		{Name: "kept", Source: "/vol/kept"},
+		{Name: "released", Source: "/vol/released"},
+		{Name: "added", Source: "/vol/added"},
+		{Name: "unused", Source: "/vol/unused"},
+	}
+	info := &apitypes.PodInfo{
+		Spec: &apitypes.PodSpec{
+			Volumes: []*apitypes.PodVolume{
+				{Name: "a", Source: "/vol/kept"},
+				{Name: "b", Source: "/vol/released"},
+				{Name: "c", Source: "/vol/added"},
+			},
+			Containers: []*apitypes.Container{
+				{VolumeMounts: []*apitypes.VolumeMount{{Name: "a"}, {Name: "c"}}},
+			},
+		},
+	}
+	nv.sync("pod", vols, mountedVolumeSources(info))
+
+	if names := nv.namesOf("pod"); len(names) != 2 || !nv.refs["kept"]["pod"] || !nv.refs["added"]["pod"] {
+		t.Fatalf("unexpected volumes of pod %v", names)
+	}
+	if pods := nv.pods("released"); !reflect.DeepEqual(pods, []string{"other"}) {
+		t.Fatalf("unexpected pods of released volume %v", pods)
+	}
+	if pods := nv.pods("unused"); len(pods) != 0 {
+		t.Fatalf("unexpected pods of unused volume %v", pods)
+	}
+}
+
+func TestAcquireNamedVolumes(t *testing.T) {
+	tmp, err := ioutil.TempDir("", "volumes")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(tmp)
+
+	db, err := daemondb.NewDaemonDB(filepath.Join(tmp, "db"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+
+	daemon := &Daemon{db: db, volumes: newNamedVolumes()}
+	for _, info := range []*apitypes.VolumeInfo{
+		{Name: "dir", Source: "/vol/dir", Format: "vfs", Fstype: "dir"},
+		{Name: "block", Source: "/dev/mapper/block", Format: "raw", Fstype: "ext4", SizeBytes: 1 << 30},
+	} {
+		data, err := proto.Marshal(info)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err = db.UpdateNamedVolume(info.Name, data); err != nil {
+			t.Fatal(err)
+		}
+	}
+	named := func(names ...string) []*apitypes.UserVolume {
+		specs := []*apitypes.UserVolume{{Name: "local", Source: "/local", Format: "vfs"}}
+		for _, n := range names {
+			specs = append(specs, &apitypes.UserVolume{Name: n, Source: n, Format: NAMED_VOLUME_FORMAT})
+		}
+		return specs
+	}
+
+	specs := named("dir", "block")
+	acquired, err := daemon.acquireNamedVolumes("pod1", specs)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(acquired) != 2 {
+		t.Fatalf("unexpected acquired volumes %v", acquired)
+	}
+	if specs[2].Source != "/dev/mapper/block" || specs[2].Format != "raw" || specs[2].Fstype != "ext4" || specs[2].SizeBytes != 1<<30 {
+		t.Fatalf("block volume is not resolved: %v", specs[2])
+	}
+	if specs[0].Source != "/local" {
+		t.Fatalf("local volume is changed: %v", specs[0])
+	}
+
+	// the pod may reference its block volume again, e.g. from a new container
+	if acquired, err = daemon.acquireNamedVolumes("pod1", named("block")); err != nil || len(acquired) != 0 {
+		t.Fatalf("reacquire by the same pod: %v, %v", acquired, err)
+	}
+
+	// a directory volume is shared, a block volume is not
+	if _, err = daemon.acquireNamedVolumes("pod2", named("dir", "block")); err == nil {
+		t.Fatal("block volume is shared by two pods")
+	}
+	if pods := daemon.volumes.pods("dir"); !reflect.DeepEqual(pods, []string{"pod1"}) {
+		t.Fatalf("failed acquire leaves references: %v", pods)
+	}
+	if acquired, err = daemon.acquireNamedVolumes("pod2", named("dir")); err != nil || len(acquired) != 1 {
+		t.Fatalf("share directory volume: %v, %v", acquired, err)
+	}
+
+	if _, err = daemon.acquireNamedVolumes("pod2", named("none")); err == nil {
+		t.Fatal("unknown volume is acquired")
+	}
+
+	daemon.releaseNamedVolumes("pod1")
+	if acquired, err = daemon.acquireNamedVolumes("pod2", named("block")); err != nil || len(acquired) != 1 {
+		t.Fatalf("acquire released block volume: %v, %v", acquired, err)
+	}
+}
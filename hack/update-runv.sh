@@ -1,6 +1,25 @@
 #!/bin/bash
+#
+# Update the vendored runv with govendor, and then apply the patches in
+# hack/runv-patches which are not in the upstream runv yet. The update fails
+# if any of the patches does not apply, the patch should be dropped if it has
+# been merged upstream, or rebased on the new revision otherwise.
+#
 
-uv=$(dirname $0)/update-govendor.sh
+set -o errexit
+set -o nounset
+set -o pipefail
 
-exec $uv github.com/hyperhq/runv/...
+root=$(cd $(dirname $0)/.. && pwd)
+uv=$root/hack/update-govendor.sh
 
+$uv github.com/hyperhq/runv/...
+
+cd $root/vendor/github.com/hyperhq/runv
+for p in $root/hack/runv-patches/*.patch; do
+	echo "applying $(basename $p)"
+	if ! patch -p1 --forward --no-backup-if-mismatch < $p; then
+		echo "failed to apply $p on the vendored runv" 1>&2
+		exit 1
+	fi
+done
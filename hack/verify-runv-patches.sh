@@ -0,0 +1,31 @@
+#!/bin/bash
+#
+# Verify the vendored runv is the revision in vendor/vendor.json with the
+# patches in hack/runv-patches applied.
+#
+
+set -o errexit
+set -o nounset
+set -o pipefail
+
+root=$(cd $(dirname $0)/.. && pwd)
+rev=$(grep -A2 '"path": "github.com/hyperhq/runv/hypervisor"' $root/vendor/vendor.json | sed -n 's/.*"revision": "\(.*\)".*/\1/p')
+tmp=$(mktemp -d)
+trap "rm -rf $tmp" EXIT
+
+git clone -q https://github.com/hyperhq/runv.git $tmp/runv
+git -C $tmp/runv checkout -q $rev
+for p in $root/hack/runv-patches/*.patch; do
+	patch -d $tmp/runv -p1 -s --forward --no-backup-if-mismatch < $p
+done
+
+# only the packages vendored by govendor are compared
+cd $root/vendor/github.com/hyperhq/runv
+bad=0
+for f in $(find . -type f); do
+	if ! cmp -s $f $tmp/runv/$f; then
+		echo "!!! vendored runv file $f differs from the patched revision $rev"
+		bad=1
+	fi
+done
+exit $bad
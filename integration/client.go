@@ -386,6 +386,7 @@ func (c *HyperClient) ContainerExecStart(containerId, execId string, stdin io.Re
 	request := types.ExecStartRequest{
 		ContainerID: containerId,
 		ExecID:      execId,
+		RawStreams:  true,
 	}
 	stream, err := c.client.ExecStart(context.Background())
 	if err != nil {
@@ -394,38 +395,28 @@ func (c *HyperClient) ContainerExecStart(containerId, execId string, stdin io.Re
 	if err := stream.Send(&request); err != nil {
 		return err
 	}
-	extractor := NewExtractor(tty)
+	if stderr == nil {
+		stderr = stdout
+	}
 	var recvStdoutError chan error
-	if stdout != nil || stderr != nil {
+	if stdout != nil {
 		recvStdoutError = promise.Go(func() (err error) {
 			for {
 				in, err := stream.Recv()
 				if err != nil && err != io.EOF {
 					return err
 				}
-				if in != nil && in.Stdout != nil {
-					so, se, ee := extractor.Extract(in.Stdout)
-					if ee != nil {
-						return ee
+				if in != nil && len(in.Stdout) > 0 {
+					w := stdout
+					if in.Stream == types.ExecStartResponse_STDERR {
+						w = stderr
 					}
-					if len(so) > 0 {
-						nw, ew := stdout.Write(so)
-						if ew != nil {
-							return ew
-						}
-						if nw != len(so) {
-							return io.ErrShortWrite
-						}
+					nw, ew := w.Write(in.Stdout)
+					if ew != nil {
+						return ew
 					}
-					if len(se) > 0 {
-						nw, ew := stdout.Write(se)
-						if ew != nil {
-							return ew
-						}
-						if nw != len(se) {
-							return io.ErrShortWrite
-						}
-
+					if nw != len(in.Stdout) {
+						return io.ErrShortWrite
 					}
 				}
 				if err == io.EOF {
@@ -456,7 +447,7 @@ func (c *HyperClient) ContainerExecStart(containerId, execId string, stdin io.Re
 			return nil
 		}()
 	}
-	if stdout != nil || stderr != nil {
+	if stdout != nil {
 		if err := <-recvStdoutError; err != nil {
 			return err
 		}
@@ -0,0 +1,337 @@
+// Package metrics implements the metric types needed by hyperd and writes
+// them in the prometheus text exposition format (version 0.0.4).
+//
+// Only counters, gauges and histograms are supported. The values which are
+// known only at scrape time are reported by a Collector, which builds the
+// families on each Gather().
+package metrics
+
+import (
+	"fmt"
+	"io"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+const ContentType = "text/plain; version=0.0.4"
+
+type MetricType string
+
+const (
+	Counter   MetricType = "counter"
+	Gauge     MetricType = "gauge"
+	Histogram MetricType = "histogram"
+)
+
+// DefBuckets are the default histogram buckets, in seconds.
+var DefBuckets = []float64{.005, .01, .025, .05, .1, .25, .5, 1, 2.5, 5, 10, 30, 60}
+
+// Sample is a single value of a family, suffix is appended to the name of
+// the family, e.g. "_bucket" of histograms.
+type Sample struct {
+	Suffix      string
+	LabelNames  []string
+	LabelValues []string
+	Value       float64
+}
+
+// Family is a set of samples with the same metric name.
+type Family struct {
+	Name    string
+	Help    string
+	Type    MetricType
+	Samples []Sample
+}
+
+func NewFamily(name, help string, typ MetricType) *Family {
+	return &Family{Name: name, Help: help, Type: typ}
+}
+
+// Add appends a sample of value to the family, labels are name/value pairs.
+func (f *Family) Add(value float64, labels ...string) {
+	s := Sample{Value: value}
+	for i := 0; i+1 < len(labels); i += 2 {
+		s.LabelNames = append(s.LabelNames, labels[i])
+		s.LabelValues = append(s.LabelValues, labels[i+1])
+	}
+	f.Samples = append(f.Samples, s)
+}
+
+// Collector reports a group of families.
+type Collector interface {
+	Collect() []*Family
+}
+
+// CollectorFunc adapts a function to a Collector.
+type CollectorFunc func() []*Family
+
+func (f CollectorFunc) Collect() []*Family {
+	return f()
+}
+
+// Registry is a set of collectors.
+type Registry struct {
+	mu         sync.RWMutex
+	collectors []Collector
+}
+
+var DefaultRegistry = &Registry{}
+
+func (r *Registry) Register(c Collector) {
+	r.mu.Lock()
+	r.collectors = append(r.collectors, c)
+	r.mu.Unlock()
+}
+
+// Gather collects the families of all the collectors, the families are
+// sorted by name.
+func (r *Registry) Gather() []*Family {
+	r.mu.RLock()
+	collectors := make([]Collector, len(r.collectors))
+	copy(collectors, r.collectors)
+	r.mu.RUnlock()
+
+	var families []*Family
+	for _, c := range collectors {
+		families = append(families, c.Collect()...)
+	}
+	sort.Stable(byName(families))
+	return families
+}
+
+// Register registers c to the DefaultRegistry.
+func Register(c Collector) {
+	DefaultRegistry.Register(c)
+}
+
+type byName []*Family
+
+func (f byName) Len() int           { return len(f) }
+func (f byName) Less(i, j int) bool { return f[i].Name < f[j].Name }
+func (f byName) Swap(i, j int)      { f[i], f[j] = f[j], f[i] }
+
+// WriteText writes the families to w in the text exposition format.
+func WriteText(w io.Writer, families []*Family) error {
+	for _, f := range families {
+		if len(f.Samples) == 0 {
+			continue
+		}
+		if _, err := fmt.Fprintf(w, "# HELP %s %s\n# TYPE %s %s\n", f.Name, escape(f.Help, false), f.Name, f.Type); err != nil {
+			return err
+		}
+		for _, s := range f.Samples {
+			if _, err := fmt.Fprintf(w, "%s%s%s %s\n", f.Name, s.Suffix, formatLabels(s.LabelNames, s.LabelValues), formatValue(s.Value)); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func formatLabels(names, values []string) string {
+	if len(names) == 0 {
+		return ""
+	}
+	pairs := make([]string, 0, len(names))
+	for i, n := range names {
+		pairs = append(pairs, fmt.Sprintf("%s=\"%s\"", n, escape(values[i], true)))
+	}
+	return "{" + strings.Join(pairs, ",") + "}"
+}
+
+func formatValue(v float64) string {
+	switch {
+	case math.IsInf(v, 1):
+		return "+Inf"
+	case math.IsInf(v, -1):
+		return "-Inf"
+	case math.IsNaN(v):
+		return "NaN"
+	}
+	return strconv.FormatFloat(v, 'g', -1, 64)
+}
+
+func escape(s string, quote bool) string {
+	s = strings.Replace(s, `\`, `\\`, -1)
+	s = strings.Replace(s, "\n", `\n`, -1)
+	if quote {
+		s = strings.Replace(s, `"`, `\"`, -1)
+	}
+	return s
+}
+
+// SanitizeLabelName converts s, e.g. the key of a pod label, to a valid
+// label name.
+func SanitizeLabelName(s string) string {
+	b := []byte(s)
+	for i, c := range b {
+		if !(c == '_' || c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || c >= '0' && c <= '9' && i > 0) {
+			b[i] = '_'
+		}
+	}
+	return string(b)
+}
+
+type vecEntry struct {
+	labelValues []string
+	value       float64
+	counts      []uint64
+	count       uint64
+}
+
+type vec struct {
+	mu         sync.Mutex
+	name       string
+	help       string
+	labelNames []string
+	entries    map[string]*vecEntry
+}
+
+func (v *vec) entry(labelValues []string) *vecEntry {
+	if len(labelValues) != len(v.labelNames) {
+		panic(fmt.Sprintf("metric %s expects %d label values, got %d", v.name, len(v.labelNames), len(labelValues)))
+	}
+	key := strings.Join(labelValues, "\xff")
+	e, ok := v.entries[key]
+	if !ok {
+		e = &vecEntry{labelValues: append([]string{}, labelValues...)}
+		v.entries[key] = e
+	}
+	return e
+}
+
+func (v *vec) sortedEntries() []*vecEntry {
+	keys := make([]string, 0, len(v.entries))
+	for k := range v.entries {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	entries := make([]*vecEntry, 0, len(keys))
+	for _, k := range keys {
+		entries = append(entries, v.entries[k])
+	}
+	return entries
+}
+
+// CounterVec is a counter partitioned by the label values.
+type CounterVec struct {
+	vec
+}
+
+func NewCounterVec(name, help string, labelNames ...string) *CounterVec {
+	return &CounterVec{vec{name: name, help: help, labelNames: labelNames, entries: map[string]*vecEntry{}}}
+}
+
+func (c *CounterVec) Inc(labelValues ...string) {
+	c.Add(1, labelValues...)
+}
+
+func (c *CounterVec) Add(delta float64, labelValues ...string) {
+	if delta < 0 {
+		panic(fmt.Sprintf("counter %s cannot decrease", c.name))
+	}
+	c.mu.Lock()
+	c.entry(labelValues).value += delta
+	c.mu.Unlock()
+}
+
+func (c *CounterVec) Collect() []*Family {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	f := NewFamily(c.name, c.help, Counter)
+	for _, e := range c.sortedEntries() {
+		f.Samples = append(f.Samples, Sample{LabelNames: c.labelNames, LabelValues: e.labelValues, Value: e.value})
+	}
+	return []*Family{f}
+}
+
+// GaugeVec is a gauge partitioned by the label values.
+type GaugeVec struct {
+	vec
+}
+
+func NewGaugeVec(name, help string, labelNames ...string) *GaugeVec {
+	return &GaugeVec{vec{name: name, help: help, labelNames: labelNames, entries: map[string]*vecEntry{}}}
+}
+
+func (g *GaugeVec) Set(value float64, labelValues ...string) {
+	g.mu.Lock()
+	g.entry(labelValues).value = value
+	g.mu.Unlock()
+}
+
+func (g *GaugeVec) Add(delta float64, labelValues ...string) {
+	g.mu.Lock()
+	g.entry(labelValues).value += delta
+	g.mu.Unlock()
+}
+
+func (g *GaugeVec) Collect() []*Family {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	f := NewFamily(g.name, g.help, Gauge)
+	for _, e := range g.sortedEntries() {
+		f.Samples = append(f.Samples, Sample{LabelNames: g.labelNames, LabelValues: e.labelValues, Value: e.value})
+	}
+	return []*Family{f}
+}
+
+// HistogramVec is a histogram partitioned by the label values.
+type HistogramVec struct {
+	vec
+	buckets []float64
+}
+
+func NewHistogramVec(name, help string, buckets []float64, labelNames ...string) *HistogramVec {
+	if buckets == nil {
+		buckets = DefBuckets
+	}
+	b := append([]float64{}, buckets...)
+	sort.Float64s(b)
+	return &HistogramVec{
+		vec:     vec{name: name, help: help, labelNames: labelNames, entries: map[string]*vecEntry{}},
+		buckets: b,
+	}
+}
+
+func (h *HistogramVec) Observe(value float64, labelValues ...string) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	e := h.entry(labelValues)
+	if e.counts == nil {
+		e.counts = make([]uint64, len(h.buckets))
+	}
+	for i, b := range h.buckets {
+		if value <= b {
+			e.counts[i]++
+		}
+	}
+	e.count++
+	e.value += value
+}
+
+func (h *HistogramVec) Collect() []*Family {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	f := NewFamily(h.name, h.help, Histogram)
+	leNames := append(append([]string{}, h.labelNames...), "le")
+	for _, e := range h.sortedEntries() {
+		for i, b := range h.buckets {
+			f.Samples = append(f.Samples, Sample{
+				Suffix:      "_bucket",
+				LabelNames:  leNames,
+				LabelValues: append(append([]string{}, e.labelValues...), formatValue(b)),
+				Value:       float64(e.counts[i]),
+			})
+		}
+		f.Samples = append(f.Samples,
+			Sample{Suffix: "_bucket", LabelNames: leNames, LabelValues: append(append([]string{}, e.labelValues...), "+Inf"), Value: float64(e.count)},
+			Sample{Suffix: "_sum", LabelNames: h.labelNames, LabelValues: e.labelValues, Value: e.value},
+			Sample{Suffix: "_count", LabelNames: h.labelNames, LabelValues: e.labelValues, Value: float64(e.count)},
+		)
+	}
+	return []*Family{f}
+}
@@ -0,0 +1,63 @@
+package metrics
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestWriteText(t *testing.T) {
+	r := &Registry{}
+
+	c := NewCounterVec("test_requests_total", "Requests.", "route")
+	c.Inc("/a")
+	c.Add(2, "/a")
+	c.Inc("/b\"")
+	r.Register(c)
+
+	h := NewHistogramVec("test_latency_seconds", "Latency.", []float64{1, 0.5})
+	h.Observe(0.3)
+	h.Observe(0.7)
+	r.Register(h)
+
+	r.Register(CollectorFunc(func() []*Family {
+		f := NewFamily("test_pods", "Pods\nby state.", Gauge)
+		f.Add(3, "state", "running")
+		return []*Family{f, NewFamily("test_empty", "Empty.", Gauge)}
+	}))
+
+	buf := &bytes.Buffer{}
+	if err := WriteText(buf, r.Gather()); err != nil {
+		t.Fatal(err)
+	}
+	expect := `# HELP test_latency_seconds Latency.
+# TYPE test_latency_seconds histogram
+test_latency_seconds_bucket{le="0.5"} 1
+test_latency_seconds_bucket{le="1"} 2
+test_latency_seconds_bucket{le="+Inf"} 2
+test_latency_seconds_sum 1
+test_latency_seconds_count 2
+# HELP test_pods Pods\nby state.
+# TYPE test_pods gauge
+test_pods{state="running"} 3
+# HELP test_requests_total Requests.
+# TYPE test_requests_total counter
+test_requests_total{route="/a"} 3
+test_requests_total{route="/b\""} 1
+`
+	if buf.String() != expect {
+		t.Errorf("unexpected output:\n%s\nexpect:\n%s", buf.String(), expect)
+	}
+}
+
+func TestSanitizeLabelName(t *testing.T) {
+	for s, expect := range map[string]string{
+		"app":             "app",
+		"k8s.io/name":     "k8s_io_name",
+		"0day":            "_day",
+		"tier-frontend_1": "tier_frontend_1",
+	} {
+		if n := SanitizeLabelName(s); n != expect {
+			t.Errorf("sanitized %q to %q, expect %q", s, n, expect)
+		}
+	}
+}
@@ -0,0 +1,184 @@
+// Package selector implements the kubernetes style label selectors, i.e.
+//
+//	app=web,tier!=db,env in (prod, qa),release notin (canary),owner,!deprecated
+//
+// A selector is a comma separated list of requirements, all of which should
+// be satisfied by the labels to match.
+package selector
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+type Operator string
+
+const (
+	Equals       Operator = "="
+	NotEquals    Operator = "!="
+	In           Operator = "in"
+	NotIn        Operator = "notin"
+	Exists       Operator = "exists"
+	DoesNotExist Operator = "!"
+)
+
+// Requirement is a single condition of a selector on the label of Key
+type Requirement struct {
+	Key      string
+	Operator Operator
+	Values   []string
+}
+
+// Selector is the conjunction of the requirements, an empty selector
+// matches everything.
+type Selector []Requirement
+
+var (
+	setRequirement = regexp.MustCompile(`^(\S+)\s+(in|notin)\s*\((.*)\)$`)
+	labelToken     = regexp.MustCompile(`^[A-Za-z0-9]([-A-Za-z0-9_./]*[A-Za-z0-9])?$`)
+)
+
+// Parse parses the selector string s
+func Parse(s string) (Selector, error) {
+	var sel Selector
+	for _, r := range split(s) {
+		r = strings.TrimSpace(r)
+		if r == "" {
+			continue
+		}
+		req, err := parseRequirement(r)
+		if err != nil {
+			return nil, err
+		}
+		sel = append(sel, req)
+	}
+	return sel, nil
+}
+
+// split splits the requirements by the commas outside the parentheses
+func split(s string) []string {
+	var (
+		result = []string{}
+		depth  = 0
+		start  = 0
+	)
+	for i, c := range s {
+		switch c {
+		case '(':
+			depth++
+		case ')':
+			depth--
+		case ',':
+			if depth == 0 {
+				result = append(result, s[start:i])
+				start = i + 1
+			}
+		}
+	}
+	return append(result, s[start:])
+}
+
+func parseRequirement(r string) (Requirement, error) {
+	var req Requirement
+
+	if m := setRequirement.FindStringSubmatch(r); m != nil {
+		req.Key, req.Operator = m[1], Operator(m[2])
+		for _, v := range strings.Split(m[3], ",") {
+			v = strings.TrimSpace(v)
+			if v == "" {
+				continue
+			}
+			req.Values = append(req.Values, v)
+		}
+		if len(req.Values) == 0 {
+			return req, fmt.Errorf("no value in the set of requirement %q", r)
+		}
+		sort.Strings(req.Values)
+	} else if i := strings.Index(r, "!="); i >= 0 {
+		req.Key, req.Operator, req.Values = r[:i], NotEquals, []string{r[i+2:]}
+	} else if i := strings.Index(r, "=="); i >= 0 {
+		req.Key, req.Operator, req.Values = r[:i], Equals, []string{r[i+2:]}
+	} else if i := strings.Index(r, "="); i >= 0 {
+		req.Key, req.Operator, req.Values = r[:i], Equals, []string{r[i+1:]}
+	} else if strings.HasPrefix(r, "!") {
+		req.Key, req.Operator = r[1:], DoesNotExist
+	} else {
+		req.Key, req.Operator = r, Exists
+	}
+
+	req.Key = strings.TrimSpace(req.Key)
+	if !labelToken.MatchString(req.Key) {
+		return req, fmt.Errorf("invalid label key %q in requirement %q", req.Key, r)
+	}
+	for i, v := range req.Values {
+		v = strings.TrimSpace(v)
+		// an empty value is valid for the equality
+		if v != "" && !labelToken.MatchString(v) {
+			return req, fmt.Errorf("invalid label value %q in requirement %q", v, r)
+		}
+		req.Values[i] = v
+	}
+	return req, nil
+}
+
+func (r *Requirement) hasValue(v string) bool {
+	for _, value := range r.Values {
+		if value == v {
+			return true
+		}
+	}
+	return false
+}
+
+// Matches returns whether the labels satisfy the requirement
+func (r *Requirement) Matches(labels map[string]string) bool {
+	v, ok := labels[r.Key]
+	switch r.Operator {
+	case Equals, In:
+		return ok && r.hasValue(v)
+	case NotEquals, NotIn:
+		return !ok || !r.hasValue(v)
+	case Exists:
+		return ok
+	case DoesNotExist:
+		return !ok
+	}
+	return false
+}
+
+// Matches returns whether the labels satisfy all the requirements
+func (s Selector) Matches(labels map[string]string) bool {
+	for i := range s {
+		if !s[i].Matches(labels) {
+			return false
+		}
+	}
+	return true
+}
+
+// Empty returns whether the selector matches everything
+func (s Selector) Empty() bool {
+	return len(s) == 0
+}
+
+func (r *Requirement) String() string {
+	switch r.Operator {
+	case Exists:
+		return r.Key
+	case DoesNotExist:
+		return "!" + r.Key
+	case In, NotIn:
+		return fmt.Sprintf("%s %s (%s)", r.Key, r.Operator, strings.Join(r.Values, ","))
+	}
+	return r.Key + string(r.Operator) + r.Values[0]
+}
+
+func (s Selector) String() string {
+	reqs := make([]string, 0, len(s))
+	for i := range s {
+		reqs = append(reqs, s[i].String())
+	}
+	return strings.Join(reqs, ",")
+}
@@ -0,0 +1,51 @@
+package selector
+
+import (
+	"testing"
+)
+
+func TestSelectorMatches(t *testing.T) {
+	labels := map[string]string{
+		"app":  "web",
+		"env":  "prod",
+		"tier": "frontend",
+	}
+	cases := []struct {
+		selector string
+		match    bool
+	}{
+		{"", true},
+		{"app=web", true},
+		{"app==web", true},
+		{"app=db", false},
+		{"app!=db", true},
+		{"missing!=db", true},
+		{"env in (prod, qa)", true},
+		{"env in (qa)", false},
+		{"env notin (qa,dev)", true},
+		{"missing notin (qa)", true},
+		{"tier", true},
+		{"!tier", false},
+		{"!missing", true},
+		{"app=web,env in (prod,qa),!missing", true},
+		{"app=web, env in (qa,dev)", false},
+	}
+	for _, c := range cases {
+		sel, err := Parse(c.selector)
+		if err != nil {
+			t.Errorf("failed to parse %q: %v", c.selector, err)
+			continue
+		}
+		if m := sel.Matches(labels); m != c.match {
+			t.Errorf("selector %q matches %v, expect %v", c.selector, m, c.match)
+		}
+	}
+}
+
+func TestSelectorParseError(t *testing.T) {
+	for _, s := range []string{"=web", "app in ()", "a b=c", "app=we b", "!"} {
+		if _, err := Parse(s); err == nil {
+			t.Errorf("selector %q should be invalid", s)
+		}
+	}
+}
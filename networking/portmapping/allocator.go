@@ -0,0 +1,161 @@
+package portmapping
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/hyperhq/hypercontainer-utils/hlog"
+)
+
+var (
+	// hostPortRange is where the host ports are allocated from, if the host
+	// port of a mapping is not specified
+	hostPortRange = PortRange{Begin: 49153, End: 65535}
+	allocMutex    sync.Mutex
+	// the next port to try of each protocol, the ports are allocated
+	// round-robin so that a released port is not reused immediately
+	nextHostPort = map[string]int{}
+)
+
+// SetHostPortRange sets the range of the automatically allocated host ports,
+// in format begin-end.
+func SetHostPortRange(r string) error {
+	pr, err := NewPortRange(r)
+	if err != nil {
+		return fmt.Errorf("invalid host port range %s: %v", r, err)
+	}
+	if pr.Begin == 0 || pr.End < pr.Begin {
+		return fmt.Errorf("invalid host port range %s", r)
+	}
+
+	allocMutex.Lock()
+	hostPortRange = *pr
+	allocMutex.Unlock()
+	return nil
+}
+
+// AutoHostPort returns whether the host ports of the mapping should be
+// allocated by hyperd.
+func (m *PortMapping) AutoHostPort() bool {
+	return m.FromPorts.Begin == 0
+}
+
+func (pr *PortRange) String() string {
+	if pr.End == 0 || pr.End == pr.Begin {
+		return fmt.Sprintf("%d", pr.Begin)
+	}
+	return fmt.Sprintf("%d-%d", pr.Begin, pr.End)
+}
+
+func normalizeProto(proto string) string {
+	if strings.EqualFold(proto, "udp") {
+		return "udp"
+	}
+	return "tcp"
+}
+
+// allocateHostPorts allocates continuous free host ports for the mappings
+// without host port, as many as the container ports. The allocated ports
+// are taken in PortMapper immediately, so that they could not be allocated
+// by others before the rules are setup.
+func allocateHostPorts(containerip string, maps []*PortMapping) (allocated []*PortMapping, err error) {
+	allocMutex.Lock()
+	defer allocMutex.Unlock()
+
+	defer func() {
+		if err != nil {
+			releaseHostPorts(allocated)
+			allocated = nil
+		}
+	}()
+
+	size := hostPortRange.End - hostPortRange.Begin + 1
+	for _, m := range maps {
+		if !m.AutoHostPort() {
+			continue
+		}
+		proto := normalizeProto(m.Protocol)
+		count := 1
+		if m.ToPorts.End > m.ToPorts.Begin {
+			count = m.ToPorts.End - m.ToPorts.Begin + 1
+		}
+
+		start := nextHostPort[proto]
+		if start < hostPortRange.Begin || start > hostPortRange.End {
+			start = hostPortRange.Begin
+		}
+		found := false
+		for i := 0; i < size && !found; i++ {
+			begin := hostPortRange.Begin + (start-hostPortRange.Begin+i)%size
+			end := begin + count - 1
+			if end > hostPortRange.End {
+				continue
+			}
+			var free bool
+			if free, err = hostPortsFree(proto, begin, end); err != nil {
+				return allocated, fmt.Errorf("failed to check host ports %d-%d/%s: %v", begin, end, proto, err)
+			} else if !free {
+				continue
+			}
+			if err = reserveHostPorts(proto, begin, end, containerip, m.ToPorts.Begin); err != nil {
+				// taken by someone else just now, try the next one
+				err = nil
+				continue
+			}
+			m.FromPorts.Begin, m.FromPorts.End = begin, end
+			m.allocated = true
+			allocated = append(allocated, m)
+			nextHostPort[proto] = end + 1
+			found = true
+		}
+		if !found {
+			return allocated, fmt.Errorf("no %d free %s host ports in range %s for container port %s", count, proto, hostPortRange.String(), m.ToPorts.String())
+		}
+		hlog.Log(hlog.DEBUG, "allocated host port %s/%s for container port %s", m.FromPorts.String(), proto, m.ToPorts.String())
+	}
+	return allocated, nil
+}
+
+// hostPortsFree returns whether the host ports are neither taken in
+// PortMapper nor mapped by the backends.
+func hostPortsFree(proto string, begin, end int) (bool, error) {
+	for p := begin; p <= end; p++ {
+		if PortMapper.Used(proto, p) {
+			return false, nil
+		}
+	}
+	for _, b := range []backend{hostBackend6, hostBackend} {
+		if b == nil {
+			continue
+		}
+		if used, err := b.portMapUsed(proto, begin, end); err != nil || used {
+			return false, err
+		}
+	}
+	return true, nil
+}
+
+func reserveHostPorts(proto string, begin, end int, containerip string, containerPort int) error {
+	for p := begin; p <= end; p++ {
+		if err := PortMapper.AllocateMap(proto, p, containerip, containerPort+p-begin); err != nil {
+			for r := begin; r < p; r++ {
+				PortMapper.ReleaseMap(proto, r)
+			}
+			return err
+		}
+	}
+	return nil
+}
+
+// releaseHostPorts releases the allocated host ports of the mappings which
+// have not been setup, the mappings are back to without host port.
+func releaseHostPorts(maps []*PortMapping) {
+	for _, m := range maps {
+		for p := m.FromPorts.Begin; p <= m.FromPorts.End; p++ {
+			PortMapper.ReleaseMap(normalizeProto(m.Protocol), p)
+		}
+		m.FromPorts.Begin, m.FromPorts.End = 0, 0
+		m.allocated = false
+	}
+}
@@ -0,0 +1,149 @@
+package portmapping
+
+import (
+	"testing"
+
+	"github.com/hyperhq/hyperd/networking/portmapping/portmapper"
+)
+
+// withHostPortRange runs f with a fresh PortMapper and allocation state in
+// the range, without any host backend.
+func withHostPortRange(t *testing.T, r string, f func()) {
+	savedRange, savedNext, savedMapper := hostPortRange, nextHostPort, PortMapper
+	savedBackend, savedBackend6 := hostBackend, hostBackend6
+	defer func() {
+		hostPortRange, nextHostPort, PortMapper = savedRange, savedNext, savedMapper
+		hostBackend, hostBackend6 = savedBackend, savedBackend6
+	}()
+
+	if err := SetHostPortRange(r); err != nil {
+		t.Fatal(err)
+	}
+	nextHostPort = map[string]int{}
+	PortMapper = portmapper.New()
+	hostBackend, hostBackend6 = nil, nil
+	f()
+}
+
+func autoMapping(proto string, begin, end int) *PortMapping {
+	return &PortMapping{Protocol: proto, FromPorts: &PortRange{}, ToPorts: &PortRange{Begin: begin, End: end}}
+}
+
+func TestAllocateHostPortsRoundRobin(t *testing.T) {
+	withHostPortRange(t, "50000-50009", func() {
+		m := autoMapping("tcp", 80, 0)
+		if _, err := allocateHostPorts("192.168.123.2", []*PortMapping{m}); err != nil {
+			t.Fatal(err)
+		}
+		if m.FromPorts.Begin != 50000 || m.FromPorts.End != 50000 || !m.allocated {
+			t.Fatalf("unexpected first host port %v", m.FromPorts)
+		}
+		if !PortMapper.Used("tcp", 50000) {
+			t.Fatal("allocated host port is not taken")
+		}
+
+		// a released port is not reused immediately
+		releaseHostPorts([]*PortMapping{m})
+		if m.FromPorts.Begin != 0 || m.allocated || PortMapper.Used("tcp", 50000) {
+			t.Fatalf("host port is not released: %v", m.FromPorts)
+		}
+		if _, err := allocateHostPorts("192.168.123.2", []*PortMapping{m}); err != nil {
+			t.Fatal(err)
+		}
+		if m.FromPorts.Begin != 50001 {
+			t.Fatalf("unexpected next host port %v", m.FromPorts)
+		}
+
+		// the protocols are allocated separately
+		u := autoMapping("UDP", 53, 0)
+		if _, err := allocateHostPorts("192.168.123.2", []*PortMapping{u}); err != nil {
+			t.Fatal(err)
+		}
+		if u.FromPorts.Begin != 50000 || !PortMapper.Used("udp", 50000) {
+			t.Fatalf("unexpected udp host port %v", u.FromPorts)
+		}
+
+		// the allocation wraps around to the beginning of the range
+		nextHostPort["tcp"] = 50009
+		w := autoMapping("tcp", 80, 0)
+		x := autoMapping("tcp", 80, 0)
+		if _, err := allocateHostPorts("192.168.123.2", []*PortMapping{w, x}); err != nil {
+			t.Fatal(err)
+		}
+		if w.FromPorts.Begin != 50009 || x.FromPorts.Begin != 50000 {
+			t.Fatalf("unexpected wrapped host ports %v %v", w.FromPorts, x.FromPorts)
+		}
+	})
+}
+
+func TestAllocateHostPortsRange(t *testing.T) {
+	withHostPortRange(t, "50000-50009", func() {
+		// the ports of a range are continuous and do not cross the end
+		nextHostPort["tcp"] = 50008
+		m := autoMapping("tcp", 80, 82)
+		if _, err := allocateHostPorts("192.168.123.2", []*PortMapping{m}); err != nil {
+			t.Fatal(err)
+		}
+		if m.FromPorts.Begin != 50000 || m.FromPorts.End != 50002 {
+			t.Fatalf("unexpected host ports %v", m.FromPorts)
+		}
+		for p := 50000; p <= 50002; p++ {
+			if !PortMapper.Used("tcp", p) {
+				t.Fatalf("host port %d is not taken", p)
+			}
+		}
+
+		// the mappings with host port are left alone
+		fixed := &PortMapping{Protocol: "tcp", FromPorts: &PortRange{Begin: 8080}, ToPorts: &PortRange{Begin: 80}}
+		allocated, err := allocateHostPorts("192.168.123.2", []*PortMapping{fixed})
+		if err != nil || len(allocated) != 0 || fixed.FromPorts.Begin != 8080 || fixed.allocated {
+			t.Fatalf("mapping with host port is allocated: %v, %v", fixed.FromPorts, err)
+		}
+	})
+}
+
+func TestAllocateHostPortsSkipUsed(t *testing.T) {
+	withHostPortRange(t, "50000-50009", func() {
+		if err := PortMapper.AllocateMap("tcp", 50001, "192.168.123.9", 80); err != nil {
+			t.Fatal(err)
+		}
+		m := autoMapping("tcp", 80, 81)
+		if _, err := allocateHostPorts("192.168.123.2", []*PortMapping{m}); err != nil {
+			t.Fatal(err)
+		}
+		if m.FromPorts.Begin != 50002 || m.FromPorts.End != 50003 {
+			t.Fatalf("used host port is not skipped: %v", m.FromPorts)
+		}
+		if PortMapper.Used("tcp", 50000) {
+			t.Fatal("host port before the used one is left taken")
+		}
+	})
+}
+
+func TestAllocateHostPortsExhausted(t *testing.T) {
+	withHostPortRange(t, "50000-50001", func() {
+		maps := []*PortMapping{autoMapping("tcp", 80, 0), autoMapping("tcp", 81, 0), autoMapping("tcp", 82, 0)}
+		allocated, err := allocateHostPorts("192.168.123.2", maps)
+		if err == nil {
+			t.Fatal("allocate more host ports than the range")
+		}
+		if len(allocated) != 0 {
+			t.Fatalf("failed allocation returns %v", allocated)
+		}
+		for _, m := range maps {
+			if m.FromPorts.Begin != 0 || m.allocated {
+				t.Fatalf("failed allocation leaves host port %v", m.FromPorts)
+			}
+		}
+		for p := 50000; p <= 50001; p++ {
+			if PortMapper.Used("tcp", p) {
+				t.Fatalf("failed allocation leaves host port %d taken", p)
+			}
+		}
+
+		// a range larger than the whole range never fits
+		if _, err = allocateHostPorts("192.168.123.2", []*PortMapping{autoMapping("tcp", 80, 82)}); err == nil {
+			t.Fatal("allocate a range larger than the host port range")
+		}
+	})
+}
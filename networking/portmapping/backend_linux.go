@@ -0,0 +1,128 @@
+package portmapping
+
+import (
+	"fmt"
+	"os/exec"
+	"strings"
+
+	"github.com/hyperhq/hyperd/networking/portmapping/iptables"
+	"github.com/hyperhq/hyperd/networking/portmapping/nftables"
+)
+
+const (
+	BackendAuto     = "auto"
+	BackendIptables = "iptables"
+	BackendNftables = "nftables"
+	BackendUserland = "userland"
+)
+
+// backend programs the host side of the port mappings, i.e. the DNAT of the
+// host ports and the NAT of the bridge network.
+type backend interface {
+	name() string
+	// setup prepares the base rules, addr is the address of the bridge
+	setup(addr string) error
+	// setupMaps adds the rules of the mappings and takes their host ports
+	// in PortMapper, except the allocated ones
+	setupMaps(containerip string, maps []*PortMapping) error
+	// releaseMaps removes the rules of the mappings and releases their host
+	// ports in PortMapper
+	releaseMaps(containerip string, maps []*PortMapping) error
+	// portMapUsed returns whether any of the host ports has been mapped,
+	// an error is returned if the mappings could not be listed
+	portMapUsed(proto string, begin, end int) (bool, error)
+	// setupNetwork adds the NAT and forward rules of the bridge of a
+	// user-defined network, the subnet is in CIDR
+	setupNetwork(bridge, subnet string) error
+	// releaseNetwork removes the rules added by setupNetwork
+	releaseNetwork(bridge, subnet string) error
+}
+
+var (
+	// hostBackend is nil if the host rules are disabled
+	hostBackend backend
+	// hostBackend6 programs the IPv6 rules, it is nil if the bridge has no
+	// IPv6 prefix, or the backend could serve IPv6 by itself
+	hostBackend6 backend
+)
+
+func newBackend(name string) (backend, error) {
+	if name == "" || name == BackendAuto {
+		name = detectBackend()
+	}
+	switch name {
+	case BackendIptables:
+		return &iptablesBackend{ipt: iptables.IPv4}, nil
+	case BackendNftables:
+		return &nftablesBackend{family: "ip", addrType: "ipv4_addr", loopback: "127.0.0.0/8"}, nil
+	case BackendUserland:
+		return newUserlandBackend(), nil
+	}
+	return nil, fmt.Errorf("unknown port mapping backend %s", name)
+}
+
+// newBackend6 returns the IPv6 counterpart of b, the userland proxies
+// listen on the IPv6 addresses too, and forward to the IPv4 addresses of
+// the containers.
+func newBackend6(b backend) backend {
+	switch b.(type) {
+	case *iptablesBackend:
+		return &iptablesBackend{ipt: iptables.IPv6, v6: true}
+	case *nftablesBackend:
+		return &nftablesBackend{family: "ip6", addrType: "ipv6_addr", loopback: "::1", v6: true}
+	}
+	return nil
+}
+
+// detectBackend prefers nftables if iptables is not installed, or it is
+// the nf_tables variant of iptables.
+func detectBackend() string {
+	if !nftables.Available() {
+		return BackendIptables
+	}
+	if _, err := exec.LookPath("iptables"); err != nil {
+		return BackendNftables
+	}
+	if output, err := exec.Command("iptables", "-V").CombinedOutput(); err == nil && strings.Contains(string(output), "nf_tables") {
+		return BackendNftables
+	}
+	return BackendIptables
+}
+
+// iptablesBackend keeps the rules in the HYPER chains. The IPv6 one does not
+// take the host ports in PortMapper, which have been taken by the IPv4 one.
+type iptablesBackend struct {
+	ipt *iptables.IPTables
+	v6  bool
+}
+
+func (b *iptablesBackend) name() string {
+	if b.v6 {
+		return "ip6tables"
+	}
+	return BackendIptables
+}
+
+func (b *iptablesBackend) setup(addr string) error {
+	return setupIPTables(b.ipt, addr)
+}
+
+func (b *iptablesBackend) setupMaps(containerip string, maps []*PortMapping) error {
+	return setupIptablesPortMaps(b.ipt, containerip, maps, !b.v6)
+}
+
+func (b *iptablesBackend) releaseMaps(containerip string, maps []*PortMapping) error {
+	return releaseIptablesPortMaps(b.ipt, containerip, maps, !b.v6)
+}
+
+func (b *iptablesBackend) portMapUsed(proto string, begin, end int) (bool, error) {
+	return b.ipt.PortMapUsed("HYPER", proto, begin, end), nil
+}
+
+func (b *iptablesBackend) setupNetwork(bridge, subnet string) error {
+	return setupIptablesNetwork(b.ipt, bridge, subnet)
+}
+
+func (b *iptablesBackend) releaseNetwork(bridge, subnet string) error {
+	return releaseIptablesNetwork(b.ipt, bridge, subnet)
+}
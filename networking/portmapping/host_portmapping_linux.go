@@ -24,6 +24,9 @@ type PortMapping struct {
 	Protocol  string
 	ToPorts   *PortRange
 	FromPorts *PortRange
+
+	// the host ports have been allocated and taken in PortMapper
+	allocated bool
 }
 
 // NewPortRange generate a port range from string r. the r should be a decimal number or
@@ -50,11 +53,15 @@ func NewPortRange(r string) (*PortRange, error) {
 }
 
 // NewPortMapping generate a PortMapping from three strings: proto (tcp or udp, default is tcp),
-// and from/to port (single port or a range, see NewPortRange)
+// and from/to port (single port or a range, see NewPortRange). An empty or 0 from port means
+// the host ports will be allocated by SetupPortMaps
 func NewPortMapping(proto, from, to string) (*PortMapping, error) {
 	if proto == "" {
 		proto = "tcp"
 	}
+	if from == "" {
+		from = "0"
+	}
 	if proto != "tcp" && proto != "udp" {
 		return nil, fmt.Errorf("unsupported protocol %s", proto)
 	}
@@ -130,7 +137,9 @@ func parseRawResultOnHyper(output []byte, err error) error {
 	return nil
 }
 
-func setupIptablesPortMaps(containerip string, maps []*PortMapping) error {
+// setupIptablesPortMaps adds the rules of the mappings, and takes the host
+// ports in PortMapper if takePorts.
+func setupIptablesPortMaps(ipt *iptables.IPTables, containerip string, maps []*PortMapping, takePorts bool) error {
 	var (
 		revert      bool
 		revertRules = [][]string{}
@@ -140,7 +149,7 @@ func setupIptablesPortMaps(containerip string, maps []*PortMapping) error {
 			hlog.Log(hlog.WARNING, "revert portmapping rules...")
 			for _, r := range revertRules {
 				hlog.Log(hlog.INFO, "revert rule: %v", r)
-				err := parseRawResultOnHyper(iptables.Raw(r...))
+				err := parseRawResultOnHyper(ipt.Raw(r...))
 				if err != nil {
 					hlog.Log(hlog.ERROR, "failed to revert rule: %v", err)
 					err = nil //just ignore
@@ -158,23 +167,23 @@ func setupIptablesPortMaps(containerip string, maps []*PortMapping) error {
 		}
 
 		//check if this rule has already existed
-		if iptables.PortMapExists("HYPER", natArgs) {
+		if ipt.PortMapExists("HYPER", natArgs) {
 			continue
 		}
 
-		if iptables.PortMapUsed("HYPER", m.Protocol, m.FromPorts.Begin, m.FromPorts.End) {
+		if ipt.PortMapUsed("HYPER", m.Protocol, m.FromPorts.Begin, m.FromPorts.End) {
 			revert = true
 			return fmt.Errorf("Host port %v has aleady been used", m.FromPorts)
 		}
 
-		err = parseRawResultOnHyper(iptables.Raw(append([]string{"-t", "nat", "-I", "HYPER"}, natArgs...)...))
+		err = parseRawResultOnHyper(ipt.Raw(append([]string{"-t", "nat", "-I", "HYPER"}, natArgs...)...))
 		if err != nil {
 			revert = true
 			return fmt.Errorf("Unable to setup NAT rule in HYPER chain: %s", err)
 		}
 		revertRules = append(revertRules, append([]string{"-t", "nat", "-D", "HYPER"}, natArgs...))
 
-		if err = parseRawResultOnHyper(iptables.Raw(append([]string{"-I", "HYPER"}, filterArgs...)...)); err != nil {
+		if err = parseRawResultOnHyper(ipt.Raw(append([]string{"-I", "HYPER"}, filterArgs...)...)); err != nil {
 			revert = true
 			return fmt.Errorf("Unable to setup FILTER rule in HYPER chain: %s", err)
 		}
@@ -192,7 +201,7 @@ func setupIptablesPortMaps(containerip string, maps []*PortMapping) error {
 			}
 		}()
 
-		for i <= m.FromPorts.End {
+		for i <= m.FromPorts.End && !m.allocated && takePorts {
 			if err = PortMapper.AllocateMap(m.Protocol, i, containerip, j); err != nil {
 				revert = true
 				return err
@@ -205,7 +214,9 @@ func setupIptablesPortMaps(containerip string, maps []*PortMapping) error {
 	return nil
 }
 
-func releaseIptablesPortMaps(containerip string, maps []*PortMapping) error {
+// releaseIptablesPortMaps removes the rules of the mappings, and releases
+// the host ports in PortMapper if releasePorts.
+func releaseIptablesPortMaps(ipt *iptables.IPTables, containerip string, maps []*PortMapping, releasePorts bool) error {
 
 release_loop:
 	for _, m := range maps {
@@ -218,7 +229,7 @@ release_loop:
 		}
 
 		hlog.Log(hlog.DEBUG, "release port map %d-%d/%s", m.FromPorts.Begin, m.FromPorts.End, m.Protocol)
-		for i := m.FromPorts.Begin; i <= m.FromPorts.End; i++ {
+		for i := m.FromPorts.Begin; i <= m.FromPorts.End && releasePorts; i++ {
 			err := PortMapper.ReleaseMap(m.Protocol, i)
 			if err != nil {
 				continue release_loop
@@ -230,9 +241,9 @@ release_loop:
 			continue
 		}
 
-		iptables.OperatePortMap(iptables.Delete, "HYPER", natArgs)
+		ipt.OperatePortMap(iptables.Delete, "HYPER", natArgs)
 
-		iptables.Raw(append([]string{"-D", "HYPER"}, filterArgs...)...)
+		ipt.Raw(append([]string{"-D", "HYPER"}, filterArgs...)...)
 	}
 	/* forbid to map ports twice */
 	return nil
@@ -26,11 +26,21 @@ const (
 )
 
 var (
-	iptablesPath        string
-	supportsXlock       = false
 	ErrIptablesNotFound = errors.New("Iptables not found")
+
+	// IPv4 and IPv6 run iptables and ip6tables respectively, the package
+	// level functions run iptables
+	IPv4 = &IPTables{cmd: "iptables"}
+	IPv6 = &IPTables{cmd: "ip6tables"}
 )
 
+// IPTables runs one of the iptables commands
+type IPTables struct {
+	cmd           string
+	path          string
+	supportsXlock bool
+}
+
 type Chain struct {
 	Name   string
 	Bridge string
@@ -46,21 +56,25 @@ func (e *ChainError) Error() string {
 	return fmt.Sprintf("Error iptables %s: %s", e.Chain, string(e.Output))
 }
 
-func initCheck() error {
-	if iptablesPath == "" {
-		path, err := exec.LookPath("iptables")
+func (ipt *IPTables) initCheck() error {
+	if ipt.path == "" {
+		path, err := exec.LookPath(ipt.cmd)
 		if err != nil {
 			return ErrIptablesNotFound
 		}
-		iptablesPath = path
-		supportsXlock = exec.Command(iptablesPath, "--wait", "-L", "-n").Run() == nil
+		ipt.path = path
+		ipt.supportsXlock = exec.Command(ipt.path, "--wait", "-L", "-n").Run() == nil
 	}
 	return nil
 }
 
 // Check if a dnat rule exists
 func OperatePortMap(action Action, chain string, rule []string) error {
-	if output, err := Raw(append([]string{
+	return IPv4.OperatePortMap(action, chain, rule)
+}
+
+func (ipt *IPTables) OperatePortMap(action Action, chain string, rule []string) error {
+	if output, err := ipt.Raw(append([]string{
 		"-t", string(Nat), string(action), chain}, rule...)...); err != nil {
 		return fmt.Errorf("Unable to setup network port map: %s", err)
 	} else if len(output) != 0 {
@@ -71,12 +85,16 @@ func OperatePortMap(action Action, chain string, rule []string) error {
 }
 
 func PortMapExists(chain string, rule []string) bool {
+	return IPv4.PortMapExists(chain, rule)
+}
+
+func (ipt *IPTables) PortMapExists(chain string, rule []string) bool {
 	// iptables -C, --check option was added in v.1.4.11
 	// http://ftp.netfilter.org/pub/iptables/changes-iptables-1.4.11.txt
 
 	// try -C
 	// if exit status is 0 then return true, the rule exists
-	if _, err := Raw(append([]string{
+	if _, err := ipt.Raw(append([]string{
 		"-t", "nat", "-C", chain}, rule...)...); err == nil {
 		return true
 	}
@@ -87,9 +105,13 @@ func PortMapExists(chain string, rule []string) bool {
 var hostPortRulePattern = regexp.MustCompile(`.* -p ([cdtpu]{3}) .* --dport ([0-9]{1,5})(:([0-9]{1,5}))?`)
 
 func PortMapUsed(chain string, proto string, begin, end int) bool {
+	return IPv4.PortMapUsed(chain, proto, begin, end)
+}
+
+func (ipt *IPTables) PortMapUsed(chain string, proto string, begin, end int) bool {
 	// parse "iptables -S" for the rule (this checks rules in a specific chain
 	// in a specific table)
-	outputs, _ := exec.Command("iptables", "-t", "nat", "-S", chain).Output()
+	outputs, _ := exec.Command(ipt.cmd, "-t", "nat", "-S", chain).Output()
 	existingRules := bytes.NewBuffer(outputs)
 	var fin = false
 	for !fin {
@@ -140,6 +162,10 @@ func PortMapUsed(chain string, proto string, begin, end int) bool {
 
 // Check if a rule exists
 func Exists(table Table, chain string, rule ...string) bool {
+	return IPv4.Exists(table, chain, rule...)
+}
+
+func (ipt *IPTables) Exists(table Table, chain string, rule ...string) bool {
 	if string(table) == "" {
 		table = Filter
 	}
@@ -149,7 +175,7 @@ func Exists(table Table, chain string, rule ...string) bool {
 
 	// try -C
 	// if exit status is 0 then return true, the rule exists
-	if _, err := Raw(append([]string{
+	if _, err := ipt.Raw(append([]string{
 		"-t", string(table), "-C", chain}, rule...)...); err == nil {
 		return true
 	}
@@ -157,11 +183,11 @@ func Exists(table Table, chain string, rule ...string) bool {
 	// parse "iptables -S" for the rule (this checks rules in a specific chain
 	// in a specific table)
 	ruleString := strings.Join(rule, " ")
-	existingRules, _ := exec.Command("iptables", "-t", string(table), "-S", chain).Output()
+	existingRules, _ := exec.Command(ipt.cmd, "-t", string(table), "-S", chain).Output()
 
 	// regex to replace ips in rule
 	// because MASQUERADE rule will not be exactly what was passed
-	re := regexp.MustCompile(`[0-9]{1,3}\.[0-9]{1,3}\.[0-9]{1,3}\.[0-9]{1,3}\/[0-9]{1,2}`)
+	re := regexp.MustCompile(`[0-9]{1,3}\.[0-9]{1,3}\.[0-9]{1,3}\.[0-9]{1,3}\/[0-9]{1,2}|[0-9a-f:]*:[0-9a-f:]*\/[0-9]{1,3}`)
 
 	return strings.Contains(
 		re.ReplaceAllString(string(existingRules), "?"),
@@ -171,18 +197,22 @@ func Exists(table Table, chain string, rule ...string) bool {
 
 // Call 'iptables' system command, passing supplied arguments
 func Raw(args ...string) ([]byte, error) {
-	if err := initCheck(); err != nil {
+	return IPv4.Raw(args...)
+}
+
+func (ipt *IPTables) Raw(args ...string) ([]byte, error) {
+	if err := ipt.initCheck(); err != nil {
 		return nil, err
 	}
-	if supportsXlock {
+	if ipt.supportsXlock {
 		args = append([]string{"--wait"}, args...)
 	}
 
-	hlog.Log(hlog.TRACE, "%s, %v", iptablesPath, args)
+	hlog.Log(hlog.TRACE, "%s, %v", ipt.path, args)
 
-	output, err := exec.Command(iptablesPath, args...).CombinedOutput()
+	output, err := exec.Command(ipt.path, args...).CombinedOutput()
 	if err != nil {
-		return nil, fmt.Errorf("iptables failed: iptables %v: %s (%s)", strings.Join(args, " "), output, err)
+		return nil, fmt.Errorf("%s failed: %s %v: %s (%s)", ipt.cmd, ipt.cmd, strings.Join(args, " "), output, err)
 	}
 
 	// ignore iptables' message about xtables lock
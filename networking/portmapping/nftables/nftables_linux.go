@@ -0,0 +1,98 @@
+package nftables
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"os/exec"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/hyperhq/hypercontainer-utils/hlog"
+)
+
+var (
+	nftPath        string
+	ErrNftNotFound = errors.New("nft not found")
+
+	// an element of a port map, such as "8080 : 192.168.123.2 . 80" or
+	// "8080 : fd00::2 . 80"
+	elementRegexp = regexp.MustCompile(`(\d+)\s+:\s+([0-9a-fA-F:.]+)\s+\.\s+(\d+)`)
+)
+
+func initCheck() error {
+	if nftPath == "" {
+		path, err := exec.LookPath("nft")
+		if err != nil {
+			return ErrNftNotFound
+		}
+		nftPath = path
+	}
+	return nil
+}
+
+// Available returns whether the nft command is installed
+func Available() bool {
+	return initCheck() == nil
+}
+
+// Call 'nft' system command, passing supplied arguments
+func Raw(args ...string) ([]byte, error) {
+	if err := initCheck(); err != nil {
+		return nil, err
+	}
+
+	hlog.Log(hlog.TRACE, "%s, %v", nftPath, args)
+
+	output, err := exec.Command(nftPath, args...).CombinedOutput()
+	if err != nil {
+		return nil, fmt.Errorf("nft failed: nft %v: %s (%s)", strings.Join(args, " "), output, err)
+	}
+	return output, nil
+}
+
+// Apply runs the commands in script in one transaction, either all or
+// none of them take effect.
+func Apply(script string) error {
+	if err := initCheck(); err != nil {
+		return err
+	}
+
+	hlog.Log(hlog.TRACE, "%s -f -\n%s", nftPath, script)
+
+	cmd := exec.Command(nftPath, "-f", "-")
+	cmd.Stdin = strings.NewReader(script)
+	output, err := cmd.CombinedOutput()
+	if err != nil {
+		return fmt.Errorf("nft failed: %s (%s)", bytes.TrimSpace(output), err)
+	}
+	return nil
+}
+
+// Target is the destination of a port map element
+type Target struct {
+	Addr string
+	Port int
+}
+
+// MapElements lists the elements of a map in type inet_service :
+// ipv4_addr . inet_service, or ipv6_addr, keyed by the port.
+func MapElements(family, table, name string) (map[int]Target, error) {
+	output, err := Raw("-nn", "list", "map", family, table, name)
+	if err != nil {
+		return nil, err
+	}
+	return parseMapElements(string(output)), nil
+}
+
+// parseMapElements gets the elements from the output of "nft list map"
+func parseMapElements(output string) map[int]Target {
+	elements := make(map[int]Target)
+	for _, m := range elementRegexp.FindAllStringSubmatch(output, -1) {
+		key, _ := strconv.Atoi(m[1])
+		port, _ := strconv.Atoi(m[3])
+		elements[key] = Target{Addr: m[2], Port: port}
+	}
+	return elements
+}
@@ -0,0 +1,59 @@
+package nftables
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParseMapElements(t *testing.T) {
+	for _, c := range []struct {
+		name   string
+		output string
+		expect map[int]Target
+	}{
+		{
+			name: "ipv4",
+			output: `table ip hyperd {
+	map tcp_ports {
+		type inet_service : ipv4_addr . inet_service
+		elements = { 8080 : 192.168.123.2 . 80, 8443 : 192.168.123.2 . 443,
+			     49153 : 192.168.123.3 . 8080 }
+	}
+}
+`,
+			expect: map[int]Target{
+				8080:  {Addr: "192.168.123.2", Port: 80},
+				8443:  {Addr: "192.168.123.2", Port: 443},
+				49153: {Addr: "192.168.123.3", Port: 8080},
+			},
+		},
+		{
+			name: "ipv6",
+			output: `table ip6 hyperd {
+	map udp_ports {
+		type inet_service : ipv6_addr . inet_service
+		elements = { 53 : fd00:123::2 . 53, 5353 : fd00:123::a . 5353 }
+	}
+}
+`,
+			expect: map[int]Target{
+				53:   {Addr: "fd00:123::2", Port: 53},
+				5353: {Addr: "fd00:123::a", Port: 5353},
+			},
+		},
+		{
+			name: "empty",
+			output: `table ip hyperd {
+	map tcp_ports {
+		type inet_service : ipv4_addr . inet_service
+	}
+}
+`,
+			expect: map[int]Target{},
+		},
+	} {
+		if elements := parseMapElements(c.output); !reflect.DeepEqual(elements, c.expect) {
+			t.Errorf("%s: got %v, expect %v", c.name, elements, c.expect)
+		}
+	}
+}
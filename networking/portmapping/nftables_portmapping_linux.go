@@ -0,0 +1,265 @@
+package portmapping
+
+import (
+	"fmt"
+	"net"
+	"strings"
+
+	"github.com/hyperhq/hypercontainer-utils/hlog"
+	"github.com/hyperhq/hyperd/networking/portmapping/nftables"
+)
+
+const nftTable = "hyperd"
+
+// nftablesBackend keeps all the rules in its own table, the host ports are
+// the keys of the tcp_ports and udp_ports maps, which are looked up by the
+// DNAT rules. There is a table of each family, the IPv6 one does not take
+// the host ports in PortMapper, which have been taken by the IPv4 one.
+//
+// A packet traverses the forward chains of all the tables, an accept only
+// ends the chain of its own table. The forward chains here could not
+// override a drop policy or rule in the forward chain of another table,
+// such as the one of a host firewall, which has to allow the bridges too.
+type nftablesBackend struct {
+	family   string
+	addrType string
+	loopback string
+	v6       bool
+}
+
+func (b *nftablesBackend) name() string {
+	if b.v6 {
+		return BackendNftables + " (ip6)"
+	}
+	return BackendNftables
+}
+
+func nftMap(proto string) string {
+	return normalizeProto(proto) + "_ports"
+}
+
+// setup recreates the table, the mappings of the existing pods are added
+// back by RestorePortMaps.
+func (b *nftablesBackend) setup(addr string) error {
+	_, subnet, err := net.ParseCIDR(addr)
+	if err != nil {
+		return fmt.Errorf("invalid bridge address %s: %v", addr, err)
+	}
+
+	script := fmt.Sprintf(`add table %[1]s %[2]s
+delete table %[1]s %[2]s
+table %[1]s %[2]s {
+	map tcp_ports {
+		type inet_service : %[3]s . inet_service
+	}
+	map udp_ports {
+		type inet_service : %[3]s . inet_service
+	}
+	chain prerouting {
+		type nat hook prerouting priority -100; policy accept;
+		fib daddr type local dnat %[1]s to tcp dport map @tcp_ports
+		fib daddr type local dnat %[1]s to udp dport map @udp_ports
+	}
+	chain output {
+		type nat hook output priority -100; policy accept;
+		fib daddr type local %[1]s daddr != %[4]s dnat %[1]s to tcp dport map @tcp_ports
+		fib daddr type local %[1]s daddr != %[4]s dnat %[1]s to udp dport map @udp_ports
+	}
+	chain postrouting {
+		type nat hook postrouting priority 100; policy accept;
+		%[1]s saddr %[5]s oifname != "%[6]s" masquerade
+	}
+	chain forward {
+		type filter hook forward priority 0; policy accept;
+		iifname "%[6]s" accept
+		oifname "%[6]s" ct state related,established accept
+		oifname "%[6]s" ct status dnat accept
+	}
+}
+`, b.family, nftTable, b.addrType, b.loopback, subnet.String(), bridgeIface)
+
+	if err := nftables.Apply(script); err != nil {
+		return fmt.Errorf("Unable to setup nftables table %s: %v", nftTable, err)
+	}
+
+	return enableBridgeNetfilter()
+}
+
+// nftNetworkTable is the table of the rules of the bridge of a user-defined
+// network, it is removed with the network.
+func nftNetworkTable(bridge string) string {
+	return nftTable + "-" + bridge
+}
+
+func (b *nftablesBackend) setupNetwork(bridge, subnet string) error {
+	script := fmt.Sprintf(`add table %[1]s %[2]s
+delete table %[1]s %[2]s
+table %[1]s %[2]s {
+	chain postrouting {
+		type nat hook postrouting priority 100; policy accept;
+		%[1]s saddr %[3]s oifname != "%[4]s" masquerade
+	}
+	chain forward {
+		type filter hook forward priority 0; policy accept;
+		iifname "%[4]s" accept
+		oifname "%[4]s" ct state related,established accept
+	}
+}
+`, b.family, nftNetworkTable(bridge), subnet, bridge)
+
+	if err := nftables.Apply(script); err != nil {
+		return fmt.Errorf("Unable to setup nftables table %s: %v", nftNetworkTable(bridge), err)
+	}
+	return nil
+}
+
+func (b *nftablesBackend) releaseNetwork(bridge, subnet string) error {
+	script := fmt.Sprintf("add table %[1]s %[2]s\ndelete table %[1]s %[2]s\n", b.family, nftNetworkTable(bridge))
+	if err := nftables.Apply(script); err != nil {
+		return fmt.Errorf("Unable to remove nftables table %s: %v", nftNetworkTable(bridge), err)
+	}
+	return nil
+}
+
+// nftElements returns the elements of the map of the mapping, in
+// "hostport : containerip . containerport" form.
+func nftElements(containerip string, m *PortMapping) ([]string, map[int]nftables.Target, error) {
+	if m.FromPorts.End == 0 || m.FromPorts.End < m.FromPorts.Begin {
+		m.FromPorts.End = m.FromPorts.Begin
+	}
+	if m.ToPorts.End == 0 || m.ToPorts.End < m.ToPorts.Begin {
+		m.ToPorts.End = m.ToPorts.Begin
+	}
+
+	//we may map ports 1:N or N:N, but not M:N (M!=1, M!=N)
+	hostRange := m.FromPorts.End - m.FromPorts.Begin
+	containerRange := m.ToPorts.End - m.ToPorts.Begin
+	if hostRange != 0 && hostRange != containerRange {
+		return nil, nil, fmt.Errorf("range mismatch, cannot map ports %s to %s", m.FromPorts.String(), m.ToPorts.String())
+	}
+
+	var (
+		elements = []string{}
+		targets  = make(map[int]nftables.Target)
+	)
+	for i := 0; i <= hostRange; i++ {
+		t := nftables.Target{Addr: containerip, Port: m.ToPorts.Begin + i}
+		targets[m.FromPorts.Begin+i] = t
+		elements = append(elements, fmt.Sprintf("%d : %s . %d", m.FromPorts.Begin+i, t.Addr, t.Port))
+	}
+	return elements, targets, nil
+}
+
+func nftKeys(m *PortMapping) string {
+	keys := []string{}
+	for p := m.FromPorts.Begin; p <= m.FromPorts.End; p++ {
+		keys = append(keys, fmt.Sprintf("%d", p))
+	}
+	return strings.Join(keys, ", ")
+}
+
+func (b *nftablesBackend) setupMaps(containerip string, maps []*PortMapping) (err error) {
+	var (
+		added    = []*PortMapping{}
+		reserved = []*PortMapping{}
+	)
+	defer func() {
+		if err != nil {
+			hlog.Log(hlog.WARNING, "revert portmapping rules...")
+			for _, m := range added {
+				b.deleteElements(m)
+			}
+			for _, m := range reserved {
+				for p := m.FromPorts.Begin; p <= m.FromPorts.End; p++ {
+					PortMapper.ReleaseMap(m.Protocol, p)
+				}
+			}
+		}
+	}()
+
+	for _, m := range maps {
+		m.Protocol = normalizeProto(m.Protocol)
+		elements, targets, err := nftElements(containerip, m)
+		if err != nil {
+			return err
+		}
+
+		existing, err := nftables.MapElements(b.family, nftTable, nftMap(m.Protocol))
+		if err != nil {
+			return err
+		}
+		//check if the mapping has already existed
+		matched := 0
+		for port, t := range targets {
+			if e, ok := existing[port]; ok {
+				if e != t {
+					return fmt.Errorf("Host port %v has aleady been used", m.FromPorts)
+				}
+				matched++
+			}
+		}
+		if matched == len(targets) {
+			continue
+		} else if matched > 0 {
+			return fmt.Errorf("Host port %v has aleady been used", m.FromPorts)
+		}
+
+		err = nftables.Apply(fmt.Sprintf("add element %s %s %s { %s }\n",
+			b.family, nftTable, nftMap(m.Protocol), strings.Join(elements, ", ")))
+		if err != nil {
+			return fmt.Errorf("Unable to setup nftables port mapping: %v", err)
+		}
+		added = append(added, m)
+
+		if !m.allocated && !b.v6 {
+			if err = reserveHostPorts(m.Protocol, m.FromPorts.Begin, m.FromPorts.End, containerip, m.ToPorts.Begin); err != nil {
+				return err
+			}
+			reserved = append(reserved, m)
+		}
+	}
+	return nil
+}
+
+func (b *nftablesBackend) deleteElements(m *PortMapping) {
+	err := nftables.Apply(fmt.Sprintf("delete element %s %s %s { %s }\n",
+		b.family, nftTable, nftMap(m.Protocol), nftKeys(m)))
+	if err != nil {
+		hlog.Log(hlog.ERROR, "failed to delete port mapping %s/%s: %v", m.FromPorts.String(), m.Protocol, err)
+	}
+}
+
+func (b *nftablesBackend) releaseMaps(containerip string, maps []*PortMapping) error {
+
+release_loop:
+	for _, m := range maps {
+		m.Protocol = normalizeProto(m.Protocol)
+		if m.FromPorts.End == 0 {
+			m.FromPorts.End = m.FromPorts.Begin
+		}
+
+		hlog.Log(hlog.DEBUG, "release port map %d-%d/%s", m.FromPorts.Begin, m.FromPorts.End, m.Protocol)
+		for i := m.FromPorts.Begin; i <= m.FromPorts.End && !b.v6; i++ {
+			err := PortMapper.ReleaseMap(m.Protocol, i)
+			if err != nil {
+				continue release_loop
+			}
+		}
+
+		b.deleteElements(m)
+	}
+	return nil
+}
+
+func (b *nftablesBackend) portMapUsed(proto string, begin, end int) (bool, error) {
+	existing, err := nftables.MapElements(b.family, nftTable, nftMap(proto))
+	if err != nil {
+		return false, err
+	}
+	for p := begin; p <= end; p++ {
+		if _, ok := existing[p]; ok {
+			return true, nil
+		}
+	}
+	return false, nil
+}
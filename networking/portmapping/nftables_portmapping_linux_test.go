@@ -0,0 +1,71 @@
+package portmapping
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/hyperhq/hyperd/networking/portmapping/nftables"
+)
+
+func TestNftElements(t *testing.T) {
+	for _, c := range []struct {
+		name     string
+		m        *PortMapping
+		elements []string
+		targets  map[int]nftables.Target
+		fail     bool
+	}{
+		{
+			name:     "single port",
+			m:        &PortMapping{Protocol: "tcp", FromPorts: &PortRange{Begin: 8080}, ToPorts: &PortRange{Begin: 80}},
+			elements: []string{"8080 : 192.168.123.2 . 80"},
+			targets:  map[int]nftables.Target{8080: {Addr: "192.168.123.2", Port: 80}},
+		},
+		{
+			name: "n to n",
+			m:    &PortMapping{Protocol: "tcp", FromPorts: &PortRange{Begin: 8080, End: 8082}, ToPorts: &PortRange{Begin: 80, End: 82}},
+			elements: []string{
+				"8080 : 192.168.123.2 . 80",
+				"8081 : 192.168.123.2 . 81",
+				"8082 : 192.168.123.2 . 82",
+			},
+			targets: map[int]nftables.Target{
+				8080: {Addr: "192.168.123.2", Port: 80},
+				8081: {Addr: "192.168.123.2", Port: 81},
+				8082: {Addr: "192.168.123.2", Port: 82},
+			},
+		},
+		{
+			// a single host port to the first of the container ports
+			name:     "1 to n",
+			m:        &PortMapping{Protocol: "udp", FromPorts: &PortRange{Begin: 5353}, ToPorts: &PortRange{Begin: 53, End: 55}},
+			elements: []string{"5353 : 192.168.123.2 . 53"},
+			targets:  map[int]nftables.Target{5353: {Addr: "192.168.123.2", Port: 53}},
+		},
+		{
+			name: "m to n",
+			m:    &PortMapping{Protocol: "tcp", FromPorts: &PortRange{Begin: 8080, End: 8081}, ToPorts: &PortRange{Begin: 80, End: 82}},
+			fail: true,
+		},
+	} {
+		elements, targets, err := nftElements("192.168.123.2", c.m)
+		if c.fail {
+			if err == nil {
+				t.Errorf("%s: expect an error", c.name)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%s: %v", c.name, err)
+			continue
+		}
+		if !reflect.DeepEqual(elements, c.elements) || !reflect.DeepEqual(targets, c.targets) {
+			t.Errorf("%s: got %v %v, expect %v %v", c.name, elements, targets, c.elements, c.targets)
+		}
+	}
+
+	if _, targets, err := nftElements("fd00:123::2", &PortMapping{Protocol: "tcp", FromPorts: &PortRange{Begin: 8080}, ToPorts: &PortRange{Begin: 80}}); err != nil ||
+		targets[8080] != (nftables.Target{Addr: "fd00:123::2", Port: 80}) {
+		t.Errorf("ipv6 target: %v, %v", targets, err)
+	}
+}
@@ -57,6 +57,19 @@ func (p *PortMapper) AllocateMap(protocol string, hostPort int,
 	return nil
 }
 
+// Used returns whether the host port has been mapped
+func (p *PortMapper) Used(protocol string, hostPort int) bool {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+
+	if strings.EqualFold(protocol, "udp") {
+		_, ok := p.udpMap[hostPort]
+		return ok
+	}
+	_, ok := p.tcpMap[hostPort]
+	return ok
+}
+
 func (p *PortMapper) ReleaseMap(protocol string, hostPort int) error {
 	p.mutex.Lock()
 	defer p.mutex.Unlock()
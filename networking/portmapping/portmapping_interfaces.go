@@ -1,9 +1,26 @@
 package portmapping
 
+import (
+	"fmt"
+
+	"github.com/hyperhq/hypercontainer-utils/hlog"
+)
+
+// SetupPortMaps setups the port mappings to containerip, the host ports of
+// the mappings without host port are allocated and filled in maps.
 func SetupPortMaps(containerip string, externalPrefix []string, maps []*PortMapping) (preExec [][]string, err error) {
 	if len(maps) == 0 {
 		return [][]string{}, nil
 	}
+	allocated, err := allocateHostPorts(containerip, maps)
+	if err != nil {
+		return [][]string{}, err
+	}
+	defer func() {
+		if err != nil {
+			releaseHostPorts(allocated)
+		}
+	}()
 	if len(externalPrefix) > 0 {
 		preExec, err = setupInSandboxMappings(externalPrefix, maps)
 		if err != nil {
@@ -15,15 +32,35 @@ func SetupPortMaps(containerip string, externalPrefix []string, maps []*PortMapp
 			}
 		}()
 	}
-	if !disableIptables {
-		err = setupIptablesPortMaps(containerip, maps)
+	if hostBackend != nil {
+		err = hostBackend.setupMaps(containerip, maps)
 		if err != nil {
 			return [][]string{}, err
 		}
+	} else if len(externalPrefix) == 0 {
+		hlog.Log(hlog.WARNING, "no port mapping backend, the host ports of %d port mappings to %s are not forwarded", len(maps), containerip)
 	}
 	return preExec, nil
 }
 
+// SetupPortMaps6 setups the IPv6 host rules of the port mappings to
+// containerip6, the host ports have been taken by SetupPortMaps.
+func SetupPortMaps6(containerip6 string, maps []*PortMapping) error {
+	if len(maps) == 0 || containerip6 == "" || hostBackend6 == nil {
+		return nil
+	}
+	return hostBackend6.setupMaps(containerip6, maps)
+}
+
+// ReleasePortMaps6 removes the IPv6 host rules of the port mappings, it
+// should be called before ReleasePortMaps.
+func ReleasePortMaps6(containerip6 string, maps []*PortMapping) error {
+	if len(maps) == 0 || containerip6 == "" || hostBackend6 == nil {
+		return nil
+	}
+	return hostBackend6.releaseMaps(containerip6, maps)
+}
+
 func ReleasePortMaps(containerip string, externalPrefix []string, maps []*PortMapping) (postExec [][]string, err error) {
 	if len(maps) == 0 {
 		return [][]string{}, nil
@@ -34,11 +71,68 @@ func ReleasePortMaps(containerip string, externalPrefix []string, maps []*PortMa
 			return [][]string{}, err
 		}
 	}
-	if !disableIptables {
-		err = releaseIptablesPortMaps(containerip, maps)
+	if hostBackend != nil {
+		err = hostBackend.releaseMaps(containerip, maps)
 		if err != nil {
 			return [][]string{}, err
 		}
+	} else {
+		// only the allocated host ports are taken in PortMapper
+		for _, m := range maps {
+			for p := m.FromPorts.Begin; p <= m.FromPorts.End; p++ {
+				if PortMapper.Used(m.Protocol, p) {
+					PortMapper.ReleaseMap(m.Protocol, p)
+				}
+			}
+		}
 	}
 	return postExec, nil
 }
+
+// RestorePortMaps rebuilds the host rules of the port mappings of a running
+// pod at daemon start, and takes their host ports in PortMapper. The
+// in-sandbox mappings are kept by the sandbox itself. containerip6 is empty
+// if the pod has no IPv6 address.
+func RestorePortMaps(containerip, containerip6 string, maps []*PortMapping) (err error) {
+	if len(maps) == 0 {
+		return nil
+	}
+	allocMutex.Lock()
+	defer allocMutex.Unlock()
+
+	taken := []*PortMapping{}
+	defer func() {
+		if err != nil {
+			for _, m := range taken {
+				for p := m.FromPorts.Begin; p <= m.FromPorts.End; p++ {
+					PortMapper.ReleaseMap(m.Protocol, p)
+				}
+				m.allocated = false
+			}
+		}
+	}()
+	for _, m := range maps {
+		if m.AutoHostPort() {
+			return fmt.Errorf("host port of container port %s has not been allocated", m.ToPorts.String())
+		}
+		m.Protocol = normalizeProto(m.Protocol)
+		if m.FromPorts.End < m.FromPorts.Begin {
+			m.FromPorts.End = m.FromPorts.Begin
+		}
+		if err = reserveHostPorts(m.Protocol, m.FromPorts.Begin, m.FromPorts.End, containerip, m.ToPorts.Begin); err != nil {
+			return err
+		}
+		m.allocated = true
+		taken = append(taken, m)
+	}
+	if hostBackend != nil {
+		if err = hostBackend.setupMaps(containerip, maps); err != nil {
+			return err
+		}
+	}
+	// the IPv4 mappings work anyway
+	if e := SetupPortMaps6(containerip6, maps); e != nil {
+		hlog.Log(hlog.ERROR, "failed to restore IPv6 port mappings to %s: %v", containerip6, e)
+	}
+	return nil
+}
@@ -0,0 +1,97 @@
+// Package proxy forwards the traffic of a host port to a container port in
+// userland, for the hosts where the port mappings could not be setup with
+// iptables or nftables.
+package proxy
+
+import (
+	"fmt"
+	"net"
+	"strings"
+	"sync/atomic"
+	"time"
+)
+
+var (
+	// UDPSessionTimeout is how long a UDP session is kept without any
+	// datagram in either direction
+	UDPSessionTimeout = 90 * time.Second
+	// DialTimeout is the timeout of connecting to the container port
+	DialTimeout = 10 * time.Second
+)
+
+// Proxy forwards the traffic between the frontend on the host and the
+// backend in the container.
+type Proxy interface {
+	// Run serves until the proxy is closed
+	Run()
+	// Close stops the proxy and closes all the connections
+	Close()
+	Stats() Stats
+}
+
+// Stats are the counters of a proxy, In is from the clients to the
+// container and Out is the reverse.
+type Stats struct {
+	Protocol          string
+	Frontend          string
+	Backend           string
+	ActiveConnections int64
+	TotalConnections  uint64
+	FailedConnections uint64
+	BytesIn           uint64
+	BytesOut          uint64
+}
+
+type counters struct {
+	active   int64
+	total    uint64
+	failed   uint64
+	bytesIn  uint64
+	bytesOut uint64
+}
+
+func (c *counters) stats(proto string, frontend, backend net.Addr) Stats {
+	return Stats{
+		Protocol:          proto,
+		Frontend:          frontend.String(),
+		Backend:           backend.String(),
+		ActiveConnections: atomic.LoadInt64(&c.active),
+		TotalConnections:  atomic.LoadUint64(&c.total),
+		FailedConnections: atomic.LoadUint64(&c.failed),
+		BytesIn:           atomic.LoadUint64(&c.bytesIn),
+		BytesOut:          atomic.LoadUint64(&c.bytesOut),
+	}
+}
+
+// New listens on hostPort of all the host addresses and returns the proxy
+// to containerip:containerPort, the caller should Run it.
+func New(proto string, hostPort int, containerip string, containerPort int) (Proxy, error) {
+	frontend := net.JoinHostPort("", fmt.Sprintf("%d", hostPort))
+	backend := net.JoinHostPort(containerip, fmt.Sprintf("%d", containerPort))
+	switch strings.ToLower(proto) {
+	case "tcp":
+		return NewTCPProxy(frontend, backend)
+	case "udp":
+		return NewUDPProxy(frontend, backend)
+	}
+	return nil, fmt.Errorf("unsupported protocol %s", proto)
+}
+
+// Available returns whether the host port could be listened on
+func Available(proto string, hostPort int) bool {
+	addr := net.JoinHostPort("", fmt.Sprintf("%d", hostPort))
+	if strings.EqualFold(proto, "udp") {
+		conn, err := net.ListenPacket("udp", addr)
+		if err != nil {
+			return false
+		}
+		conn.Close()
+		return true
+	}
+	l, err := net.Listen("tcp", addr)
+	if err != nil {
+		return false
+	}
+	l.Close()
+	return true
+}
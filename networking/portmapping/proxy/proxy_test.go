@@ -0,0 +1,104 @@
+package proxy
+
+import (
+	"io/ioutil"
+	"net"
+	"testing"
+	"time"
+)
+
+func TestTCPProxyHalfClose(t *testing.T) {
+	backend, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer backend.Close()
+	go func() {
+		c, err := backend.Accept()
+		if err != nil {
+			return
+		}
+		defer c.Close()
+		// reply after the request has been half-closed
+		req, _ := ioutil.ReadAll(c)
+		c.Write(append([]byte("echo:"), req...))
+	}()
+
+	p, err := NewTCPProxy("127.0.0.1:0", backend.Addr().String())
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer p.Close()
+	go p.Run()
+
+	conn, err := net.Dial("tcp", p.listener.Addr().String())
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer conn.Close()
+	conn.Write([]byte("ping"))
+	conn.(*net.TCPConn).CloseWrite()
+	conn.SetReadDeadline(time.Now().Add(5 * time.Second))
+	resp, err := ioutil.ReadAll(conn)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(resp) != "echo:ping" {
+		t.Fatalf("unexpected response %q", resp)
+	}
+
+	s := p.Stats()
+	if s.TotalConnections != 1 || s.BytesIn != 4 || s.BytesOut != 9 {
+		t.Fatalf("unexpected stats %#v", s)
+	}
+}
+
+func TestUDPProxySessionTimeout(t *testing.T) {
+	backend, err := net.ListenUDP("udp", &net.UDPAddr{IP: net.IPv4(127, 0, 0, 1)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer backend.Close()
+	go func() {
+		buf := make([]byte, 1024)
+		for {
+			n, from, err := backend.ReadFromUDP(buf)
+			if err != nil {
+				return
+			}
+			backend.WriteToUDP(buf[:n], from)
+		}
+	}()
+
+	p, err := NewUDPProxy("127.0.0.1:0", backend.LocalAddr().String())
+	if err != nil {
+		t.Fatal(err)
+	}
+	p.timeout = 200 * time.Millisecond
+	defer p.Close()
+	go p.Run()
+
+	conn, err := net.Dial("udp", p.listener.LocalAddr().String())
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer conn.Close()
+	conn.Write([]byte("ping"))
+	conn.SetReadDeadline(time.Now().Add(5 * time.Second))
+	buf := make([]byte, 1024)
+	n, err := conn.Read(buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(buf[:n]) != "ping" {
+		t.Fatalf("unexpected response %q", buf[:n])
+	}
+	if s := p.Stats(); s.ActiveConnections != 1 {
+		t.Fatalf("expect an active session, got %#v", s)
+	}
+
+	time.Sleep(time.Second)
+	if s := p.Stats(); s.ActiveConnections != 0 || s.TotalConnections != 1 {
+		t.Fatalf("expect the session timed out, got %#v", s)
+	}
+}
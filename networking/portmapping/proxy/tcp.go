@@ -0,0 +1,139 @@
+package proxy
+
+import (
+	"io"
+	"net"
+	"sync"
+	"sync/atomic"
+
+	"github.com/golang/glog"
+)
+
+// TCPProxy accepts the connections on the frontend and connects each of
+// them to the backend. A half-closed connection is half-closed on the
+// other side too, so that the peer gets the EOF while the response is still
+// being sent.
+type TCPProxy struct {
+	listener *net.TCPListener
+	backend  *net.TCPAddr
+	counters counters
+
+	mutex  sync.Mutex
+	conns  map[net.Conn]struct{}
+	closed bool
+}
+
+func NewTCPProxy(frontend, backend string) (*TCPProxy, error) {
+	baddr, err := net.ResolveTCPAddr("tcp", backend)
+	if err != nil {
+		return nil, err
+	}
+	faddr, err := net.ResolveTCPAddr("tcp", frontend)
+	if err != nil {
+		return nil, err
+	}
+	l, err := net.ListenTCP("tcp", faddr)
+	if err != nil {
+		return nil, err
+	}
+	return &TCPProxy{
+		listener: l,
+		backend:  baddr,
+		conns:    make(map[net.Conn]struct{}),
+	}, nil
+}
+
+func (p *TCPProxy) Run() {
+	for {
+		client, err := p.listener.AcceptTCP()
+		if err != nil {
+			glog.V(1).Infof("stop proxy on tcp/%v for %v: %v", p.listener.Addr(), p.backend, err)
+			return
+		}
+		go p.serve(client)
+	}
+}
+
+// track adds the connections to be closed by Close, it returns false if
+// the proxy has been closed.
+func (p *TCPProxy) track(conns ...net.Conn) bool {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+	if p.closed {
+		return false
+	}
+	for _, c := range conns {
+		p.conns[c] = struct{}{}
+	}
+	return true
+}
+
+func (p *TCPProxy) untrack(conns ...net.Conn) {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+	for _, c := range conns {
+		delete(p.conns, c)
+		c.Close()
+	}
+}
+
+func (p *TCPProxy) serve(client *net.TCPConn) {
+	atomic.AddUint64(&p.counters.total, 1)
+	conn, err := net.DialTimeout("tcp", p.backend.String(), DialTimeout)
+	if err != nil {
+		glog.Warningf("failed to forward tcp/%v to %v: %v", p.listener.Addr(), p.backend, err)
+		atomic.AddUint64(&p.counters.failed, 1)
+		client.Close()
+		return
+	}
+	backend := conn.(*net.TCPConn)
+	if !p.track(client, backend) {
+		client.Close()
+		backend.Close()
+		return
+	}
+	atomic.AddInt64(&p.counters.active, 1)
+	defer atomic.AddInt64(&p.counters.active, -1)
+
+	var wg sync.WaitGroup
+	pipe := func(dst, src *net.TCPConn, counter *uint64) {
+		defer wg.Done()
+		io.Copy(&countingWriter{w: dst, n: counter}, src)
+		// forward the EOF, and the other direction keeps going
+		dst.CloseWrite()
+	}
+	wg.Add(2)
+	go pipe(backend, client, &p.counters.bytesIn)
+	go pipe(client, backend, &p.counters.bytesOut)
+	wg.Wait()
+
+	p.untrack(client, backend)
+}
+
+func (p *TCPProxy) Close() {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+	if p.closed {
+		return
+	}
+	p.closed = true
+	p.listener.Close()
+	for c := range p.conns {
+		c.Close()
+	}
+}
+
+func (p *TCPProxy) Stats() Stats {
+	return p.counters.stats("tcp", p.listener.Addr(), p.backend)
+}
+
+type countingWriter struct {
+	w io.Writer
+	n *uint64
+}
+
+func (cw *countingWriter) Write(b []byte) (int, error) {
+	n, err := cw.w.Write(b)
+	atomic.AddUint64(cw.n, uint64(n))
+	return n, err
+}
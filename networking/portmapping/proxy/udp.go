@@ -0,0 +1,145 @@
+package proxy
+
+import (
+	"errors"
+	"net"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/golang/glog"
+)
+
+const udpBufSize = 65507
+
+var errProxyClosed = errors.New("proxy has been closed")
+
+// UDPProxy keeps a session, i.e. a socket to the backend, for each client
+// address. A session is closed if it is idle for UDPSessionTimeout.
+type UDPProxy struct {
+	listener *net.UDPConn
+	backend  *net.UDPAddr
+	timeout  time.Duration
+	counters counters
+
+	mutex    sync.Mutex
+	sessions map[string]*net.UDPConn
+	closed   bool
+}
+
+func NewUDPProxy(frontend, backend string) (*UDPProxy, error) {
+	baddr, err := net.ResolveUDPAddr("udp", backend)
+	if err != nil {
+		return nil, err
+	}
+	faddr, err := net.ResolveUDPAddr("udp", frontend)
+	if err != nil {
+		return nil, err
+	}
+	l, err := net.ListenUDP("udp", faddr)
+	if err != nil {
+		return nil, err
+	}
+	return &UDPProxy{
+		listener: l,
+		backend:  baddr,
+		timeout:  UDPSessionTimeout,
+		sessions: make(map[string]*net.UDPConn),
+	}, nil
+}
+
+func (p *UDPProxy) Run() {
+	buf := make([]byte, udpBufSize)
+	for {
+		n, from, err := p.listener.ReadFromUDP(buf)
+		if err != nil {
+			if ne, ok := err.(net.Error); ok && ne.Temporary() {
+				continue
+			}
+			glog.V(1).Infof("stop proxy on udp/%v for %v: %v", p.listener.LocalAddr(), p.backend, err)
+			return
+		}
+
+		conn, err := p.session(from)
+		if err != nil {
+			glog.Warningf("failed to forward udp/%v to %v: %v", p.listener.LocalAddr(), p.backend, err)
+			atomic.AddUint64(&p.counters.failed, 1)
+			continue
+		}
+		conn.SetReadDeadline(time.Now().Add(p.timeout))
+		written, err := conn.Write(buf[:n])
+		atomic.AddUint64(&p.counters.bytesIn, uint64(written))
+		if err != nil {
+			glog.V(1).Infof("failed to write to udp/%v: %v", p.backend, err)
+		}
+	}
+}
+
+// session returns the socket to the backend of the client, which is created
+// on the first datagram of the client.
+func (p *UDPProxy) session(client *net.UDPAddr) (*net.UDPConn, error) {
+	key := client.String()
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+	if conn, ok := p.sessions[key]; ok {
+		return conn, nil
+	}
+	if p.closed {
+		return nil, errProxyClosed
+	}
+	conn, err := net.DialUDP("udp", nil, p.backend)
+	if err != nil {
+		return nil, err
+	}
+	p.sessions[key] = conn
+	atomic.AddUint64(&p.counters.total, 1)
+	atomic.AddInt64(&p.counters.active, 1)
+	go p.reply(client, conn)
+	return conn, nil
+}
+
+// reply forwards the datagrams from the backend to the client, until the
+// session times out.
+func (p *UDPProxy) reply(client *net.UDPAddr, conn *net.UDPConn) {
+	defer func() {
+		p.mutex.Lock()
+		delete(p.sessions, client.String())
+		p.mutex.Unlock()
+		conn.Close()
+		atomic.AddInt64(&p.counters.active, -1)
+	}()
+
+	buf := make([]byte, udpBufSize)
+	for {
+		conn.SetReadDeadline(time.Now().Add(p.timeout))
+		n, err := conn.Read(buf)
+		if err != nil {
+			if ne, ok := err.(net.Error); ok && ne.Timeout() {
+				glog.V(3).Infof("udp session %v to %v timed out", client, p.backend)
+			}
+			return
+		}
+		written, err := p.listener.WriteToUDP(buf[:n], client)
+		atomic.AddUint64(&p.counters.bytesOut, uint64(written))
+		if err != nil {
+			return
+		}
+	}
+}
+
+func (p *UDPProxy) Close() {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+	if p.closed {
+		return
+	}
+	p.closed = true
+	p.listener.Close()
+	for _, conn := range p.sessions {
+		conn.Close()
+	}
+}
+
+func (p *UDPProxy) Stats() Stats {
+	return p.counters.stats("udp", p.listener.LocalAddr(), p.backend)
+}
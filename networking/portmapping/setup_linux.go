@@ -2,6 +2,8 @@ package portmapping
 
 import (
 	"fmt"
+	"io/ioutil"
+	"net"
 	"os"
 	"os/exec"
 
@@ -10,42 +12,73 @@ import (
 )
 
 var (
-	disableIptables bool
-	bridgeIface     string
+	bridgeIface string
 )
 
-//setup environment for iptables and IP forwarding
-func Setup(bIface, addr string, disable bool) error {
-	var err error
-
-	disableIptables = disable
+// setup environment for the host rules and IP forwarding, the rules are
+// programmed by backend, which is one of iptables, nftables or auto. The
+// userland backend proxies the host ports without any host rules, it works
+// even if iptables is disabled.
+func Setup(bIface, addr, backendName string, disable bool) error {
 	bridgeIface = bIface
 
-	if disableIptables {
-		hlog.Log(hlog.DEBUG, "Iptables is disabled")
+	if disable && backendName != BackendUserland {
+		// the host ports are left to others, e.g. hypernetes, the mappings
+		// are not reachable from the host ports otherwise
+		hlog.Log(hlog.WARNING, "Iptables is disabled, the host ports of the port mappings are not forwarded unless PortMappingBackend is %s", BackendUserland)
+		hostBackend = nil
 		return nil
 	}
 
-	hlog.Log(hlog.TRACE, "setting up iptables")
-	err = setupIPTables(addr)
+	b, err := newBackend(backendName)
+	if err != nil {
+		hlog.Log(hlog.ERROR, "failed to setup port mapping: %v", err)
+		return err
+	}
+	hostBackend = b
+
+	hlog.Log(hlog.INFO, "setting up port mapping backend %s", b.name())
+	err = b.setup(addr)
 	if err != nil {
-		hlog.Log(hlog.ERROR, "failed to setup iptables: %v", err)
+		hlog.Log(hlog.ERROR, "failed to setup %s: %v", b.name(), err)
 		return err
 	}
 
 	return nil
 }
 
-func setupIPTables(addr string) error {
-	if disableIptables {
+// SetupIPv6 setups the IPv6 rules with the IPv6 counterpart of the backend,
+// addr is the IPv6 address of the bridge. It should be called after Setup.
+func SetupIPv6(addr string) error {
+	hostBackend6 = nil
+	if hostBackend == nil {
 		return nil
 	}
+	b := newBackend6(hostBackend)
+	if b == nil {
+		return nil
+	}
+
+	hlog.Log(hlog.INFO, "setting up port mapping backend %s", b.name())
+	if err := b.setup(addr); err != nil {
+		hlog.Log(hlog.ERROR, "failed to setup %s: %v", b.name(), err)
+		return err
+	}
+	hostBackend6 = b
+	return nil
+}
+
+func setupIPTables(ipt *iptables.IPTables, addr string) error {
+	loopback := "127.0.0.1/8"
+	if ipt == iptables.IPv6 {
+		loopback = "::1/128"
+	}
 
 	// Enable NAT
 	natArgs := []string{"-s", addr, "!", "-o", bridgeIface, "-j", "MASQUERADE"}
 
-	if !iptables.Exists(iptables.Nat, "POSTROUTING", natArgs...) {
-		if output, err := iptables.Raw(append([]string{
+	if !ipt.Exists(iptables.Nat, "POSTROUTING", natArgs...) {
+		if output, err := ipt.Raw(append([]string{
 			"-t", string(iptables.Nat), "-I", "POSTROUTING"}, natArgs...)...); err != nil {
 			return fmt.Errorf("Unable to enable network bridge NAT: %s", err)
 		} else if len(output) != 0 {
@@ -54,12 +87,12 @@ func setupIPTables(addr string) error {
 	}
 
 	// Create HYPER iptables Chain
-	iptables.Raw("-N", "HYPER")
+	ipt.Raw("-N", "HYPER")
 
 	// Goto HYPER chain
 	gotoArgs := []string{"-o", bridgeIface, "-j", "HYPER"}
-	if !iptables.Exists(iptables.Filter, "FORWARD", gotoArgs...) {
-		if output, err := iptables.Raw(append([]string{"-I", "FORWARD"}, gotoArgs...)...); err != nil {
+	if !ipt.Exists(iptables.Filter, "FORWARD", gotoArgs...) {
+		if output, err := ipt.Raw(append([]string{"-I", "FORWARD"}, gotoArgs...)...); err != nil {
 			return fmt.Errorf("Unable to setup goto HYPER rule %s", err)
 		} else if len(output) != 0 {
 			return &iptables.ChainError{Chain: "FORWARD goto HYPER", Output: output}
@@ -68,8 +101,8 @@ func setupIPTables(addr string) error {
 
 	// Accept all outgoing packets
 	outgoingArgs := []string{"-i", bridgeIface, "-j", "ACCEPT"}
-	if !iptables.Exists(iptables.Filter, "FORWARD", outgoingArgs...) {
-		if output, err := iptables.Raw(append([]string{"-I", "FORWARD"}, outgoingArgs...)...); err != nil {
+	if !ipt.Exists(iptables.Filter, "FORWARD", outgoingArgs...) {
+		if output, err := ipt.Raw(append([]string{"-I", "FORWARD"}, outgoingArgs...)...); err != nil {
 			return fmt.Errorf("Unable to allow outgoing packets: %s", err)
 		} else if len(output) != 0 {
 			return &iptables.ChainError{Chain: "FORWARD outgoing", Output: output}
@@ -79,37 +112,25 @@ func setupIPTables(addr string) error {
 	// Accept incoming packets for existing connections
 	existingArgs := []string{"-o", bridgeIface, "-m", "conntrack", "--ctstate", "RELATED,ESTABLISHED", "-j", "ACCEPT"}
 
-	if !iptables.Exists(iptables.Filter, "FORWARD", existingArgs...) {
-		if output, err := iptables.Raw(append([]string{"-I", "FORWARD"}, existingArgs...)...); err != nil {
+	if !ipt.Exists(iptables.Filter, "FORWARD", existingArgs...) {
+		if output, err := ipt.Raw(append([]string{"-I", "FORWARD"}, existingArgs...)...); err != nil {
 			return fmt.Errorf("Unable to allow incoming packets: %s", err)
 		} else if len(output) != 0 {
 			return &iptables.ChainError{Chain: "FORWARD incoming", Output: output}
 		}
 	}
 
-	err := Modprobe("br_netfilter")
-	if err != nil {
-		hlog.Log(hlog.DEBUG, "modprobe br_netfilter failed %s", err)
-	}
-
-	file, err := os.OpenFile("/proc/sys/net/bridge/bridge-nf-call-iptables",
-		os.O_RDWR, 0)
-	if err != nil {
-		return err
-	}
-
-	_, err = file.WriteString("1")
-	if err != nil {
+	if err := enableBridgeNetfilter(); err != nil {
 		return err
 	}
 
 	// Create HYPER iptables Chain
-	iptables.Raw("-t", string(iptables.Nat), "-N", "HYPER")
+	ipt.Raw("-t", string(iptables.Nat), "-N", "HYPER")
 	// Goto HYPER chain
 	gotoArgs = []string{"-m", "addrtype", "--dst-type", "LOCAL", "!",
-		"-d", "127.0.0.1/8", "-j", "HYPER"}
-	if !iptables.Exists(iptables.Nat, "OUTPUT", gotoArgs...) {
-		if output, err := iptables.Raw(append([]string{"-t", string(iptables.Nat),
+		"-d", loopback, "-j", "HYPER"}
+	if !ipt.Exists(iptables.Nat, "OUTPUT", gotoArgs...) {
+		if output, err := ipt.Raw(append([]string{"-t", string(iptables.Nat),
 			"-I", "OUTPUT"}, gotoArgs...)...); err != nil {
 			return fmt.Errorf("Unable to setup goto HYPER rule %s", err)
 		} else if len(output) != 0 {
@@ -119,8 +140,8 @@ func setupIPTables(addr string) error {
 
 	gotoArgs = []string{"-m", "addrtype", "--dst-type", "LOCAL",
 		"-j", "HYPER"}
-	if !iptables.Exists(iptables.Nat, "PREROUTING", gotoArgs...) {
-		if output, err := iptables.Raw(append([]string{"-t", string(iptables.Nat),
+	if !ipt.Exists(iptables.Nat, "PREROUTING", gotoArgs...) {
+		if output, err := ipt.Raw(append([]string{"-t", string(iptables.Nat),
 			"-I", "PREROUTING"}, gotoArgs...)...); err != nil {
 			return fmt.Errorf("Unable to setup goto HYPER rule %s", err)
 		} else if len(output) != 0 {
@@ -131,6 +152,121 @@ func setupIPTables(addr string) error {
 	return nil
 }
 
+// SetupNetwork adds the NAT and forward rules of the bridge of a user-defined
+// network with the subnet in CIDR, the same as the default bridge has except
+// the port mappings. It is called again for the existing networks when the
+// daemon restarts.
+func SetupNetwork(bridge, subnet string) error {
+	b, err := networkBackend(subnet)
+	if err != nil || b == nil {
+		return err
+	}
+	if err = b.setupNetwork(bridge, subnet); err != nil {
+		hlog.Log(hlog.ERROR, "failed to setup the rules of bridge %s with %s: %v", bridge, b.name(), err)
+	}
+	return err
+}
+
+// ReleaseNetwork removes the rules added by SetupNetwork
+func ReleaseNetwork(bridge, subnet string) error {
+	b, err := networkBackend(subnet)
+	if err != nil || b == nil {
+		return err
+	}
+	if err = b.releaseNetwork(bridge, subnet); err != nil {
+		hlog.Log(hlog.ERROR, "failed to remove the rules of bridge %s with %s: %v", bridge, b.name(), err)
+	}
+	return err
+}
+
+// networkBackend gets the backend of the family of the subnet, it is nil if
+// the host rules are disabled.
+func networkBackend(subnet string) (backend, error) {
+	ip, _, err := net.ParseCIDR(subnet)
+	if err != nil {
+		return nil, fmt.Errorf("invalid subnet %s: %v", subnet, err)
+	}
+	switch {
+	case hostBackend == nil || ip.To4() != nil:
+		return hostBackend, nil
+	case hostBackend6 != nil:
+		return hostBackend6, nil
+	}
+	// the default bridge has no IPv6 prefix
+	return newBackend6(hostBackend), nil
+}
+
+// iptablesRule is a rule of the chain in the table
+type iptablesRule struct {
+	table iptables.Table
+	chain string
+	args  []string
+}
+
+func networkIptablesRules(bridge, subnet string) []iptablesRule {
+	return []iptablesRule{
+		{iptables.Nat, "POSTROUTING", []string{"-s", subnet, "!", "-o", bridge, "-j", "MASQUERADE"}},
+		{iptables.Filter, "FORWARD", []string{"-i", bridge, "-j", "ACCEPT"}},
+		{iptables.Filter, "FORWARD", []string{"-o", bridge, "-m", "conntrack", "--ctstate", "RELATED,ESTABLISHED", "-j", "ACCEPT"}},
+	}
+}
+
+func setupIptablesNetwork(ipt *iptables.IPTables, bridge, subnet string) error {
+	for _, r := range networkIptablesRules(bridge, subnet) {
+		if ipt.Exists(r.table, r.chain, r.args...) {
+			continue
+		}
+		if output, err := ipt.Raw(append([]string{"-t", string(r.table), "-I", r.chain}, r.args...)...); err != nil {
+			return fmt.Errorf("Unable to setup %s rule of bridge %s: %s", r.chain, bridge, err)
+		} else if len(output) != 0 {
+			return &iptables.ChainError{Chain: r.chain, Output: output}
+		}
+	}
+	return nil
+}
+
+func releaseIptablesNetwork(ipt *iptables.IPTables, bridge, subnet string) error {
+	var result error
+	for _, r := range networkIptablesRules(bridge, subnet) {
+		if !ipt.Exists(r.table, r.chain, r.args...) {
+			continue
+		}
+		if output, err := ipt.Raw(append([]string{"-t", string(r.table), "-D", r.chain}, r.args...)...); err != nil {
+			result = fmt.Errorf("Unable to remove %s rule of bridge %s: %s", r.chain, bridge, err)
+		} else if len(output) != 0 {
+			result = &iptables.ChainError{Chain: r.chain, Output: output}
+		}
+	}
+	return result
+}
+
+// enableBridgeNetfilter passes the bridged packets to the netfilter hooks,
+// which is required by the DNAT of the port mappings.
+func enableBridgeNetfilter() error {
+	err := Modprobe("br_netfilter")
+	if err != nil {
+		hlog.Log(hlog.DEBUG, "modprobe br_netfilter failed %s", err)
+	}
+
+	file, err := os.OpenFile("/proc/sys/net/bridge/bridge-nf-call-iptables",
+		os.O_RDWR, 0)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	_, err = file.WriteString("1")
+	if err != nil {
+		return err
+	}
+
+	// optional, the IPv6 rules are not setup without IPv6 prefix
+	if err := ioutil.WriteFile("/proc/sys/net/bridge/bridge-nf-call-ip6tables", []byte("1"), 0644); err != nil {
+		hlog.Log(hlog.DEBUG, "failed to enable bridge-nf-call-ip6tables: %v", err)
+	}
+	return nil
+}
+
 func Modprobe(module string) error {
 	modprobePath, err := exec.LookPath("modprobe")
 	if err != nil {
@@ -0,0 +1,176 @@
+package portmapping
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/hyperhq/hypercontainer-utils/hlog"
+	"github.com/hyperhq/hyperd/networking/portmapping/proxy"
+)
+
+// userlandBackend forwards the host ports with the userland proxies instead
+// of the host rules, which are not touched at all.
+type userlandBackend struct {
+	mutex   sync.Mutex
+	proxies map[string]proxy.Proxy
+}
+
+func newUserlandBackend() *userlandBackend {
+	return &userlandBackend{
+		proxies: make(map[string]proxy.Proxy),
+	}
+}
+
+// SetProxyUDPTimeout sets how long an idle UDP session of the userland
+// proxies is kept.
+func SetProxyUDPTimeout(timeout time.Duration) {
+	proxy.UDPSessionTimeout = timeout
+}
+
+func proxyKey(proto string, hostPort int) string {
+	return fmt.Sprintf("%s/%d", proto, hostPort)
+}
+
+func (b *userlandBackend) name() string {
+	return BackendUserland
+}
+
+func (b *userlandBackend) setup(addr string) error {
+	return nil
+}
+
+// the userland backend does not touch the host rules, the bridges are not
+// masqueraded as well
+func (b *userlandBackend) setupNetwork(bridge, subnet string) error {
+	return nil
+}
+
+func (b *userlandBackend) releaseNetwork(bridge, subnet string) error {
+	return nil
+}
+
+func (b *userlandBackend) setupMaps(containerip string, maps []*PortMapping) (err error) {
+	var (
+		started  = []string{}
+		reserved = []*PortMapping{}
+	)
+	defer func() {
+		if err != nil {
+			b.stop(started...)
+			for _, m := range reserved {
+				for p := m.FromPorts.Begin; p <= m.FromPorts.End; p++ {
+					PortMapper.ReleaseMap(m.Protocol, p)
+				}
+			}
+		}
+	}()
+
+	for _, m := range maps {
+		m.Protocol = normalizeProto(m.Protocol)
+		if m.FromPorts.End == 0 || m.FromPorts.End < m.FromPorts.Begin {
+			m.FromPorts.End = m.FromPorts.Begin
+		}
+		if m.ToPorts.End == 0 || m.ToPorts.End < m.ToPorts.Begin {
+			m.ToPorts.End = m.ToPorts.Begin
+		}
+		//we may map ports 1:N or N:N, but not M:N (M!=1, M!=N)
+		hostRange := m.FromPorts.End - m.FromPorts.Begin
+		if hostRange != 0 && hostRange != m.ToPorts.End-m.ToPorts.Begin {
+			return fmt.Errorf("range mismatch, cannot map ports %s to %s", m.FromPorts.String(), m.ToPorts.String())
+		}
+
+		if !m.allocated {
+			if err = reserveHostPorts(m.Protocol, m.FromPorts.Begin, m.FromPorts.End, containerip, m.ToPorts.Begin); err != nil {
+				return err
+			}
+			reserved = append(reserved, m)
+		}
+
+		for i := 0; i <= hostRange; i++ {
+			key := proxyKey(m.Protocol, m.FromPorts.Begin+i)
+			p, err := proxy.New(m.Protocol, m.FromPorts.Begin+i, containerip, m.ToPorts.Begin+i)
+			if err != nil {
+				return fmt.Errorf("Unable to proxy host port %s: %v", key, err)
+			}
+			b.mutex.Lock()
+			if old, ok := b.proxies[key]; ok {
+				old.Close()
+			}
+			b.proxies[key] = p
+			b.mutex.Unlock()
+			started = append(started, key)
+			go p.Run()
+		}
+	}
+	return nil
+}
+
+func (b *userlandBackend) stop(keys ...string) {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+	for _, key := range keys {
+		if p, ok := b.proxies[key]; ok {
+			p.Close()
+			delete(b.proxies, key)
+		}
+	}
+}
+
+func (b *userlandBackend) releaseMaps(containerip string, maps []*PortMapping) error {
+
+release_loop:
+	for _, m := range maps {
+		m.Protocol = normalizeProto(m.Protocol)
+		if m.FromPorts.End == 0 {
+			m.FromPorts.End = m.FromPorts.Begin
+		}
+
+		hlog.Log(hlog.DEBUG, "release port map %d-%d/%s", m.FromPorts.Begin, m.FromPorts.End, m.Protocol)
+		keys := []string{}
+		for i := m.FromPorts.Begin; i <= m.FromPorts.End; i++ {
+			err := PortMapper.ReleaseMap(m.Protocol, i)
+			if err != nil {
+				continue release_loop
+			}
+			keys = append(keys, proxyKey(m.Protocol, i))
+		}
+		b.stop(keys...)
+	}
+	return nil
+}
+
+// portMapUsed returns whether the host ports are listened on by others
+func (b *userlandBackend) portMapUsed(proto string, begin, end int) (bool, error) {
+	for p := begin; p <= end; p++ {
+		if !proxy.Available(proto, p) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func (b *userlandBackend) stats() []proxy.Stats {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+	keys := make([]string, 0, len(b.proxies))
+	for key := range b.proxies {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	result := make([]proxy.Stats, 0, len(keys))
+	for _, key := range keys {
+		result = append(result, b.proxies[key].Stats())
+	}
+	return result
+}
+
+// ProxyStats returns the counters of the userland proxies, which is empty if
+// the userland backend is not used.
+func ProxyStats() []proxy.Stats {
+	if b, ok := hostBackend.(*userlandBackend); ok {
+		return b.stats()
+	}
+	return nil
+}
@@ -0,0 +1,29 @@
+package server
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/hyperhq/hyperd/lib/metrics"
+)
+
+var apiRequestLatency = metrics.NewHistogramVec(
+	"hyperd_api_request_duration_seconds",
+	"Time spent on serving the API requests, by method and route.",
+	nil,
+	"method", "route")
+
+func init() {
+	metrics.Register(apiRequestLatency)
+}
+
+// instrumentHandler observes the latency of the requests to the route. The
+// response writer is not wrapped, so that the hijacking of attach and exec
+// still works, and the streaming requests are observed on their end.
+func instrumentHandler(method, route string, handler http.HandlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		start := time.Now()
+		handler(w, r)
+		apiRequestLatency.Observe(time.Since(start).Seconds(), method, route)
+	}
+}
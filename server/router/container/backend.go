@@ -5,6 +5,7 @@ import (
 
 	"github.com/docker/engine-api/types"
 	"github.com/hyperhq/hyperd/daemon"
+	"github.com/hyperhq/hyperd/daemon/pod"
 	"github.com/hyperhq/hyperd/engine"
 )
 
@@ -21,7 +22,9 @@ type Backend interface {
 	CmdAttach(in io.ReadCloser, out io.WriteCloser, id string) error
 	CmdCommitImage(name string, cfg *types.ContainerCommitConfig) (*engine.Env, error)
 	CmdTtyResize(podId, tag string, h, w int) error
-	CreateExec(id, cmd string, terminal bool) (string, error)
-	StartExec(stdin io.ReadCloser, stdout io.WriteCloser, containerId, execId string) error
+	CreateExec(id, cmd string, terminal bool, opts *pod.ExecOptions) (string, error)
+	StartExec(stdin io.ReadCloser, stdout io.WriteCloser, stderr io.Writer, containerId, execId string) error
+	CopyFromContainer(container, path string) (io.ReadCloser, error)
+	CopyToContainer(container, path string, content io.Reader) error
 	ExecVM(podID, cmd string, stdin io.ReadCloser, stdout, stderr io.WriteCloser) (int, error)
 }
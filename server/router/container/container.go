@@ -32,6 +32,7 @@ func (r *containerRouter) initRoutes() {
 		local.NewGetRoute("/container/info", r.getContainerInfo),
 		local.NewGetRoute("/container/logs", r.getContainerLogs),
 		local.NewGetRoute("/exitcode", r.getExitCode),
+		local.NewGetRoute("/container/archive", r.getContainerArchive),
 		// POST
 		local.NewPostRoute("/container/create", r.postContainerCreate),
 		local.NewPostRoute("/container/start", r.postContainerStart),
@@ -46,6 +47,7 @@ func (r *containerRouter) initRoutes() {
 		local.NewPostRoute("/tty/resize", r.postTtyResize),
 		local.NewPostRoute("/execvm", r.postExecVM),
 		// PUT
+		local.NewPutRoute("/container/archive", r.putContainerArchive),
 		// DELETE
 	}
 }
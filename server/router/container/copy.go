@@ -0,0 +1,38 @@
+package container
+
+import (
+	"io"
+	"net/http"
+
+	"github.com/docker/docker/api/server/httputils"
+	"golang.org/x/net/context"
+)
+
+func (s *containerRouter) getContainerArchive(ctx context.Context, w http.ResponseWriter, r *http.Request, vars map[string]string) error {
+	if err := httputils.ParseForm(r); err != nil {
+		return err
+	}
+
+	content, err := s.backend.CopyFromContainer(r.Form.Get("container"), r.Form.Get("path"))
+	if err != nil {
+		return err
+	}
+	defer content.Close()
+
+	w.Header().Set("Content-Type", "application/x-tar")
+	_, err = io.Copy(w, content)
+	return err
+}
+
+func (s *containerRouter) putContainerArchive(ctx context.Context, w http.ResponseWriter, r *http.Request, vars map[string]string) error {
+	if err := httputils.ParseForm(r); err != nil {
+		return err
+	}
+
+	if err := s.backend.CopyToContainer(r.Form.Get("container"), r.Form.Get("path"), r.Body); err != nil {
+		return err
+	}
+
+	w.WriteHeader(http.StatusOK)
+	return nil
+}
@@ -7,6 +7,7 @@ import (
 	"strconv"
 
 	"github.com/docker/docker/api/server/httputils"
+	"github.com/hyperhq/hyperd/daemon/pod"
 	"golang.org/x/net/context"
 )
 
@@ -32,8 +33,15 @@ func (s *containerRouter) postContainerExecCreate(ctx context.Context, w http.Re
 	command := r.Form.Get("command")
 	tty := r.Form.Get("tty")
 	terminal := tty == "yes" || tty == "true" || tty == "on"
+	opts := &pod.ExecOptions{
+		Envs:       r.Form["env"],
+		User:       r.Form.Get("user"),
+		Group:      r.Form.Get("group"),
+		Workdir:    r.Form.Get("workdir"),
+		Privileged: httputils.BoolValue(r, "privileged"),
+	}
 
-	execId, err := s.backend.CreateExec(id, command, terminal)
+	execId, err := s.backend.CreateExec(id, command, terminal, opts)
 	if err != nil {
 		return err
 	}
@@ -77,7 +85,7 @@ func (s *containerRouter) postContainerExecStart(ctx context.Context, w http.Res
 	defer httputils.CloseStreams(inStream, outStream)
 	fmt.Fprintf(outStream, "HTTP/1.1 101 UPGRADED\r\nContent-Type: application/vnd.docker.raw-stream\r\nConnection: Upgrade\r\nUpgrade: tcp\r\n\r\n")
 
-	return s.backend.StartExec(inStream, outStream.(io.WriteCloser), id, execId)
+	return s.backend.StartExec(inStream, outStream.(io.WriteCloser), nil, id, execId)
 }
 
 func (s *containerRouter) postContainerAttach(ctx context.Context, w http.ResponseWriter, r *http.Request, vars map[string]string) error {
@@ -0,0 +1,36 @@
+package network
+
+import (
+	"github.com/hyperhq/hyperd/server/router"
+	"github.com/hyperhq/hyperd/server/router/local"
+)
+
+// networkRouter is a router to talk with the user-defined networks controller.
+type networkRouter struct {
+	backend Backend
+	routes  []router.Route
+}
+
+// NewRouter initializes a new networkRouter
+func NewRouter(b Backend) router.Router {
+	r := &networkRouter{
+		backend: b,
+	}
+
+	r.routes = []router.Route{
+		// GET
+		local.NewGetRoute("/network/list", r.getNetworks),
+		local.NewGetRoute("/network/info", r.getNetworkInfo),
+		// POST
+		local.NewPostRoute("/network/create", r.postNetworkCreate),
+		// DELETE
+		local.NewDeleteRoute("/network", r.deleteNetwork),
+	}
+
+	return r
+}
+
+// Routes return all the API routes dedicated to the user-defined networks.
+func (n *networkRouter) Routes() []router.Route {
+	return n.routes
+}
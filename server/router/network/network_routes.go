@@ -0,0 +1,58 @@
+package network
+
+import (
+	"io/ioutil"
+	"net/http"
+
+	"github.com/hyperhq/hyperd/server/httputils"
+	"golang.org/x/net/context"
+)
+
+func (n *networkRouter) getNetworks(ctx context.Context, w http.ResponseWriter, r *http.Request, vars map[string]string) error {
+	nets, err := n.backend.CmdListNetworks()
+	if err != nil {
+		return err
+	}
+
+	return httputils.WriteJSON(w, http.StatusOK, map[string]interface{}{"networks": nets})
+}
+
+func (n *networkRouter) getNetworkInfo(ctx context.Context, w http.ResponseWriter, r *http.Request, vars map[string]string) error {
+	if err := httputils.ParseForm(r); err != nil {
+		return err
+	}
+
+	info, err := n.backend.CmdInspectNetwork(r.Form.Get("name"))
+	if err != nil {
+		return err
+	}
+
+	return httputils.WriteJSON(w, http.StatusOK, info)
+}
+
+func (n *networkRouter) postNetworkCreate(ctx context.Context, w http.ResponseWriter, r *http.Request, vars map[string]string) error {
+	if err := httputils.CheckForJSON(r); err != nil {
+		return err
+	}
+
+	data, _ := ioutil.ReadAll(r.Body)
+	info, err := n.backend.CmdCreateNetwork(data)
+	if err != nil {
+		return err
+	}
+
+	return httputils.WriteJSON(w, http.StatusCreated, info)
+}
+
+func (n *networkRouter) deleteNetwork(ctx context.Context, w http.ResponseWriter, r *http.Request, vars map[string]string) error {
+	if err := httputils.ParseForm(r); err != nil {
+		return err
+	}
+
+	if err := n.backend.CmdRemoveNetwork(r.Form.Get("name")); err != nil {
+		return err
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+	return nil
+}
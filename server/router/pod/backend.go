@@ -1,26 +1,37 @@
 package pod
 
 import (
+	"time"
+
+	"github.com/hyperhq/hyperd/daemon/stats"
 	"github.com/hyperhq/hyperd/engine"
+	apitypes "github.com/hyperhq/hyperd/types"
 )
 
 // Backend is the methods that need to be implemented to provide
 // system specific functionality.
 type Backend interface {
-	CmdGetPodInfo(podName string) (interface{}, error)
+	CmdGetPodInfo(podName string, volumeUsage bool) (interface{}, error)
 	CmdGetPodStats(podId string) (interface{}, error)
+	SubscribePodStats(podIDs []string, interval time.Duration) (<-chan *stats.Sample, func(), error)
 	CmdCreatePod(podArgs string) (*engine.Env, error)
 	CmdSetPodLabels(podId string, override bool, labels map[string]string) (*engine.Env, error)
 	CmdStartPod(podId string) (*engine.Env, error)
 	CmdPausePod(podId string) error
 	CmdUnpausePod(podId string) error
-	CmdList(item, podId, vmId string) (*engine.Env, error)
+	CmdCheckpointPod(podId, dir string) error
+	CmdRestorePod(dir string, coldBoot bool) (*engine.Env, error)
+	CmdList(item, podId, vmId string, filter *apitypes.ListFilter) (*engine.Env, error)
 	CmdStopPod(podId, stopVm string) (*engine.Env, error)
 	CmdKillPod(podName, container string, signal int64) (*engine.Env, error)
 	CmdCleanPod(podId string) (*engine.Env, error)
+	CmdUpdatePodResources(podId string, res []byte) (*engine.Env, error)
 
 	//port mapping
 	CmdListPortMappings(podId string) (*engine.Env, error)
 	CmdAddPortMappings(podId string, pms []byte) (*engine.Env, error)
 	CmdDeletePortMappings(podId string, pms []byte) (*engine.Env, error)
+	CmdListInterfaces(podId string) (*engine.Env, error)
+	CmdAddInterface(podId string, spec []byte) (*engine.Env, error)
+	CmdRemoveInterface(podId, ifname string) (*engine.Env, error)
 }
@@ -22,6 +22,7 @@ func NewRouter(b Backend) router.Router {
 		local.NewGetRoute("/pod/info", r.getPodInfo),
 		local.NewGetRoute("/pod/stats", r.getPodStats),
 		local.NewGetRoute("/pod/{id}/portmappings", r.getPortMappings),
+		local.NewGetRoute("/pod/{id}/interfaces", r.getInterfaces),
 		local.NewGetRoute("/list", r.getList),
 		// POST
 		local.NewPostRoute("/pod/create", r.postPodCreate),
@@ -31,10 +32,15 @@ func NewRouter(b Backend) router.Router {
 		local.NewPostRoute("/pod/kill", r.postPodKill),
 		local.NewPostRoute("/pod/pause", r.postPodPause),
 		local.NewPostRoute("/pod/unpause", r.postPodUnpause),
+		local.NewPostRoute("/pod/checkpoint", r.postPodCheckpoint),
+		local.NewPostRoute("/pod/restore", r.postPodRestore),
+		local.NewPostRoute("/pod/{id}/interfaces", r.postInterface),
 		// PUT
 		local.NewPutRoute("/pod/{id}/portmappings/{action}", r.putPortMappings),
+		local.NewPutRoute("/pod/{id}/resources", r.putPodResources),
 		// DELETE
 		local.NewDeleteRoute("/pod", r.deletePod),
+		local.NewDeleteRoute("/pod/{id}/interfaces/{ifname}", r.deleteInterface),
 	}
 
 	return r
@@ -2,11 +2,15 @@ package pod
 
 import (
 	"encoding/json"
+	"fmt"
 	"io/ioutil"
 	"net/http"
+	"time"
 
+	"github.com/docker/docker/pkg/ioutils"
 	"github.com/golang/glog"
 	"github.com/hyperhq/hyperd/server/httputils"
+	apitypes "github.com/hyperhq/hyperd/types"
 	"golang.org/x/net/context"
 )
 
@@ -15,7 +19,7 @@ func (p *podRouter) getPodInfo(ctx context.Context, w http.ResponseWriter, r *ht
 		return err
 	}
 
-	data, err := p.backend.CmdGetPodInfo(r.Form.Get("podName"))
+	data, err := p.backend.CmdGetPodInfo(r.Form.Get("podName"), httputils.BoolValue(r, "volumeUsage"))
 	if err != nil {
 		return err
 	}
@@ -28,6 +32,10 @@ func (p *podRouter) getPodStats(ctx context.Context, w http.ResponseWriter, r *h
 		return err
 	}
 
+	if httputils.BoolValue(r, "stream") {
+		return p.streamPodStats(ctx, w, r)
+	}
+
 	data, err := p.backend.CmdGetPodStats(r.Form.Get("podId"))
 	if err != nil {
 		return err
@@ -36,6 +44,48 @@ func (p *podRouter) getPodStats(ctx context.Context, w http.ResponseWriter, r *h
 	return httputils.WriteJSON(w, http.StatusOK, data)
 }
 
+// streamPodStats streams the samples of the pods as json objects, which
+// carry the rates only, the cumulative counters are in the unary stats.
+func (p *podRouter) streamPodStats(ctx context.Context, w http.ResponseWriter, r *http.Request) error {
+	interval, err := httputils.Int64ValueOrDefault(r, "interval", 0)
+	if err != nil {
+		return err
+	}
+	ch, cancel, err := p.backend.SubscribePodStats(r.Form["podId"], time.Duration(interval)*time.Second)
+	if err != nil {
+		return err
+	}
+	defer cancel()
+
+	var closeNotifier <-chan bool
+	if notifier, ok := w.(http.CloseNotifier); ok {
+		closeNotifier = notifier.CloseNotify()
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	output := ioutils.NewWriteFlusher(w)
+	defer output.Close()
+	output.Flush()
+
+	enc := json.NewEncoder(output)
+	for {
+		select {
+		case sample, ok := <-ch:
+			if !ok {
+				return nil
+			}
+			if err := enc.Encode(sample.Response()); err != nil {
+				return nil
+			}
+		case <-closeNotifier:
+			return nil
+		case <-ctx.Done():
+			return nil
+		}
+	}
+}
+
 func (p *podRouter) getList(ctx context.Context, w http.ResponseWriter, r *http.Request, vars map[string]string) error {
 	if err := httputils.ParseForm(r); err != nil {
 		return err
@@ -45,9 +95,15 @@ func (p *podRouter) getList(ctx context.Context, w http.ResponseWriter, r *http.
 	pod := r.Form.Get("pod")
 	vm := r.Form.Get("vm")
 
-	glog.V(1).Infof("List type is %s, specified pod: [%s], specified vm: [%s]", item, pod, vm)
+	filter := &apitypes.ListFilter{
+		LabelSelector: r.Form.Get("label"),
+		Status:        r.Form["status"],
+		Image:         r.Form["image"],
+	}
+
+	glog.V(1).Infof("List type is %s, specified pod: [%s], specified vm: [%s], filter: %s", item, pod, vm, filter.String())
 
-	env, err := p.backend.CmdList(item, pod, vm)
+	env, err := p.backend.CmdList(item, pod, vm, filter)
 	if err != nil {
 		return err
 	}
@@ -184,6 +240,41 @@ func (p *podRouter) postPodUnpause(ctx context.Context, w http.ResponseWriter, r
 	return nil
 }
 
+func (p *podRouter) postPodCheckpoint(ctx context.Context, w http.ResponseWriter, r *http.Request, vars map[string]string) error {
+	if err := httputils.ParseForm(r); err != nil {
+		return err
+	}
+
+	podId := r.Form.Get("podId")
+	dir := r.Form.Get("dir")
+	if dir == "" {
+		return fmt.Errorf("checkpoint directory is required")
+	}
+	if err := p.backend.CmdCheckpointPod(podId, dir); err != nil {
+		return err
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+	return nil
+}
+
+func (p *podRouter) postPodRestore(ctx context.Context, w http.ResponseWriter, r *http.Request, vars map[string]string) error {
+	if err := httputils.ParseForm(r); err != nil {
+		return err
+	}
+
+	dir := r.Form.Get("dir")
+	if dir == "" {
+		return fmt.Errorf("checkpoint directory is required")
+	}
+	env, err := p.backend.CmdRestorePod(dir, httputils.BoolValue(r, "coldBoot"))
+	if err != nil {
+		return err
+	}
+
+	return env.WriteJSON(w, http.StatusCreated)
+}
+
 func (p *podRouter) deletePod(ctx context.Context, w http.ResponseWriter, r *http.Request, vars map[string]string) error {
 	if err := httputils.ParseForm(r); err != nil {
 		return err
@@ -230,3 +321,51 @@ func (p *podRouter) putPortMappings(ctx context.Context, w http.ResponseWriter,
 	}
 	return nil
 }
+
+// network interfaces
+func (p *podRouter) getInterfaces(ctx context.Context, w http.ResponseWriter, r *http.Request, vars map[string]string) error {
+	env, err := p.backend.CmdListInterfaces(vars["id"])
+	if err != nil {
+		return err
+	}
+
+	return env.WriteJSON(w, http.StatusOK)
+}
+
+func (p *podRouter) postInterface(ctx context.Context, w http.ResponseWriter, r *http.Request, vars map[string]string) error {
+	if err := httputils.CheckForJSON(r); err != nil {
+		return err
+	}
+
+	spec, _ := ioutil.ReadAll(r.Body)
+	env, err := p.backend.CmdAddInterface(vars["id"], spec)
+	if err != nil {
+		return err
+	}
+
+	return env.WriteJSON(w, http.StatusCreated)
+}
+
+func (p *podRouter) deleteInterface(ctx context.Context, w http.ResponseWriter, r *http.Request, vars map[string]string) error {
+	_, err := p.backend.CmdRemoveInterface(vars["id"], vars["ifname"])
+	if err != nil {
+		return err
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+	return nil
+}
+
+func (p *podRouter) putPodResources(ctx context.Context, w http.ResponseWriter, r *http.Request, vars map[string]string) error {
+	if err := httputils.CheckForJSON(r); err != nil {
+		return err
+	}
+
+	res, _ := ioutil.ReadAll(r.Body)
+	if _, err := p.backend.CmdUpdatePodResources(vars["id"], res); err != nil {
+		return err
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+	return nil
+}
@@ -2,7 +2,9 @@ package system
 
 import (
 	"github.com/docker/engine-api/types"
+	"github.com/hyperhq/hyperd/daemon/events"
 	"github.com/hyperhq/hyperd/engine"
+	"github.com/hyperhq/hyperd/lib/metrics"
 	apitypes "github.com/hyperhq/hyperd/types"
 )
 
@@ -11,5 +13,8 @@ import (
 type Backend interface {
 	CmdSystemInfo() (*apitypes.InfoResponse, error)
 	CmdSystemVersion() *engine.Env
+	CmdMetrics() []*metrics.Family
+	CmdSystemPrune(dryRun bool) ([]*apitypes.OrphanResource, error)
 	CmdAuthenticateToRegistry(authConfig *types.AuthConfig) (string, error)
+	SubscribeEvents(filter *events.Filter, since int64) ([]*apitypes.Event, <-chan *apitypes.Event, func())
 }
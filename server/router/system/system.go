@@ -23,7 +23,10 @@ func NewRouter(b Backend) router.Router {
 		local.NewGetRoute("/_ping", pingHandler),
 		local.NewGetRoute("/info", r.getInfo),
 		local.NewGetRoute("/version", r.getVersion),
+		local.NewGetRoute("/events", r.getEvents),
+		local.NewGetRoute("/metrics", r.getMetrics),
 		local.NewPostRoute("/auth", r.postAuth),
+		local.NewPostRoute("/system/prune", r.postPrune),
 	}
 
 	return r
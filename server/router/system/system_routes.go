@@ -3,9 +3,14 @@ package system
 import (
 	"encoding/json"
 	"net/http"
+	"time"
 
+	"github.com/docker/docker/pkg/ioutils"
 	"github.com/docker/engine-api/types"
+	timetypes "github.com/docker/engine-api/types/time"
+	"github.com/hyperhq/hyperd/daemon/events"
 	"github.com/hyperhq/hyperd/engine"
+	"github.com/hyperhq/hyperd/lib/metrics"
 	"github.com/hyperhq/hyperd/server/httputils"
 	"golang.org/x/net/context"
 )
@@ -52,6 +57,12 @@ func (s *systemRouter) getVersion(ctx context.Context, w http.ResponseWriter, r
 	return env.WriteJSON(w, http.StatusOK)
 }
 
+func (s *systemRouter) getMetrics(ctx context.Context, w http.ResponseWriter, r *http.Request, vars map[string]string) error {
+	w.Header().Set("Content-Type", metrics.ContentType)
+	w.WriteHeader(http.StatusOK)
+	return metrics.WriteText(w, s.backend.CmdMetrics())
+}
+
 func (s *systemRouter) postAuth(ctx context.Context, w http.ResponseWriter, r *http.Request, vars map[string]string) error {
 	var config *types.AuthConfig
 	err := json.NewDecoder(r.Body).Decode(&config)
@@ -66,3 +77,75 @@ func (s *systemRouter) postAuth(ctx context.Context, w http.ResponseWriter, r *h
 
 	return httputils.WriteJSON(w, http.StatusOK, &types.AuthResponse{Status: status})
 }
+
+func (s *systemRouter) getEvents(ctx context.Context, w http.ResponseWriter, r *http.Request, vars map[string]string) error {
+	if err := httputils.ParseForm(r); err != nil {
+		return err
+	}
+
+	var since int64
+	if r.Form.Get("since") != "" {
+		sec, nsec, err := timetypes.ParseTimestamps(r.Form.Get("since"), 0)
+		if err != nil {
+			return err
+		}
+		since = time.Unix(sec, nsec).UnixNano()
+	}
+
+	filter := &events.Filter{
+		PodIDs:       r.Form["pod"],
+		ContainerIDs: r.Form["container"],
+		Types:        r.Form["type"],
+	}
+
+	var closeNotifier <-chan bool
+	if notifier, ok := w.(http.CloseNotifier); ok {
+		closeNotifier = notifier.CloseNotify()
+	}
+
+	replay, ch, cancel := s.backend.SubscribeEvents(filter, since)
+	defer cancel()
+
+	// send HTTP 200 immediately, the events are streamed as json objects
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	output := ioutils.NewWriteFlusher(w)
+	defer output.Close()
+	output.Flush()
+
+	enc := json.NewEncoder(output)
+	for _, ev := range replay {
+		if err := enc.Encode(ev); err != nil {
+			return nil
+		}
+	}
+
+	for {
+		select {
+		case ev, ok := <-ch:
+			if !ok {
+				return nil
+			}
+			if err := enc.Encode(ev); err != nil {
+				return nil
+			}
+		case <-closeNotifier:
+			return nil
+		case <-ctx.Done():
+			return nil
+		}
+	}
+}
+
+func (s *systemRouter) postPrune(ctx context.Context, w http.ResponseWriter, r *http.Request, vars map[string]string) error {
+	if err := httputils.ParseForm(r); err != nil {
+		return err
+	}
+
+	orphans, err := s.backend.CmdSystemPrune(httputils.BoolValue(r, "dryRun"))
+	if err != nil {
+		return err
+	}
+
+	return httputils.WriteJSON(w, http.StatusOK, map[string]interface{}{"orphans": orphans})
+}
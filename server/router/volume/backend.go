@@ -0,0 +1,20 @@
+package volume
+
+import (
+	apitypes "github.com/hyperhq/hyperd/types"
+)
+
+// Backend is the methods that need to be implemented to provide
+// volume specific functionality.
+type Backend interface {
+	CmdCreateVolume(data []byte) (*apitypes.VolumeInfo, error)
+	CmdListVolumes() ([]*apitypes.VolumeInfo, error)
+	CmdInspectVolume(name string) (*apitypes.VolumeInfo, error)
+	CmdRemoveVolume(name string) error
+	CmdResizeVolume(data []byte) error
+	CmdSnapshotVolume(data []byte) (*apitypes.VolumeSnapshotInfo, error)
+	CmdListVolumeSnapshots(volume string) ([]*apitypes.VolumeSnapshotInfo, error)
+	CmdRemoveVolumeSnapshot(volume, name string) error
+	CmdRestoreVolume(data []byte) error
+	CmdCloneVolume(data []byte) (*apitypes.VolumeInfo, error)
+}
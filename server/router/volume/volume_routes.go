@@ -0,0 +1,140 @@
+package volume
+
+import (
+	"io/ioutil"
+	"net/http"
+
+	"github.com/hyperhq/hyperd/server/httputils"
+	"golang.org/x/net/context"
+)
+
+func (v *volumeRouter) getVolumes(ctx context.Context, w http.ResponseWriter, r *http.Request, vars map[string]string) error {
+	vols, err := v.backend.CmdListVolumes()
+	if err != nil {
+		return err
+	}
+
+	return httputils.WriteJSON(w, http.StatusOK, map[string]interface{}{"volumes": vols})
+}
+
+func (v *volumeRouter) getVolumeInfo(ctx context.Context, w http.ResponseWriter, r *http.Request, vars map[string]string) error {
+	if err := httputils.ParseForm(r); err != nil {
+		return err
+	}
+
+	info, err := v.backend.CmdInspectVolume(r.Form.Get("name"))
+	if err != nil {
+		return err
+	}
+
+	return httputils.WriteJSON(w, http.StatusOK, info)
+}
+
+func (v *volumeRouter) postVolumeCreate(ctx context.Context, w http.ResponseWriter, r *http.Request, vars map[string]string) error {
+	if err := httputils.CheckForJSON(r); err != nil {
+		return err
+	}
+
+	data, _ := ioutil.ReadAll(r.Body)
+	info, err := v.backend.CmdCreateVolume(data)
+	if err != nil {
+		return err
+	}
+
+	return httputils.WriteJSON(w, http.StatusCreated, info)
+}
+
+func (v *volumeRouter) postVolumeResize(ctx context.Context, w http.ResponseWriter, r *http.Request, vars map[string]string) error {
+	if err := httputils.CheckForJSON(r); err != nil {
+		return err
+	}
+
+	data, _ := ioutil.ReadAll(r.Body)
+	if err := v.backend.CmdResizeVolume(data); err != nil {
+		return err
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+	return nil
+}
+
+func (v *volumeRouter) deleteVolume(ctx context.Context, w http.ResponseWriter, r *http.Request, vars map[string]string) error {
+	if err := httputils.ParseForm(r); err != nil {
+		return err
+	}
+
+	if err := v.backend.CmdRemoveVolume(r.Form.Get("name")); err != nil {
+		return err
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+	return nil
+}
+
+func (v *volumeRouter) getVolumeSnapshots(ctx context.Context, w http.ResponseWriter, r *http.Request, vars map[string]string) error {
+	if err := httputils.ParseForm(r); err != nil {
+		return err
+	}
+
+	snaps, err := v.backend.CmdListVolumeSnapshots(r.Form.Get("volume"))
+	if err != nil {
+		return err
+	}
+
+	return httputils.WriteJSON(w, http.StatusOK, map[string]interface{}{"snapshots": snaps})
+}
+
+func (v *volumeRouter) postVolumeSnapshot(ctx context.Context, w http.ResponseWriter, r *http.Request, vars map[string]string) error {
+	if err := httputils.CheckForJSON(r); err != nil {
+		return err
+	}
+
+	data, _ := ioutil.ReadAll(r.Body)
+	snap, err := v.backend.CmdSnapshotVolume(data)
+	if err != nil {
+		return err
+	}
+
+	return httputils.WriteJSON(w, http.StatusCreated, snap)
+}
+
+func (v *volumeRouter) postVolumeRestore(ctx context.Context, w http.ResponseWriter, r *http.Request, vars map[string]string) error {
+	if err := httputils.CheckForJSON(r); err != nil {
+		return err
+	}
+
+	data, _ := ioutil.ReadAll(r.Body)
+	if err := v.backend.CmdRestoreVolume(data); err != nil {
+		return err
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+	return nil
+}
+
+func (v *volumeRouter) postVolumeClone(ctx context.Context, w http.ResponseWriter, r *http.Request, vars map[string]string) error {
+	if err := httputils.CheckForJSON(r); err != nil {
+		return err
+	}
+
+	data, _ := ioutil.ReadAll(r.Body)
+	info, err := v.backend.CmdCloneVolume(data)
+	if err != nil {
+		return err
+	}
+
+	return httputils.WriteJSON(w, http.StatusCreated, info)
+}
+
+func (v *volumeRouter) deleteVolumeSnapshot(ctx context.Context, w http.ResponseWriter, r *http.Request, vars map[string]string) error {
+	if err := httputils.ParseForm(r); err != nil {
+		return err
+	}
+
+	if err := v.backend.CmdRemoveVolumeSnapshot(r.Form.Get("volume"), r.Form.Get("name")); err != nil {
+		return err
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+	return nil
+}
@@ -17,9 +17,11 @@ import (
 	"github.com/hyperhq/hyperd/server/router/build"
 	"github.com/hyperhq/hyperd/server/router/container"
 	"github.com/hyperhq/hyperd/server/router/local"
+	"github.com/hyperhq/hyperd/server/router/network"
 	"github.com/hyperhq/hyperd/server/router/pod"
 	"github.com/hyperhq/hyperd/server/router/service"
 	"github.com/hyperhq/hyperd/server/router/system"
+	"github.com/hyperhq/hyperd/server/router/volume"
 
 	"github.com/gorilla/mux"
 	"golang.org/x/net/context"
@@ -181,6 +183,8 @@ func (s *Server) InitRouters(d *daemon.Daemon) {
 	s.addRouter(local.NewRouter(d))
 	s.addRouter(system.NewRouter(d))
 	s.addRouter(build.NewRouter(d))
+	s.addRouter(volume.NewRouter(d))
+	s.addRouter(network.NewRouter(d))
 }
 
 // addRouter adds a new router to the server.
@@ -199,7 +203,7 @@ func (s *Server) createMux() *mux.Router {
 	glog.V(3).Infof("Registering routers")
 	for _, apiRouter := range s.routers {
 		for _, r := range apiRouter.Routes() {
-			f := s.makeHTTPHandler(r.Handler())
+			f := instrumentHandler(r.Method(), r.Path(), s.makeHTTPHandler(r.Handler()))
 
 			glog.V(3).Infof("Registering %s, %s", r.Method(), r.Path())
 			m.Path(versionMatcher + r.Path()).Methods(r.Method()).Handler(f)
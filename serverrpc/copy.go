@@ -0,0 +1,79 @@
+package serverrpc
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/golang/glog"
+	"github.com/hyperhq/hyperd/types"
+)
+
+const copyChunkSize = 32 * 1024
+
+// ContainerCopyFrom copies files out of a container as a tar stream
+func (s *ServerRPC) ContainerCopyFrom(req *types.ContainerCopyFromRequest, stream types.PublicAPI_ContainerCopyFromServer) error {
+	glog.V(3).Infof("ContainerCopyFrom with request %s", req.String())
+
+	content, err := s.daemon.CopyFromContainer(req.Container, req.Path)
+	if err != nil {
+		glog.Errorf("CopyFromContainer failed: %v", err)
+		return err
+	}
+	defer content.Close()
+
+	buf := make([]byte, copyChunkSize)
+	for {
+		nr, err := content.Read(buf)
+		if nr > 0 {
+			if err := stream.Send(&types.ContainerCopyData{Data: buf[:nr]}); err != nil {
+				glog.Errorf("Send to stream error: %v", err)
+				return err
+			}
+		}
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			glog.Errorf("Read tar stream error: %v", err)
+			return err
+		}
+	}
+}
+
+// ContainerCopyTo extracts a tar stream to a container
+func (s *ServerRPC) ContainerCopyTo(stream types.PublicAPI_ContainerCopyToServer) error {
+	req, err := stream.Recv()
+	if err != nil {
+		return err
+	}
+	container, path := req.Container, req.Path
+	glog.V(3).Infof("ContainerCopyTo with container %s, path %s", container, path)
+	if container == "" || path == "" {
+		return fmt.Errorf("container and path are required")
+	}
+
+	reader, writer := io.Pipe()
+	go func() {
+		var err error
+		for req != nil {
+			if len(req.Data) > 0 {
+				if _, err = writer.Write(req.Data); err != nil {
+					break
+				}
+			}
+			req, err = stream.Recv()
+		}
+		if err == io.EOF {
+			err = nil
+		}
+		writer.CloseWithError(err)
+	}()
+
+	err = s.daemon.CopyToContainer(container, path, reader)
+	reader.Close()
+	if err != nil {
+		glog.Errorf("CopyToContainer failed: %v", err)
+		return err
+	}
+	return stream.SendAndClose(&types.ContainerCopyToResponse{})
+}
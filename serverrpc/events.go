@@ -0,0 +1,41 @@
+package serverrpc
+
+import (
+	"github.com/golang/glog"
+	"github.com/hyperhq/hyperd/daemon/events"
+	"github.com/hyperhq/hyperd/types"
+)
+
+// Events streams the lifecycle events of pods and containers to the client
+func (s *ServerRPC) Events(req *types.EventsRequest, stream types.PublicAPI_EventsServer) error {
+	glog.V(3).Infof("Events with request %s", req.String())
+
+	filter := &events.Filter{
+		PodIDs:       req.PodIDs,
+		ContainerIDs: req.ContainerIDs,
+		Types:        req.Types,
+	}
+	replay, ch, cancel := s.daemon.SubscribeEvents(filter, req.Since)
+	defer cancel()
+
+	for _, ev := range replay {
+		if err := stream.Send(ev); err != nil {
+			return err
+		}
+	}
+
+	for {
+		select {
+		case ev, ok := <-ch:
+			if !ok {
+				return nil
+			}
+			if err := stream.Send(ev); err != nil {
+				glog.V(1).Infof("Events stream closed: %v", err)
+				return err
+			}
+		case <-stream.Context().Done():
+			return nil
+		}
+	}
+}
@@ -3,8 +3,10 @@ package serverrpc
 import (
 	"encoding/json"
 	"io"
+	"sync"
 
 	"github.com/golang/glog"
+	"github.com/hyperhq/hyperd/daemon/pod"
 	"github.com/hyperhq/hyperd/lib/promise"
 	"github.com/hyperhq/hyperd/types"
 	"golang.org/x/net/context"
@@ -18,7 +20,14 @@ func (s *ServerRPC) ExecCreate(ctx context.Context, req *types.ExecCreateRequest
 		return nil, err
 	}
 
-	execId, err := s.daemon.CreateExec(req.ContainerID, string(cmd), req.Tty)
+	opts := &pod.ExecOptions{
+		Envs:       req.Env,
+		User:       req.User,
+		Group:      req.Group,
+		Workdir:    req.Workdir,
+		Privileged: req.Privileged,
+	}
+	execId, err := s.daemon.CreateExec(req.ContainerID, string(cmd), req.Tty, opts)
 	if err != nil {
 		glog.Errorf("ExecCreate error: %v", err)
 		return nil, err
@@ -35,15 +44,22 @@ func (s *ServerRPC) ExecStart(stream types.PublicAPI_ExecStartServer) error {
 		return err
 	}
 
-	inReader, inWriter := io.Pipe()
-	outReader, outWriter := io.Pipe()
-	outErr := promise.Go(func() (err error) {
-		defer outReader.Close()
+	var (
+		inReader, inWriter   = io.Pipe()
+		outReader, outWriter = io.Pipe()
+		errReader, errWriter = io.Pipe()
+		sendLock             sync.Mutex
+	)
+	send := func(r *io.PipeReader, typ types.ExecStartResponse_StreamType) error {
+		defer r.Close()
 		buf := make([]byte, 32)
 		for {
-			nr, err := outReader.Read(buf)
+			nr, err := r.Read(buf)
 			if nr > 0 {
-				if err := stream.Send(&types.ExecStartResponse{buf[:nr]}); err != nil {
+				sendLock.Lock()
+				err := stream.Send(&types.ExecStartResponse{Stdout: buf[:nr], Stream: typ})
+				sendLock.Unlock()
+				if err != nil {
 					glog.Errorf("Send to stream error: %v", err)
 					return err
 				}
@@ -56,6 +72,12 @@ func (s *ServerRPC) ExecStart(stream types.PublicAPI_ExecStartServer) error {
 				return err
 			}
 		}
+	}
+	outErr := promise.Go(func() error {
+		return send(outReader, types.ExecStartResponse_STDOUT)
+	})
+	errErr := promise.Go(func() error {
+		return send(errReader, types.ExecStartResponse_STDERR)
 	})
 	go func() {
 		defer inWriter.Close()
@@ -82,12 +104,37 @@ func (s *ServerRPC) ExecStart(stream types.PublicAPI_ExecStartServer) error {
 		}
 	}()
 
-	err = s.daemon.StartExec(inReader, outWriter, req.ContainerID, req.ExecID)
+	// the stderr is multiplexed in the stdout unless the raw streams are
+	// requested, which the clients before the stream type expect
+	var stderr io.Writer
+	if req.RawStreams {
+		stderr = errWriter
+	}
+	err = s.daemon.StartExec(inReader, outWriter, stderr, req.ContainerID, req.ExecID)
+	// the stderr of a tty exec is not used
+	errWriter.Close()
 	if err != nil {
 		return err
 	}
-	err = <-outErr
-	return err
+	if err = <-outErr; err != nil {
+		return err
+	}
+	if err = <-errErr; err != nil {
+		return err
+	}
+	if !req.RawStreams {
+		return nil
+	}
+
+	code, err := s.daemon.ExitCode(req.ContainerID, req.ExecID)
+	if err != nil {
+		glog.Errorf("Get exit code of exec %s error: %v", req.ExecID, err)
+		return err
+	}
+	return stream.Send(&types.ExecStartResponse{
+		Exited:   true,
+		ExitCode: int32(code),
+	})
 }
 
 // Wait gets exitcode by container and processId
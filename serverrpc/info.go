@@ -15,7 +15,15 @@ const (
 func (s *ServerRPC) PodInfo(c context.Context, req *types.PodInfoRequest) (*types.PodInfoResponse, error) {
 	glog.V(3).Infof("PodInfo with request %v", req.String())
 
-	info, err := s.daemon.GetPodInfo(req.PodID)
+	var (
+		info *types.PodInfo
+		err  error
+	)
+	if req.VolumeUsage {
+		info, err = s.daemon.GetPodInfoWithVolumeUsage(req.PodID)
+	} else {
+		info, err = s.daemon.GetPodInfo(req.PodID)
+	}
 	if err != nil {
 		glog.Errorf("GetPodInfo error: %v", err)
 		return nil, err
@@ -64,3 +72,18 @@ func (s *ServerRPC) Version(c context.Context, req *types.VersionRequest) (*type
 func (s *ServerRPC) Ping(c context.Context, req *types.PingRequest) (*types.PingResponse, error) {
 	return &types.PingResponse{HyperdStats: "OK"}, nil
 }
+
+// SystemPrune removes the resources left behind by removed or failed pods
+func (s *ServerRPC) SystemPrune(c context.Context, req *types.SystemPruneRequest) (*types.SystemPruneResponse, error) {
+	glog.V(3).Infof("SystemPrune with request %s", req.String())
+
+	orphans, err := s.daemon.Prune(req.DryRun)
+	if err != nil {
+		glog.Errorf("SystemPrune failed: %v", err)
+		return nil, err
+	}
+
+	return &types.SystemPruneResponse{
+		Orphans: orphans,
+	}, nil
+}
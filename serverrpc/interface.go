@@ -0,0 +1,63 @@
+package serverrpc
+
+import (
+	"errors"
+
+	"github.com/hyperhq/hypercontainer-utils/hlog"
+	"github.com/hyperhq/hyperd/types"
+	"golang.org/x/net/context"
+)
+
+// PodInterfaceList get the network interfaces of a Pod
+func (s *ServerRPC) PodInterfaceList(ctx context.Context, req *types.PodInterfaceListRequest) (*types.PodInterfaceListResponse, error) {
+	s.Log(hlog.TRACE, "PodInterfaceList with request %s", req.String())
+
+	p, ok := s.daemon.PodList.Get(req.PodID)
+	if !ok {
+		s.Log(hlog.INFO, "PodInterfaceList: pod %s not found", req.PodID)
+		return nil, errors.New("Pod not found")
+	}
+
+	return &types.PodInterfaceListResponse{
+		Interfaces: p.ListInterfaces(),
+	}, nil
+}
+
+// PodInterfaceAdd hot-plug a network interface to a running Pod
+func (s *ServerRPC) PodInterfaceAdd(ctx context.Context, req *types.PodInterfaceAddRequest) (*types.PodInterfaceAddResponse, error) {
+	s.Log(hlog.TRACE, "PodInterfaceAdd with request %s", req.String())
+
+	if _, ok := s.daemon.PodList.Get(req.PodID); !ok {
+		s.Log(hlog.INFO, "PodInterfaceAdd: pod %s not found", req.PodID)
+		return nil, errors.New("Pod not found")
+	}
+	if req.Interface == nil {
+		return nil, errors.New("no interface to be added")
+	}
+
+	inf, err := s.daemon.AddInterface(req.PodID, req.Interface)
+	if err != nil {
+		s.Log(hlog.ERROR, "failed to add interface: %v", err)
+		return nil, err
+	}
+	return &types.PodInterfaceAddResponse{
+		Interface: inf,
+	}, nil
+}
+
+// PodInterfaceRemove unplug a network interface from a running Pod
+func (s *ServerRPC) PodInterfaceRemove(ctx context.Context, req *types.PodInterfaceRemoveRequest) (*types.PodInterfaceRemoveResponse, error) {
+	s.Log(hlog.TRACE, "PodInterfaceRemove with request %s", req.String())
+
+	if _, ok := s.daemon.PodList.Get(req.PodID); !ok {
+		s.Log(hlog.INFO, "PodInterfaceRemove: pod %s not found", req.PodID)
+		return nil, errors.New("Pod not found")
+	}
+
+	err := s.daemon.RemoveInterface(req.PodID, req.Ifname)
+	if err != nil {
+		s.Log(hlog.ERROR, "failed to remove interface %s: %v", req.Ifname, err)
+		return nil, err
+	}
+	return &types.PodInterfaceRemoveResponse{}, nil
+}
@@ -10,7 +10,7 @@ import (
 func (s *ServerRPC) ContainerList(ctx context.Context, req *types.ContainerListRequest) (*types.ContainerListResponse, error) {
 	glog.V(3).Infof("ContainerList with request %s", req.String())
 
-	containerList, err := s.daemon.ListContainers(req.PodID, req.VmID)
+	containerList, err := s.daemon.ListContainers(req.PodID, req.VmID, req.Filter)
 	if err != nil {
 		glog.Errorf("ContainerList error: %v", err)
 		return nil, err
@@ -25,7 +25,7 @@ func (s *ServerRPC) ContainerList(ctx context.Context, req *types.ContainerListR
 func (s *ServerRPC) PodList(ctx context.Context, req *types.PodListRequest) (*types.PodListResponse, error) {
 	glog.V(3).Infof("PodList with request %s", req.String())
 
-	podList, err := s.daemon.ListPods(req.PodID, req.VmID)
+	podList, err := s.daemon.ListPods(req.PodID, req.VmID, req.Filter)
 	if err != nil {
 		glog.Errorf("PodList error: %v", err)
 		return nil, err
@@ -40,7 +40,7 @@ func (s *ServerRPC) PodList(ctx context.Context, req *types.PodListRequest) (*ty
 func (s *ServerRPC) VMList(ctx context.Context, req *types.VMListRequest) (*types.VMListResponse, error) {
 	glog.V(3).Infof("VMList with request %s", req.String())
 
-	vmList, err := s.daemon.ListVMs(req.PodID, req.VmID)
+	vmList, err := s.daemon.ListVMs(req.PodID, req.VmID, req.Filter)
 	if err != nil {
 		glog.Errorf("VmList error: %v", err)
 		return nil, err
@@ -0,0 +1,64 @@
+package serverrpc
+
+import (
+	"github.com/golang/glog"
+	"github.com/hyperhq/hyperd/types"
+	"golang.org/x/net/context"
+)
+
+// NetworkCreate creates a user-defined network
+func (s *ServerRPC) NetworkCreate(ctx context.Context, req *types.NetworkCreateRequest) (*types.NetworkCreateResponse, error) {
+	glog.V(3).Infof("NetworkCreate with request %s", req.String())
+
+	info, err := s.daemon.CreateNetwork(req)
+	if err != nil {
+		glog.Errorf("NetworkCreate %s failed: %v", req.Name, err)
+		return nil, err
+	}
+
+	return &types.NetworkCreateResponse{
+		Network: info,
+	}, nil
+}
+
+// NetworkList gets a list of user-defined networks
+func (s *ServerRPC) NetworkList(ctx context.Context, req *types.NetworkListRequest) (*types.NetworkListResponse, error) {
+	glog.V(3).Infof("NetworkList with request %s", req.String())
+
+	nets, err := s.daemon.ListNetworks()
+	if err != nil {
+		glog.Errorf("NetworkList failed: %v", err)
+		return nil, err
+	}
+
+	return &types.NetworkListResponse{
+		Networks: nets,
+	}, nil
+}
+
+// NetworkInspect gets the info and the leases of a user-defined network
+func (s *ServerRPC) NetworkInspect(ctx context.Context, req *types.NetworkInspectRequest) (*types.NetworkInspectResponse, error) {
+	glog.V(3).Infof("NetworkInspect with request %s", req.String())
+
+	info, err := s.daemon.InspectNetwork(req.Name)
+	if err != nil {
+		glog.Errorf("NetworkInspect %s failed: %v", req.Name, err)
+		return nil, err
+	}
+
+	return &types.NetworkInspectResponse{
+		Network: info,
+	}, nil
+}
+
+// NetworkRemove deletes a user-defined network which has no leases
+func (s *ServerRPC) NetworkRemove(ctx context.Context, req *types.NetworkRemoveRequest) (*types.NetworkRemoveResponse, error) {
+	glog.V(3).Infof("NetworkRemove with request %s", req.String())
+
+	if err := s.daemon.RemoveNetwork(req.Name); err != nil {
+		glog.Errorf("NetworkRemove %s failed: %v", req.Name, err)
+		return nil, err
+	}
+
+	return &types.NetworkRemoveResponse{}, nil
+}
@@ -100,6 +100,22 @@ func (s *ServerRPC) PodPause(ctx context.Context, req *types.PodPauseRequest) (*
 	return &types.PodPauseResponse{}, nil
 }
 
+// PodUpdateResources hotplugs vcpus and memory to a pod
+func (s *ServerRPC) PodUpdateResources(ctx context.Context, req *types.PodUpdateResourcesRequest) (*types.PodUpdateResourcesResponse, error) {
+	glog.V(3).Infof("PodUpdateResources with request %s", req.String())
+
+	if req.Resource == nil {
+		return nil, fmt.Errorf("no resource to be updated")
+	}
+	err := s.daemon.UpdatePodResources(req.PodID, req.Resource)
+	if err != nil {
+		glog.Errorf("UpdatePodResources %s failed: %v", req.PodID, err)
+		return nil, err
+	}
+
+	return &types.PodUpdateResourcesResponse{}, nil
+}
+
 // PodUnpause unpauses a pod
 func (s *ServerRPC) PodUnpause(ctx context.Context, req *types.PodUnpauseRequest) (*types.PodUnpauseResponse, error) {
 	glog.V(3).Infof("PodUnpause with request %s", req.String())
@@ -138,12 +154,20 @@ func (s *ServerRPC) PodStats(c context.Context, req *types.PodStatsRequest) (*ty
 
 	stats := statsObject.(*runvtypes.PodStats)
 
+	// the container limits are used to calculate the usage percent
+	var spec *types.PodSpec
+	if info, err := s.daemon.GetPodInfo(req.PodID); err == nil {
+		spec = info.Spec
+	} else {
+		glog.Warningf("PodStats failed to get limits of pod %s: %v", req.PodID, err)
+	}
+
 	return &types.PodStatsResponse{
-		PodStats: convertRunvStatsToGrpcTypes(stats),
+		PodStats: convertRunvStatsToGrpcTypes(stats, spec),
 	}, nil
 }
 
-func convertRunvStatsToGrpcTypes(stats *runvtypes.PodStats) *types.PodStats {
+func convertRunvStatsToGrpcTypes(stats *runvtypes.PodStats, spec *types.PodSpec) *types.PodStats {
 	grpcPodStats := &types.PodStats{}
 	grpcPodStats.Cpu = convertToGrpcCpuStats(stats.Cpu)
 	grpcPodStats.Block = convertToGrpcBlockStats(stats.Block)
@@ -155,8 +179,17 @@ func convertRunvStatsToGrpcTypes(stats *runvtypes.PodStats) *types.PodStats {
 		grpcPodStats.Filesystem = append(grpcPodStats.Filesystem, convertRunvFsToGrpcType(fs))
 	}
 
+	limits := make(map[string]*types.UserContainerResource)
+	podMemory := int32(0)
+	if spec != nil {
+		podMemory = spec.Memory
+		for _, c := range spec.Containers {
+			limits[c.ContainerID] = c.Resource
+		}
+	}
+
 	for _, cStats := range stats.ContainersStats {
-		var containerStats *types.ContainersStats
+		containerStats := &types.ContainersStats{}
 		containerStats.ContainerID = cStats.ContainerID
 		containerStats.Cpu = convertToGrpcCpuStats(cStats.Cpu)
 		containerStats.Memory = convertToGrpcMemoryStats(cStats.Memory)
@@ -166,6 +199,14 @@ func convertRunvStatsToGrpcTypes(stats *runvtypes.PodStats) *types.PodStats {
 			containerStats.Filesystem = append(containerStats.Filesystem, convertRunvFsToGrpcType(fs))
 		}
 		containerStats.Timestamp = cStats.Timestamp.Unix()
+		containerStats.Resource = limits[cStats.ContainerID]
+		memory := podMemory
+		if r := containerStats.Resource; r != nil && r.Memory > 0 {
+			memory = r.Memory
+		}
+		if memory > 0 {
+			containerStats.MemoryUsagePercent = float64(cStats.Memory.Usage) * 100 / float64(uint64(memory)<<20)
+		}
 		grpcPodStats.ContainersStats = append(grpcPodStats.ContainersStats, containerStats)
 	}
 	return grpcPodStats
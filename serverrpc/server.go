@@ -1,25 +1,49 @@
 package serverrpc
 
 import (
+	"crypto/tls"
+	"fmt"
 	"net"
+	"strings"
 
+	"github.com/docker/go-connections/sockets"
 	"github.com/hyperhq/hypercontainer-utils/hlog"
 	"github.com/hyperhq/hyperd/daemon"
 	"github.com/hyperhq/hyperd/types"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
 )
 
+// Config provides the configuration for the gRPC server
+type Config struct {
+	// SocketGroup is the group owns the unix socket if serve on unix socket
+	SocketGroup string
+	// TLSConfig enables TLS if it is not nil
+	TLSConfig *tls.Config
+}
+
 // ServerRPC is the main server for gRPC
 type ServerRPC struct {
 	server *grpc.Server
 	daemon *daemon.Daemon
+	cfg    *Config
 }
 
 // NewServerRPC creates a new ServerRPC
-func NewServerRPC(d *daemon.Daemon) *ServerRPC {
+func NewServerRPC(d *daemon.Daemon, cfg *Config) *ServerRPC {
+	if cfg == nil {
+		cfg = &Config{}
+	}
+
+	var opts []grpc.ServerOption
+	if cfg.TLSConfig != nil {
+		opts = append(opts, grpc.Creds(credentials.NewTLS(cfg.TLSConfig)))
+	}
+
 	s := &ServerRPC{
-		server: grpc.NewServer(),
+		server: grpc.NewServer(opts...),
 		daemon: d,
+		cfg:    cfg,
 	}
 	s.registerServer()
 	return s
@@ -40,10 +64,12 @@ func (s *ServerRPC) registerServer() {
 	types.RegisterPublicAPIServer(s.server, s)
 }
 
-// Serve serves gRPC request by goroutines
+// Serve serves gRPC request by goroutines, the addr could be a TCP address
+// in `host:port` or `tcp://host:port` format, or a unix socket in the format
+// of `unix:///path/to/socket`
 func (s *ServerRPC) Serve(addr string) error {
 	s.Log(hlog.DEBUG, "start server at %s", addr)
-	l, err := net.Listen("tcp", addr)
+	l, err := s.listen(addr)
 	if err != nil {
 		s.Log(hlog.ERROR, "Failed to listen %s: %v", addr, err)
 		return err
@@ -52,6 +78,24 @@ func (s *ServerRPC) Serve(addr string) error {
 	return s.server.Serve(l)
 }
 
+func (s *ServerRPC) listen(addr string) (net.Listener, error) {
+	proto, laddr := "tcp", addr
+	if parts := strings.SplitN(addr, "://", 2); len(parts) == 2 {
+		proto, laddr = parts[0], parts[1]
+	}
+
+	switch proto {
+	case "tcp":
+		if s.cfg.TLSConfig == nil {
+			s.Log(hlog.WARNING, "serving gRPC on %s without TLS, the API is not protected", laddr)
+		}
+		return net.Listen("tcp", laddr)
+	case "unix":
+		return sockets.NewUnixSocket(laddr, s.cfg.SocketGroup)
+	}
+	return nil, fmt.Errorf("Invalid protocol format: %q", proto)
+}
+
 // Stop stops gRPC server
 func (s *ServerRPC) Stop() {
 	s.server.Stop()
@@ -0,0 +1,45 @@
+package serverrpc
+
+import (
+	"time"
+
+	"github.com/golang/glog"
+	"github.com/hyperhq/hyperd/types"
+)
+
+// PodStatsStream streams the stats samples of the pods, with the rates
+// computed from the successive samples
+func (s *ServerRPC) PodStatsStream(req *types.PodStatsStreamRequest, stream types.PublicAPI_PodStatsStreamServer) error {
+	glog.V(3).Infof("PodStatsStream with request %s", req.String())
+
+	ch, cancel, err := s.daemon.SubscribePodStats(req.PodIDs, time.Duration(req.Interval)*time.Second)
+	if err != nil {
+		glog.Errorf("PodStatsStream error: %v", err)
+		return err
+	}
+	defer cancel()
+
+	for {
+		select {
+		case sample, ok := <-ch:
+			if !ok {
+				return nil
+			}
+			resp := sample.Response()
+			if sample.Stats != nil {
+				// the limits may be updated, so the spec is fetched for each sample
+				var spec *types.PodSpec
+				if info, err := s.daemon.GetPodInfo(sample.PodID); err == nil {
+					spec = info.Spec
+				}
+				resp.PodStats = convertRunvStatsToGrpcTypes(sample.Stats, spec)
+			}
+			if err := stream.Send(resp); err != nil {
+				glog.V(1).Infof("PodStatsStream closed: %v", err)
+				return err
+			}
+		case <-stream.Context().Done():
+			return nil
+		}
+	}
+}
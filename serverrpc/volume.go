@@ -0,0 +1,145 @@
+package serverrpc
+
+import (
+	"github.com/golang/glog"
+	"github.com/hyperhq/hyperd/types"
+	"golang.org/x/net/context"
+)
+
+// VolumeCreate creates a named volume
+func (s *ServerRPC) VolumeCreate(ctx context.Context, req *types.VolumeCreateRequest) (*types.VolumeCreateResponse, error) {
+	glog.V(3).Infof("VolumeCreate with request %s", req.String())
+
+	info, err := s.daemon.CreateVolume(req)
+	if err != nil {
+		glog.Errorf("VolumeCreate %s failed: %v", req.Name, err)
+		return nil, err
+	}
+
+	return &types.VolumeCreateResponse{
+		Volume: info,
+	}, nil
+}
+
+// VolumeList gets a list of named volumes
+func (s *ServerRPC) VolumeList(ctx context.Context, req *types.VolumeListRequest) (*types.VolumeListResponse, error) {
+	glog.V(3).Infof("VolumeList with request %s", req.String())
+
+	vols, err := s.daemon.ListVolumes()
+	if err != nil {
+		glog.Errorf("VolumeList failed: %v", err)
+		return nil, err
+	}
+
+	return &types.VolumeListResponse{
+		Volumes: vols,
+	}, nil
+}
+
+// VolumeInspect gets the info of a named volume
+func (s *ServerRPC) VolumeInspect(ctx context.Context, req *types.VolumeInspectRequest) (*types.VolumeInspectResponse, error) {
+	glog.V(3).Infof("VolumeInspect with request %s", req.String())
+
+	info, err := s.daemon.InspectVolume(req.Name)
+	if err != nil {
+		glog.Errorf("VolumeInspect %s failed: %v", req.Name, err)
+		return nil, err
+	}
+
+	return &types.VolumeInspectResponse{
+		Volume: info,
+	}, nil
+}
+
+// VolumeRemove deletes a named volume which is not used by any pods
+func (s *ServerRPC) VolumeRemove(ctx context.Context, req *types.VolumeRemoveRequest) (*types.VolumeRemoveResponse, error) {
+	glog.V(3).Infof("VolumeRemove with request %s", req.String())
+
+	if err := s.daemon.RemoveVolume(req.Name); err != nil {
+		glog.Errorf("VolumeRemove %s failed: %v", req.Name, err)
+		return nil, err
+	}
+
+	return &types.VolumeRemoveResponse{}, nil
+}
+
+// VolumeResize grows a volume of a Pod, and the filesystem on it if the Pod is running
+func (s *ServerRPC) VolumeResize(ctx context.Context, req *types.VolumeResizeRequest) (*types.VolumeResizeResponse, error) {
+	glog.V(3).Infof("VolumeResize with request %s", req.String())
+
+	if err := s.daemon.ResizeVolume(req); err != nil {
+		glog.Errorf("VolumeResize %s of pod %s failed: %v", req.Volume, req.PodID, err)
+		return nil, err
+	}
+
+	return &types.VolumeResizeResponse{}, nil
+}
+
+// VolumeSnapshot takes a copy-on-write snapshot of a named volume
+func (s *ServerRPC) VolumeSnapshot(ctx context.Context, req *types.VolumeSnapshotRequest) (*types.VolumeSnapshotResponse, error) {
+	glog.V(3).Infof("VolumeSnapshot with request %s", req.String())
+
+	snap, err := s.daemon.SnapshotVolume(req)
+	if err != nil {
+		glog.Errorf("VolumeSnapshot %s failed: %v", req.Volume, err)
+		return nil, err
+	}
+
+	return &types.VolumeSnapshotResponse{
+		Snapshot: snap,
+	}, nil
+}
+
+// VolumeSnapshotList gets the snapshots of a named volume
+func (s *ServerRPC) VolumeSnapshotList(ctx context.Context, req *types.VolumeSnapshotListRequest) (*types.VolumeSnapshotListResponse, error) {
+	glog.V(3).Infof("VolumeSnapshotList with request %s", req.String())
+
+	snaps, err := s.daemon.ListVolumeSnapshots(req.Volume)
+	if err != nil {
+		glog.Errorf("VolumeSnapshotList %s failed: %v", req.Volume, err)
+		return nil, err
+	}
+
+	return &types.VolumeSnapshotListResponse{
+		Snapshots: snaps,
+	}, nil
+}
+
+// VolumeSnapshotRemove deletes a snapshot of a named volume
+func (s *ServerRPC) VolumeSnapshotRemove(ctx context.Context, req *types.VolumeSnapshotRemoveRequest) (*types.VolumeSnapshotRemoveResponse, error) {
+	glog.V(3).Infof("VolumeSnapshotRemove with request %s", req.String())
+
+	if err := s.daemon.RemoveVolumeSnapshot(req.Volume, req.Snapshot); err != nil {
+		glog.Errorf("VolumeSnapshotRemove %s of %s failed: %v", req.Snapshot, req.Volume, err)
+		return nil, err
+	}
+
+	return &types.VolumeSnapshotRemoveResponse{}, nil
+}
+
+// VolumeRestore rolls a named volume, which is not used by any pods, back to a snapshot
+func (s *ServerRPC) VolumeRestore(ctx context.Context, req *types.VolumeRestoreRequest) (*types.VolumeRestoreResponse, error) {
+	glog.V(3).Infof("VolumeRestore with request %s", req.String())
+
+	if err := s.daemon.RestoreVolume(req.Volume, req.Snapshot); err != nil {
+		glog.Errorf("VolumeRestore %s to %s failed: %v", req.Volume, req.Snapshot, err)
+		return nil, err
+	}
+
+	return &types.VolumeRestoreResponse{}, nil
+}
+
+// VolumeClone creates a named volume from a snapshot of another one
+func (s *ServerRPC) VolumeClone(ctx context.Context, req *types.VolumeCloneRequest) (*types.VolumeCloneResponse, error) {
+	glog.V(3).Infof("VolumeClone with request %s", req.String())
+
+	info, err := s.daemon.CloneVolume(req)
+	if err != nil {
+		glog.Errorf("VolumeClone %s failed: %v", req.Name, err)
+		return nil, err
+	}
+
+	return &types.VolumeCloneResponse{
+		Volume: info,
+	}, nil
+}
@@ -0,0 +1,58 @@
+package storage
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"syscall"
+
+	"github.com/golang/glog"
+)
+
+// GrowFilesystem grows the filesystem on a block device or a block file,
+// which is not in use, to fill the whole device.
+func GrowFilesystem(device, fstype string) error {
+	var cmds [][]string
+	switch fstype {
+	case "ext4", "ext3", "ext2":
+		cmds = [][]string{{"e2fsck", "-fy", device}, {"resize2fs", device}}
+	case "xfs":
+		// xfs could only be grown when mounted
+		mnt, err := ioutil.TempDir("", "hyper-grow-")
+		if err != nil {
+			return err
+		}
+		defer os.Remove(mnt)
+		if out, err := exec.Command("mount", "-t", "xfs", device, mnt).CombinedOutput(); err != nil {
+			glog.Errorf("failed to mount %s: %v, %s", device, err, out)
+			return fmt.Errorf("failed to mount %s: %s", device, out)
+		}
+		defer exec.Command("umount", mnt).Run()
+		cmds = [][]string{{"xfs_growfs", mnt}}
+	default:
+		return fmt.Errorf("cannot grow filesystem %s on %s", fstype, device)
+	}
+
+	for _, c := range cmds {
+		out, err := exec.Command(c[0], c[1:]...).CombinedOutput()
+		if c[0] == "e2fsck" && exitStatus(err) == 1 { // errors corrected
+			err = nil
+		}
+		if err != nil {
+			glog.Errorf("%v failed: %v, %s", c, err, out)
+			return fmt.Errorf("%s failed on %s: %s", c[0], device, out)
+		}
+	}
+	glog.V(1).Infof("grew the %s filesystem on %s", fstype, device)
+	return nil
+}
+
+func exitStatus(err error) int {
+	if ee, ok := err.(*exec.ExitError); ok {
+		if ws, ok := ee.Sys().(syscall.WaitStatus); ok {
+			return ws.ExitStatus()
+		}
+	}
+	return -1
+}
@@ -10,6 +10,7 @@ import (
 	"os"
 	"os/exec"
 	"path"
+	"strconv"
 	"strings"
 	"syscall"
 
@@ -253,6 +254,100 @@ func CreateVolume(poolName, volName, dev_id, mkfs string, size int, restore bool
 	return nil
 }
 
+func volumeTable(volName string) ([]string, error) {
+	res, err := exec.Command("dmsetup", "table", volName).CombinedOutput()
+	if err != nil {
+		glog.Error(string(res))
+		return nil, fmt.Errorf(string(res))
+	}
+	// <start> <sectors> thin <pool dev> <dev_id>
+	fields := strings.Fields(string(res))
+	if len(fields) != 5 || fields[2] != "thin" {
+		return nil, fmt.Errorf("%s is not a thin volume: %s", volName, res)
+	}
+	return fields, nil
+}
+
+// VolumeSize gets the size in bytes of a thin volume from its table.
+func VolumeSize(volName string) (int, error) {
+	fields, err := volumeTable(volName)
+	if err != nil {
+		return 0, err
+	}
+	sectors, err := strconv.Atoi(fields[1])
+	if err != nil {
+		return 0, err
+	}
+	return sectors * 512, nil
+}
+
+// ResizeVolume grows a thin volume to size bytes by reloading its table,
+// the filesystem on it is not touched.
+func ResizeVolume(volName string, size int) error {
+	fields, err := volumeTable(volName)
+	if err != nil {
+		return err
+	}
+	if current, _ := strconv.Atoi(fields[1]); size/512 < current {
+		return fmt.Errorf("cannot shrink volume %s from %d to %d bytes", volName, current*512, size)
+	}
+	parms := fmt.Sprintf("dmsetup reload %s --table \"0 %d thin %s %s\" && dmsetup suspend %s && dmsetup resume %s",
+		volName, size/512, fields[3], fields[4], volName, volName)
+	if res, err := exec.Command("/bin/sh", "-c", parms).CombinedOutput(); err != nil {
+		glog.Error(string(res))
+		exec.Command("dmsetup", "resume", volName).Run()
+		return fmt.Errorf(string(res))
+	}
+	return nil
+}
+
+// VolumeDeviceId gets the thin device id of a mapped thin volume
+func VolumeDeviceId(volName string) (int, error) {
+	fields, err := volumeTable(volName)
+	if err != nil {
+		return 0, err
+	}
+	return strconv.Atoi(fields[4])
+}
+
+// ListVolumes lists the mapped thin volumes in the pool poolName, which are
+// named with the pool name as prefix.
+func ListVolumes(poolName string) ([]string, error) {
+	res, err := exec.Command("dmsetup", "ls", "--target", "thin").CombinedOutput()
+	if err != nil {
+		glog.Error(string(res))
+		return nil, fmt.Errorf(string(res))
+	}
+	// <name>	(<major>:<minor>), or "No devices found"
+	var volumes []string
+	for _, line := range strings.Split(string(res), "\n") {
+		fields := strings.Fields(line)
+		if len(fields) > 0 && strings.HasPrefix(fields[0], poolName+"-") {
+			volumes = append(volumes, fields[0])
+		}
+	}
+	return volumes, nil
+}
+
+// CreateSnapshot creates the thin device dev_id as a snapshot of the thin
+// device origin_id, the origin volume (empty if it has no mapped device) is
+// suspended during the snapshot if it is active.
+func CreateSnapshot(poolName, origin, dev_id, origin_id string) error {
+	if _, err := os.Stat("/dev/mapper/" + origin); origin != "" && err == nil {
+		if res, err := exec.Command("dmsetup", "suspend", origin).CombinedOutput(); err != nil {
+			glog.Error(string(res))
+			return fmt.Errorf(string(res))
+		}
+		defer exec.Command("dmsetup", "resume", origin).Run()
+	}
+	parms := fmt.Sprintf("dmsetup message /dev/mapper/%s 0 \"create_snap %s %s\"", poolName, dev_id, origin_id)
+	if res, err := exec.Command("/bin/sh", "-c", parms).CombinedOutput(); err != nil {
+		glog.Error(string(res))
+		return fmt.Errorf(string(res))
+	}
+	return nil
+}
+
 func UnmapVolume(deviceFullPath string) error {
 	f, err := os.Stat(deviceFullPath)
 	if err != nil && os.IsNotExist(err) {
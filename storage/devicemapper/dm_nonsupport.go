@@ -64,6 +64,26 @@ func CreateVolume(poolName, volName, dev_id string, size int, restore bool) erro
 	return nil
 }
 
+func VolumeSize(volName string) (int, error) {
+	return 0, nil
+}
+
+func ResizeVolume(volName string, size int) error {
+	return nil
+}
+
+func VolumeDeviceId(volName string) (int, error) {
+	return 0, nil
+}
+
+func ListVolumes(poolName string) ([]string, error) {
+	return nil, nil
+}
+
+func CreateSnapshot(poolName, origin, dev_id, origin_id string) error {
+	return nil
+}
+
 func DeleteVolume(dm *DeviceMapper, dev_id int) error {
 	return nil
 }
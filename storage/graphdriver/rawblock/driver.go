@@ -216,6 +216,22 @@ func CreateBlock(block, fstype, mountLabel string, size uint64) error {
 	return nil
 }
 
+// GrowBlock extends the block file to size bytes, the filesystem in it is
+// not touched.
+func GrowBlock(block string, size uint64) error {
+	fi, err := os.Stat(block)
+	if err != nil {
+		return err
+	}
+	if size < uint64(fi.Size()) {
+		return fmt.Errorf("cannot shrink block %s from %d to %d bytes", block, fi.Size(), size)
+	}
+	if out, err := exec.Command("truncate", fmt.Sprintf("--size=%d", size), block).CombinedOutput(); err != nil {
+		return fmt.Errorf("Failed to grow block:%v:%s", err, string(out))
+	}
+	return nil
+}
+
 func joinMountOptions(a, b string) string {
 	if a == "" {
 		return b
@@ -0,0 +1,41 @@
+package rawblock
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestGrowBlock(t *testing.T) {
+	tmp, err := ioutil.TempDir("", "rawblock")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(tmp)
+
+	block := filepath.Join(tmp, "block")
+	if err = ioutil.WriteFile(block, make([]byte, 4096), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = GrowBlock(block, 1<<20); err != nil {
+		t.Fatal(err)
+	}
+	if fi, err := os.Stat(block); err != nil || fi.Size() != 1<<20 {
+		t.Fatalf("block is not grown: %v, %v", fi, err)
+	}
+	// growing to the same size is a no-op
+	if err = GrowBlock(block, 1<<20); err != nil {
+		t.Fatal(err)
+	}
+	if err = GrowBlock(block, 4096); err == nil {
+		t.Fatal("block is shrunk")
+	}
+	if fi, err := os.Stat(block); err != nil || fi.Size() != 1<<20 {
+		t.Fatalf("block is changed by a refused shrink: %v, %v", fi, err)
+	}
+	if err = GrowBlock(filepath.Join(tmp, "none"), 1<<20); err == nil {
+		t.Fatal("missing block is grown")
+	}
+}
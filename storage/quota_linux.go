@@ -0,0 +1,265 @@
+package storage
+
+import (
+	"fmt"
+	"hash/fnv"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"syscall"
+
+	"github.com/docker/docker/pkg/mount"
+	"github.com/golang/glog"
+)
+
+const (
+	xfsSuperMagic   = 0x58465342
+	ext4SuperMagic  = 0xEF53
+	btrfsSuperMagic = 0x9123683E
+)
+
+func fsMagic(path string) (int64, error) {
+	var buf syscall.Statfs_t
+	if err := syscall.Statfs(path, &buf); err != nil {
+		return 0, err
+	}
+	return int64(buf.Type), nil
+}
+
+// projectLock serializes the allocation of the project quota ids, so
+// that two volumes could not pick the same free id.
+var projectLock sync.Mutex
+
+// projectId finds the project quota id of a volume directory. A directory
+// keeps the id it already has, otherwise the id derived from its path is
+// probed upwards until an id not owned by another project is found. Id 0
+// is the default project and is never used.
+func projectId(magic int64, dir, mp string) (uint32, error) {
+	if id, err := currentProjectId(magic, dir); err == nil && id != 0 {
+		return id, nil
+	}
+
+	var (
+		out string
+		err error
+	)
+	if magic == xfsSuperMagic {
+		out, err = runCmd("xfs_quota", "-x", "-c", "report -p -n -N", mp)
+	} else {
+		out, err = runCmd("repquota", "-P", "-n", mp)
+	}
+	if err != nil {
+		return 0, err
+	}
+	owned := parseQuotaReport(out)
+
+	h := fnv.New32a()
+	h.Write([]byte(dir))
+	id := h.Sum32() & 0x7fffffff
+	for id == 0 || owned[id] {
+		id = (id + 1) & 0x7fffffff
+	}
+	return id, nil
+}
+
+func currentProjectId(magic int64, dir string) (uint32, error) {
+	var (
+		out string
+		err error
+	)
+	if magic == xfsSuperMagic {
+		// projid = 123
+		if out, err = runCmd("xfs_io", "-c", "lsproj", dir); err != nil {
+			return 0, err
+		}
+		out = strings.TrimPrefix(strings.TrimSpace(out), "projid =")
+	} else {
+		// 123 --------------e-----P- /path
+		if out, err = runCmd("lsattr", "-pd", dir); err != nil {
+			return 0, err
+		}
+	}
+	fields := strings.Fields(out)
+	if len(fields) == 0 {
+		return 0, fmt.Errorf("unexpected project of %s: %s", dir, out)
+	}
+	id, err := strconv.ParseUint(fields[0], 10, 32)
+	return uint32(id), err
+}
+
+// parseQuotaReport gets the ids of the projects having any usage or limit
+// from the numeric report of xfs_quota or repquota, in which a project
+// line starts with #id.
+func parseQuotaReport(out string) map[uint32]bool {
+	owned := make(map[uint32]bool)
+	for _, line := range strings.Split(out, "\n") {
+		fields := strings.Fields(line)
+		if len(fields) == 0 || !strings.HasPrefix(fields[0], "#") {
+			continue
+		}
+		id, err := strconv.ParseUint(fields[0][1:], 10, 32)
+		if err != nil || id == 0 {
+			continue
+		}
+		for _, f := range fields[1:] {
+			if n, err := strconv.ParseUint(f, 10, 64); err == nil && n != 0 {
+				owned[uint32(id)] = true
+				break
+			}
+		}
+	}
+	return owned
+}
+
+func mountPointOf(dir string) (string, error) {
+	mounts, err := mount.GetMounts()
+	if err != nil {
+		return "", err
+	}
+	mp := ""
+	for _, m := range mounts {
+		if (dir == m.Mountpoint || strings.HasPrefix(dir, strings.TrimSuffix(m.Mountpoint, "/")+"/")) &&
+			len(m.Mountpoint) > len(mp) {
+			mp = m.Mountpoint
+		}
+	}
+	if mp == "" {
+		return "", fmt.Errorf("cannot find the mount point of %s", dir)
+	}
+	return mp, nil
+}
+
+// createQuotaDir creates the directory of a sized vfs volume, which is a
+// subvolume on btrfs, so that a qgroup limit could be set on it.
+func createQuotaDir(dir string) error {
+	magic, err := fsMagic(filepath.Dir(dir))
+	if err != nil {
+		return err
+	}
+	if magic == btrfsSuperMagic {
+		return CreateSubvolume(dir)
+	}
+	return os.Mkdir(dir, os.FileMode(0777))
+}
+
+func removeQuotaDir(dir string) error {
+	if magic, err := fsMagic(dir); err == nil && magic == btrfsSuperMagic {
+		if err := DeleteSubvolume(dir); err == nil {
+			return nil
+		}
+	}
+	return os.RemoveAll(dir)
+}
+
+// SetVFSVolumeQuota limits the size of a vfs volume directory with the
+// project quota of xfs/ext4 or the qgroup of btrfs.
+func SetVFSVolumeQuota(dir string, size uint64) error {
+	magic, err := fsMagic(dir)
+	if err != nil {
+		return err
+	}
+
+	switch magic {
+	case btrfsSuperMagic:
+		if _, err = runCmd("btrfs", "quota", "enable", dir); err != nil {
+			return err
+		}
+		_, err = runCmd("btrfs", "qgroup", "limit", strconv.FormatUint(size, 10), dir)
+	case xfsSuperMagic:
+		var (
+			mp string
+			id uint32
+		)
+		if mp, err = mountPointOf(dir); err != nil {
+			return err
+		}
+		projectLock.Lock()
+		defer projectLock.Unlock()
+		if id, err = projectId(magic, dir, mp); err != nil {
+			return err
+		}
+		if _, err = runCmd("xfs_quota", "-x", "-c", fmt.Sprintf("project -s -p %s %d", dir, id), mp); err != nil {
+			return err
+		}
+		_, err = runCmd("xfs_quota", "-x", "-c", fmt.Sprintf("limit -p bhard=%d %d", size, id), mp)
+	case ext4SuperMagic:
+		var (
+			mp string
+			id uint32
+		)
+		if mp, err = mountPointOf(dir); err != nil {
+			return err
+		}
+		projectLock.Lock()
+		defer projectLock.Unlock()
+		if id, err = projectId(magic, dir, mp); err != nil {
+			return err
+		}
+		pid := strconv.FormatUint(uint64(id), 10)
+		if _, err = runCmd("chattr", "-p", pid, "+P", dir); err != nil {
+			return err
+		}
+		_, err = runCmd("setquota", "-P", pid, "0", strconv.FormatUint(size/1024, 10), "0", "0", mp)
+	default:
+		err = fmt.Errorf("volume size is not supported on the filesystem of %s", dir)
+	}
+	if err != nil {
+		return err
+	}
+	glog.V(1).Infof("set quota of %s to %d bytes", dir, size)
+	return nil
+}
+
+// VFSVolumeUsage gets the size, used and available bytes of a vfs volume
+// directory. They are the limits of the quota if the directory has one,
+// or the numbers of the filesystem holding it.
+func VFSVolumeUsage(dir string, quota bool) (size, used, avail uint64, err error) {
+	var buf syscall.Statfs_t
+	if err = syscall.Statfs(dir, &buf); err != nil {
+		return 0, 0, 0, err
+	}
+
+	if quota && int64(buf.Type) == btrfsSuperMagic {
+		out, err := runCmd("btrfs", "qgroup", "show", "-rf", "--raw", dir)
+		if err != nil {
+			return 0, 0, 0, err
+		}
+		if size, used, err = parseQgroupShow(out); err != nil {
+			return 0, 0, 0, fmt.Errorf("unexpected qgroup of %s: %v", dir, err)
+		}
+		if size > used {
+			avail = size - used
+		}
+		return size, used, avail, nil
+	}
+
+	// statfs on a directory with the project inherit flag reports the
+	// limit of the project quota on xfs and ext4
+	size = buf.Blocks * uint64(buf.Bsize)
+	used = (buf.Blocks - buf.Bfree) * uint64(buf.Bsize)
+	avail = buf.Bavail * uint64(buf.Bsize)
+	return size, used, avail, nil
+}
+
+// parseQgroupShow gets the limit and the referenced bytes of the qgroup
+// from the output of "btrfs qgroup show -rf --raw", a limit of "none"
+// is reported as 0.
+func parseQgroupShow(out string) (size, used uint64, err error) {
+	// qgroupid rfer excl max_rfer
+	lines := strings.Split(strings.TrimSpace(out), "\n")
+	fields := strings.Fields(lines[len(lines)-1])
+	if len(fields) < 4 {
+		return 0, 0, fmt.Errorf("%q", out)
+	}
+	if used, err = strconv.ParseUint(fields[1], 10, 64); err != nil {
+		return 0, 0, fmt.Errorf("%q", out)
+	}
+	if fields[3] != "none" {
+		if size, err = strconv.ParseUint(fields[3], 10, 64); err != nil {
+			return 0, 0, fmt.Errorf("%q", out)
+		}
+	}
+	return size, used, nil
+}
@@ -0,0 +1,70 @@
+package storage
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParseQgroupShow(t *testing.T) {
+	for _, c := range []struct {
+		out        string
+		size, used uint64
+		fail       bool
+	}{
+		{
+			out: "qgroupid         rfer         excl     max_rfer \n" +
+				"--------         ----         ----     -------- \n" +
+				"0/259           16384        16384   1073741824 \n",
+			size: 1073741824, used: 16384,
+		},
+		{
+			out: "qgroupid         rfer         excl     max_rfer \n" +
+				"--------         ----         ----     -------- \n" +
+				"0/260           32768        32768         none \n",
+			used: 32768,
+		},
+		{out: "", fail: true},
+		{out: "qgroupid         rfer         excl     max_rfer \n--------         ----         ----     -------- \n", fail: true},
+		{out: "0/261 16.00KiB 16.00KiB 1.00GiB\n", fail: true},
+	} {
+		size, used, err := parseQgroupShow(c.out)
+		if c.fail {
+			if err == nil {
+				t.Errorf("parse %q: expect an error", c.out)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("parse %q: %v", c.out, err)
+			continue
+		}
+		if size != c.size || used != c.used {
+			t.Errorf("parse %q: got %d/%d, expect %d/%d", c.out, size, used, c.size, c.used)
+		}
+	}
+}
+
+func TestParseQuotaReport(t *testing.T) {
+	// xfs_quota -x -c "report -p -n -N"
+	xfs := "#0              1024          0          0     00 [--------]\n" +
+		"#100               0          0    1048576     00 [--------]\n" +
+		"#101               4          0          0     00 [--------]\n" +
+		"#102               0          0          0     00 [--------]\n"
+	if owned := parseQuotaReport(xfs); !reflect.DeepEqual(owned, map[uint32]bool{100: true, 101: true}) {
+		t.Fatalf("unexpected xfs projects %v", owned)
+	}
+
+	// repquota -P -n
+	ext4 := "*** Report for project quotas on device /dev/sda1\n" +
+		"Block grace time: 7days; Inode grace time: 7days\n" +
+		"                        Block limits                File limits\n" +
+		"Project         used    soft    hard  grace    used  soft  hard  grace\n" +
+		"----------------------------------------------------------------------\n" +
+		"#0        --      20       0       0              2     0     0       \n" +
+		"#200      --       0       0    1024              0     0     0       \n" +
+		"#201      --       0       0       0              1     0     0       \n" +
+		"#202      --       0       0       0              0     0     0       \n"
+	if owned := parseQuotaReport(ext4); !reflect.DeepEqual(owned, map[uint32]bool{200: true, 201: true}) {
+		t.Fatalf("unexpected ext4 projects %v", owned)
+	}
+}
@@ -0,0 +1,24 @@
+// +build !linux
+
+package storage
+
+import (
+	"fmt"
+	"os"
+)
+
+func createQuotaDir(dir string) error {
+	return fmt.Errorf("volume size is not supported on the filesystem of %s", dir)
+}
+
+func removeQuotaDir(dir string) error {
+	return os.RemoveAll(dir)
+}
+
+func SetVFSVolumeQuota(dir string, size uint64) error {
+	return fmt.Errorf("volume size is not supported on the filesystem of %s", dir)
+}
+
+func VFSVolumeUsage(dir string, quota bool) (size, used, avail uint64, err error) {
+	return 0, 0, 0, fmt.Errorf("volume usage is not supported in current arch")
+}
@@ -0,0 +1,73 @@
+package storage
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+
+	"github.com/golang/glog"
+)
+
+func runCmd(name string, args ...string) (string, error) {
+	out, err := exec.Command(name, args...).CombinedOutput()
+	if err != nil {
+		glog.Errorf("%s %s failed: %v, %s", name, strings.Join(args, " "), err, out)
+		return "", fmt.Errorf("%s failed: %s", name, strings.TrimSpace(string(out)))
+	}
+	return string(out), nil
+}
+
+// CreateSubvolume creates a btrfs subvolume at dir
+func CreateSubvolume(dir string) error {
+	_, err := runCmd("btrfs", "subvolume", "create", dir)
+	return err
+}
+
+// SnapshotSubvolume takes a copy-on-write snapshot of the btrfs subvolume
+// src at dst, the parent dir of dst is created if needed.
+func SnapshotSubvolume(src, dst string, readonly bool) error {
+	if err := os.MkdirAll(filepath.Dir(dst), 0700); err != nil {
+		return err
+	}
+	args := []string{"subvolume", "snapshot"}
+	if readonly {
+		args = append(args, "-r")
+	}
+	_, err := runCmd("btrfs", append(args, src, dst)...)
+	return err
+}
+
+// DeleteSubvolume deletes the btrfs subvolume at dir
+func DeleteSubvolume(dir string) error {
+	_, err := runCmd("btrfs", "subvolume", "delete", dir)
+	return err
+}
+
+// ReflinkFile copies the file src to dst, the data is shared by reflinks.
+// It fails if the filesystem does not support reflinks, a full copy of a
+// volume is neither instant nor space saving, which a snapshot should be.
+func ReflinkFile(src, dst string) error {
+	if err := os.MkdirAll(filepath.Dir(dst), 0700); err != nil {
+		return err
+	}
+	if _, err := runCmd("cp", "-a", "--reflink=always", src, dst); err != nil {
+		os.Remove(dst)
+		return fmt.Errorf("failed to reflink %s, the filesystem may not support reflinks: %v", src, err)
+	}
+	return nil
+}
+
+// ListSnapshots lists the names of the snapshots kept in dir by the file
+// and subvolume based storages.
+func ListSnapshots(dir string) ([]string, error) {
+	f, err := os.Open(dir)
+	if os.IsNotExist(err) {
+		return []string{}, nil
+	} else if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	return f.Readdirnames(-1)
+}
@@ -0,0 +1,66 @@
+package storage
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"testing"
+)
+
+func TestReflinkFile(t *testing.T) {
+	tmp, err := ioutil.TempDir("", "reflink")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(tmp)
+
+	src := filepath.Join(tmp, "src")
+	content := []byte("volume data")
+	if err = ioutil.WriteFile(src, content, 0600); err != nil {
+		t.Fatal(err)
+	}
+	dst := filepath.Join(tmp, "snapshots", "volume", "snap")
+	if err = ReflinkFile(src, dst); err != nil {
+		// the filesystem of the temp dir does not support reflinks, the
+		// file should not be copied then
+		if _, e := os.Stat(dst); !os.IsNotExist(e) {
+			t.Fatalf("file is left without reflink: %v", err)
+		}
+		t.Skipf("reflink is not supported: %v", err)
+	}
+	data, err := ioutil.ReadFile(dst)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(data, content) {
+		t.Fatalf("reflinked content %q, expect %q", data, content)
+	}
+}
+
+func TestListSnapshots(t *testing.T) {
+	tmp, err := ioutil.TempDir("", "snapshots")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(tmp)
+
+	if names, err := ListSnapshots(filepath.Join(tmp, "none")); err != nil || len(names) != 0 {
+		t.Fatalf("list snapshots in missing dir: %v, %v", names, err)
+	}
+	for _, n := range []string{"s1", "s2"} {
+		if err = ioutil.WriteFile(filepath.Join(tmp, n), nil, 0600); err != nil {
+			t.Fatal(err)
+		}
+	}
+	names, err := ListSnapshots(tmp)
+	if err != nil {
+		t.Fatal(err)
+	}
+	sort.Strings(names)
+	if !reflect.DeepEqual(names, []string{"s1", "s2"}) {
+		t.Fatalf("unexpected snapshots %v", names)
+	}
+}
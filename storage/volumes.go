@@ -1,6 +1,7 @@
 package storage
 
 import (
+	"fmt"
 	"os"
 	"path"
 	"path/filepath"
@@ -11,16 +12,48 @@ import (
 	"github.com/hyperhq/hyperd/utils"
 )
 
-func CreateVFSVolume(podId, shortName string) (string, error) {
-	volName := path.Join("/var/tmp/hyper", podId, shortName)
-	if _, err := os.Stat(volName); err != nil && os.IsNotExist(err) {
+// CreateVFSVolume creates the directory of a vfs volume, a non-zero size
+// is applied as the quota of the directory.
+func CreateVFSVolume(podId, shortName string, size uint64) (string, error) {
+	volName := path.Join(DEFAULT_VFS_VOL_ROOT, podId, shortName)
+	if _, err := os.Stat(volName); !os.IsNotExist(err) {
+		return volName, nil
+	}
+	if size == 0 {
 		if err := os.MkdirAll(volName, os.FileMode(0777)); err != nil {
 			return "", err
 		}
+		return volName, nil
+	}
+
+	if err := os.MkdirAll(filepath.Dir(volName), os.FileMode(0777)); err != nil {
+		return "", err
+	}
+	if err := createQuotaDir(volName); err != nil {
+		return "", err
+	}
+	if err := SetVFSVolumeQuota(volName, size); err != nil {
+		removeQuotaDir(volName)
+		return "", err
 	}
 	return volName, nil
 }
 
+// ResizeVFSVolume raises the quota of a vfs volume directory from the
+// current size, 0 means the directory has no quota yet.
+func ResizeVFSVolume(dir string, current, size uint64) error {
+	if size < current {
+		return fmt.Errorf("cannot shrink volume %s from %d to %d bytes", dir, current, size)
+	}
+	return SetVFSVolumeQuota(dir, size)
+}
+
+// RemoveVFSVolume removes the directory of a vfs volume, including the
+// subvolume created for its quota.
+func RemoveVFSVolume(dir string) error {
+	return removeQuotaDir(dir)
+}
+
 func MountVFSVolume(src, sharedDir string) (string, error) {
 	var flags uintptr = utils.MS_BIND
 
@@ -62,6 +95,16 @@ func MountVFSVolume(src, sharedDir string) (string, error) {
 	return mountSharedDir, nil
 }
 
+// RebindVFSVolume binds src over the target of a vfs volume in the shared
+// dir, so that a file volume replaced by a rename is seen through the
+// existing target. The previous bind is kept below and is unmounted with
+// the volume.
+func RebindVFSVolume(src, vol, sharedDir string) error {
+	target := path.Join(sharedDir, vol)
+	glog.V(1).Infof("trying to rebind %s to %s", src, target)
+	return utils.Mount(src, target, "none", utils.MS_BIND, "--bind")
+}
+
 func UmountVFSVolume(vol, sharedDir string) error {
 	mount := path.Join(sharedDir, vol)
 
@@ -74,6 +117,9 @@ func UmountVFSVolume(vol, sharedDir string) error {
 			return err
 		}
 	}
+	// drop the binds stacked by RebindVFSVolume
+	for syscall.Unmount(mount, syscall.MNT_DETACH) == nil {
+	}
 
 	os.Remove(mount)
 	return nil
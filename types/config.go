@@ -13,21 +13,33 @@ import (
 type HyperConfig struct {
 	ConfigFile string
 
-	Root            string
-	Host            string
-	GRPCHost        string
-	StorageDriver   string
-	StorageBaseSize int
-	VmFactoryPolicy string
-	Driver          string
-	Kernel          string
-	Initrd          string
-	Bridge          string
-	BridgeIP        string
-	DisableIptables bool
-	EnableVsock     bool
-	DefaultLog      string
-	DefaultLogOpt   map[string]string
+	Root               string
+	Host               string
+	GRPCHost           string
+	GRPCTLSCert        string
+	GRPCTLSKey         string
+	GRPCTLSCACert      string
+	GRPCSocketGroup    string
+	StorageDriver      string
+	GraphDriver        string
+	StorageBaseSize    int
+	VmFactoryPolicy    string
+	Driver             string
+	Kernel             string
+	Initrd             string
+	Bridge             string
+	BridgeIP           string
+	BridgeIPv6         string
+	DisableIptables    bool
+	DisableDNS         bool
+	PruneOnRestore     bool
+	DNSDomain          string
+	HostPortRange      string
+	PortMappingBackend string
+	ProxyUDPTimeout    int
+	EnableVsock        bool
+	DefaultLog         string
+	DefaultLogOpt      map[string]string
 
 	logPrefix string
 }
@@ -56,20 +68,36 @@ func NewHyperConfig(config string) *HyperConfig {
 	}
 
 	c.StorageDriver, _ = cfg.GetValue(goconfig.DEFAULT_SECTION, "StorageDriver")
+	c.GraphDriver, _ = cfg.GetValue(goconfig.DEFAULT_SECTION, "GraphDriver")
+	if c.GraphDriver == "" {
+		// the docker graph driver is the same as the hyperd storage by default
+		c.GraphDriver = c.StorageDriver
+	}
 	c.StorageBaseSize = cfg.MustInt(goconfig.DEFAULT_SECTION, "StorageBaseSize", 0)
 	c.Kernel, _ = cfg.GetValue(goconfig.DEFAULT_SECTION, "Kernel")
 	c.Initrd, _ = cfg.GetValue(goconfig.DEFAULT_SECTION, "Initrd")
 	c.Bridge, _ = cfg.GetValue(goconfig.DEFAULT_SECTION, "Bridge")
 	c.BridgeIP, _ = cfg.GetValue(goconfig.DEFAULT_SECTION, "BridgeIP")
+	c.BridgeIPv6, _ = cfg.GetValue(goconfig.DEFAULT_SECTION, "BridgeIPv6")
 	c.Host, _ = cfg.GetValue(goconfig.DEFAULT_SECTION, "Host")
 	driver, _ := cfg.GetValue(goconfig.DEFAULT_SECTION, "Hypervisor")
 	c.Driver = strings.ToLower(driver)
 	c.DisableIptables = cfg.MustBool(goconfig.DEFAULT_SECTION, "DisableIptables", false)
+	c.DisableDNS = cfg.MustBool(goconfig.DEFAULT_SECTION, "DisableDNS", false)
+	c.PruneOnRestore = cfg.MustBool(goconfig.DEFAULT_SECTION, "PruneOrphansOnRestore", false)
+	c.DNSDomain, _ = cfg.GetValue(goconfig.DEFAULT_SECTION, "DNSDomain")
+	c.HostPortRange, _ = cfg.GetValue(goconfig.DEFAULT_SECTION, "HostPortRange")
+	c.PortMappingBackend, _ = cfg.GetValue(goconfig.DEFAULT_SECTION, "PortMappingBackend")
+	c.ProxyUDPTimeout = cfg.MustInt(goconfig.DEFAULT_SECTION, "UserlandProxyUDPTimeout", 0)
 	c.EnableVsock = cfg.MustBool(goconfig.DEFAULT_SECTION, "EnableVsock", false)
 	c.DefaultLog, _ = cfg.GetValue(goconfig.DEFAULT_SECTION, "Logger")
 	c.DefaultLogOpt, _ = cfg.GetSection("Log")
 	c.VmFactoryPolicy, _ = cfg.GetValue(goconfig.DEFAULT_SECTION, "VmFactoryPolicy")
 	c.GRPCHost, _ = cfg.GetValue(goconfig.DEFAULT_SECTION, "gRPCHost")
+	c.GRPCTLSCert, _ = cfg.GetValue(goconfig.DEFAULT_SECTION, "gRPCTLSCert")
+	c.GRPCTLSKey, _ = cfg.GetValue(goconfig.DEFAULT_SECTION, "gRPCTLSKey")
+	c.GRPCTLSCACert, _ = cfg.GetValue(goconfig.DEFAULT_SECTION, "gRPCTLSCACert")
+	c.GRPCSocketGroup, _ = cfg.GetValue(goconfig.DEFAULT_SECTION, "gRPCSocketGroup")
 
 	c.Log(hlog.INFO, "config items: %#v", c)
 	return c
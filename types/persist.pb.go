@@ -105,6 +105,8 @@ func (m *PersistPodMeta) GetCreatedAt() int64 {
 type SandboxPersistInfo struct {
 	Id          string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	PersistInfo []byte `protobuf:"bytes,2,opt,name=PersistInfo,proto3" json:"PersistInfo,omitempty"`
+	// memory has been hot-added to the sandbox
+	MemHotplugged bool `protobuf:"varint,3,opt,name=memHotplugged,proto3" json:"memHotplugged,omitempty"`
 }
 
 func (m *SandboxPersistInfo) Reset()                    { *m = SandboxPersistInfo{} }
@@ -126,11 +128,19 @@ func (m *SandboxPersistInfo) GetPersistInfo() []byte {
 	return nil
 }
 
+func (m *SandboxPersistInfo) GetMemHotplugged() bool {
+	if m != nil {
+		return m.MemHotplugged
+	}
+	return false
+}
+
 type PersistContainer struct {
-	Id       string                    `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Pod      string                    `protobuf:"bytes,2,opt,name=pod,proto3" json:"pod,omitempty"`
-	Spec     *UserContainer            `protobuf:"bytes,11,opt,name=spec" json:"spec,omitempty"`
-	Descript *api.ContainerDescription `protobuf:"bytes,12,opt,name=descript" json:"descript,omitempty"`
+	Id           string                    `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Pod          string                    `protobuf:"bytes,2,opt,name=pod,proto3" json:"pod,omitempty"`
+	Spec         *UserContainer            `protobuf:"bytes,11,opt,name=spec" json:"spec,omitempty"`
+	Descript     *api.ContainerDescription `protobuf:"bytes,12,opt,name=descript" json:"descript,omitempty"`
+	RestartCount int32                     `protobuf:"varint,21,opt,name=restartCount,proto3" json:"restartCount,omitempty"`
 }
 
 func (m *PersistContainer) Reset()                    { *m = PersistContainer{} }
@@ -166,6 +176,13 @@ func (m *PersistContainer) GetDescript() *api.ContainerDescription {
 	return nil
 }
 
+func (m *PersistContainer) GetRestartCount() int32 {
+	if m != nil {
+		return m.RestartCount
+	}
+	return 0
+}
+
 type PersistVolume struct {
 	Name     string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
 	Pod      string                 `protobuf:"bytes,2,opt,name=pod,proto3" json:"pod,omitempty"`
@@ -247,9 +264,10 @@ func (m *PersistInterface) GetDescript() *api.InterfaceDescription {
 }
 
 type PersistPortmappings struct {
-	Pod          string         `protobuf:"bytes,1,opt,name=pod,proto3" json:"pod,omitempty"`
-	ContainerIP  string         `protobuf:"bytes,2,opt,name=containerIP,proto3" json:"containerIP,omitempty"`
-	PortMappings []*PortMapping `protobuf:"bytes,11,rep,name=portMappings" json:"portMappings,omitempty"`
+	Pod           string         `protobuf:"bytes,1,opt,name=pod,proto3" json:"pod,omitempty"`
+	ContainerIP   string         `protobuf:"bytes,2,opt,name=containerIP,proto3" json:"containerIP,omitempty"`
+	ContainerIPv6 string         `protobuf:"bytes,3,opt,name=containerIPv6,proto3" json:"containerIPv6,omitempty"`
+	PortMappings  []*PortMapping `protobuf:"bytes,11,rep,name=portMappings" json:"portMappings,omitempty"`
 }
 
 func (m *PersistPortmappings) Reset()                    { *m = PersistPortmappings{} }
@@ -271,6 +289,13 @@ func (m *PersistPortmappings) GetContainerIP() string {
 	return ""
 }
 
+func (m *PersistPortmappings) GetContainerIPv6() string {
+	if m != nil {
+		return m.ContainerIPv6
+	}
+	return ""
+}
+
 func (m *PersistPortmappings) GetPortMappings() []*PortMapping {
 	if m != nil {
 		return m.PortMappings
@@ -291,38 +316,41 @@ func init() {
 func init() { proto.RegisterFile("persist.proto", fileDescriptorPersist) }
 
 var fileDescriptorPersist = []byte{
-	// 514 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x09, 0x6e, 0x88, 0x02, 0xff, 0xa4, 0x94, 0x5d, 0x6f, 0xd3, 0x3e,
-	0x14, 0xc6, 0x95, 0x76, 0xdb, 0x7f, 0x3b, 0xe9, 0xa6, 0xfe, 0xcd, 0x36, 0x4c, 0x85, 0x50, 0xa8,
-	0x84, 0xd4, 0xab, 0x54, 0x2a, 0x02, 0x31, 0xee, 0x10, 0x2f, 0x52, 0xa5, 0x4d, 0xaa, 0x52, 0xc1,
-	0xbd, 0x93, 0x78, 0xad, 0x45, 0x6a, 0x1b, 0xdb, 0xa9, 0xc8, 0x25, 0xdf, 0x80, 0x1b, 0xee, 0xf8,
-	0x78, 0x7c, 0x10, 0x14, 0xc7, 0x79, 0x29, 0xad, 0xc4, 0x05, 0x77, 0xf6, 0x73, 0x9e, 0xf3, 0xf4,
-	0x97, 0x63, 0xbb, 0x70, 0x2e, 0xa9, 0xd2, 0x4c, 0x9b, 0x50, 0x2a, 0x61, 0x04, 0x3a, 0x36, 0x85,
-	0xa4, 0x7a, 0x14, 0xae, 0x98, 0x59, 0xe7, 0x71, 0x98, 0x88, 0xcd, 0x74, 0x5d, 0x48, 0xaa, 0xd6,
-	0x5f, 0xa6, 0x2a, 0xe7, 0xdb, 0x29, 0x91, 0x6c, 0x9a, 0x52, 0x9d, 0x28, 0x26, 0x0d, 0x13, 0x5c,
-	0x57, 0x6d, 0x23, 0xdf, 0xb6, 0x55, 0x9b, 0xf1, 0x4f, 0x0f, 0x86, 0x8b, 0x2a, 0x75, 0x21, 0xd2,
-	0x5b, 0x52, 0x88, 0xdc, 0xa0, 0x0b, 0xe8, 0xb1, 0x14, 0x7b, 0x81, 0x37, 0x39, 0x8b, 0x7a, 0x2c,
-	0x45, 0x4f, 0x00, 0x56, 0x99, 0x88, 0x49, 0xb6, 0x94, 0x34, 0xc1, 0xbe, 0xd5, 0x3b, 0x4a, 0x59,
-	0x4f, 0x04, 0x37, 0x84, 0x71, 0xaa, 0x34, 0xbe, 0x0a, 0xfa, 0x65, 0xbd, 0x55, 0x10, 0x86, 0xff,
-	0xb6, 0x22, 0xcb, 0x37, 0x54, 0xe3, 0x6b, 0x5b, 0xac, 0xb7, 0x65, 0x27, 0xe3, 0x86, 0xaa, 0x7b,
-	0x92, 0x50, 0x8d, 0x1f, 0x56, 0x9d, 0xad, 0x32, 0xfe, 0xe5, 0xc1, 0x45, 0x8b, 0x77, 0x47, 0x0d,
-	0xd9, 0x83, 0x0b, 0xe1, 0x54, 0x53, 0xb5, 0x65, 0x65, 0x80, 0x1f, 0xf4, 0x27, 0xfe, 0x0c, 0x85,
-	0xd5, 0x17, 0x7e, 0xd4, 0x54, 0x2d, 0xab, 0x52, 0xd4, 0x78, 0xd0, 0x0d, 0x9c, 0x64, 0x24, 0xa6,
-	0x99, 0xc6, 0x03, 0xeb, 0x7e, 0xea, 0xdc, 0xbb, 0x3f, 0x13, 0xde, 0x5a, 0xcf, 0x7b, 0x6e, 0x54,
-	0x11, 0xb9, 0x06, 0xf4, 0x18, 0xce, 0x12, 0x45, 0x89, 0xa1, 0xe9, 0x1b, 0x83, 0xaf, 0x02, 0x6f,
-	0xd2, 0x8f, 0x5a, 0x61, 0x74, 0x03, 0x7e, 0xa7, 0x09, 0x0d, 0xa1, 0xff, 0x99, 0x16, 0x0e, 0xb4,
-	0x5c, 0xa2, 0x4b, 0x38, 0xde, 0x92, 0x2c, 0xa7, 0xb8, 0x67, 0xb5, 0x6a, 0xf3, 0xba, 0xf7, 0xca,
-	0x1b, 0x7f, 0x00, 0xb4, 0x24, 0x3c, 0x8d, 0xc5, 0x57, 0x47, 0x31, 0xe7, 0xf7, 0x62, 0xef, 0x4b,
-	0x03, 0xf0, 0x3b, 0x65, 0x9b, 0x32, 0x88, 0xba, 0xd2, 0xf8, 0x47, 0x7b, 0x9a, 0x6f, 0xeb, 0xf1,
-	0xef, 0xc5, 0x0c, 0xa1, 0x2f, 0x45, 0xea, 0x20, 0xca, 0x25, 0x9a, 0xc0, 0x91, 0xae, 0x4f, 0xd6,
-	0x9f, 0x5d, 0x76, 0xc6, 0xd7, 0xa4, 0x44, 0xd6, 0x81, 0x5e, 0xc0, 0x69, 0x7d, 0xa3, 0xf0, 0xc0,
-	0xba, 0x1f, 0x85, 0x44, 0xb2, 0xb0, 0xf1, 0xbd, 0x6b, 0xef, 0x5b, 0xd4, 0x58, 0xc7, 0xdf, 0x3d,
-	0x38, 0x77, 0x5c, 0x9f, 0xec, 0xc9, 0x23, 0x04, 0x47, 0x9c, 0x6c, 0xa8, 0xc3, 0xb2, 0xeb, 0x03,
-	0x60, 0xcf, 0x76, 0xc0, 0xfe, 0xef, 0x80, 0x55, 0x31, 0x8e, 0x6a, 0xb6, 0x47, 0x75, 0x6d, 0xa9,
-	0x2a, 0xd3, 0x61, 0xa4, 0xce, 0xa8, 0xe6, 0xf5, 0x7d, 0xfb, 0xa7, 0x51, 0x35, 0x29, 0x7f, 0x19,
-	0x55, 0xe3, 0x3b, 0xcc, 0xf5, 0xcd, 0x83, 0x07, 0xcd, 0x55, 0x54, 0x66, 0x43, 0xa4, 0x64, 0x7c,
-	0xa5, 0x6b, 0x14, 0xaf, 0x45, 0x09, 0xc0, 0x6f, 0xde, 0xd8, 0x7c, 0xe1, 0x20, 0xbb, 0x12, 0x7a,
-	0x09, 0x03, 0x29, 0x94, 0xb9, 0x73, 0x19, 0x7f, 0x3c, 0x8f, 0x45, 0x5b, 0x8a, 0x76, 0x7c, 0xf1,
-	0x89, 0xfd, 0x6f, 0x78, 0xfe, 0x3b, 0x00, 0x00, 0xff, 0xff, 0x65, 0x89, 0x38, 0x67, 0x70, 0x04,
+	// 562 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x94, 0xdf, 0x6e, 0xd3, 0x3e,
+	0x14, 0xc7, 0x95, 0x66, 0xdb, 0x6f, 0x3d, 0x69, 0xa7, 0xfe, 0xcc, 0x36, 0x42, 0x85, 0x50, 0x88,
+	0x40, 0xea, 0x55, 0x2a, 0x15, 0x31, 0x31, 0xee, 0xd0, 0x40, 0xa2, 0xd2, 0x26, 0x55, 0xa9, 0xe0,
+	0xde, 0x4d, 0xbc, 0xd6, 0x22, 0xb1, 0x8d, 0xed, 0x54, 0xf4, 0x2d, 0xb8, 0xe1, 0x8e, 0x7b, 0x9e,
+	0x81, 0xf7, 0xe1, 0x41, 0x50, 0x9c, 0xff, 0xb4, 0x12, 0x17, 0xdc, 0xd9, 0xdf, 0xf3, 0x3d, 0xc7,
+	0x9f, 0x1c, 0x1f, 0x07, 0x86, 0x82, 0x48, 0x45, 0x95, 0x0e, 0x84, 0xe4, 0x9a, 0xa3, 0x63, 0xbd,
+	0x13, 0x44, 0x8d, 0x83, 0x35, 0xd5, 0x9b, 0x6c, 0x15, 0x44, 0x3c, 0x9d, 0x6e, 0x76, 0x82, 0xc8,
+	0xcd, 0xe7, 0xa9, 0xcc, 0xd8, 0x76, 0x8a, 0x05, 0x9d, 0xc6, 0x44, 0x45, 0x92, 0x0a, 0x4d, 0x39,
+	0x53, 0x45, 0xda, 0xd8, 0x31, 0x69, 0xc5, 0xc6, 0xff, 0x6e, 0xc1, 0x68, 0x51, 0x54, 0x5d, 0xf0,
+	0xf8, 0x16, 0xef, 0x78, 0xa6, 0xd1, 0x19, 0xf4, 0x68, 0xec, 0x5a, 0x9e, 0x35, 0xe9, 0x87, 0x3d,
+	0x1a, 0xa3, 0x27, 0x00, 0xeb, 0x84, 0xaf, 0x70, 0xb2, 0x14, 0x24, 0x72, 0x1d, 0xa3, 0xb7, 0x94,
+	0x3c, 0x1e, 0x71, 0xa6, 0x31, 0x65, 0x44, 0x2a, 0xf7, 0xc2, 0xb3, 0xf3, 0x78, 0xa3, 0x20, 0x17,
+	0xfe, 0xdb, 0xf2, 0x24, 0x4b, 0x89, 0x72, 0x2f, 0x4d, 0xb0, 0xda, 0xe6, 0x99, 0x94, 0x69, 0x22,
+	0xef, 0x71, 0x44, 0x94, 0xfb, 0xb0, 0xc8, 0x6c, 0x14, 0xff, 0x97, 0x05, 0x67, 0x0d, 0xde, 0x1d,
+	0xd1, 0x78, 0x0f, 0x2e, 0x80, 0x53, 0x45, 0xe4, 0x96, 0xe6, 0x05, 0x1c, 0xcf, 0x9e, 0x38, 0x33,
+	0x14, 0x14, 0x5f, 0xf8, 0x41, 0x11, 0xb9, 0x2c, 0x42, 0x61, 0xed, 0x41, 0xd7, 0x70, 0x92, 0xe0,
+	0x15, 0x49, 0x94, 0x3b, 0x30, 0xee, 0xa7, 0xa5, 0xbb, 0x7b, 0x4c, 0x70, 0x6b, 0x3c, 0xef, 0x98,
+	0x96, 0xbb, 0xb0, 0x4c, 0x40, 0x8f, 0xa1, 0x1f, 0x49, 0x82, 0x35, 0x89, 0xdf, 0x68, 0xf7, 0xc2,
+	0xb3, 0x26, 0x76, 0xd8, 0x08, 0xe3, 0x6b, 0x70, 0x5a, 0x49, 0x68, 0x04, 0xf6, 0x27, 0xb2, 0x2b,
+	0x41, 0xf3, 0x25, 0x3a, 0x87, 0xe3, 0x2d, 0x4e, 0x32, 0xe2, 0xf6, 0x8c, 0x56, 0x6c, 0x5e, 0xf7,
+	0x5e, 0x59, 0x7e, 0x02, 0x68, 0x89, 0x59, 0xbc, 0xe2, 0x5f, 0x4a, 0x8a, 0x39, 0xbb, 0xe7, 0x7b,
+	0x5f, 0xea, 0x81, 0xd3, 0x0a, 0x9b, 0x2a, 0x83, 0xb0, 0x2d, 0xa1, 0x67, 0x30, 0x4c, 0x49, 0xfa,
+	0x9e, 0x6b, 0x91, 0x64, 0xeb, 0x35, 0x89, 0x5d, 0xdb, 0xb3, 0x26, 0xa7, 0x61, 0x57, 0xf4, 0x7f,
+	0x36, 0x77, 0x7e, 0x53, 0x5d, 0xd2, 0xde, 0x61, 0x23, 0xb0, 0x05, 0x8f, 0x4b, 0xd4, 0x7c, 0x89,
+	0x26, 0x70, 0xa4, 0xaa, 0xfb, 0x77, 0x66, 0xe7, 0xad, 0x26, 0xd7, 0x55, 0x42, 0xe3, 0x40, 0x2f,
+	0xe1, 0xb4, 0x9a, 0x3b, 0x77, 0x60, 0xdc, 0x8f, 0x02, 0x2c, 0x68, 0x50, 0xfb, 0xde, 0x36, 0x53,
+	0x19, 0xd6, 0x56, 0xe4, 0xc3, 0x40, 0x12, 0xa5, 0xb1, 0xd4, 0x37, 0x3c, 0x63, 0x45, 0x87, 0x8f,
+	0xc3, 0x8e, 0xe6, 0x7f, 0xb5, 0x60, 0x58, 0xb2, 0x7f, 0x34, 0x33, 0x84, 0x10, 0x1c, 0x31, 0x9c,
+	0x92, 0x12, 0xdd, 0xac, 0x0f, 0xc0, 0x3f, 0xef, 0xc0, 0xff, 0xdf, 0x82, 0x2f, 0xca, 0x94, 0xe4,
+	0xb3, 0x3d, 0xf2, 0x4b, 0x43, 0x5e, 0x98, 0x0e, 0x62, 0xfb, 0xdf, 0x9a, 0x76, 0xce, 0xab, 0xc9,
+	0xfd, 0xa7, 0x76, 0xd6, 0x55, 0xfe, 0xd2, 0xce, 0xda, 0x77, 0x98, 0xeb, 0x87, 0x05, 0x0f, 0xea,
+	0xa1, 0x96, 0x3a, 0xc5, 0x42, 0x50, 0xb6, 0x56, 0x15, 0x8a, 0xd5, 0xa0, 0x78, 0xe0, 0xd4, 0xaf,
+	0x75, 0xbe, 0x28, 0x21, 0xdb, 0x52, 0x3e, 0x58, 0xad, 0xed, 0xf6, 0xca, 0x0c, 0x56, 0x3f, 0xec,
+	0x8a, 0xe8, 0x0a, 0x06, 0x82, 0x4b, 0x7d, 0x57, 0x9e, 0xf4, 0xc7, 0x73, 0x5c, 0x34, 0xa1, 0xb0,
+	0xe3, 0x5b, 0x9d, 0x98, 0x7f, 0xd1, 0x8b, 0xdf, 0x03, 0x00, 0xae, 0x20, 0xb4, 0x9a, 0xe0, 0x04,
 	0x00, 0x00,
 }
@@ -206,6 +206,21 @@ func TestReadPortMapping(t *testing.T) {
 		t.Fatal("not found illegal protocol")
 	}
 	t.Logf("--found illegal protocol: %v", err)
+
+	t.Log("> testing auto host port")
+	for _, host := range []string{"", "0"} {
+		res, err = readPortMapping(&PortMapping{
+			HostPort:      host,
+			ContainerPort: "3000-3010",
+			Protocol:      "tcp",
+		})
+		if err != nil {
+			t.Fatalf("failed with auto host port %q: %v", host, err)
+		}
+		if spec := res.toSpec(); spec.HostPort != "0" || spec.ContainerPort != "3000-3010" {
+			t.Fatalf("mistake handles auto host port %q: %#v", host, spec)
+		}
+	}
 }
 
 func TestMergePorts(t *testing.T) {
@@ -620,3 +635,141 @@ func TestMergePortMappings(t *testing.T) {
 		t.Fatalf("failed with udp port overlapped rules: %v", tp.Portmappings)
 	}
 }
+
+func TestParseRestartPolicy(t *testing.T) {
+	valid := map[string]struct {
+		policy string
+		retry  int
+	}{
+		"":            {RESTART_POLICY_NEVER, 0},
+		"never":       {RESTART_POLICY_NEVER, 0},
+		"always":      {RESTART_POLICY_ALWAYS, 0},
+		"onFailure":   {RESTART_POLICY_ONFAILURE, 0},
+		"onfailure:3": {RESTART_POLICY_ONFAILURE, 3},
+	}
+	for in, expect := range valid {
+		policy, retry, err := ParseRestartPolicy(in)
+		if err != nil {
+			t.Fatalf("failed to parse restart policy %q: %v", in, err)
+		}
+		if policy != expect.policy || retry != expect.retry {
+			t.Fatalf("restart policy %q parsed as %s:%d", in, policy, retry)
+		}
+	}
+
+	for _, in := range []string{"sometimes", "always:3", "never:1", "onFailure:-1", "onFailure:x"} {
+		if _, _, err := ParseRestartPolicy(in); err == nil {
+			t.Fatalf("invalid restart policy %q is accepted", in)
+		}
+	}
+}
+
+func TestProbeValidate(t *testing.T) {
+	var probe *UserProbe
+	if err := probe.validate(); err != nil {
+		t.Fatalf("nil probe should be valid: %v", err)
+	}
+
+	valid := []*UserProbe{
+		{Exec: &UserProbeExec{Command: []string{"true"}}},
+		{TcpSocket: &UserProbeTCPSocket{Port: 80}, PeriodSeconds: 5},
+		{HttpGet: &UserProbeHTTPGet{Port: 8080, Path: "/healthz", Scheme: "HTTPS"}},
+	}
+	for _, p := range valid {
+		if err := p.validate(); err != nil {
+			t.Fatalf("valid probe %v is rejected: %v", p, err)
+		}
+	}
+
+	invalid := []*UserProbe{
+		{},
+		{Exec: &UserProbeExec{}},
+		{Exec: &UserProbeExec{Command: []string{"true"}}, TcpSocket: &UserProbeTCPSocket{Port: 80}},
+		{TcpSocket: &UserProbeTCPSocket{Port: 65536}},
+		{HttpGet: &UserProbeHTTPGet{Port: 80, Scheme: "ftp"}},
+		{TcpSocket: &UserProbeTCPSocket{Port: 80}, TimeoutSeconds: -1},
+	}
+	for _, p := range invalid {
+		if err := p.validate(); err == nil {
+			t.Fatalf("invalid probe %v is accepted", p)
+		}
+	}
+}
+
+func TestContainerResourceValidate(t *testing.T) {
+	var res *UserContainerResource
+	if err := res.validate(nil); err != nil {
+		t.Fatalf("nil resource should be valid: %v", err)
+	}
+
+	pod := &UserResource{Vcpu: 1, Memory: 128}
+	valid := []*UserContainerResource{
+		{},
+		{CpuShares: 512},
+		{CpuQuota: 50000, CpuPeriod: 100000},
+		{CpuQuota: 50000},
+		{Memory: 128, PidsLimit: 100},
+	}
+	for _, r := range valid {
+		if err := r.validate(pod); err != nil {
+			t.Fatalf("valid resource %v is rejected: %v", r, err)
+		}
+	}
+
+	invalid := []*UserContainerResource{
+		{CpuShares: 1},
+		{CpuShares: 262145},
+		{CpuPeriod: 100},
+		{CpuQuota: -1},
+		{Memory: -1},
+		{PidsLimit: -1},
+		{Memory: 256},
+	}
+	for _, r := range invalid {
+		if err := r.validate(pod); err == nil {
+			t.Fatalf("invalid resource %v is accepted", r)
+		}
+	}
+}
+
+func TestHostAliasValidate(t *testing.T) {
+	valid := []*HostAlias{
+		{Ip: "10.0.0.2", Hostnames: []string{"db"}},
+		{Ip: "fd00::2", Hostnames: []string{"db", "db.example.com"}},
+	}
+	for _, h := range valid {
+		if err := h.validate(); err != nil {
+			t.Fatalf("valid host alias %v is rejected: %v", h, err)
+		}
+	}
+
+	invalid := []*HostAlias{
+		{Ip: "10.0.0.2"},
+		{Ip: "10.0.0.300", Hostnames: []string{"db"}},
+		{Hostnames: []string{"db"}},
+		{Ip: "10.0.0.2", Hostnames: []string{"db_1"}},
+		{Ip: "10.0.0.2", Hostnames: []string{"db..example"}},
+	}
+	for _, h := range invalid {
+		if err := h.validate(); err == nil {
+			t.Fatalf("invalid host alias %v is accepted", h)
+		}
+	}
+}
+
+func TestServiceNamesValidate(t *testing.T) {
+	valid := []*UserService{
+		{ServiceIP: "10.10.0.24", ServicePort: 80},
+		{ServiceIP: "10.10.0.24", ServicePort: 80, Name: "web"},
+	}
+	if err := ValidateServiceNames(valid); err != nil {
+		t.Fatalf("valid service names are rejected: %v", err)
+	}
+
+	for _, name := range []string{"Web", "web.svc", "web_1"} {
+		invalid := []*UserService{{ServiceIP: "10.10.0.24", ServicePort: 80, Name: name}}
+		if err := ValidateServiceNames(invalid); err == nil {
+			t.Fatalf("invalid service name %s is accepted", name)
+		}
+	}
+}
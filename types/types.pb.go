@@ -6,10 +6,12 @@
 Package types is a generated protocol buffer package.
 
 It is generated from these files:
+
 	types.proto
 	persist.proto
 
 It has these top-level messages:
+
 	ContainerPort
 	EnvironmentVar
 	VolumeMount
@@ -17,6 +19,7 @@ It has these top-level messages:
 	RunningStatus
 	TermStatus
 	ContainerStatus
+	HealthStatus
 	ContainerInfo
 	Container
 	RBDVolumeSource
@@ -39,6 +42,7 @@ It has these top-level messages:
 	ContainersStats
 	PodInfoRequest
 	PodInfoResponse
+	ListFilter
 	PodListRequest
 	PodListResult
 	PodListResponse
@@ -62,7 +66,12 @@ It has these top-level messages:
 	UserUser
 	Ulimit
 	UserContainer
+	UserProbeExec
+	UserProbeTCPSocket
+	UserProbeHTTPGet
+	UserProbe
 	UserResource
+	UserContainerResource
 	UserFile
 	UserVolumeOption
 	UserVolume
@@ -73,6 +82,7 @@ It has these top-level messages:
 	PortMapping
 	PortmappingWhiteList
 	UserPod
+	HostAlias
 	PodCreateRequest
 	PodCreateResponse
 	PodRemoveRequest
@@ -101,6 +111,10 @@ It has these top-level messages:
 	ContainerStartResponse
 	ContainerRenameRequest
 	ContainerRenameResponse
+	ContainerCopyFromRequest
+	ContainerCopyData
+	ContainerCopyToRequest
+	ContainerCopyToResponse
 	ContainerRemoveRequest
 	ContainerRemoveResponse
 	AuthConfig
@@ -127,6 +141,12 @@ It has these top-level messages:
 	PortMappingListResponse
 	PortMappingModifyRequest
 	PortMappingModifyResponse
+	PodInterfaceListRequest
+	PodInterfaceListResponse
+	PodInterfaceAddRequest
+	PodInterfaceAddResponse
+	PodInterfaceRemoveRequest
+	PodInterfaceRemoveResponse
 	PodStopRequest
 	PodStopResponse
 	PodSignalRequest
@@ -135,16 +155,59 @@ It has these top-level messages:
 	PodPauseResponse
 	PodUnpauseRequest
 	PodUnpauseResponse
+	PodUpdateResourcesRequest
+	PodUpdateResourcesResponse
 	PodLabelsRequest
 	PodLabelsResponse
 	PodStatsRequest
 	PodStatsResponse
+	PodStatsStreamRequest
+	StatsRates
+	ContainerStatsRates
+	PodStatsStreamResponse
 	PingRequest
 	PingResponse
 	ContainerSignalRequest
 	ContainerSignalResponse
 	TTYResizeRequest
 	TTYResizeResponse
+	Event
+	EventsRequest
+	VolumeInfo
+	VolumeCreateRequest
+	VolumeCreateResponse
+	VolumeListRequest
+	VolumeListResponse
+	VolumeInspectRequest
+	VolumeInspectResponse
+	VolumeRemoveRequest
+	VolumeRemoveResponse
+	VolumeResizeRequest
+	VolumeResizeResponse
+	VolumeSnapshotInfo
+	VolumeSnapshotRequest
+	VolumeSnapshotResponse
+	VolumeSnapshotListRequest
+	VolumeSnapshotListResponse
+	VolumeSnapshotRemoveRequest
+	VolumeSnapshotRemoveResponse
+	VolumeRestoreRequest
+	VolumeRestoreResponse
+	VolumeCloneRequest
+	VolumeCloneResponse
+	NetworkInfo
+	NetworkLease
+	NetworkCreateRequest
+	NetworkCreateResponse
+	NetworkListRequest
+	NetworkListResponse
+	NetworkInspectRequest
+	NetworkInspectResponse
+	NetworkRemoveRequest
+	NetworkRemoveResponse
+	OrphanResource
+	SystemPruneRequest
+	SystemPruneResponse
 	PersistPodLayout
 	PersistPodMeta
 	SandboxPersistInfo
@@ -175,6 +238,29 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion2 // please upgrade the proto package
 
+type ExecStartResponse_StreamType int32
+
+const (
+	ExecStartResponse_STDOUT ExecStartResponse_StreamType = 0
+	ExecStartResponse_STDERR ExecStartResponse_StreamType = 1
+)
+
+var ExecStartResponse_StreamType_name = map[int32]string{
+	0: "STDOUT",
+	1: "STDERR",
+}
+var ExecStartResponse_StreamType_value = map[string]int32{
+	"STDOUT": 0,
+	"STDERR": 1,
+}
+
+func (x ExecStartResponse_StreamType) String() string {
+	return proto.EnumName(ExecStartResponse_StreamType_name, int32(x))
+}
+func (ExecStartResponse_StreamType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptorTypes, []int{83, 0}
+}
+
 // Types definitions for HyperContainer
 type ContainerPort struct {
 	Name          string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
@@ -361,12 +447,14 @@ func (m *TermStatus) GetFinishedAt() string {
 }
 
 type ContainerStatus struct {
-	Name        string         `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	ContainerID string         `protobuf:"bytes,2,opt,name=containerID,proto3" json:"containerID,omitempty"`
-	Phase       string         `protobuf:"bytes,3,opt,name=phase,proto3" json:"phase,omitempty"`
-	Waiting     *WaitingStatus `protobuf:"bytes,4,opt,name=waiting" json:"waiting,omitempty"`
-	Running     *RunningStatus `protobuf:"bytes,5,opt,name=running" json:"running,omitempty"`
-	Terminated  *TermStatus    `protobuf:"bytes,6,opt,name=terminated" json:"terminated,omitempty"`
+	Name         string         `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	ContainerID  string         `protobuf:"bytes,2,opt,name=containerID,proto3" json:"containerID,omitempty"`
+	Phase        string         `protobuf:"bytes,3,opt,name=phase,proto3" json:"phase,omitempty"`
+	Waiting      *WaitingStatus `protobuf:"bytes,4,opt,name=waiting" json:"waiting,omitempty"`
+	Running      *RunningStatus `protobuf:"bytes,5,opt,name=running" json:"running,omitempty"`
+	Terminated   *TermStatus    `protobuf:"bytes,6,opt,name=terminated" json:"terminated,omitempty"`
+	RestartCount int32          `protobuf:"varint,7,opt,name=restartCount,proto3" json:"restartCount,omitempty"`
+	Health       *HealthStatus  `protobuf:"bytes,8,opt,name=health" json:"health,omitempty"`
 }
 
 func (m *ContainerStatus) Reset()                    { *m = ContainerStatus{} }
@@ -416,6 +504,69 @@ func (m *ContainerStatus) GetTerminated() *TermStatus {
 	return nil
 }
 
+func (m *ContainerStatus) GetRestartCount() int32 {
+	if m != nil {
+		return m.RestartCount
+	}
+	return 0
+}
+
+func (m *ContainerStatus) GetHealth() *HealthStatus {
+	if m != nil {
+		return m.Health
+	}
+	return nil
+}
+
+type HealthStatus struct {
+	// status is the liveness of the container: starting, healthy or unhealthy
+	Status        string `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Ready         bool   `protobuf:"varint,2,opt,name=ready,proto3" json:"ready,omitempty"`
+	FailingStreak int32  `protobuf:"varint,3,opt,name=failingStreak,proto3" json:"failingStreak,omitempty"`
+	LastProbe     string `protobuf:"bytes,4,opt,name=lastProbe,proto3" json:"lastProbe,omitempty"`
+	LastOutput    string `protobuf:"bytes,5,opt,name=lastOutput,proto3" json:"lastOutput,omitempty"`
+}
+
+func (m *HealthStatus) Reset()                    { *m = HealthStatus{} }
+func (m *HealthStatus) String() string            { return proto.CompactTextString(m) }
+func (*HealthStatus) ProtoMessage()               {}
+func (*HealthStatus) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{7} }
+
+func (m *HealthStatus) GetStatus() string {
+	if m != nil {
+		return m.Status
+	}
+	return ""
+}
+
+func (m *HealthStatus) GetReady() bool {
+	if m != nil {
+		return m.Ready
+	}
+	return false
+}
+
+func (m *HealthStatus) GetFailingStreak() int32 {
+	if m != nil {
+		return m.FailingStreak
+	}
+	return 0
+}
+
+func (m *HealthStatus) GetLastProbe() string {
+	if m != nil {
+		return m.LastProbe
+	}
+	return ""
+}
+
+func (m *HealthStatus) GetLastOutput() string {
+	if m != nil {
+		return m.LastOutput
+	}
+	return ""
+}
+
 type ContainerInfo struct {
 	Container *Container       `protobuf:"bytes,1,opt,name=container" json:"container,omitempty"`
 	CreatedAt int64            `protobuf:"varint,2,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
@@ -426,7 +577,7 @@ type ContainerInfo struct {
 func (m *ContainerInfo) Reset()                    { *m = ContainerInfo{} }
 func (m *ContainerInfo) String() string            { return proto.CompactTextString(m) }
 func (*ContainerInfo) ProtoMessage()               {}
-func (*ContainerInfo) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{7} }
+func (*ContainerInfo) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{8} }
 
 func (m *ContainerInfo) GetContainer() *Container {
 	if m != nil {
@@ -457,26 +608,27 @@ func (m *ContainerInfo) GetStatus() *ContainerStatus {
 }
 
 type Container struct {
-	Name            string            `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	ContainerID     string            `protobuf:"bytes,2,opt,name=containerID,proto3" json:"containerID,omitempty"`
-	Image           string            `protobuf:"bytes,3,opt,name=image,proto3" json:"image,omitempty"`
-	ImageID         string            `protobuf:"bytes,4,opt,name=imageID,proto3" json:"imageID,omitempty"`
-	ImagePullPolicy string            `protobuf:"bytes,5,opt,name=imagePullPolicy,proto3" json:"imagePullPolicy,omitempty"`
-	WorkingDir      string            `protobuf:"bytes,6,opt,name=workingDir,proto3" json:"workingDir,omitempty"`
-	User            string            `protobuf:"bytes,7,opt,name=user,proto3" json:"user,omitempty"`
-	Tty             bool              `protobuf:"varint,8,opt,name=tty,proto3" json:"tty,omitempty"`
-	Commands        []string          `protobuf:"bytes,9,rep,name=commands" json:"commands,omitempty"`
-	Args            []string          `protobuf:"bytes,10,rep,name=args" json:"args,omitempty"`
-	Ports           []*ContainerPort  `protobuf:"bytes,11,rep,name=ports" json:"ports,omitempty"`
-	Env             []*EnvironmentVar `protobuf:"bytes,12,rep,name=env" json:"env,omitempty"`
-	VolumeMounts    []*VolumeMount    `protobuf:"bytes,13,rep,name=volumeMounts" json:"volumeMounts,omitempty"`
-	Labels          map[string]string `protobuf:"bytes,14,rep,name=labels" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Name            string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	ContainerID     string                 `protobuf:"bytes,2,opt,name=containerID,proto3" json:"containerID,omitempty"`
+	Image           string                 `protobuf:"bytes,3,opt,name=image,proto3" json:"image,omitempty"`
+	ImageID         string                 `protobuf:"bytes,4,opt,name=imageID,proto3" json:"imageID,omitempty"`
+	ImagePullPolicy string                 `protobuf:"bytes,5,opt,name=imagePullPolicy,proto3" json:"imagePullPolicy,omitempty"`
+	WorkingDir      string                 `protobuf:"bytes,6,opt,name=workingDir,proto3" json:"workingDir,omitempty"`
+	User            string                 `protobuf:"bytes,7,opt,name=user,proto3" json:"user,omitempty"`
+	Tty             bool                   `protobuf:"varint,8,opt,name=tty,proto3" json:"tty,omitempty"`
+	Commands        []string               `protobuf:"bytes,9,rep,name=commands" json:"commands,omitempty"`
+	Args            []string               `protobuf:"bytes,10,rep,name=args" json:"args,omitempty"`
+	Ports           []*ContainerPort       `protobuf:"bytes,11,rep,name=ports" json:"ports,omitempty"`
+	Env             []*EnvironmentVar      `protobuf:"bytes,12,rep,name=env" json:"env,omitempty"`
+	VolumeMounts    []*VolumeMount         `protobuf:"bytes,13,rep,name=volumeMounts" json:"volumeMounts,omitempty"`
+	Labels          map[string]string      `protobuf:"bytes,14,rep,name=labels" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Resource        *UserContainerResource `protobuf:"bytes,15,opt,name=resource" json:"resource,omitempty"`
 }
 
 func (m *Container) Reset()                    { *m = Container{} }
 func (m *Container) String() string            { return proto.CompactTextString(m) }
 func (*Container) ProtoMessage()               {}
-func (*Container) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{8} }
+func (*Container) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{9} }
 
 func (m *Container) GetName() string {
 	if m != nil {
@@ -576,6 +728,13 @@ func (m *Container) GetLabels() map[string]string {
 	return nil
 }
 
+func (m *Container) GetResource() *UserContainerResource {
+	if m != nil {
+		return m.Resource
+	}
+	return nil
+}
+
 type RBDVolumeSource struct {
 	Monitors []string `protobuf:"bytes,1,rep,name=monitors" json:"monitors,omitempty"`
 	Image    string   `protobuf:"bytes,2,opt,name=image,proto3" json:"image,omitempty"`
@@ -589,7 +748,7 @@ type RBDVolumeSource struct {
 func (m *RBDVolumeSource) Reset()                    { *m = RBDVolumeSource{} }
 func (m *RBDVolumeSource) String() string            { return proto.CompactTextString(m) }
 func (*RBDVolumeSource) ProtoMessage()               {}
-func (*RBDVolumeSource) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{9} }
+func (*RBDVolumeSource) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{10} }
 
 func (m *RBDVolumeSource) GetMonitors() []string {
 	if m != nil {
@@ -641,16 +800,19 @@ func (m *RBDVolumeSource) GetReadOnly() bool {
 }
 
 type PodVolume struct {
-	Name   string           `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Source string           `protobuf:"bytes,2,opt,name=source,proto3" json:"source,omitempty"`
-	Driver string           `protobuf:"bytes,3,opt,name=driver,proto3" json:"driver,omitempty"`
-	Rbd    *RBDVolumeSource `protobuf:"bytes,4,opt,name=rbd" json:"rbd,omitempty"`
+	Name           string           `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Source         string           `protobuf:"bytes,2,opt,name=source,proto3" json:"source,omitempty"`
+	Driver         string           `protobuf:"bytes,3,opt,name=driver,proto3" json:"driver,omitempty"`
+	Rbd            *RBDVolumeSource `protobuf:"bytes,4,opt,name=rbd" json:"rbd,omitempty"`
+	SizeBytes      int64            `protobuf:"varint,5,opt,name=sizeBytes,proto3" json:"sizeBytes,omitempty"`
+	UsedBytes      int64            `protobuf:"varint,6,opt,name=usedBytes,proto3" json:"usedBytes,omitempty"`
+	AvailableBytes int64            `protobuf:"varint,7,opt,name=availableBytes,proto3" json:"availableBytes,omitempty"`
 }
 
 func (m *PodVolume) Reset()                    { *m = PodVolume{} }
 func (m *PodVolume) String() string            { return proto.CompactTextString(m) }
 func (*PodVolume) ProtoMessage()               {}
-func (*PodVolume) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{10} }
+func (*PodVolume) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{11} }
 
 func (m *PodVolume) GetName() string {
 	if m != nil {
@@ -680,18 +842,40 @@ func (m *PodVolume) GetRbd() *RBDVolumeSource {
 	return nil
 }
 
+func (m *PodVolume) GetSizeBytes() int64 {
+	if m != nil {
+		return m.SizeBytes
+	}
+	return 0
+}
+
+func (m *PodVolume) GetUsedBytes() int64 {
+	if m != nil {
+		return m.UsedBytes
+	}
+	return 0
+}
+
+func (m *PodVolume) GetAvailableBytes() int64 {
+	if m != nil {
+		return m.AvailableBytes
+	}
+	return 0
+}
+
 type PodSpec struct {
 	Volumes    []*PodVolume      `protobuf:"bytes,1,rep,name=volumes" json:"volumes,omitempty"`
 	Containers []*Container      `protobuf:"bytes,2,rep,name=containers" json:"containers,omitempty"`
 	Labels     map[string]string `protobuf:"bytes,3,rep,name=labels" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 	Vcpu       int32             `protobuf:"varint,4,opt,name=vcpu,proto3" json:"vcpu,omitempty"`
 	Memory     int32             `protobuf:"varint,5,opt,name=memory,proto3" json:"memory,omitempty"`
+	Interfaces []*UserInterface  `protobuf:"bytes,6,rep,name=interfaces" json:"interfaces,omitempty"`
 }
 
 func (m *PodSpec) Reset()                    { *m = PodSpec{} }
 func (m *PodSpec) String() string            { return proto.CompactTextString(m) }
 func (*PodSpec) ProtoMessage()               {}
-func (*PodSpec) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{11} }
+func (*PodSpec) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{12} }
 
 func (m *PodSpec) GetVolumes() []*PodVolume {
 	if m != nil {
@@ -728,6 +912,13 @@ func (m *PodSpec) GetMemory() int32 {
 	return 0
 }
 
+func (m *PodSpec) GetInterfaces() []*UserInterface {
+	if m != nil {
+		return m.Interfaces
+	}
+	return nil
+}
+
 type PodStatus struct {
 	Phase           string             `protobuf:"bytes,1,opt,name=phase,proto3" json:"phase,omitempty"`
 	Message         string             `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
@@ -737,12 +928,13 @@ type PodStatus struct {
 	StartTime       string             `protobuf:"bytes,6,opt,name=startTime,proto3" json:"startTime,omitempty"`
 	ContainerStatus []*ContainerStatus `protobuf:"bytes,7,rep,name=containerStatus" json:"containerStatus,omitempty"`
 	FinishTime      string             `protobuf:"bytes,8,opt,name=finishTime,proto3" json:"finishTime,omitempty"`
+	PortMappings    []*PortMapping     `protobuf:"bytes,9,rep,name=portMappings" json:"portMappings,omitempty"`
 }
 
 func (m *PodStatus) Reset()                    { *m = PodStatus{} }
 func (m *PodStatus) String() string            { return proto.CompactTextString(m) }
 func (*PodStatus) ProtoMessage()               {}
-func (*PodStatus) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{12} }
+func (*PodStatus) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{13} }
 
 func (m *PodStatus) GetPhase() string {
 	if m != nil {
@@ -800,6 +992,13 @@ func (m *PodStatus) GetFinishTime() string {
 	return ""
 }
 
+func (m *PodStatus) GetPortMappings() []*PortMapping {
+	if m != nil {
+		return m.PortMappings
+	}
+	return nil
+}
+
 type PodInfo struct {
 	PodID      string     `protobuf:"bytes,1,opt,name=podID,proto3" json:"podID,omitempty"`
 	Kind       string     `protobuf:"bytes,2,opt,name=kind,proto3" json:"kind,omitempty"`
@@ -814,7 +1013,7 @@ type PodInfo struct {
 func (m *PodInfo) Reset()                    { *m = PodInfo{} }
 func (m *PodInfo) String() string            { return proto.CompactTextString(m) }
 func (*PodInfo) ProtoMessage()               {}
-func (*PodInfo) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{13} }
+func (*PodInfo) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{14} }
 
 func (m *PodInfo) GetPodID() string {
 	if m != nil {
@@ -885,7 +1084,7 @@ type ImageInfo struct {
 func (m *ImageInfo) Reset()                    { *m = ImageInfo{} }
 func (m *ImageInfo) String() string            { return proto.CompactTextString(m) }
 func (*ImageInfo) ProtoMessage()               {}
-func (*ImageInfo) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{14} }
+func (*ImageInfo) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{15} }
 
 func (m *ImageInfo) GetId() string {
 	if m != nil {
@@ -949,7 +1148,7 @@ type PodStats struct {
 func (m *PodStats) Reset()                    { *m = PodStats{} }
 func (m *PodStats) String() string            { return proto.CompactTextString(m) }
 func (*PodStats) ProtoMessage()               {}
-func (*PodStats) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{15} }
+func (*PodStats) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{16} }
 
 func (m *PodStats) GetCpu() *CpuStats {
 	if m != nil {
@@ -1008,7 +1207,7 @@ type CpuStats struct {
 func (m *CpuStats) Reset()                    { *m = CpuStats{} }
 func (m *CpuStats) String() string            { return proto.CompactTextString(m) }
 func (*CpuStats) ProtoMessage()               {}
-func (*CpuStats) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{16} }
+func (*CpuStats) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{17} }
 
 func (m *CpuStats) GetUsage() *CpuUsage {
 	if m != nil {
@@ -1034,7 +1233,7 @@ type CpuUsage struct {
 func (m *CpuUsage) Reset()                    { *m = CpuUsage{} }
 func (m *CpuUsage) String() string            { return proto.CompactTextString(m) }
 func (*CpuUsage) ProtoMessage()               {}
-func (*CpuUsage) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{17} }
+func (*CpuUsage) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{18} }
 
 func (m *CpuUsage) GetTotal() uint64 {
 	if m != nil {
@@ -1078,7 +1277,7 @@ type BlkioStats struct {
 func (m *BlkioStats) Reset()                    { *m = BlkioStats{} }
 func (m *BlkioStats) String() string            { return proto.CompactTextString(m) }
 func (*BlkioStats) ProtoMessage()               {}
-func (*BlkioStats) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{18} }
+func (*BlkioStats) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{19} }
 
 func (m *BlkioStats) GetIoServiceBytesRecursive() []*BlkioStatEntry {
 	if m != nil {
@@ -1148,7 +1347,7 @@ type BlkioStatEntry struct {
 func (m *BlkioStatEntry) Reset()                    { *m = BlkioStatEntry{} }
 func (m *BlkioStatEntry) String() string            { return proto.CompactTextString(m) }
 func (*BlkioStatEntry) ProtoMessage()               {}
-func (*BlkioStatEntry) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{19} }
+func (*BlkioStatEntry) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{20} }
 
 func (m *BlkioStatEntry) GetName() string {
 	if m != nil {
@@ -1203,7 +1402,7 @@ type MemoryStats struct {
 func (m *MemoryStats) Reset()                    { *m = MemoryStats{} }
 func (m *MemoryStats) String() string            { return proto.CompactTextString(m) }
 func (*MemoryStats) ProtoMessage()               {}
-func (*MemoryStats) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{20} }
+func (*MemoryStats) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{21} }
 
 func (m *MemoryStats) GetUsage() uint64 {
 	if m != nil {
@@ -1248,7 +1447,7 @@ type MemoryStatsMemoryData struct {
 func (m *MemoryStatsMemoryData) Reset()                    { *m = MemoryStatsMemoryData{} }
 func (m *MemoryStatsMemoryData) String() string            { return proto.CompactTextString(m) }
 func (*MemoryStatsMemoryData) ProtoMessage()               {}
-func (*MemoryStatsMemoryData) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{21} }
+func (*MemoryStatsMemoryData) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{22} }
 
 func (m *MemoryStatsMemoryData) GetPgfault() uint64 {
 	if m != nil {
@@ -1273,7 +1472,7 @@ type NetworkStats struct {
 func (m *NetworkStats) Reset()                    { *m = NetworkStats{} }
 func (m *NetworkStats) String() string            { return proto.CompactTextString(m) }
 func (*NetworkStats) ProtoMessage()               {}
-func (*NetworkStats) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{22} }
+func (*NetworkStats) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{23} }
 
 func (m *NetworkStats) GetInterfaces() []*InterfaceStats {
 	if m != nil {
@@ -1313,7 +1512,7 @@ type TcpStat struct {
 func (m *TcpStat) Reset()                    { *m = TcpStat{} }
 func (m *TcpStat) String() string            { return proto.CompactTextString(m) }
 func (*TcpStat) ProtoMessage()               {}
-func (*TcpStat) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{23} }
+func (*TcpStat) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{24} }
 
 func (m *TcpStat) GetEstablished() uint64 {
 	if m != nil {
@@ -1407,7 +1606,7 @@ type InterfaceStats struct {
 func (m *InterfaceStats) Reset()                    { *m = InterfaceStats{} }
 func (m *InterfaceStats) String() string            { return proto.CompactTextString(m) }
 func (*InterfaceStats) ProtoMessage()               {}
-func (*InterfaceStats) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{24} }
+func (*InterfaceStats) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{25} }
 
 func (m *InterfaceStats) GetName() string {
 	if m != nil {
@@ -1493,7 +1692,7 @@ type FsStats struct {
 func (m *FsStats) Reset()                    { *m = FsStats{} }
 func (m *FsStats) String() string            { return proto.CompactTextString(m) }
 func (*FsStats) ProtoMessage()               {}
-func (*FsStats) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{25} }
+func (*FsStats) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{26} }
 
 func (m *FsStats) GetDevice() string {
 	if m != nil {
@@ -1608,12 +1807,16 @@ type ContainersStats struct {
 	Network     *NetworkStats `protobuf:"bytes,5,opt,name=network" json:"network,omitempty"`
 	Filesystem  []*FsStats    `protobuf:"bytes,6,rep,name=filesystem" json:"filesystem,omitempty"`
 	Timestamp   int64         `protobuf:"varint,7,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	// the limits of the container, and the memory usage relative to the
+	// memory limit, or to the pod memory if the container is unlimited.
+	Resource           *UserContainerResource `protobuf:"bytes,8,opt,name=resource" json:"resource,omitempty"`
+	MemoryUsagePercent float64                `protobuf:"fixed64,9,opt,name=memoryUsagePercent,proto3" json:"memoryUsagePercent,omitempty"`
 }
 
 func (m *ContainersStats) Reset()                    { *m = ContainersStats{} }
 func (m *ContainersStats) String() string            { return proto.CompactTextString(m) }
 func (*ContainersStats) ProtoMessage()               {}
-func (*ContainersStats) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{26} }
+func (*ContainersStats) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{27} }
 
 func (m *ContainersStats) GetContainerID() string {
 	if m != nil {
@@ -1664,14 +1867,31 @@ func (m *ContainersStats) GetTimestamp() int64 {
 	return 0
 }
 
+func (m *ContainersStats) GetResource() *UserContainerResource {
+	if m != nil {
+		return m.Resource
+	}
+	return nil
+}
+
+func (m *ContainersStats) GetMemoryUsagePercent() float64 {
+	if m != nil {
+		return m.MemoryUsagePercent
+	}
+	return 0
+}
+
 type PodInfoRequest struct {
 	PodID string `protobuf:"bytes,1,opt,name=podID,proto3" json:"podID,omitempty"`
+	// volumeUsage fills the size and usage of the volumes, which may take a
+	// while as the block volumes are measured in the sandbox
+	VolumeUsage bool `protobuf:"varint,2,opt,name=volumeUsage,proto3" json:"volumeUsage,omitempty"`
 }
 
 func (m *PodInfoRequest) Reset()                    { *m = PodInfoRequest{} }
 func (m *PodInfoRequest) String() string            { return proto.CompactTextString(m) }
 func (*PodInfoRequest) ProtoMessage()               {}
-func (*PodInfoRequest) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{27} }
+func (*PodInfoRequest) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{28} }
 
 func (m *PodInfoRequest) GetPodID() string {
 	if m != nil {
@@ -1680,6 +1900,13 @@ func (m *PodInfoRequest) GetPodID() string {
 	return ""
 }
 
+func (m *PodInfoRequest) GetVolumeUsage() bool {
+	if m != nil {
+		return m.VolumeUsage
+	}
+	return false
+}
+
 type PodInfoResponse struct {
 	PodInfo *PodInfo `protobuf:"bytes,1,opt,name=podInfo" json:"podInfo,omitempty"`
 }
@@ -1687,7 +1914,7 @@ type PodInfoResponse struct {
 func (m *PodInfoResponse) Reset()                    { *m = PodInfoResponse{} }
 func (m *PodInfoResponse) String() string            { return proto.CompactTextString(m) }
 func (*PodInfoResponse) ProtoMessage()               {}
-func (*PodInfoResponse) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{28} }
+func (*PodInfoResponse) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{29} }
 
 func (m *PodInfoResponse) GetPodInfo() *PodInfo {
 	if m != nil {
@@ -1696,15 +1923,55 @@ func (m *PodInfoResponse) GetPodInfo() *PodInfo {
 	return nil
 }
 
+// ListFilter selects the listed objects, an empty field matches everything
+type ListFilter struct {
+	// labelSelector is a kubernetes style selector of the labels, i.e.
+	// "app=web,tier!=db,env in (prod,qa),release notin (canary),owner,!test".
+	// The labels of a container are the pod labels overridden by its own ones.
+	LabelSelector string `protobuf:"bytes,1,opt,name=labelSelector,proto3" json:"labelSelector,omitempty"`
+	// status matches any of the statuses, i.e. "running"
+	Status []string `protobuf:"bytes,2,rep,name=status" json:"status,omitempty"`
+	// image matches any of the images, with or without the tag, a pod matches
+	// if any of its containers matches
+	Image []string `protobuf:"bytes,3,rep,name=image" json:"image,omitempty"`
+}
+
+func (m *ListFilter) Reset()                    { *m = ListFilter{} }
+func (m *ListFilter) String() string            { return proto.CompactTextString(m) }
+func (*ListFilter) ProtoMessage()               {}
+func (*ListFilter) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{30} }
+
+func (m *ListFilter) GetLabelSelector() string {
+	if m != nil {
+		return m.LabelSelector
+	}
+	return ""
+}
+
+func (m *ListFilter) GetStatus() []string {
+	if m != nil {
+		return m.Status
+	}
+	return nil
+}
+
+func (m *ListFilter) GetImage() []string {
+	if m != nil {
+		return m.Image
+	}
+	return nil
+}
+
 type PodListRequest struct {
-	PodID string `protobuf:"bytes,1,opt,name=podID,proto3" json:"podID,omitempty"`
-	VmID  string `protobuf:"bytes,2,opt,name=vmID,proto3" json:"vmID,omitempty"`
+	PodID  string      `protobuf:"bytes,1,opt,name=podID,proto3" json:"podID,omitempty"`
+	VmID   string      `protobuf:"bytes,2,opt,name=vmID,proto3" json:"vmID,omitempty"`
+	Filter *ListFilter `protobuf:"bytes,3,opt,name=filter" json:"filter,omitempty"`
 }
 
 func (m *PodListRequest) Reset()                    { *m = PodListRequest{} }
 func (m *PodListRequest) String() string            { return proto.CompactTextString(m) }
 func (*PodListRequest) ProtoMessage()               {}
-func (*PodListRequest) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{29} }
+func (*PodListRequest) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{31} }
 
 func (m *PodListRequest) GetPodID() string {
 	if m != nil {
@@ -1720,6 +1987,13 @@ func (m *PodListRequest) GetVmID() string {
 	return ""
 }
 
+func (m *PodListRequest) GetFilter() *ListFilter {
+	if m != nil {
+		return m.Filter
+	}
+	return nil
+}
+
 type PodListResult struct {
 	PodID     string            `protobuf:"bytes,1,opt,name=podID,proto3" json:"podID,omitempty"`
 	PodName   string            `protobuf:"bytes,2,opt,name=podName,proto3" json:"podName,omitempty"`
@@ -1732,7 +2006,7 @@ type PodListResult struct {
 func (m *PodListResult) Reset()                    { *m = PodListResult{} }
 func (m *PodListResult) String() string            { return proto.CompactTextString(m) }
 func (*PodListResult) ProtoMessage()               {}
-func (*PodListResult) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{30} }
+func (*PodListResult) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{32} }
 
 func (m *PodListResult) GetPodID() string {
 	if m != nil {
@@ -1783,7 +2057,7 @@ type PodListResponse struct {
 func (m *PodListResponse) Reset()                    { *m = PodListResponse{} }
 func (m *PodListResponse) String() string            { return proto.CompactTextString(m) }
 func (*PodListResponse) ProtoMessage()               {}
-func (*PodListResponse) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{31} }
+func (*PodListResponse) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{33} }
 
 func (m *PodListResponse) GetPodList() []*PodListResult {
 	if m != nil {
@@ -1793,14 +2067,15 @@ func (m *PodListResponse) GetPodList() []*PodListResult {
 }
 
 type ContainerListRequest struct {
-	PodID string `protobuf:"bytes,1,opt,name=podID,proto3" json:"podID,omitempty"`
-	VmID  string `protobuf:"bytes,2,opt,name=vmID,proto3" json:"vmID,omitempty"`
+	PodID  string      `protobuf:"bytes,1,opt,name=podID,proto3" json:"podID,omitempty"`
+	VmID   string      `protobuf:"bytes,2,opt,name=vmID,proto3" json:"vmID,omitempty"`
+	Filter *ListFilter `protobuf:"bytes,3,opt,name=filter" json:"filter,omitempty"`
 }
 
 func (m *ContainerListRequest) Reset()                    { *m = ContainerListRequest{} }
 func (m *ContainerListRequest) String() string            { return proto.CompactTextString(m) }
 func (*ContainerListRequest) ProtoMessage()               {}
-func (*ContainerListRequest) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{32} }
+func (*ContainerListRequest) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{34} }
 
 func (m *ContainerListRequest) GetPodID() string {
 	if m != nil {
@@ -1816,17 +2091,25 @@ func (m *ContainerListRequest) GetVmID() string {
 	return ""
 }
 
+func (m *ContainerListRequest) GetFilter() *ListFilter {
+	if m != nil {
+		return m.Filter
+	}
+	return nil
+}
+
 type ContainerListResult struct {
 	ContainerID   string `protobuf:"bytes,1,opt,name=containerID,proto3" json:"containerID,omitempty"`
 	ContainerName string `protobuf:"bytes,2,opt,name=containerName,proto3" json:"containerName,omitempty"`
 	PodID         string `protobuf:"bytes,3,opt,name=podID,proto3" json:"podID,omitempty"`
 	Status        string `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"`
+	RestartCount  int32  `protobuf:"varint,5,opt,name=restartCount,proto3" json:"restartCount,omitempty"`
 }
 
 func (m *ContainerListResult) Reset()                    { *m = ContainerListResult{} }
 func (m *ContainerListResult) String() string            { return proto.CompactTextString(m) }
 func (*ContainerListResult) ProtoMessage()               {}
-func (*ContainerListResult) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{33} }
+func (*ContainerListResult) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{35} }
 
 func (m *ContainerListResult) GetContainerID() string {
 	if m != nil {
@@ -1856,6 +2139,13 @@ func (m *ContainerListResult) GetStatus() string {
 	return ""
 }
 
+func (m *ContainerListResult) GetRestartCount() int32 {
+	if m != nil {
+		return m.RestartCount
+	}
+	return 0
+}
+
 type ContainerListResponse struct {
 	ContainerList []*ContainerListResult `protobuf:"bytes,1,rep,name=containerList" json:"containerList,omitempty"`
 }
@@ -1863,7 +2153,7 @@ type ContainerListResponse struct {
 func (m *ContainerListResponse) Reset()                    { *m = ContainerListResponse{} }
 func (m *ContainerListResponse) String() string            { return proto.CompactTextString(m) }
 func (*ContainerListResponse) ProtoMessage()               {}
-func (*ContainerListResponse) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{34} }
+func (*ContainerListResponse) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{36} }
 
 func (m *ContainerListResponse) GetContainerList() []*ContainerListResult {
 	if m != nil {
@@ -1880,7 +2170,7 @@ type ContainerInfoRequest struct {
 func (m *ContainerInfoRequest) Reset()                    { *m = ContainerInfoRequest{} }
 func (m *ContainerInfoRequest) String() string            { return proto.CompactTextString(m) }
 func (*ContainerInfoRequest) ProtoMessage()               {}
-func (*ContainerInfoRequest) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{35} }
+func (*ContainerInfoRequest) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{37} }
 
 func (m *ContainerInfoRequest) GetContainer() string {
 	if m != nil {
@@ -1896,7 +2186,7 @@ type ContainerInfoResponse struct {
 func (m *ContainerInfoResponse) Reset()                    { *m = ContainerInfoResponse{} }
 func (m *ContainerInfoResponse) String() string            { return proto.CompactTextString(m) }
 func (*ContainerInfoResponse) ProtoMessage()               {}
-func (*ContainerInfoResponse) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{36} }
+func (*ContainerInfoResponse) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{38} }
 
 func (m *ContainerInfoResponse) GetContainerInfo() *ContainerInfo {
 	if m != nil {
@@ -1914,7 +2204,7 @@ type VMListResult struct {
 func (m *VMListResult) Reset()                    { *m = VMListResult{} }
 func (m *VMListResult) String() string            { return proto.CompactTextString(m) }
 func (*VMListResult) ProtoMessage()               {}
-func (*VMListResult) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{37} }
+func (*VMListResult) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{39} }
 
 func (m *VMListResult) GetVmID() string {
 	if m != nil {
@@ -1938,14 +2228,15 @@ func (m *VMListResult) GetStatus() string {
 }
 
 type VMListRequest struct {
-	PodID string `protobuf:"bytes,1,opt,name=podID,proto3" json:"podID,omitempty"`
-	VmID  string `protobuf:"bytes,2,opt,name=vmID,proto3" json:"vmID,omitempty"`
+	PodID  string      `protobuf:"bytes,1,opt,name=podID,proto3" json:"podID,omitempty"`
+	VmID   string      `protobuf:"bytes,2,opt,name=vmID,proto3" json:"vmID,omitempty"`
+	Filter *ListFilter `protobuf:"bytes,3,opt,name=filter" json:"filter,omitempty"`
 }
 
 func (m *VMListRequest) Reset()                    { *m = VMListRequest{} }
 func (m *VMListRequest) String() string            { return proto.CompactTextString(m) }
 func (*VMListRequest) ProtoMessage()               {}
-func (*VMListRequest) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{38} }
+func (*VMListRequest) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{40} }
 
 func (m *VMListRequest) GetPodID() string {
 	if m != nil {
@@ -1961,6 +2252,13 @@ func (m *VMListRequest) GetVmID() string {
 	return ""
 }
 
+func (m *VMListRequest) GetFilter() *ListFilter {
+	if m != nil {
+		return m.Filter
+	}
+	return nil
+}
+
 type VMListResponse struct {
 	VmList []*VMListResult `protobuf:"bytes,1,rep,name=vmList" json:"vmList,omitempty"`
 }
@@ -1968,7 +2266,7 @@ type VMListResponse struct {
 func (m *VMListResponse) Reset()                    { *m = VMListResponse{} }
 func (m *VMListResponse) String() string            { return proto.CompactTextString(m) }
 func (*VMListResponse) ProtoMessage()               {}
-func (*VMListResponse) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{39} }
+func (*VMListResponse) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{41} }
 
 func (m *VMListResponse) GetVmList() []*VMListResult {
 	if m != nil {
@@ -1989,7 +2287,7 @@ type ImageListRequest struct {
 func (m *ImageListRequest) Reset()                    { *m = ImageListRequest{} }
 func (m *ImageListRequest) String() string            { return proto.CompactTextString(m) }
 func (*ImageListRequest) ProtoMessage()               {}
-func (*ImageListRequest) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{40} }
+func (*ImageListRequest) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{42} }
 
 func (m *ImageListRequest) GetFilterArgs() string {
 	if m != nil {
@@ -2019,7 +2317,7 @@ type ImageListResponse struct {
 func (m *ImageListResponse) Reset()                    { *m = ImageListResponse{} }
 func (m *ImageListResponse) String() string            { return proto.CompactTextString(m) }
 func (*ImageListResponse) ProtoMessage()               {}
-func (*ImageListResponse) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{41} }
+func (*ImageListResponse) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{43} }
 
 func (m *ImageListResponse) GetImageList() []*ImageInfo {
 	if m != nil {
@@ -2036,7 +2334,7 @@ type VMCreateRequest struct {
 func (m *VMCreateRequest) Reset()                    { *m = VMCreateRequest{} }
 func (m *VMCreateRequest) String() string            { return proto.CompactTextString(m) }
 func (*VMCreateRequest) ProtoMessage()               {}
-func (*VMCreateRequest) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{42} }
+func (*VMCreateRequest) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{44} }
 
 func (m *VMCreateRequest) GetCpu() int32 {
 	if m != nil {
@@ -2059,7 +2357,7 @@ type VMCreateResponse struct {
 func (m *VMCreateResponse) Reset()                    { *m = VMCreateResponse{} }
 func (m *VMCreateResponse) String() string            { return proto.CompactTextString(m) }
 func (*VMCreateResponse) ProtoMessage()               {}
-func (*VMCreateResponse) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{43} }
+func (*VMCreateResponse) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{45} }
 
 func (m *VMCreateResponse) GetVmID() string {
 	if m != nil {
@@ -2075,7 +2373,7 @@ type VMRemoveRequest struct {
 func (m *VMRemoveRequest) Reset()                    { *m = VMRemoveRequest{} }
 func (m *VMRemoveRequest) String() string            { return proto.CompactTextString(m) }
 func (*VMRemoveRequest) ProtoMessage()               {}
-func (*VMRemoveRequest) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{44} }
+func (*VMRemoveRequest) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{46} }
 
 func (m *VMRemoveRequest) GetVmID() string {
 	if m != nil {
@@ -2092,7 +2390,7 @@ type VMRemoveResponse struct {
 func (m *VMRemoveResponse) Reset()                    { *m = VMRemoveResponse{} }
 func (m *VMRemoveResponse) String() string            { return proto.CompactTextString(m) }
 func (*VMRemoveResponse) ProtoMessage()               {}
-func (*VMRemoveResponse) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{45} }
+func (*VMRemoveResponse) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{47} }
 
 func (m *VMRemoveResponse) GetCode() int32 {
 	if m != nil {
@@ -2118,7 +2416,7 @@ type UserContainerPort struct {
 func (m *UserContainerPort) Reset()                    { *m = UserContainerPort{} }
 func (m *UserContainerPort) String() string            { return proto.CompactTextString(m) }
 func (*UserContainerPort) ProtoMessage()               {}
-func (*UserContainerPort) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{46} }
+func (*UserContainerPort) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{48} }
 
 func (m *UserContainerPort) GetHostPort() int32 {
 	if m != nil {
@@ -2158,7 +2456,7 @@ type UserVolumeReference struct {
 func (m *UserVolumeReference) Reset()                    { *m = UserVolumeReference{} }
 func (m *UserVolumeReference) String() string            { return proto.CompactTextString(m) }
 func (*UserVolumeReference) ProtoMessage()               {}
-func (*UserVolumeReference) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{47} }
+func (*UserVolumeReference) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{49} }
 
 func (m *UserVolumeReference) GetPath() string {
 	if m != nil {
@@ -2200,7 +2498,7 @@ type UserFileReference struct {
 func (m *UserFileReference) Reset()                    { *m = UserFileReference{} }
 func (m *UserFileReference) String() string            { return proto.CompactTextString(m) }
 func (*UserFileReference) ProtoMessage()               {}
-func (*UserFileReference) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{48} }
+func (*UserFileReference) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{50} }
 
 func (m *UserFileReference) GetPath() string {
 	if m != nil {
@@ -2253,7 +2551,7 @@ type UserUser struct {
 func (m *UserUser) Reset()                    { *m = UserUser{} }
 func (m *UserUser) String() string            { return proto.CompactTextString(m) }
 func (*UserUser) ProtoMessage()               {}
-func (*UserUser) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{49} }
+func (*UserUser) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{51} }
 
 func (m *UserUser) GetName() string {
 	if m != nil {
@@ -2285,7 +2583,7 @@ type Ulimit struct {
 func (m *Ulimit) Reset()                    { *m = Ulimit{} }
 func (m *Ulimit) String() string            { return proto.CompactTextString(m) }
 func (*Ulimit) ProtoMessage()               {}
-func (*Ulimit) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{50} }
+func (*Ulimit) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{52} }
 
 func (m *Ulimit) GetName() string {
 	if m != nil {
@@ -2309,31 +2607,34 @@ func (m *Ulimit) GetSoft() uint64 {
 }
 
 type UserContainer struct {
-	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Image         string                 `protobuf:"bytes,2,opt,name=image,proto3" json:"image,omitempty"`
-	Workdir       string                 `protobuf:"bytes,3,opt,name=workdir,proto3" json:"workdir,omitempty"`
-	RestartPolicy string                 `protobuf:"bytes,4,opt,name=restartPolicy,proto3" json:"restartPolicy,omitempty"`
-	Tty           bool                   `protobuf:"varint,5,opt,name=tty,proto3" json:"tty,omitempty"`
-	Sysctl        map[string]string      `protobuf:"bytes,6,rep,name=sysctl" json:"sysctl,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
-	Envs          []*EnvironmentVar      `protobuf:"bytes,7,rep,name=envs" json:"envs,omitempty"`
-	Command       []string               `protobuf:"bytes,8,rep,name=command" json:"command,omitempty"`
-	Entrypoint    []string               `protobuf:"bytes,9,rep,name=entrypoint" json:"entrypoint,omitempty"`
-	Ports         []*UserContainerPort   `protobuf:"bytes,10,rep,name=ports" json:"ports,omitempty"`
-	Volumes       []*UserVolumeReference `protobuf:"bytes,11,rep,name=volumes" json:"volumes,omitempty"`
-	Files         []*UserFileReference   `protobuf:"bytes,12,rep,name=files" json:"files,omitempty"`
-	User          *UserUser              `protobuf:"bytes,13,opt,name=user" json:"user,omitempty"`
-	Labels        map[string]string      `protobuf:"bytes,14,rep,name=labels" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
-	Id            string                 `protobuf:"bytes,15,opt,name=id,proto3" json:"id,omitempty"`
-	StopSignal    string                 `protobuf:"bytes,17,opt,name=StopSignal,proto3" json:"StopSignal,omitempty"`
-	Ulimits       []*Ulimit              `protobuf:"bytes,18,rep,name=ulimits" json:"ulimits,omitempty"`
-	LogPath       string                 `protobuf:"bytes,19,opt,name=logPath,proto3" json:"logPath,omitempty"`
-	ReadOnly      bool                   `protobuf:"varint,20,opt,name=readOnly,proto3" json:"readOnly,omitempty"`
+	Name           string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Image          string                 `protobuf:"bytes,2,opt,name=image,proto3" json:"image,omitempty"`
+	Workdir        string                 `protobuf:"bytes,3,opt,name=workdir,proto3" json:"workdir,omitempty"`
+	RestartPolicy  string                 `protobuf:"bytes,4,opt,name=restartPolicy,proto3" json:"restartPolicy,omitempty"`
+	Tty            bool                   `protobuf:"varint,5,opt,name=tty,proto3" json:"tty,omitempty"`
+	Sysctl         map[string]string      `protobuf:"bytes,6,rep,name=sysctl" json:"sysctl,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Envs           []*EnvironmentVar      `protobuf:"bytes,7,rep,name=envs" json:"envs,omitempty"`
+	Command        []string               `protobuf:"bytes,8,rep,name=command" json:"command,omitempty"`
+	Entrypoint     []string               `protobuf:"bytes,9,rep,name=entrypoint" json:"entrypoint,omitempty"`
+	Ports          []*UserContainerPort   `protobuf:"bytes,10,rep,name=ports" json:"ports,omitempty"`
+	Volumes        []*UserVolumeReference `protobuf:"bytes,11,rep,name=volumes" json:"volumes,omitempty"`
+	Files          []*UserFileReference   `protobuf:"bytes,12,rep,name=files" json:"files,omitempty"`
+	User           *UserUser              `protobuf:"bytes,13,opt,name=user" json:"user,omitempty"`
+	Labels         map[string]string      `protobuf:"bytes,14,rep,name=labels" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Id             string                 `protobuf:"bytes,15,opt,name=id,proto3" json:"id,omitempty"`
+	StopSignal     string                 `protobuf:"bytes,17,opt,name=StopSignal,proto3" json:"StopSignal,omitempty"`
+	Ulimits        []*Ulimit              `protobuf:"bytes,18,rep,name=ulimits" json:"ulimits,omitempty"`
+	LogPath        string                 `protobuf:"bytes,19,opt,name=logPath,proto3" json:"logPath,omitempty"`
+	ReadOnly       bool                   `protobuf:"varint,20,opt,name=readOnly,proto3" json:"readOnly,omitempty"`
+	LivenessProbe  *UserProbe             `protobuf:"bytes,21,opt,name=livenessProbe" json:"livenessProbe,omitempty"`
+	ReadinessProbe *UserProbe             `protobuf:"bytes,22,opt,name=readinessProbe" json:"readinessProbe,omitempty"`
+	Resource       *UserContainerResource `protobuf:"bytes,23,opt,name=resource" json:"resource,omitempty"`
 }
 
 func (m *UserContainer) Reset()                    { *m = UserContainer{} }
 func (m *UserContainer) String() string            { return proto.CompactTextString(m) }
 func (*UserContainer) ProtoMessage()               {}
-func (*UserContainer) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{51} }
+func (*UserContainer) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{53} }
 
 func (m *UserContainer) GetName() string {
 	if m != nil {
@@ -2468,163 +2769,388 @@ func (m *UserContainer) GetReadOnly() bool {
 	return false
 }
 
-type UserResource struct {
-	Vcpu   int32 `protobuf:"varint,1,opt,name=vcpu,proto3" json:"vcpu,omitempty"`
-	Memory int32 `protobuf:"varint,2,opt,name=memory,proto3" json:"memory,omitempty"`
+func (m *UserContainer) GetLivenessProbe() *UserProbe {
+	if m != nil {
+		return m.LivenessProbe
+	}
+	return nil
 }
 
-func (m *UserResource) Reset()                    { *m = UserResource{} }
-func (m *UserResource) String() string            { return proto.CompactTextString(m) }
-func (*UserResource) ProtoMessage()               {}
-func (*UserResource) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{52} }
+func (m *UserContainer) GetReadinessProbe() *UserProbe {
+	if m != nil {
+		return m.ReadinessProbe
+	}
+	return nil
+}
 
-func (m *UserResource) GetVcpu() int32 {
+func (m *UserContainer) GetResource() *UserContainerResource {
 	if m != nil {
-		return m.Vcpu
+		return m.Resource
 	}
-	return 0
+	return nil
 }
 
-func (m *UserResource) GetMemory() int32 {
+type UserProbeExec struct {
+	Command []string `protobuf:"bytes,1,rep,name=command" json:"command,omitempty"`
+}
+
+func (m *UserProbeExec) Reset()                    { *m = UserProbeExec{} }
+func (m *UserProbeExec) String() string            { return proto.CompactTextString(m) }
+func (*UserProbeExec) ProtoMessage()               {}
+func (*UserProbeExec) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{54} }
+
+func (m *UserProbeExec) GetCommand() []string {
 	if m != nil {
-		return m.Memory
+		return m.Command
 	}
-	return 0
+	return nil
 }
 
-type UserFile struct {
-	Name     string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Encoding string `protobuf:"bytes,2,opt,name=encoding,proto3" json:"encoding,omitempty"`
-	Uri      string `protobuf:"bytes,3,opt,name=uri,proto3" json:"uri,omitempty"`
-	Content  string `protobuf:"bytes,4,opt,name=content,proto3" json:"content,omitempty"`
+type UserProbeTCPSocket struct {
+	Port int32 `protobuf:"varint,1,opt,name=port,proto3" json:"port,omitempty"`
 }
 
-func (m *UserFile) Reset()                    { *m = UserFile{} }
-func (m *UserFile) String() string            { return proto.CompactTextString(m) }
-func (*UserFile) ProtoMessage()               {}
-func (*UserFile) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{53} }
+func (m *UserProbeTCPSocket) Reset()                    { *m = UserProbeTCPSocket{} }
+func (m *UserProbeTCPSocket) String() string            { return proto.CompactTextString(m) }
+func (*UserProbeTCPSocket) ProtoMessage()               {}
+func (*UserProbeTCPSocket) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{55} }
 
-func (m *UserFile) GetName() string {
+func (m *UserProbeTCPSocket) GetPort() int32 {
 	if m != nil {
-		return m.Name
+		return m.Port
 	}
-	return ""
+	return 0
 }
 
-func (m *UserFile) GetEncoding() string {
+type UserProbeHTTPGet struct {
+	Path   string `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+	Port   int32  `protobuf:"varint,2,opt,name=port,proto3" json:"port,omitempty"`
+	Scheme string `protobuf:"bytes,3,opt,name=scheme,proto3" json:"scheme,omitempty"`
+}
+
+func (m *UserProbeHTTPGet) Reset()                    { *m = UserProbeHTTPGet{} }
+func (m *UserProbeHTTPGet) String() string            { return proto.CompactTextString(m) }
+func (*UserProbeHTTPGet) ProtoMessage()               {}
+func (*UserProbeHTTPGet) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{56} }
+
+func (m *UserProbeHTTPGet) GetPath() string {
 	if m != nil {
-		return m.Encoding
+		return m.Path
 	}
 	return ""
 }
 
-func (m *UserFile) GetUri() string {
+func (m *UserProbeHTTPGet) GetPort() int32 {
 	if m != nil {
-		return m.Uri
+		return m.Port
 	}
-	return ""
+	return 0
 }
 
-func (m *UserFile) GetContent() string {
+func (m *UserProbeHTTPGet) GetScheme() string {
 	if m != nil {
-		return m.Content
+		return m.Scheme
 	}
 	return ""
 }
 
-type UserVolumeOption struct {
-	User     string   `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
-	Keyring  string   `protobuf:"bytes,2,opt,name=keyring,proto3" json:"keyring,omitempty"`
-	Monitors []string `protobuf:"bytes,3,rep,name=monitors" json:"monitors,omitempty"`
+// UserProbe describes a health check of a container, one and only one of
+// the exec, tcpSocket and httpGet handlers should be specified.
+type UserProbe struct {
+	Exec                *UserProbeExec      `protobuf:"bytes,1,opt,name=exec" json:"exec,omitempty"`
+	TcpSocket           *UserProbeTCPSocket `protobuf:"bytes,2,opt,name=tcpSocket" json:"tcpSocket,omitempty"`
+	HttpGet             *UserProbeHTTPGet   `protobuf:"bytes,3,opt,name=httpGet" json:"httpGet,omitempty"`
+	InitialDelaySeconds int32               `protobuf:"varint,4,opt,name=initialDelaySeconds,proto3" json:"initialDelaySeconds,omitempty"`
+	PeriodSeconds       int32               `protobuf:"varint,5,opt,name=periodSeconds,proto3" json:"periodSeconds,omitempty"`
+	TimeoutSeconds      int32               `protobuf:"varint,6,opt,name=timeoutSeconds,proto3" json:"timeoutSeconds,omitempty"`
+	FailureThreshold    int32               `protobuf:"varint,7,opt,name=failureThreshold,proto3" json:"failureThreshold,omitempty"`
+	SuccessThreshold    int32               `protobuf:"varint,8,opt,name=successThreshold,proto3" json:"successThreshold,omitempty"`
 }
 
-func (m *UserVolumeOption) Reset()                    { *m = UserVolumeOption{} }
-func (m *UserVolumeOption) String() string            { return proto.CompactTextString(m) }
-func (*UserVolumeOption) ProtoMessage()               {}
-func (*UserVolumeOption) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{54} }
+func (m *UserProbe) Reset()                    { *m = UserProbe{} }
+func (m *UserProbe) String() string            { return proto.CompactTextString(m) }
+func (*UserProbe) ProtoMessage()               {}
+func (*UserProbe) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{57} }
 
-func (m *UserVolumeOption) GetUser() string {
+func (m *UserProbe) GetExec() *UserProbeExec {
 	if m != nil {
-		return m.User
+		return m.Exec
 	}
-	return ""
+	return nil
 }
 
-func (m *UserVolumeOption) GetKeyring() string {
+func (m *UserProbe) GetTcpSocket() *UserProbeTCPSocket {
 	if m != nil {
-		return m.Keyring
+		return m.TcpSocket
 	}
-	return ""
+	return nil
 }
 
-func (m *UserVolumeOption) GetMonitors() []string {
+func (m *UserProbe) GetHttpGet() *UserProbeHTTPGet {
 	if m != nil {
-		return m.Monitors
+		return m.HttpGet
 	}
 	return nil
 }
 
-type UserVolume struct {
-	Name   string            `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Source string            `protobuf:"bytes,2,opt,name=source,proto3" json:"source,omitempty"`
-	Format string            `protobuf:"bytes,3,opt,name=format,proto3" json:"format,omitempty"`
-	Option *UserVolumeOption `protobuf:"bytes,4,opt,name=option" json:"option,omitempty"`
-	Fstype string            `protobuf:"bytes,5,opt,name=fstype,proto3" json:"fstype,omitempty"`
-}
-
-func (m *UserVolume) Reset()                    { *m = UserVolume{} }
-func (m *UserVolume) String() string            { return proto.CompactTextString(m) }
-func (*UserVolume) ProtoMessage()               {}
-func (*UserVolume) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{55} }
-
-func (m *UserVolume) GetName() string {
+func (m *UserProbe) GetInitialDelaySeconds() int32 {
 	if m != nil {
-		return m.Name
+		return m.InitialDelaySeconds
 	}
-	return ""
+	return 0
 }
 
-func (m *UserVolume) GetSource() string {
+func (m *UserProbe) GetPeriodSeconds() int32 {
 	if m != nil {
-		return m.Source
+		return m.PeriodSeconds
 	}
-	return ""
+	return 0
 }
 
-func (m *UserVolume) GetFormat() string {
+func (m *UserProbe) GetTimeoutSeconds() int32 {
 	if m != nil {
-		return m.Format
+		return m.TimeoutSeconds
 	}
-	return ""
+	return 0
 }
 
-func (m *UserVolume) GetOption() *UserVolumeOption {
+func (m *UserProbe) GetFailureThreshold() int32 {
 	if m != nil {
-		return m.Option
+		return m.FailureThreshold
 	}
-	return nil
+	return 0
 }
 
-func (m *UserVolume) GetFstype() string {
+func (m *UserProbe) GetSuccessThreshold() int32 {
 	if m != nil {
-		return m.Fstype
+		return m.SuccessThreshold
 	}
-	return ""
+	return 0
 }
 
-type UserInterface struct {
-	Bridge  string `protobuf:"bytes,1,opt,name=bridge,proto3" json:"bridge,omitempty"`
-	Ip      string `protobuf:"bytes,2,opt,name=ip,proto3" json:"ip,omitempty"`
-	Ifname  string `protobuf:"bytes,3,opt,name=ifname,proto3" json:"ifname,omitempty"`
-	Mac     string `protobuf:"bytes,4,opt,name=mac,proto3" json:"mac,omitempty"`
-	Gateway string `protobuf:"bytes,5,opt,name=gateway,proto3" json:"gateway,omitempty"`
-	Tap     string `protobuf:"bytes,6,opt,name=tap,proto3" json:"tap,omitempty"`
+type UserResource struct {
+	Vcpu   int32 `protobuf:"varint,1,opt,name=vcpu,proto3" json:"vcpu,omitempty"`
+	Memory int32 `protobuf:"varint,2,opt,name=memory,proto3" json:"memory,omitempty"`
 }
 
-func (m *UserInterface) Reset()                    { *m = UserInterface{} }
-func (m *UserInterface) String() string            { return proto.CompactTextString(m) }
+func (m *UserResource) Reset()                    { *m = UserResource{} }
+func (m *UserResource) String() string            { return proto.CompactTextString(m) }
+func (*UserResource) ProtoMessage()               {}
+func (*UserResource) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{58} }
+
+func (m *UserResource) GetVcpu() int32 {
+	if m != nil {
+		return m.Vcpu
+	}
+	return 0
+}
+
+func (m *UserResource) GetMemory() int32 {
+	if m != nil {
+		return m.Memory
+	}
+	return 0
+}
+
+// UserContainerResource limits a container inside the sandbox with cgroups,
+// zero means unlimited. cpuQuota is in microseconds per cpuPeriod, and
+// memory is in MB as the pod resource.
+type UserContainerResource struct {
+	CpuShares uint64 `protobuf:"varint,1,opt,name=cpuShares,proto3" json:"cpuShares,omitempty"`
+	CpuQuota  int64  `protobuf:"varint,2,opt,name=cpuQuota,proto3" json:"cpuQuota,omitempty"`
+	CpuPeriod uint64 `protobuf:"varint,3,opt,name=cpuPeriod,proto3" json:"cpuPeriod,omitempty"`
+	Memory    int32  `protobuf:"varint,4,opt,name=memory,proto3" json:"memory,omitempty"`
+	PidsLimit int64  `protobuf:"varint,5,opt,name=pidsLimit,proto3" json:"pidsLimit,omitempty"`
+}
+
+func (m *UserContainerResource) Reset()                    { *m = UserContainerResource{} }
+func (m *UserContainerResource) String() string            { return proto.CompactTextString(m) }
+func (*UserContainerResource) ProtoMessage()               {}
+func (*UserContainerResource) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{59} }
+
+func (m *UserContainerResource) GetCpuShares() uint64 {
+	if m != nil {
+		return m.CpuShares
+	}
+	return 0
+}
+
+func (m *UserContainerResource) GetCpuQuota() int64 {
+	if m != nil {
+		return m.CpuQuota
+	}
+	return 0
+}
+
+func (m *UserContainerResource) GetCpuPeriod() uint64 {
+	if m != nil {
+		return m.CpuPeriod
+	}
+	return 0
+}
+
+func (m *UserContainerResource) GetMemory() int32 {
+	if m != nil {
+		return m.Memory
+	}
+	return 0
+}
+
+func (m *UserContainerResource) GetPidsLimit() int64 {
+	if m != nil {
+		return m.PidsLimit
+	}
+	return 0
+}
+
+type UserFile struct {
+	Name     string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Encoding string `protobuf:"bytes,2,opt,name=encoding,proto3" json:"encoding,omitempty"`
+	Uri      string `protobuf:"bytes,3,opt,name=uri,proto3" json:"uri,omitempty"`
+	Content  string `protobuf:"bytes,4,opt,name=content,proto3" json:"content,omitempty"`
+}
+
+func (m *UserFile) Reset()                    { *m = UserFile{} }
+func (m *UserFile) String() string            { return proto.CompactTextString(m) }
+func (*UserFile) ProtoMessage()               {}
+func (*UserFile) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{60} }
+
+func (m *UserFile) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *UserFile) GetEncoding() string {
+	if m != nil {
+		return m.Encoding
+	}
+	return ""
+}
+
+func (m *UserFile) GetUri() string {
+	if m != nil {
+		return m.Uri
+	}
+	return ""
+}
+
+func (m *UserFile) GetContent() string {
+	if m != nil {
+		return m.Content
+	}
+	return ""
+}
+
+type UserVolumeOption struct {
+	User     string   `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	Keyring  string   `protobuf:"bytes,2,opt,name=keyring,proto3" json:"keyring,omitempty"`
+	Monitors []string `protobuf:"bytes,3,rep,name=monitors" json:"monitors,omitempty"`
+}
+
+func (m *UserVolumeOption) Reset()                    { *m = UserVolumeOption{} }
+func (m *UserVolumeOption) String() string            { return proto.CompactTextString(m) }
+func (*UserVolumeOption) ProtoMessage()               {}
+func (*UserVolumeOption) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{61} }
+
+func (m *UserVolumeOption) GetUser() string {
+	if m != nil {
+		return m.User
+	}
+	return ""
+}
+
+func (m *UserVolumeOption) GetKeyring() string {
+	if m != nil {
+		return m.Keyring
+	}
+	return ""
+}
+
+func (m *UserVolumeOption) GetMonitors() []string {
+	if m != nil {
+		return m.Monitors
+	}
+	return nil
+}
+
+type UserVolume struct {
+	Name   string            `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Source string            `protobuf:"bytes,2,opt,name=source,proto3" json:"source,omitempty"`
+	Format string            `protobuf:"bytes,3,opt,name=format,proto3" json:"format,omitempty"`
+	Option *UserVolumeOption `protobuf:"bytes,4,opt,name=option" json:"option,omitempty"`
+	Fstype string            `protobuf:"bytes,5,opt,name=fstype,proto3" json:"fstype,omitempty"`
+	// requested size of the volume created by the storage driver,
+	// 0 means the default size of the driver
+	SizeBytes int64 `protobuf:"varint,6,opt,name=sizeBytes,proto3" json:"sizeBytes,omitempty"`
+}
+
+func (m *UserVolume) Reset()                    { *m = UserVolume{} }
+func (m *UserVolume) String() string            { return proto.CompactTextString(m) }
+func (*UserVolume) ProtoMessage()               {}
+func (*UserVolume) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{62} }
+
+func (m *UserVolume) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *UserVolume) GetSource() string {
+	if m != nil {
+		return m.Source
+	}
+	return ""
+}
+
+func (m *UserVolume) GetFormat() string {
+	if m != nil {
+		return m.Format
+	}
+	return ""
+}
+
+func (m *UserVolume) GetOption() *UserVolumeOption {
+	if m != nil {
+		return m.Option
+	}
+	return nil
+}
+
+func (m *UserVolume) GetFstype() string {
+	if m != nil {
+		return m.Fstype
+	}
+	return ""
+}
+
+func (m *UserVolume) GetSizeBytes() int64 {
+	if m != nil {
+		return m.SizeBytes
+	}
+	return 0
+}
+
+type UserInterface struct {
+	Bridge  string `protobuf:"bytes,1,opt,name=bridge,proto3" json:"bridge,omitempty"`
+	Ip      string `protobuf:"bytes,2,opt,name=ip,proto3" json:"ip,omitempty"`
+	Ifname  string `protobuf:"bytes,3,opt,name=ifname,proto3" json:"ifname,omitempty"`
+	Mac     string `protobuf:"bytes,4,opt,name=mac,proto3" json:"mac,omitempty"`
+	Gateway string `protobuf:"bytes,5,opt,name=gateway,proto3" json:"gateway,omitempty"`
+	Tap     string `protobuf:"bytes,6,opt,name=tap,proto3" json:"tap,omitempty"`
+	// the IPv6 gateway, ip may contain IPv6 addresses separated by ","
+	Gateway6 string `protobuf:"bytes,7,opt,name=gateway6,proto3" json:"gateway6,omitempty"`
+	// the user-defined network to get the address from, the bridge, ip and
+	// gateway are filled in by hyperd
+	Network string `protobuf:"bytes,8,opt,name=network,proto3" json:"network,omitempty"`
+}
+
+func (m *UserInterface) Reset()                    { *m = UserInterface{} }
+func (m *UserInterface) String() string            { return proto.CompactTextString(m) }
 func (*UserInterface) ProtoMessage()               {}
-func (*UserInterface) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{56} }
+func (*UserInterface) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{63} }
 
 func (m *UserInterface) GetBridge() string {
 	if m != nil {
@@ -2668,6 +3194,20 @@ func (m *UserInterface) GetTap() string {
 	return ""
 }
 
+func (m *UserInterface) GetGateway6() string {
+	if m != nil {
+		return m.Gateway6
+	}
+	return ""
+}
+
+func (m *UserInterface) GetNetwork() string {
+	if m != nil {
+		return m.Network
+	}
+	return ""
+}
+
 type UserServiceBackend struct {
 	HostIP   string `protobuf:"bytes,1,opt,name=hostIP,proto3" json:"hostIP,omitempty"`
 	HostPort int32  `protobuf:"varint,2,opt,name=hostPort,proto3" json:"hostPort,omitempty"`
@@ -2676,7 +3216,7 @@ type UserServiceBackend struct {
 func (m *UserServiceBackend) Reset()                    { *m = UserServiceBackend{} }
 func (m *UserServiceBackend) String() string            { return proto.CompactTextString(m) }
 func (*UserServiceBackend) ProtoMessage()               {}
-func (*UserServiceBackend) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{57} }
+func (*UserServiceBackend) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{64} }
 
 func (m *UserServiceBackend) GetHostIP() string {
 	if m != nil {
@@ -2697,12 +3237,14 @@ type UserService struct {
 	Protocol    string                `protobuf:"bytes,2,opt,name=protocol,proto3" json:"protocol,omitempty"`
 	ServicePort int32                 `protobuf:"varint,3,opt,name=servicePort,proto3" json:"servicePort,omitempty"`
 	Hosts       []*UserServiceBackend `protobuf:"bytes,4,rep,name=hosts" json:"hosts,omitempty"`
+	// the VIP is resolved as <name>.svc.<domain> by the hyperd DNS server
+	Name string `protobuf:"bytes,5,opt,name=name,proto3" json:"name,omitempty"`
 }
 
 func (m *UserService) Reset()                    { *m = UserService{} }
 func (m *UserService) String() string            { return proto.CompactTextString(m) }
 func (*UserService) ProtoMessage()               {}
-func (*UserService) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{58} }
+func (*UserService) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{65} }
 
 func (m *UserService) GetServiceIP() string {
 	if m != nil {
@@ -2732,6 +3274,13 @@ func (m *UserService) GetHosts() []*UserServiceBackend {
 	return nil
 }
 
+func (m *UserService) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
 type PodLogConfig struct {
 	Type   string            `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
 	Config map[string]string `protobuf:"bytes,2,rep,name=config" json:"config,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
@@ -2740,7 +3289,7 @@ type PodLogConfig struct {
 func (m *PodLogConfig) Reset()                    { *m = PodLogConfig{} }
 func (m *PodLogConfig) String() string            { return proto.CompactTextString(m) }
 func (*PodLogConfig) ProtoMessage()               {}
-func (*PodLogConfig) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{59} }
+func (*PodLogConfig) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{66} }
 
 func (m *PodLogConfig) GetType() string {
 	if m != nil {
@@ -2758,14 +3307,18 @@ func (m *PodLogConfig) GetConfig() map[string]string {
 
 type PortMapping struct {
 	ContainerPort string `protobuf:"bytes,1,opt,name=containerPort,proto3" json:"containerPort,omitempty"`
-	HostPort      string `protobuf:"bytes,2,opt,name=hostPort,proto3" json:"hostPort,omitempty"`
-	Protocol      string `protobuf:"bytes,3,opt,name=protocol,proto3" json:"protocol,omitempty"`
+	// the host port or range, an empty or "0" hostPort means the host ports
+	// are allocated by hyperd from the HostPortRange of the config, as many
+	// as the container ports. The allocated ports are returned in the
+	// portmapping list and the pod info.
+	HostPort string `protobuf:"bytes,2,opt,name=hostPort,proto3" json:"hostPort,omitempty"`
+	Protocol string `protobuf:"bytes,3,opt,name=protocol,proto3" json:"protocol,omitempty"`
 }
 
 func (m *PortMapping) Reset()                    { *m = PortMapping{} }
 func (m *PortMapping) String() string            { return proto.CompactTextString(m) }
 func (*PortMapping) ProtoMessage()               {}
-func (*PortMapping) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{60} }
+func (*PortMapping) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{67} }
 
 func (m *PortMapping) GetContainerPort() string {
 	if m != nil {
@@ -2800,7 +3353,7 @@ type PortmappingWhiteList struct {
 func (m *PortmappingWhiteList) Reset()                    { *m = PortmappingWhiteList{} }
 func (m *PortmappingWhiteList) String() string            { return proto.CompactTextString(m) }
 func (*PortmappingWhiteList) ProtoMessage()               {}
-func (*PortmappingWhiteList) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{61} }
+func (*PortmappingWhiteList) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{68} }
 
 func (m *PortmappingWhiteList) GetInternalNetworks() []string {
 	if m != nil {
@@ -2836,12 +3389,14 @@ type UserPod struct {
 	Portmappings          []*PortMapping        `protobuf:"bytes,16,rep,name=portmappings" json:"portmappings,omitempty"`
 	DnsOptions            []string              `protobuf:"bytes,17,rep,name=dnsOptions" json:"dnsOptions,omitempty"`
 	DnsSearch             []string              `protobuf:"bytes,18,rep,name=dnsSearch" json:"dnsSearch,omitempty"`
+	// extra entries of the hosts file of the pod
+	ExtraHosts []*HostAlias `protobuf:"bytes,19,rep,name=extraHosts" json:"extraHosts,omitempty"`
 }
 
 func (m *UserPod) Reset()                    { *m = UserPod{} }
 func (m *UserPod) String() string            { return proto.CompactTextString(m) }
 func (*UserPod) ProtoMessage()               {}
-func (*UserPod) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{62} }
+func (*UserPod) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{69} }
 
 func (m *UserPod) GetId() string {
 	if m != nil {
@@ -2969,6 +3524,38 @@ func (m *UserPod) GetDnsSearch() []string {
 	return nil
 }
 
+func (m *UserPod) GetExtraHosts() []*HostAlias {
+	if m != nil {
+		return m.ExtraHosts
+	}
+	return nil
+}
+
+// HostAlias resolves the hostnames to the ip in the hosts file of a pod
+type HostAlias struct {
+	Ip        string   `protobuf:"bytes,1,opt,name=ip,proto3" json:"ip,omitempty"`
+	Hostnames []string `protobuf:"bytes,2,rep,name=hostnames" json:"hostnames,omitempty"`
+}
+
+func (m *HostAlias) Reset()                    { *m = HostAlias{} }
+func (m *HostAlias) String() string            { return proto.CompactTextString(m) }
+func (*HostAlias) ProtoMessage()               {}
+func (*HostAlias) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{70} }
+
+func (m *HostAlias) GetIp() string {
+	if m != nil {
+		return m.Ip
+	}
+	return ""
+}
+
+func (m *HostAlias) GetHostnames() []string {
+	if m != nil {
+		return m.Hostnames
+	}
+	return nil
+}
+
 type PodCreateRequest struct {
 	PodSpec *UserPod `protobuf:"bytes,1,opt,name=podSpec" json:"podSpec,omitempty"`
 	PodID   string   `protobuf:"bytes,2,opt,name=podID,proto3" json:"podID,omitempty"`
@@ -2977,7 +3564,7 @@ type PodCreateRequest struct {
 func (m *PodCreateRequest) Reset()                    { *m = PodCreateRequest{} }
 func (m *PodCreateRequest) String() string            { return proto.CompactTextString(m) }
 func (*PodCreateRequest) ProtoMessage()               {}
-func (*PodCreateRequest) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{63} }
+func (*PodCreateRequest) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{71} }
 
 func (m *PodCreateRequest) GetPodSpec() *UserPod {
 	if m != nil {
@@ -3000,7 +3587,7 @@ type PodCreateResponse struct {
 func (m *PodCreateResponse) Reset()                    { *m = PodCreateResponse{} }
 func (m *PodCreateResponse) String() string            { return proto.CompactTextString(m) }
 func (*PodCreateResponse) ProtoMessage()               {}
-func (*PodCreateResponse) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{64} }
+func (*PodCreateResponse) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{72} }
 
 func (m *PodCreateResponse) GetPodID() string {
 	if m != nil {
@@ -3016,7 +3603,7 @@ type PodRemoveRequest struct {
 func (m *PodRemoveRequest) Reset()                    { *m = PodRemoveRequest{} }
 func (m *PodRemoveRequest) String() string            { return proto.CompactTextString(m) }
 func (*PodRemoveRequest) ProtoMessage()               {}
-func (*PodRemoveRequest) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{65} }
+func (*PodRemoveRequest) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{73} }
 
 func (m *PodRemoveRequest) GetPodID() string {
 	if m != nil {
@@ -3033,7 +3620,7 @@ type PodRemoveResponse struct {
 func (m *PodRemoveResponse) Reset()                    { *m = PodRemoveResponse{} }
 func (m *PodRemoveResponse) String() string            { return proto.CompactTextString(m) }
 func (*PodRemoveResponse) ProtoMessage()               {}
-func (*PodRemoveResponse) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{66} }
+func (*PodRemoveResponse) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{74} }
 
 func (m *PodRemoveResponse) GetCode() int32 {
 	if m != nil {
@@ -3062,7 +3649,7 @@ type ContainerLogsRequest struct {
 func (m *ContainerLogsRequest) Reset()                    { *m = ContainerLogsRequest{} }
 func (m *ContainerLogsRequest) String() string            { return proto.CompactTextString(m) }
 func (*ContainerLogsRequest) ProtoMessage()               {}
-func (*ContainerLogsRequest) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{67} }
+func (*ContainerLogsRequest) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{75} }
 
 func (m *ContainerLogsRequest) GetContainer() string {
 	if m != nil {
@@ -3120,7 +3707,7 @@ type ContainerLogsResponse struct {
 func (m *ContainerLogsResponse) Reset()                    { *m = ContainerLogsResponse{} }
 func (m *ContainerLogsResponse) String() string            { return proto.CompactTextString(m) }
 func (*ContainerLogsResponse) ProtoMessage()               {}
-func (*ContainerLogsResponse) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{68} }
+func (*ContainerLogsResponse) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{76} }
 
 func (m *ContainerLogsResponse) GetLog() []byte {
 	if m != nil {
@@ -3137,7 +3724,7 @@ type DriverStatus struct {
 func (m *DriverStatus) Reset()                    { *m = DriverStatus{} }
 func (m *DriverStatus) String() string            { return proto.CompactTextString(m) }
 func (*DriverStatus) ProtoMessage()               {}
-func (*DriverStatus) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{69} }
+func (*DriverStatus) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{77} }
 
 func (m *DriverStatus) GetName() string {
 	if m != nil {
@@ -3159,7 +3746,7 @@ type InfoRequest struct {
 func (m *InfoRequest) Reset()                    { *m = InfoRequest{} }
 func (m *InfoRequest) String() string            { return proto.CompactTextString(m) }
 func (*InfoRequest) ProtoMessage()               {}
-func (*InfoRequest) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{70} }
+func (*InfoRequest) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{78} }
 
 type InfoResponse struct {
 	ID                 string          `protobuf:"bytes,1,opt,name=ID,proto3" json:"ID,omitempty"`
@@ -3179,7 +3766,7 @@ type InfoResponse struct {
 func (m *InfoResponse) Reset()                    { *m = InfoResponse{} }
 func (m *InfoResponse) String() string            { return proto.CompactTextString(m) }
 func (*InfoResponse) ProtoMessage()               {}
-func (*InfoResponse) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{71} }
+func (*InfoResponse) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{79} }
 
 func (m *InfoResponse) GetID() string {
 	if m != nil {
@@ -3269,12 +3856,19 @@ type ExecCreateRequest struct {
 	ContainerID string   `protobuf:"bytes,1,opt,name=containerID,proto3" json:"containerID,omitempty"`
 	Command     []string `protobuf:"bytes,2,rep,name=command" json:"command,omitempty"`
 	Tty         bool     `protobuf:"varint,3,opt,name=tty,proto3" json:"tty,omitempty"`
+	// env are the KEY=VALUE pairs added to the env of the container
+	Env []string `protobuf:"bytes,4,rep,name=env" json:"env,omitempty"`
+	// user and group override the ones of the container if set
+	User       string `protobuf:"bytes,5,opt,name=user,proto3" json:"user,omitempty"`
+	Group      string `protobuf:"bytes,6,opt,name=group,proto3" json:"group,omitempty"`
+	Workdir    string `protobuf:"bytes,7,opt,name=workdir,proto3" json:"workdir,omitempty"`
+	Privileged bool   `protobuf:"varint,8,opt,name=privileged,proto3" json:"privileged,omitempty"`
 }
 
 func (m *ExecCreateRequest) Reset()                    { *m = ExecCreateRequest{} }
 func (m *ExecCreateRequest) String() string            { return proto.CompactTextString(m) }
 func (*ExecCreateRequest) ProtoMessage()               {}
-func (*ExecCreateRequest) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{72} }
+func (*ExecCreateRequest) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{80} }
 
 func (m *ExecCreateRequest) GetContainerID() string {
 	if m != nil {
@@ -3297,6 +3891,41 @@ func (m *ExecCreateRequest) GetTty() bool {
 	return false
 }
 
+func (m *ExecCreateRequest) GetEnv() []string {
+	if m != nil {
+		return m.Env
+	}
+	return nil
+}
+
+func (m *ExecCreateRequest) GetUser() string {
+	if m != nil {
+		return m.User
+	}
+	return ""
+}
+
+func (m *ExecCreateRequest) GetGroup() string {
+	if m != nil {
+		return m.Group
+	}
+	return ""
+}
+
+func (m *ExecCreateRequest) GetWorkdir() string {
+	if m != nil {
+		return m.Workdir
+	}
+	return ""
+}
+
+func (m *ExecCreateRequest) GetPrivileged() bool {
+	if m != nil {
+		return m.Privileged
+	}
+	return false
+}
+
 type ExecCreateResponse struct {
 	ExecID string `protobuf:"bytes,1,opt,name=execID,proto3" json:"execID,omitempty"`
 }
@@ -3304,7 +3933,7 @@ type ExecCreateResponse struct {
 func (m *ExecCreateResponse) Reset()                    { *m = ExecCreateResponse{} }
 func (m *ExecCreateResponse) String() string            { return proto.CompactTextString(m) }
 func (*ExecCreateResponse) ProtoMessage()               {}
-func (*ExecCreateResponse) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{73} }
+func (*ExecCreateResponse) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{81} }
 
 func (m *ExecCreateResponse) GetExecID() string {
 	if m != nil {
@@ -3317,12 +3946,16 @@ type ExecStartRequest struct {
 	ContainerID string `protobuf:"bytes,1,opt,name=containerID,proto3" json:"containerID,omitempty"`
 	ExecID      string `protobuf:"bytes,2,opt,name=execID,proto3" json:"execID,omitempty"`
 	Stdin       []byte `protobuf:"bytes,3,opt,name=stdin,proto3" json:"stdin,omitempty"`
+	// the output of a non-tty exec is multiplexed in stdout with the stdcopy
+	// headers by default. With rawStreams, the responses carry the raw output
+	// of the stream they indicate, and the last one carries the exit code
+	RawStreams bool `protobuf:"varint,4,opt,name=rawStreams,proto3" json:"rawStreams,omitempty"`
 }
 
 func (m *ExecStartRequest) Reset()                    { *m = ExecStartRequest{} }
 func (m *ExecStartRequest) String() string            { return proto.CompactTextString(m) }
 func (*ExecStartRequest) ProtoMessage()               {}
-func (*ExecStartRequest) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{74} }
+func (*ExecStartRequest) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{82} }
 
 func (m *ExecStartRequest) GetContainerID() string {
 	if m != nil {
@@ -3345,14 +3978,28 @@ func (m *ExecStartRequest) GetStdin() []byte {
 	return nil
 }
 
+func (m *ExecStartRequest) GetRawStreams() bool {
+	if m != nil {
+		return m.RawStreams
+	}
+	return false
+}
+
 type ExecStartResponse struct {
-	Stdout []byte `protobuf:"bytes,1,opt,name=stdout,proto3" json:"stdout,omitempty"`
+	// stdout carries the output of the exec, of the stream indicated by
+	// stream if rawStreams is requested. The output of a tty exec is always
+	// from STDOUT
+	Stdout []byte                       `protobuf:"bytes,1,opt,name=stdout,proto3" json:"stdout,omitempty"`
+	Stream ExecStartResponse_StreamType `protobuf:"varint,2,opt,name=stream,proto3,enum=types.ExecStartResponse_StreamType" json:"stream,omitempty"`
+	// the last message of the rawStreams carries the exit code of the exec
+	Exited   bool  `protobuf:"varint,3,opt,name=exited,proto3" json:"exited,omitempty"`
+	ExitCode int32 `protobuf:"varint,4,opt,name=exitCode,proto3" json:"exitCode,omitempty"`
 }
 
 func (m *ExecStartResponse) Reset()                    { *m = ExecStartResponse{} }
 func (m *ExecStartResponse) String() string            { return proto.CompactTextString(m) }
 func (*ExecStartResponse) ProtoMessage()               {}
-func (*ExecStartResponse) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{75} }
+func (*ExecStartResponse) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{83} }
 
 func (m *ExecStartResponse) GetStdout() []byte {
 	if m != nil {
@@ -3361,16 +4008,37 @@ func (m *ExecStartResponse) GetStdout() []byte {
 	return nil
 }
 
-type ExecVMRequest struct {
-	PodID   string   `protobuf:"bytes,1,opt,name=podID,proto3" json:"podID,omitempty"`
-	Command []string `protobuf:"bytes,2,rep,name=command" json:"command,omitempty"`
-	Stdin   []byte   `protobuf:"bytes,3,opt,name=stdin,proto3" json:"stdin,omitempty"`
+func (m *ExecStartResponse) GetStream() ExecStartResponse_StreamType {
+	if m != nil {
+		return m.Stream
+	}
+	return ExecStartResponse_STDOUT
+}
+
+func (m *ExecStartResponse) GetExited() bool {
+	if m != nil {
+		return m.Exited
+	}
+	return false
+}
+
+func (m *ExecStartResponse) GetExitCode() int32 {
+	if m != nil {
+		return m.ExitCode
+	}
+	return 0
+}
+
+type ExecVMRequest struct {
+	PodID   string   `protobuf:"bytes,1,opt,name=podID,proto3" json:"podID,omitempty"`
+	Command []string `protobuf:"bytes,2,rep,name=command" json:"command,omitempty"`
+	Stdin   []byte   `protobuf:"bytes,3,opt,name=stdin,proto3" json:"stdin,omitempty"`
 }
 
 func (m *ExecVMRequest) Reset()                    { *m = ExecVMRequest{} }
 func (m *ExecVMRequest) String() string            { return proto.CompactTextString(m) }
 func (*ExecVMRequest) ProtoMessage()               {}
-func (*ExecVMRequest) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{76} }
+func (*ExecVMRequest) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{84} }
 
 func (m *ExecVMRequest) GetPodID() string {
 	if m != nil {
@@ -3401,7 +4069,7 @@ type ExecVMResponse struct {
 func (m *ExecVMResponse) Reset()                    { *m = ExecVMResponse{} }
 func (m *ExecVMResponse) String() string            { return proto.CompactTextString(m) }
 func (*ExecVMResponse) ProtoMessage()               {}
-func (*ExecVMResponse) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{77} }
+func (*ExecVMResponse) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{85} }
 
 func (m *ExecVMResponse) GetStdout() []byte {
 	if m != nil {
@@ -3426,7 +4094,7 @@ type ExecSignalRequest struct {
 func (m *ExecSignalRequest) Reset()                    { *m = ExecSignalRequest{} }
 func (m *ExecSignalRequest) String() string            { return proto.CompactTextString(m) }
 func (*ExecSignalRequest) ProtoMessage()               {}
-func (*ExecSignalRequest) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{78} }
+func (*ExecSignalRequest) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{86} }
 
 func (m *ExecSignalRequest) GetContainerID() string {
 	if m != nil {
@@ -3455,7 +4123,7 @@ type ExecSignalResponse struct {
 func (m *ExecSignalResponse) Reset()                    { *m = ExecSignalResponse{} }
 func (m *ExecSignalResponse) String() string            { return proto.CompactTextString(m) }
 func (*ExecSignalResponse) ProtoMessage()               {}
-func (*ExecSignalResponse) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{79} }
+func (*ExecSignalResponse) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{87} }
 
 type PodStartRequest struct {
 	PodID string `protobuf:"bytes,1,opt,name=podID,proto3" json:"podID,omitempty"`
@@ -3464,7 +4132,7 @@ type PodStartRequest struct {
 func (m *PodStartRequest) Reset()                    { *m = PodStartRequest{} }
 func (m *PodStartRequest) String() string            { return proto.CompactTextString(m) }
 func (*PodStartRequest) ProtoMessage()               {}
-func (*PodStartRequest) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{80} }
+func (*PodStartRequest) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{88} }
 
 func (m *PodStartRequest) GetPodID() string {
 	if m != nil {
@@ -3479,7 +4147,7 @@ type PodStartResponse struct {
 func (m *PodStartResponse) Reset()                    { *m = PodStartResponse{} }
 func (m *PodStartResponse) String() string            { return proto.CompactTextString(m) }
 func (*PodStartResponse) ProtoMessage()               {}
-func (*PodStartResponse) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{81} }
+func (*PodStartResponse) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{89} }
 
 type WaitRequest struct {
 	Container string `protobuf:"bytes,1,opt,name=container,proto3" json:"container,omitempty"`
@@ -3490,7 +4158,7 @@ type WaitRequest struct {
 func (m *WaitRequest) Reset()                    { *m = WaitRequest{} }
 func (m *WaitRequest) String() string            { return proto.CompactTextString(m) }
 func (*WaitRequest) ProtoMessage()               {}
-func (*WaitRequest) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{82} }
+func (*WaitRequest) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{90} }
 
 func (m *WaitRequest) GetContainer() string {
 	if m != nil {
@@ -3520,7 +4188,7 @@ type WaitResponse struct {
 func (m *WaitResponse) Reset()                    { *m = WaitResponse{} }
 func (m *WaitResponse) String() string            { return proto.CompactTextString(m) }
 func (*WaitResponse) ProtoMessage()               {}
-func (*WaitResponse) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{83} }
+func (*WaitResponse) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{91} }
 
 func (m *WaitResponse) GetExitCode() int32 {
 	if m != nil {
@@ -3537,7 +4205,7 @@ type AttachMessage struct {
 func (m *AttachMessage) Reset()                    { *m = AttachMessage{} }
 func (m *AttachMessage) String() string            { return proto.CompactTextString(m) }
 func (*AttachMessage) ProtoMessage()               {}
-func (*AttachMessage) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{84} }
+func (*AttachMessage) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{92} }
 
 func (m *AttachMessage) GetContainerID() string {
 	if m != nil {
@@ -3561,7 +4229,7 @@ type ContainerCreateRequest struct {
 func (m *ContainerCreateRequest) Reset()                    { *m = ContainerCreateRequest{} }
 func (m *ContainerCreateRequest) String() string            { return proto.CompactTextString(m) }
 func (*ContainerCreateRequest) ProtoMessage()               {}
-func (*ContainerCreateRequest) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{85} }
+func (*ContainerCreateRequest) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{93} }
 
 func (m *ContainerCreateRequest) GetContainerSpec() *UserContainer {
 	if m != nil {
@@ -3584,7 +4252,7 @@ type ContainerCreateResponse struct {
 func (m *ContainerCreateResponse) Reset()                    { *m = ContainerCreateResponse{} }
 func (m *ContainerCreateResponse) String() string            { return proto.CompactTextString(m) }
 func (*ContainerCreateResponse) ProtoMessage()               {}
-func (*ContainerCreateResponse) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{86} }
+func (*ContainerCreateResponse) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{94} }
 
 func (m *ContainerCreateResponse) GetContainerID() string {
 	if m != nil {
@@ -3601,7 +4269,7 @@ type ContainerStartRequest struct {
 func (m *ContainerStartRequest) Reset()                    { *m = ContainerStartRequest{} }
 func (m *ContainerStartRequest) String() string            { return proto.CompactTextString(m) }
 func (*ContainerStartRequest) ProtoMessage()               {}
-func (*ContainerStartRequest) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{87} }
+func (*ContainerStartRequest) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{95} }
 
 func (m *ContainerStartRequest) GetContainerId() string {
 	if m != nil {
@@ -3616,7 +4284,7 @@ type ContainerStartResponse struct {
 func (m *ContainerStartResponse) Reset()                    { *m = ContainerStartResponse{} }
 func (m *ContainerStartResponse) String() string            { return proto.CompactTextString(m) }
 func (*ContainerStartResponse) ProtoMessage()               {}
-func (*ContainerStartResponse) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{88} }
+func (*ContainerStartResponse) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{96} }
 
 type ContainerRenameRequest struct {
 	OldContainerName string `protobuf:"bytes,1,opt,name=oldContainerName,proto3" json:"oldContainerName,omitempty"`
@@ -3626,7 +4294,7 @@ type ContainerRenameRequest struct {
 func (m *ContainerRenameRequest) Reset()                    { *m = ContainerRenameRequest{} }
 func (m *ContainerRenameRequest) String() string            { return proto.CompactTextString(m) }
 func (*ContainerRenameRequest) ProtoMessage()               {}
-func (*ContainerRenameRequest) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{89} }
+func (*ContainerRenameRequest) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{97} }
 
 func (m *ContainerRenameRequest) GetOldContainerName() string {
 	if m != nil {
@@ -3648,7 +4316,90 @@ type ContainerRenameResponse struct {
 func (m *ContainerRenameResponse) Reset()                    { *m = ContainerRenameResponse{} }
 func (m *ContainerRenameResponse) String() string            { return proto.CompactTextString(m) }
 func (*ContainerRenameResponse) ProtoMessage()               {}
-func (*ContainerRenameResponse) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{90} }
+func (*ContainerRenameResponse) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{98} }
+
+type ContainerCopyFromRequest struct {
+	Container string `protobuf:"bytes,1,opt,name=container,proto3" json:"container,omitempty"`
+	Path      string `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
+}
+
+func (m *ContainerCopyFromRequest) Reset()                    { *m = ContainerCopyFromRequest{} }
+func (m *ContainerCopyFromRequest) String() string            { return proto.CompactTextString(m) }
+func (*ContainerCopyFromRequest) ProtoMessage()               {}
+func (*ContainerCopyFromRequest) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{99} }
+
+func (m *ContainerCopyFromRequest) GetContainer() string {
+	if m != nil {
+		return m.Container
+	}
+	return ""
+}
+
+func (m *ContainerCopyFromRequest) GetPath() string {
+	if m != nil {
+		return m.Path
+	}
+	return ""
+}
+
+// ContainerCopyData is a chunk of the tar stream of the copied files
+type ContainerCopyData struct {
+	Data []byte `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+}
+
+func (m *ContainerCopyData) Reset()                    { *m = ContainerCopyData{} }
+func (m *ContainerCopyData) String() string            { return proto.CompactTextString(m) }
+func (*ContainerCopyData) ProtoMessage()               {}
+func (*ContainerCopyData) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{100} }
+
+func (m *ContainerCopyData) GetData() []byte {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+// the container and path are only required in the first message of the
+// stream, the following ones carry the tar stream
+type ContainerCopyToRequest struct {
+	Container string `protobuf:"bytes,1,opt,name=container,proto3" json:"container,omitempty"`
+	Path      string `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
+	Data      []byte `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+}
+
+func (m *ContainerCopyToRequest) Reset()                    { *m = ContainerCopyToRequest{} }
+func (m *ContainerCopyToRequest) String() string            { return proto.CompactTextString(m) }
+func (*ContainerCopyToRequest) ProtoMessage()               {}
+func (*ContainerCopyToRequest) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{101} }
+
+func (m *ContainerCopyToRequest) GetContainer() string {
+	if m != nil {
+		return m.Container
+	}
+	return ""
+}
+
+func (m *ContainerCopyToRequest) GetPath() string {
+	if m != nil {
+		return m.Path
+	}
+	return ""
+}
+
+func (m *ContainerCopyToRequest) GetData() []byte {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+type ContainerCopyToResponse struct {
+}
+
+func (m *ContainerCopyToResponse) Reset()                    { *m = ContainerCopyToResponse{} }
+func (m *ContainerCopyToResponse) String() string            { return proto.CompactTextString(m) }
+func (*ContainerCopyToResponse) ProtoMessage()               {}
+func (*ContainerCopyToResponse) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{102} }
 
 type ContainerRemoveRequest struct {
 	ContainerId string `protobuf:"bytes,1,opt,name=container_id,json=containerId,proto3" json:"container_id,omitempty"`
@@ -3657,7 +4408,7 @@ type ContainerRemoveRequest struct {
 func (m *ContainerRemoveRequest) Reset()                    { *m = ContainerRemoveRequest{} }
 func (m *ContainerRemoveRequest) String() string            { return proto.CompactTextString(m) }
 func (*ContainerRemoveRequest) ProtoMessage()               {}
-func (*ContainerRemoveRequest) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{91} }
+func (*ContainerRemoveRequest) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{103} }
 
 func (m *ContainerRemoveRequest) GetContainerId() string {
 	if m != nil {
@@ -3672,7 +4423,7 @@ type ContainerRemoveResponse struct {
 func (m *ContainerRemoveResponse) Reset()                    { *m = ContainerRemoveResponse{} }
 func (m *ContainerRemoveResponse) String() string            { return proto.CompactTextString(m) }
 func (*ContainerRemoveResponse) ProtoMessage()               {}
-func (*ContainerRemoveResponse) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{92} }
+func (*ContainerRemoveResponse) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{104} }
 
 type AuthConfig struct {
 	Username      string `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
@@ -3686,7 +4437,7 @@ type AuthConfig struct {
 func (m *AuthConfig) Reset()                    { *m = AuthConfig{} }
 func (m *AuthConfig) String() string            { return proto.CompactTextString(m) }
 func (*AuthConfig) ProtoMessage()               {}
-func (*AuthConfig) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{93} }
+func (*AuthConfig) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{105} }
 
 func (m *AuthConfig) GetUsername() string {
 	if m != nil {
@@ -3739,7 +4490,7 @@ type ImagePullRequest struct {
 func (m *ImagePullRequest) Reset()                    { *m = ImagePullRequest{} }
 func (m *ImagePullRequest) String() string            { return proto.CompactTextString(m) }
 func (*ImagePullRequest) ProtoMessage()               {}
-func (*ImagePullRequest) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{94} }
+func (*ImagePullRequest) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{106} }
 
 func (m *ImagePullRequest) GetImage() string {
 	if m != nil {
@@ -3769,7 +4520,7 @@ type ImagePullResponse struct {
 func (m *ImagePullResponse) Reset()                    { *m = ImagePullResponse{} }
 func (m *ImagePullResponse) String() string            { return proto.CompactTextString(m) }
 func (*ImagePullResponse) ProtoMessage()               {}
-func (*ImagePullResponse) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{95} }
+func (*ImagePullResponse) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{107} }
 
 func (m *ImagePullResponse) GetData() []byte {
 	if m != nil {
@@ -3787,7 +4538,7 @@ type ImagePushRequest struct {
 func (m *ImagePushRequest) Reset()                    { *m = ImagePushRequest{} }
 func (m *ImagePushRequest) String() string            { return proto.CompactTextString(m) }
 func (*ImagePushRequest) ProtoMessage()               {}
-func (*ImagePushRequest) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{96} }
+func (*ImagePushRequest) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{108} }
 
 func (m *ImagePushRequest) GetRepo() string {
 	if m != nil {
@@ -3817,7 +4568,7 @@ type ImagePushResponse struct {
 func (m *ImagePushResponse) Reset()                    { *m = ImagePushResponse{} }
 func (m *ImagePushResponse) String() string            { return proto.CompactTextString(m) }
 func (*ImagePushResponse) ProtoMessage()               {}
-func (*ImagePushResponse) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{97} }
+func (*ImagePushResponse) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{109} }
 
 func (m *ImagePushResponse) GetData() []byte {
 	if m != nil {
@@ -3835,7 +4586,7 @@ type ImageRemoveRequest struct {
 func (m *ImageRemoveRequest) Reset()                    { *m = ImageRemoveRequest{} }
 func (m *ImageRemoveRequest) String() string            { return proto.CompactTextString(m) }
 func (*ImageRemoveRequest) ProtoMessage()               {}
-func (*ImageRemoveRequest) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{98} }
+func (*ImageRemoveRequest) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{110} }
 
 func (m *ImageRemoveRequest) GetImage() string {
 	if m != nil {
@@ -3866,7 +4617,7 @@ type ImageDelete struct {
 func (m *ImageDelete) Reset()                    { *m = ImageDelete{} }
 func (m *ImageDelete) String() string            { return proto.CompactTextString(m) }
 func (*ImageDelete) ProtoMessage()               {}
-func (*ImageDelete) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{99} }
+func (*ImageDelete) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{111} }
 
 func (m *ImageDelete) GetUntaged() string {
 	if m != nil {
@@ -3889,7 +4640,7 @@ type ImageRemoveResponse struct {
 func (m *ImageRemoveResponse) Reset()                    { *m = ImageRemoveResponse{} }
 func (m *ImageRemoveResponse) String() string            { return proto.CompactTextString(m) }
 func (*ImageRemoveResponse) ProtoMessage()               {}
-func (*ImageRemoveResponse) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{100} }
+func (*ImageRemoveResponse) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{112} }
 
 func (m *ImageRemoveResponse) GetImages() []*ImageDelete {
 	if m != nil {
@@ -3906,7 +4657,7 @@ type ContainerStopRequest struct {
 func (m *ContainerStopRequest) Reset()                    { *m = ContainerStopRequest{} }
 func (m *ContainerStopRequest) String() string            { return proto.CompactTextString(m) }
 func (*ContainerStopRequest) ProtoMessage()               {}
-func (*ContainerStopRequest) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{101} }
+func (*ContainerStopRequest) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{113} }
 
 func (m *ContainerStopRequest) GetContainerID() string {
 	if m != nil {
@@ -3928,7 +4679,7 @@ type ContainerStopResponse struct {
 func (m *ContainerStopResponse) Reset()                    { *m = ContainerStopResponse{} }
 func (m *ContainerStopResponse) String() string            { return proto.CompactTextString(m) }
 func (*ContainerStopResponse) ProtoMessage()               {}
-func (*ContainerStopResponse) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{102} }
+func (*ContainerStopResponse) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{114} }
 
 type VersionRequest struct {
 }
@@ -3936,7 +4687,7 @@ type VersionRequest struct {
 func (m *VersionRequest) Reset()                    { *m = VersionRequest{} }
 func (m *VersionRequest) String() string            { return proto.CompactTextString(m) }
 func (*VersionRequest) ProtoMessage()               {}
-func (*VersionRequest) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{103} }
+func (*VersionRequest) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{115} }
 
 type VersionResponse struct {
 	// Version is the version of hyperd
@@ -3948,7 +4699,7 @@ type VersionResponse struct {
 func (m *VersionResponse) Reset()                    { *m = VersionResponse{} }
 func (m *VersionResponse) String() string            { return proto.CompactTextString(m) }
 func (*VersionResponse) ProtoMessage()               {}
-func (*VersionResponse) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{104} }
+func (*VersionResponse) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{116} }
 
 func (m *VersionResponse) GetVersion() string {
 	if m != nil {
@@ -3971,7 +4722,7 @@ type ServiceListResponse struct {
 func (m *ServiceListResponse) Reset()                    { *m = ServiceListResponse{} }
 func (m *ServiceListResponse) String() string            { return proto.CompactTextString(m) }
 func (*ServiceListResponse) ProtoMessage()               {}
-func (*ServiceListResponse) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{105} }
+func (*ServiceListResponse) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{117} }
 
 func (m *ServiceListResponse) GetServices() []*UserService {
 	if m != nil {
@@ -3987,7 +4738,7 @@ type ServiceListRequest struct {
 func (m *ServiceListRequest) Reset()                    { *m = ServiceListRequest{} }
 func (m *ServiceListRequest) String() string            { return proto.CompactTextString(m) }
 func (*ServiceListRequest) ProtoMessage()               {}
-func (*ServiceListRequest) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{106} }
+func (*ServiceListRequest) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{118} }
 
 func (m *ServiceListRequest) GetPodID() string {
 	if m != nil {
@@ -4004,7 +4755,7 @@ type ServiceAddRequest struct {
 func (m *ServiceAddRequest) Reset()                    { *m = ServiceAddRequest{} }
 func (m *ServiceAddRequest) String() string            { return proto.CompactTextString(m) }
 func (*ServiceAddRequest) ProtoMessage()               {}
-func (*ServiceAddRequest) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{107} }
+func (*ServiceAddRequest) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{119} }
 
 func (m *ServiceAddRequest) GetPodID() string {
 	if m != nil {
@@ -4026,7 +4777,7 @@ type ServiceAddResponse struct {
 func (m *ServiceAddResponse) Reset()                    { *m = ServiceAddResponse{} }
 func (m *ServiceAddResponse) String() string            { return proto.CompactTextString(m) }
 func (*ServiceAddResponse) ProtoMessage()               {}
-func (*ServiceAddResponse) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{108} }
+func (*ServiceAddResponse) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{120} }
 
 type ServiceDelRequest struct {
 	PodID    string         `protobuf:"bytes,1,opt,name=podID,proto3" json:"podID,omitempty"`
@@ -4036,7 +4787,7 @@ type ServiceDelRequest struct {
 func (m *ServiceDelRequest) Reset()                    { *m = ServiceDelRequest{} }
 func (m *ServiceDelRequest) String() string            { return proto.CompactTextString(m) }
 func (*ServiceDelRequest) ProtoMessage()               {}
-func (*ServiceDelRequest) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{109} }
+func (*ServiceDelRequest) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{121} }
 
 func (m *ServiceDelRequest) GetPodID() string {
 	if m != nil {
@@ -4058,7 +4809,7 @@ type ServiceDelResponse struct {
 func (m *ServiceDelResponse) Reset()                    { *m = ServiceDelResponse{} }
 func (m *ServiceDelResponse) String() string            { return proto.CompactTextString(m) }
 func (*ServiceDelResponse) ProtoMessage()               {}
-func (*ServiceDelResponse) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{110} }
+func (*ServiceDelResponse) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{122} }
 
 type ServiceUpdateRequest struct {
 	PodID    string         `protobuf:"bytes,1,opt,name=podID,proto3" json:"podID,omitempty"`
@@ -4068,7 +4819,7 @@ type ServiceUpdateRequest struct {
 func (m *ServiceUpdateRequest) Reset()                    { *m = ServiceUpdateRequest{} }
 func (m *ServiceUpdateRequest) String() string            { return proto.CompactTextString(m) }
 func (*ServiceUpdateRequest) ProtoMessage()               {}
-func (*ServiceUpdateRequest) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{111} }
+func (*ServiceUpdateRequest) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{123} }
 
 func (m *ServiceUpdateRequest) GetPodID() string {
 	if m != nil {
@@ -4090,7 +4841,7 @@ type ServiceUpdateResponse struct {
 func (m *ServiceUpdateResponse) Reset()                    { *m = ServiceUpdateResponse{} }
 func (m *ServiceUpdateResponse) String() string            { return proto.CompactTextString(m) }
 func (*ServiceUpdateResponse) ProtoMessage()               {}
-func (*ServiceUpdateResponse) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{112} }
+func (*ServiceUpdateResponse) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{124} }
 
 type PortMappingListRequest struct {
 	PodID string `protobuf:"bytes,1,opt,name=podID,proto3" json:"podID,omitempty"`
@@ -4099,7 +4850,7 @@ type PortMappingListRequest struct {
 func (m *PortMappingListRequest) Reset()                    { *m = PortMappingListRequest{} }
 func (m *PortMappingListRequest) String() string            { return proto.CompactTextString(m) }
 func (*PortMappingListRequest) ProtoMessage()               {}
-func (*PortMappingListRequest) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{113} }
+func (*PortMappingListRequest) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{125} }
 
 func (m *PortMappingListRequest) GetPodID() string {
 	if m != nil {
@@ -4115,7 +4866,7 @@ type PortMappingListResponse struct {
 func (m *PortMappingListResponse) Reset()                    { *m = PortMappingListResponse{} }
 func (m *PortMappingListResponse) String() string            { return proto.CompactTextString(m) }
 func (*PortMappingListResponse) ProtoMessage()               {}
-func (*PortMappingListResponse) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{114} }
+func (*PortMappingListResponse) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{126} }
 
 func (m *PortMappingListResponse) GetPortMappings() []*PortMapping {
 	if m != nil {
@@ -4132,7 +4883,7 @@ type PortMappingModifyRequest struct {
 func (m *PortMappingModifyRequest) Reset()                    { *m = PortMappingModifyRequest{} }
 func (m *PortMappingModifyRequest) String() string            { return proto.CompactTextString(m) }
 func (*PortMappingModifyRequest) ProtoMessage()               {}
-func (*PortMappingModifyRequest) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{115} }
+func (*PortMappingModifyRequest) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{127} }
 
 func (m *PortMappingModifyRequest) GetPodID() string {
 	if m != nil {
@@ -4151,10 +4902,122 @@ func (m *PortMappingModifyRequest) GetPortMappings() []*PortMapping {
 type PortMappingModifyResponse struct {
 }
 
-func (m *PortMappingModifyResponse) Reset()                    { *m = PortMappingModifyResponse{} }
-func (m *PortMappingModifyResponse) String() string            { return proto.CompactTextString(m) }
-func (*PortMappingModifyResponse) ProtoMessage()               {}
-func (*PortMappingModifyResponse) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{116} }
+func (m *PortMappingModifyResponse) Reset()         { *m = PortMappingModifyResponse{} }
+func (m *PortMappingModifyResponse) String() string { return proto.CompactTextString(m) }
+func (*PortMappingModifyResponse) ProtoMessage()    {}
+func (*PortMappingModifyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptorTypes, []int{128}
+}
+
+type PodInterfaceListRequest struct {
+	PodID string `protobuf:"bytes,1,opt,name=podID,proto3" json:"podID,omitempty"`
+}
+
+func (m *PodInterfaceListRequest) Reset()                    { *m = PodInterfaceListRequest{} }
+func (m *PodInterfaceListRequest) String() string            { return proto.CompactTextString(m) }
+func (*PodInterfaceListRequest) ProtoMessage()               {}
+func (*PodInterfaceListRequest) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{129} }
+
+func (m *PodInterfaceListRequest) GetPodID() string {
+	if m != nil {
+		return m.PodID
+	}
+	return ""
+}
+
+type PodInterfaceListResponse struct {
+	Interfaces []*UserInterface `protobuf:"bytes,1,rep,name=interfaces" json:"interfaces,omitempty"`
+}
+
+func (m *PodInterfaceListResponse) Reset()                    { *m = PodInterfaceListResponse{} }
+func (m *PodInterfaceListResponse) String() string            { return proto.CompactTextString(m) }
+func (*PodInterfaceListResponse) ProtoMessage()               {}
+func (*PodInterfaceListResponse) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{130} }
+
+func (m *PodInterfaceListResponse) GetInterfaces() []*UserInterface {
+	if m != nil {
+		return m.Interfaces
+	}
+	return nil
+}
+
+type PodInterfaceAddRequest struct {
+	PodID string `protobuf:"bytes,1,opt,name=podID,proto3" json:"podID,omitempty"`
+	// the ip is allocated from the default bridge if both ip and bridge are
+	// empty, and the ifname is generated if empty
+	Interface *UserInterface `protobuf:"bytes,2,opt,name=interface" json:"interface,omitempty"`
+}
+
+func (m *PodInterfaceAddRequest) Reset()                    { *m = PodInterfaceAddRequest{} }
+func (m *PodInterfaceAddRequest) String() string            { return proto.CompactTextString(m) }
+func (*PodInterfaceAddRequest) ProtoMessage()               {}
+func (*PodInterfaceAddRequest) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{131} }
+
+func (m *PodInterfaceAddRequest) GetPodID() string {
+	if m != nil {
+		return m.PodID
+	}
+	return ""
+}
+
+func (m *PodInterfaceAddRequest) GetInterface() *UserInterface {
+	if m != nil {
+		return m.Interface
+	}
+	return nil
+}
+
+type PodInterfaceAddResponse struct {
+	Interface *UserInterface `protobuf:"bytes,1,opt,name=interface" json:"interface,omitempty"`
+}
+
+func (m *PodInterfaceAddResponse) Reset()                    { *m = PodInterfaceAddResponse{} }
+func (m *PodInterfaceAddResponse) String() string            { return proto.CompactTextString(m) }
+func (*PodInterfaceAddResponse) ProtoMessage()               {}
+func (*PodInterfaceAddResponse) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{132} }
+
+func (m *PodInterfaceAddResponse) GetInterface() *UserInterface {
+	if m != nil {
+		return m.Interface
+	}
+	return nil
+}
+
+type PodInterfaceRemoveRequest struct {
+	PodID  string `protobuf:"bytes,1,opt,name=podID,proto3" json:"podID,omitempty"`
+	Ifname string `protobuf:"bytes,2,opt,name=ifname,proto3" json:"ifname,omitempty"`
+}
+
+func (m *PodInterfaceRemoveRequest) Reset()         { *m = PodInterfaceRemoveRequest{} }
+func (m *PodInterfaceRemoveRequest) String() string { return proto.CompactTextString(m) }
+func (*PodInterfaceRemoveRequest) ProtoMessage()    {}
+func (*PodInterfaceRemoveRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptorTypes, []int{133}
+}
+
+func (m *PodInterfaceRemoveRequest) GetPodID() string {
+	if m != nil {
+		return m.PodID
+	}
+	return ""
+}
+
+func (m *PodInterfaceRemoveRequest) GetIfname() string {
+	if m != nil {
+		return m.Ifname
+	}
+	return ""
+}
+
+type PodInterfaceRemoveResponse struct {
+}
+
+func (m *PodInterfaceRemoveResponse) Reset()         { *m = PodInterfaceRemoveResponse{} }
+func (m *PodInterfaceRemoveResponse) String() string { return proto.CompactTextString(m) }
+func (*PodInterfaceRemoveResponse) ProtoMessage()    {}
+func (*PodInterfaceRemoveResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptorTypes, []int{134}
+}
 
 type PodStopRequest struct {
 	PodID string `protobuf:"bytes,1,opt,name=podID,proto3" json:"podID,omitempty"`
@@ -4163,7 +5026,7 @@ type PodStopRequest struct {
 func (m *PodStopRequest) Reset()                    { *m = PodStopRequest{} }
 func (m *PodStopRequest) String() string            { return proto.CompactTextString(m) }
 func (*PodStopRequest) ProtoMessage()               {}
-func (*PodStopRequest) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{117} }
+func (*PodStopRequest) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{135} }
 
 func (m *PodStopRequest) GetPodID() string {
 	if m != nil {
@@ -4180,7 +5043,7 @@ type PodStopResponse struct {
 func (m *PodStopResponse) Reset()                    { *m = PodStopResponse{} }
 func (m *PodStopResponse) String() string            { return proto.CompactTextString(m) }
 func (*PodStopResponse) ProtoMessage()               {}
-func (*PodStopResponse) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{118} }
+func (*PodStopResponse) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{136} }
 
 func (m *PodStopResponse) GetCode() int32 {
 	if m != nil {
@@ -4204,7 +5067,7 @@ type PodSignalRequest struct {
 func (m *PodSignalRequest) Reset()                    { *m = PodSignalRequest{} }
 func (m *PodSignalRequest) String() string            { return proto.CompactTextString(m) }
 func (*PodSignalRequest) ProtoMessage()               {}
-func (*PodSignalRequest) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{119} }
+func (*PodSignalRequest) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{137} }
 
 func (m *PodSignalRequest) GetPodID() string {
 	if m != nil {
@@ -4226,7 +5089,7 @@ type PodSignalResponse struct {
 func (m *PodSignalResponse) Reset()                    { *m = PodSignalResponse{} }
 func (m *PodSignalResponse) String() string            { return proto.CompactTextString(m) }
 func (*PodSignalResponse) ProtoMessage()               {}
-func (*PodSignalResponse) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{120} }
+func (*PodSignalResponse) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{138} }
 
 type PodPauseRequest struct {
 	PodID string `protobuf:"bytes,1,opt,name=podID,proto3" json:"podID,omitempty"`
@@ -4235,7 +5098,7 @@ type PodPauseRequest struct {
 func (m *PodPauseRequest) Reset()                    { *m = PodPauseRequest{} }
 func (m *PodPauseRequest) String() string            { return proto.CompactTextString(m) }
 func (*PodPauseRequest) ProtoMessage()               {}
-func (*PodPauseRequest) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{121} }
+func (*PodPauseRequest) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{139} }
 
 func (m *PodPauseRequest) GetPodID() string {
 	if m != nil {
@@ -4250,7 +5113,7 @@ type PodPauseResponse struct {
 func (m *PodPauseResponse) Reset()                    { *m = PodPauseResponse{} }
 func (m *PodPauseResponse) String() string            { return proto.CompactTextString(m) }
 func (*PodPauseResponse) ProtoMessage()               {}
-func (*PodPauseResponse) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{122} }
+func (*PodPauseResponse) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{140} }
 
 type PodUnpauseRequest struct {
 	PodID string `protobuf:"bytes,1,opt,name=podID,proto3" json:"podID,omitempty"`
@@ -4259,7 +5122,7 @@ type PodUnpauseRequest struct {
 func (m *PodUnpauseRequest) Reset()                    { *m = PodUnpauseRequest{} }
 func (m *PodUnpauseRequest) String() string            { return proto.CompactTextString(m) }
 func (*PodUnpauseRequest) ProtoMessage()               {}
-func (*PodUnpauseRequest) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{123} }
+func (*PodUnpauseRequest) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{141} }
 
 func (m *PodUnpauseRequest) GetPodID() string {
 	if m != nil {
@@ -4274,7 +5137,43 @@ type PodUnpauseResponse struct {
 func (m *PodUnpauseResponse) Reset()                    { *m = PodUnpauseResponse{} }
 func (m *PodUnpauseResponse) String() string            { return proto.CompactTextString(m) }
 func (*PodUnpauseResponse) ProtoMessage()               {}
-func (*PodUnpauseResponse) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{124} }
+func (*PodUnpauseResponse) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{142} }
+
+type PodUpdateResourcesRequest struct {
+	PodID    string        `protobuf:"bytes,1,opt,name=podID,proto3" json:"podID,omitempty"`
+	Resource *UserResource `protobuf:"bytes,2,opt,name=resource" json:"resource,omitempty"`
+}
+
+func (m *PodUpdateResourcesRequest) Reset()         { *m = PodUpdateResourcesRequest{} }
+func (m *PodUpdateResourcesRequest) String() string { return proto.CompactTextString(m) }
+func (*PodUpdateResourcesRequest) ProtoMessage()    {}
+func (*PodUpdateResourcesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptorTypes, []int{143}
+}
+
+func (m *PodUpdateResourcesRequest) GetPodID() string {
+	if m != nil {
+		return m.PodID
+	}
+	return ""
+}
+
+func (m *PodUpdateResourcesRequest) GetResource() *UserResource {
+	if m != nil {
+		return m.Resource
+	}
+	return nil
+}
+
+type PodUpdateResourcesResponse struct {
+}
+
+func (m *PodUpdateResourcesResponse) Reset()         { *m = PodUpdateResourcesResponse{} }
+func (m *PodUpdateResourcesResponse) String() string { return proto.CompactTextString(m) }
+func (*PodUpdateResourcesResponse) ProtoMessage()    {}
+func (*PodUpdateResourcesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptorTypes, []int{144}
+}
 
 type PodLabelsRequest struct {
 	PodID    string            `protobuf:"bytes,1,opt,name=podID,proto3" json:"podID,omitempty"`
@@ -4285,7 +5184,7 @@ type PodLabelsRequest struct {
 func (m *PodLabelsRequest) Reset()                    { *m = PodLabelsRequest{} }
 func (m *PodLabelsRequest) String() string            { return proto.CompactTextString(m) }
 func (*PodLabelsRequest) ProtoMessage()               {}
-func (*PodLabelsRequest) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{125} }
+func (*PodLabelsRequest) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{145} }
 
 func (m *PodLabelsRequest) GetPodID() string {
 	if m != nil {
@@ -4314,7 +5213,7 @@ type PodLabelsResponse struct {
 func (m *PodLabelsResponse) Reset()                    { *m = PodLabelsResponse{} }
 func (m *PodLabelsResponse) String() string            { return proto.CompactTextString(m) }
 func (*PodLabelsResponse) ProtoMessage()               {}
-func (*PodLabelsResponse) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{126} }
+func (*PodLabelsResponse) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{146} }
 
 type PodStatsRequest struct {
 	PodID string `protobuf:"bytes,1,opt,name=podID,proto3" json:"podID,omitempty"`
@@ -4323,7 +5222,7 @@ type PodStatsRequest struct {
 func (m *PodStatsRequest) Reset()                    { *m = PodStatsRequest{} }
 func (m *PodStatsRequest) String() string            { return proto.CompactTextString(m) }
 func (*PodStatsRequest) ProtoMessage()               {}
-func (*PodStatsRequest) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{127} }
+func (*PodStatsRequest) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{147} }
 
 func (m *PodStatsRequest) GetPodID() string {
 	if m != nil {
@@ -4339,7 +5238,7 @@ type PodStatsResponse struct {
 func (m *PodStatsResponse) Reset()                    { *m = PodStatsResponse{} }
 func (m *PodStatsResponse) String() string            { return proto.CompactTextString(m) }
 func (*PodStatsResponse) ProtoMessage()               {}
-func (*PodStatsResponse) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{128} }
+func (*PodStatsResponse) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{148} }
 
 func (m *PodStatsResponse) GetPodStats() *PodStats {
 	if m != nil {
@@ -4348,126 +5247,1331 @@ func (m *PodStatsResponse) GetPodStats() *PodStats {
 	return nil
 }
 
-type PingRequest struct {
+type PodStatsStreamRequest struct {
+	// the pods to watch, at least one is required
+	PodIDs []string `protobuf:"bytes,1,rep,name=podIDs" json:"podIDs,omitempty"`
+	// the interval between the samples in seconds, defaults to 1
+	Interval int32 `protobuf:"varint,2,opt,name=interval,proto3" json:"interval,omitempty"`
 }
 
-func (m *PingRequest) Reset()                    { *m = PingRequest{} }
-func (m *PingRequest) String() string            { return proto.CompactTextString(m) }
-func (*PingRequest) ProtoMessage()               {}
-func (*PingRequest) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{129} }
+func (m *PodStatsStreamRequest) Reset()                    { *m = PodStatsStreamRequest{} }
+func (m *PodStatsStreamRequest) String() string            { return proto.CompactTextString(m) }
+func (*PodStatsStreamRequest) ProtoMessage()               {}
+func (*PodStatsStreamRequest) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{149} }
 
-type PingResponse struct {
-	HyperdStats string `protobuf:"bytes,1,opt,name=hyperdStats,proto3" json:"hyperdStats,omitempty"`
+func (m *PodStatsStreamRequest) GetPodIDs() []string {
+	if m != nil {
+		return m.PodIDs
+	}
+	return nil
 }
 
-func (m *PingResponse) Reset()                    { *m = PingResponse{} }
-func (m *PingResponse) String() string            { return proto.CompactTextString(m) }
-func (*PingResponse) ProtoMessage()               {}
-func (*PingResponse) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{130} }
+func (m *PodStatsStreamRequest) GetInterval() int32 {
+	if m != nil {
+		return m.Interval
+	}
+	return 0
+}
 
-func (m *PingResponse) GetHyperdStats() string {
+// StatsRates are the usage computed from two successive samples
+type StatsRates struct {
+	// the cpu usage relative to one cpu, i.e. 200 means two cpus are busy
+	CpuPercent  float64 `protobuf:"fixed64,1,opt,name=cpuPercent,proto3" json:"cpuPercent,omitempty"`
+	MemoryUsage uint64  `protobuf:"varint,2,opt,name=memoryUsage,proto3" json:"memoryUsage,omitempty"`
+	// the memory limit in bytes, 0 if unknown
+	MemoryLimit              uint64  `protobuf:"varint,3,opt,name=memoryLimit,proto3" json:"memoryLimit,omitempty"`
+	MemoryPercent            float64 `protobuf:"fixed64,4,opt,name=memoryPercent,proto3" json:"memoryPercent,omitempty"`
+	BlockReadBytesPerSecond  float64 `protobuf:"fixed64,5,opt,name=blockReadBytesPerSecond,proto3" json:"blockReadBytesPerSecond,omitempty"`
+	BlockWriteBytesPerSecond float64 `protobuf:"fixed64,6,opt,name=blockWriteBytesPerSecond,proto3" json:"blockWriteBytesPerSecond,omitempty"`
+	NetworkRxBytesPerSecond  float64 `protobuf:"fixed64,7,opt,name=networkRxBytesPerSecond,proto3" json:"networkRxBytesPerSecond,omitempty"`
+	NetworkTxBytesPerSecond  float64 `protobuf:"fixed64,8,opt,name=networkTxBytesPerSecond,proto3" json:"networkTxBytesPerSecond,omitempty"`
+}
+
+func (m *StatsRates) Reset()                    { *m = StatsRates{} }
+func (m *StatsRates) String() string            { return proto.CompactTextString(m) }
+func (*StatsRates) ProtoMessage()               {}
+func (*StatsRates) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{150} }
+
+func (m *StatsRates) GetCpuPercent() float64 {
 	if m != nil {
-		return m.HyperdStats
+		return m.CpuPercent
 	}
-	return ""
+	return 0
 }
 
-type ContainerSignalRequest struct {
-	PodID       string `protobuf:"bytes,1,opt,name=podID,proto3" json:"podID,omitempty"`
-	ContainerID string `protobuf:"bytes,2,opt,name=containerID,proto3" json:"containerID,omitempty"`
-	Signal      int64  `protobuf:"varint,3,opt,name=signal,proto3" json:"signal,omitempty"`
+func (m *StatsRates) GetMemoryUsage() uint64 {
+	if m != nil {
+		return m.MemoryUsage
+	}
+	return 0
 }
 
-func (m *ContainerSignalRequest) Reset()                    { *m = ContainerSignalRequest{} }
-func (m *ContainerSignalRequest) String() string            { return proto.CompactTextString(m) }
-func (*ContainerSignalRequest) ProtoMessage()               {}
-func (*ContainerSignalRequest) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{131} }
+func (m *StatsRates) GetMemoryLimit() uint64 {
+	if m != nil {
+		return m.MemoryLimit
+	}
+	return 0
+}
 
-func (m *ContainerSignalRequest) GetPodID() string {
+func (m *StatsRates) GetMemoryPercent() float64 {
 	if m != nil {
-		return m.PodID
+		return m.MemoryPercent
 	}
-	return ""
+	return 0
 }
 
-func (m *ContainerSignalRequest) GetContainerID() string {
+func (m *StatsRates) GetBlockReadBytesPerSecond() float64 {
 	if m != nil {
-		return m.ContainerID
+		return m.BlockReadBytesPerSecond
 	}
-	return ""
+	return 0
 }
 
-func (m *ContainerSignalRequest) GetSignal() int64 {
+func (m *StatsRates) GetBlockWriteBytesPerSecond() float64 {
 	if m != nil {
-		return m.Signal
+		return m.BlockWriteBytesPerSecond
 	}
 	return 0
 }
 
-type ContainerSignalResponse struct {
+func (m *StatsRates) GetNetworkRxBytesPerSecond() float64 {
+	if m != nil {
+		return m.NetworkRxBytesPerSecond
+	}
+	return 0
 }
 
-func (m *ContainerSignalResponse) Reset()                    { *m = ContainerSignalResponse{} }
-func (m *ContainerSignalResponse) String() string            { return proto.CompactTextString(m) }
-func (*ContainerSignalResponse) ProtoMessage()               {}
-func (*ContainerSignalResponse) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{132} }
+func (m *StatsRates) GetNetworkTxBytesPerSecond() float64 {
+	if m != nil {
+		return m.NetworkTxBytesPerSecond
+	}
+	return 0
+}
 
-type TTYResizeRequest struct {
-	ContainerID string `protobuf:"bytes,1,opt,name=containerID,proto3" json:"containerID,omitempty"`
-	ExecID      string `protobuf:"bytes,2,opt,name=execID,proto3" json:"execID,omitempty"`
-	Width       int32  `protobuf:"varint,3,opt,name=width,proto3" json:"width,omitempty"`
-	Height      int32  `protobuf:"varint,4,opt,name=height,proto3" json:"height,omitempty"`
+type ContainerStatsRates struct {
+	ContainerID   string      `protobuf:"bytes,1,opt,name=containerID,proto3" json:"containerID,omitempty"`
+	ContainerName string      `protobuf:"bytes,2,opt,name=containerName,proto3" json:"containerName,omitempty"`
+	Rates         *StatsRates `protobuf:"bytes,3,opt,name=rates" json:"rates,omitempty"`
 }
 
-func (m *TTYResizeRequest) Reset()                    { *m = TTYResizeRequest{} }
-func (m *TTYResizeRequest) String() string            { return proto.CompactTextString(m) }
-func (*TTYResizeRequest) ProtoMessage()               {}
-func (*TTYResizeRequest) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{133} }
+func (m *ContainerStatsRates) Reset()                    { *m = ContainerStatsRates{} }
+func (m *ContainerStatsRates) String() string            { return proto.CompactTextString(m) }
+func (*ContainerStatsRates) ProtoMessage()               {}
+func (*ContainerStatsRates) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{151} }
 
-func (m *TTYResizeRequest) GetContainerID() string {
+func (m *ContainerStatsRates) GetContainerID() string {
 	if m != nil {
 		return m.ContainerID
 	}
 	return ""
 }
 
-func (m *TTYResizeRequest) GetExecID() string {
+func (m *ContainerStatsRates) GetContainerName() string {
 	if m != nil {
-		return m.ExecID
+		return m.ContainerName
 	}
 	return ""
 }
 
-func (m *TTYResizeRequest) GetWidth() int32 {
+func (m *ContainerStatsRates) GetRates() *StatsRates {
 	if m != nil {
-		return m.Width
+		return m.Rates
 	}
-	return 0
+	return nil
 }
 
-func (m *TTYResizeRequest) GetHeight() int32 {
+type PodStatsStreamResponse struct {
+	PodID     string `protobuf:"bytes,1,opt,name=podID,proto3" json:"podID,omitempty"`
+	Timestamp int64  `protobuf:"varint,2,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	// the cumulative counters of the sample
+	PodStats       *PodStats              `protobuf:"bytes,3,opt,name=podStats" json:"podStats,omitempty"`
+	Rates          *StatsRates            `protobuf:"bytes,4,opt,name=rates" json:"rates,omitempty"`
+	ContainerRates []*ContainerStatsRates `protobuf:"bytes,5,rep,name=containerRates" json:"containerRates,omitempty"`
+	// set if the pod could not be sampled, i.e. it is not running. the pod
+	// is dropped from the stream if it has been removed.
+	Error string `protobuf:"bytes,6,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (m *PodStatsStreamResponse) Reset()                    { *m = PodStatsStreamResponse{} }
+func (m *PodStatsStreamResponse) String() string            { return proto.CompactTextString(m) }
+func (*PodStatsStreamResponse) ProtoMessage()               {}
+func (*PodStatsStreamResponse) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{152} }
+
+func (m *PodStatsStreamResponse) GetPodID() string {
 	if m != nil {
-		return m.Height
+		return m.PodID
+	}
+	return ""
+}
+
+func (m *PodStatsStreamResponse) GetTimestamp() int64 {
+	if m != nil {
+		return m.Timestamp
 	}
 	return 0
 }
 
-type TTYResizeResponse struct {
+func (m *PodStatsStreamResponse) GetPodStats() *PodStats {
+	if m != nil {
+		return m.PodStats
+	}
+	return nil
 }
 
-func (m *TTYResizeResponse) Reset()                    { *m = TTYResizeResponse{} }
-func (m *TTYResizeResponse) String() string            { return proto.CompactTextString(m) }
-func (*TTYResizeResponse) ProtoMessage()               {}
-func (*TTYResizeResponse) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{134} }
+func (m *PodStatsStreamResponse) GetRates() *StatsRates {
+	if m != nil {
+		return m.Rates
+	}
+	return nil
+}
 
-func init() {
-	proto.RegisterType((*ContainerPort)(nil), "types.ContainerPort")
-	proto.RegisterType((*EnvironmentVar)(nil), "types.EnvironmentVar")
-	proto.RegisterType((*VolumeMount)(nil), "types.VolumeMount")
-	proto.RegisterType((*WaitingStatus)(nil), "types.WaitingStatus")
-	proto.RegisterType((*RunningStatus)(nil), "types.RunningStatus")
+func (m *PodStatsStreamResponse) GetContainerRates() []*ContainerStatsRates {
+	if m != nil {
+		return m.ContainerRates
+	}
+	return nil
+}
+
+func (m *PodStatsStreamResponse) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+type PingRequest struct {
+}
+
+func (m *PingRequest) Reset()                    { *m = PingRequest{} }
+func (m *PingRequest) String() string            { return proto.CompactTextString(m) }
+func (*PingRequest) ProtoMessage()               {}
+func (*PingRequest) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{153} }
+
+type PingResponse struct {
+	HyperdStats string `protobuf:"bytes,1,opt,name=hyperdStats,proto3" json:"hyperdStats,omitempty"`
+}
+
+func (m *PingResponse) Reset()                    { *m = PingResponse{} }
+func (m *PingResponse) String() string            { return proto.CompactTextString(m) }
+func (*PingResponse) ProtoMessage()               {}
+func (*PingResponse) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{154} }
+
+func (m *PingResponse) GetHyperdStats() string {
+	if m != nil {
+		return m.HyperdStats
+	}
+	return ""
+}
+
+type ContainerSignalRequest struct {
+	PodID       string `protobuf:"bytes,1,opt,name=podID,proto3" json:"podID,omitempty"`
+	ContainerID string `protobuf:"bytes,2,opt,name=containerID,proto3" json:"containerID,omitempty"`
+	Signal      int64  `protobuf:"varint,3,opt,name=signal,proto3" json:"signal,omitempty"`
+}
+
+func (m *ContainerSignalRequest) Reset()                    { *m = ContainerSignalRequest{} }
+func (m *ContainerSignalRequest) String() string            { return proto.CompactTextString(m) }
+func (*ContainerSignalRequest) ProtoMessage()               {}
+func (*ContainerSignalRequest) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{155} }
+
+func (m *ContainerSignalRequest) GetPodID() string {
+	if m != nil {
+		return m.PodID
+	}
+	return ""
+}
+
+func (m *ContainerSignalRequest) GetContainerID() string {
+	if m != nil {
+		return m.ContainerID
+	}
+	return ""
+}
+
+func (m *ContainerSignalRequest) GetSignal() int64 {
+	if m != nil {
+		return m.Signal
+	}
+	return 0
+}
+
+type ContainerSignalResponse struct {
+}
+
+func (m *ContainerSignalResponse) Reset()                    { *m = ContainerSignalResponse{} }
+func (m *ContainerSignalResponse) String() string            { return proto.CompactTextString(m) }
+func (*ContainerSignalResponse) ProtoMessage()               {}
+func (*ContainerSignalResponse) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{156} }
+
+type TTYResizeRequest struct {
+	ContainerID string `protobuf:"bytes,1,opt,name=containerID,proto3" json:"containerID,omitempty"`
+	ExecID      string `protobuf:"bytes,2,opt,name=execID,proto3" json:"execID,omitempty"`
+	Width       int32  `protobuf:"varint,3,opt,name=width,proto3" json:"width,omitempty"`
+	Height      int32  `protobuf:"varint,4,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (m *TTYResizeRequest) Reset()                    { *m = TTYResizeRequest{} }
+func (m *TTYResizeRequest) String() string            { return proto.CompactTextString(m) }
+func (*TTYResizeRequest) ProtoMessage()               {}
+func (*TTYResizeRequest) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{157} }
+
+func (m *TTYResizeRequest) GetContainerID() string {
+	if m != nil {
+		return m.ContainerID
+	}
+	return ""
+}
+
+func (m *TTYResizeRequest) GetExecID() string {
+	if m != nil {
+		return m.ExecID
+	}
+	return ""
+}
+
+func (m *TTYResizeRequest) GetWidth() int32 {
+	if m != nil {
+		return m.Width
+	}
+	return 0
+}
+
+func (m *TTYResizeRequest) GetHeight() int32 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+type TTYResizeResponse struct {
+}
+
+func (m *TTYResizeResponse) Reset()                    { *m = TTYResizeResponse{} }
+func (m *TTYResizeResponse) String() string            { return proto.CompactTextString(m) }
+func (*TTYResizeResponse) ProtoMessage()               {}
+func (*TTYResizeResponse) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{158} }
+
+type Event struct {
+	// type of the event, e.g. pod.start, container.exit
+	Type        string `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	PodID       string `protobuf:"bytes,2,opt,name=podID,proto3" json:"podID,omitempty"`
+	ContainerID string `protobuf:"bytes,3,opt,name=containerID,proto3" json:"containerID,omitempty"`
+	ExecID      string `protobuf:"bytes,4,opt,name=execID,proto3" json:"execID,omitempty"`
+	ExitCode    int32  `protobuf:"varint,5,opt,name=exitCode,proto3" json:"exitCode,omitempty"`
+	// timestamp of the event in unix nano seconds
+	Timestamp  int64             `protobuf:"varint,6,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	Attributes map[string]string `protobuf:"bytes,7,rep,name=attributes" json:"attributes,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (m *Event) Reset()                    { *m = Event{} }
+func (m *Event) String() string            { return proto.CompactTextString(m) }
+func (*Event) ProtoMessage()               {}
+func (*Event) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{159} }
+
+func (m *Event) GetType() string {
+	if m != nil {
+		return m.Type
+	}
+	return ""
+}
+
+func (m *Event) GetPodID() string {
+	if m != nil {
+		return m.PodID
+	}
+	return ""
+}
+
+func (m *Event) GetContainerID() string {
+	if m != nil {
+		return m.ContainerID
+	}
+	return ""
+}
+
+func (m *Event) GetExecID() string {
+	if m != nil {
+		return m.ExecID
+	}
+	return ""
+}
+
+func (m *Event) GetExitCode() int32 {
+	if m != nil {
+		return m.ExitCode
+	}
+	return 0
+}
+
+func (m *Event) GetTimestamp() int64 {
+	if m != nil {
+		return m.Timestamp
+	}
+	return 0
+}
+
+func (m *Event) GetAttributes() map[string]string {
+	if m != nil {
+		return m.Attributes
+	}
+	return nil
+}
+
+type EventsRequest struct {
+	PodIDs       []string `protobuf:"bytes,1,rep,name=podIDs" json:"podIDs,omitempty"`
+	ContainerIDs []string `protobuf:"bytes,2,rep,name=containerIDs" json:"containerIDs,omitempty"`
+	// event types to watch, a type could be a prefix such as "pod" or "container"
+	Types []string `protobuf:"bytes,3,rep,name=types" json:"types,omitempty"`
+	// replay the buffered events happened after since (unix nano seconds)
+	Since int64 `protobuf:"varint,4,opt,name=since,proto3" json:"since,omitempty"`
+}
+
+func (m *EventsRequest) Reset()                    { *m = EventsRequest{} }
+func (m *EventsRequest) String() string            { return proto.CompactTextString(m) }
+func (*EventsRequest) ProtoMessage()               {}
+func (*EventsRequest) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{160} }
+
+func (m *EventsRequest) GetPodIDs() []string {
+	if m != nil {
+		return m.PodIDs
+	}
+	return nil
+}
+
+func (m *EventsRequest) GetContainerIDs() []string {
+	if m != nil {
+		return m.ContainerIDs
+	}
+	return nil
+}
+
+func (m *EventsRequest) GetTypes() []string {
+	if m != nil {
+		return m.Types
+	}
+	return nil
+}
+
+func (m *EventsRequest) GetSince() int64 {
+	if m != nil {
+		return m.Since
+	}
+	return 0
+}
+
+// VolumeInfo describes a named volume, which is created by the storage driver
+// and could be referenced by the pods with the format "named".
+type VolumeInfo struct {
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// the storage driver created the volume
+	Driver string `protobuf:"bytes,2,opt,name=driver,proto3" json:"driver,omitempty"`
+	Source string `protobuf:"bytes,3,opt,name=source,proto3" json:"source,omitempty"`
+	Format string `protobuf:"bytes,4,opt,name=format,proto3" json:"format,omitempty"`
+	Fstype string `protobuf:"bytes,5,opt,name=fstype,proto3" json:"fstype,omitempty"`
+	// size in bytes, 0 means the default size of the driver
+	SizeBytes int64             `protobuf:"varint,6,opt,name=sizeBytes,proto3" json:"sizeBytes,omitempty"`
+	Labels    map[string]string `protobuf:"bytes,7,rep,name=labels" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	CreatedAt int64             `protobuf:"varint,8,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
+	// the pods referencing the volume
+	Pods []string `protobuf:"bytes,9,rep,name=pods" json:"pods,omitempty"`
+}
+
+func (m *VolumeInfo) Reset()                    { *m = VolumeInfo{} }
+func (m *VolumeInfo) String() string            { return proto.CompactTextString(m) }
+func (*VolumeInfo) ProtoMessage()               {}
+func (*VolumeInfo) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{161} }
+
+func (m *VolumeInfo) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *VolumeInfo) GetDriver() string {
+	if m != nil {
+		return m.Driver
+	}
+	return ""
+}
+
+func (m *VolumeInfo) GetSource() string {
+	if m != nil {
+		return m.Source
+	}
+	return ""
+}
+
+func (m *VolumeInfo) GetFormat() string {
+	if m != nil {
+		return m.Format
+	}
+	return ""
+}
+
+func (m *VolumeInfo) GetFstype() string {
+	if m != nil {
+		return m.Fstype
+	}
+	return ""
+}
+
+func (m *VolumeInfo) GetSizeBytes() int64 {
+	if m != nil {
+		return m.SizeBytes
+	}
+	return 0
+}
+
+func (m *VolumeInfo) GetLabels() map[string]string {
+	if m != nil {
+		return m.Labels
+	}
+	return nil
+}
+
+func (m *VolumeInfo) GetCreatedAt() int64 {
+	if m != nil {
+		return m.CreatedAt
+	}
+	return 0
+}
+
+func (m *VolumeInfo) GetPods() []string {
+	if m != nil {
+		return m.Pods
+	}
+	return nil
+}
+
+type VolumeCreateRequest struct {
+	Name      string            `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	SizeBytes int64             `protobuf:"varint,2,opt,name=sizeBytes,proto3" json:"sizeBytes,omitempty"`
+	Fstype    string            `protobuf:"bytes,3,opt,name=fstype,proto3" json:"fstype,omitempty"`
+	Labels    map[string]string `protobuf:"bytes,4,rep,name=labels" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (m *VolumeCreateRequest) Reset()                    { *m = VolumeCreateRequest{} }
+func (m *VolumeCreateRequest) String() string            { return proto.CompactTextString(m) }
+func (*VolumeCreateRequest) ProtoMessage()               {}
+func (*VolumeCreateRequest) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{162} }
+
+func (m *VolumeCreateRequest) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *VolumeCreateRequest) GetSizeBytes() int64 {
+	if m != nil {
+		return m.SizeBytes
+	}
+	return 0
+}
+
+func (m *VolumeCreateRequest) GetFstype() string {
+	if m != nil {
+		return m.Fstype
+	}
+	return ""
+}
+
+func (m *VolumeCreateRequest) GetLabels() map[string]string {
+	if m != nil {
+		return m.Labels
+	}
+	return nil
+}
+
+type VolumeCreateResponse struct {
+	Volume *VolumeInfo `protobuf:"bytes,1,opt,name=volume" json:"volume,omitempty"`
+}
+
+func (m *VolumeCreateResponse) Reset()                    { *m = VolumeCreateResponse{} }
+func (m *VolumeCreateResponse) String() string            { return proto.CompactTextString(m) }
+func (*VolumeCreateResponse) ProtoMessage()               {}
+func (*VolumeCreateResponse) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{163} }
+
+func (m *VolumeCreateResponse) GetVolume() *VolumeInfo {
+	if m != nil {
+		return m.Volume
+	}
+	return nil
+}
+
+type VolumeListRequest struct {
+}
+
+func (m *VolumeListRequest) Reset()                    { *m = VolumeListRequest{} }
+func (m *VolumeListRequest) String() string            { return proto.CompactTextString(m) }
+func (*VolumeListRequest) ProtoMessage()               {}
+func (*VolumeListRequest) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{164} }
+
+type VolumeListResponse struct {
+	Volumes []*VolumeInfo `protobuf:"bytes,1,rep,name=volumes" json:"volumes,omitempty"`
+}
+
+func (m *VolumeListResponse) Reset()                    { *m = VolumeListResponse{} }
+func (m *VolumeListResponse) String() string            { return proto.CompactTextString(m) }
+func (*VolumeListResponse) ProtoMessage()               {}
+func (*VolumeListResponse) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{165} }
+
+func (m *VolumeListResponse) GetVolumes() []*VolumeInfo {
+	if m != nil {
+		return m.Volumes
+	}
+	return nil
+}
+
+type VolumeInspectRequest struct {
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+func (m *VolumeInspectRequest) Reset()                    { *m = VolumeInspectRequest{} }
+func (m *VolumeInspectRequest) String() string            { return proto.CompactTextString(m) }
+func (*VolumeInspectRequest) ProtoMessage()               {}
+func (*VolumeInspectRequest) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{166} }
+
+func (m *VolumeInspectRequest) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+type VolumeInspectResponse struct {
+	Volume *VolumeInfo `protobuf:"bytes,1,opt,name=volume" json:"volume,omitempty"`
+}
+
+func (m *VolumeInspectResponse) Reset()                    { *m = VolumeInspectResponse{} }
+func (m *VolumeInspectResponse) String() string            { return proto.CompactTextString(m) }
+func (*VolumeInspectResponse) ProtoMessage()               {}
+func (*VolumeInspectResponse) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{167} }
+
+func (m *VolumeInspectResponse) GetVolume() *VolumeInfo {
+	if m != nil {
+		return m.Volume
+	}
+	return nil
+}
+
+type VolumeRemoveRequest struct {
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+func (m *VolumeRemoveRequest) Reset()                    { *m = VolumeRemoveRequest{} }
+func (m *VolumeRemoveRequest) String() string            { return proto.CompactTextString(m) }
+func (*VolumeRemoveRequest) ProtoMessage()               {}
+func (*VolumeRemoveRequest) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{168} }
+
+func (m *VolumeRemoveRequest) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+type VolumeRemoveResponse struct {
+}
+
+func (m *VolumeRemoveResponse) Reset()                    { *m = VolumeRemoveResponse{} }
+func (m *VolumeRemoveResponse) String() string            { return proto.CompactTextString(m) }
+func (*VolumeRemoveResponse) ProtoMessage()               {}
+func (*VolumeRemoveResponse) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{169} }
+
+type VolumeResizeRequest struct {
+	PodID     string `protobuf:"bytes,1,opt,name=podID,proto3" json:"podID,omitempty"`
+	Volume    string `protobuf:"bytes,2,opt,name=volume,proto3" json:"volume,omitempty"`
+	SizeBytes int64  `protobuf:"varint,3,opt,name=sizeBytes,proto3" json:"sizeBytes,omitempty"`
+}
+
+func (m *VolumeResizeRequest) Reset()                    { *m = VolumeResizeRequest{} }
+func (m *VolumeResizeRequest) String() string            { return proto.CompactTextString(m) }
+func (*VolumeResizeRequest) ProtoMessage()               {}
+func (*VolumeResizeRequest) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{170} }
+
+func (m *VolumeResizeRequest) GetPodID() string {
+	if m != nil {
+		return m.PodID
+	}
+	return ""
+}
+
+func (m *VolumeResizeRequest) GetVolume() string {
+	if m != nil {
+		return m.Volume
+	}
+	return ""
+}
+
+func (m *VolumeResizeRequest) GetSizeBytes() int64 {
+	if m != nil {
+		return m.SizeBytes
+	}
+	return 0
+}
+
+type VolumeResizeResponse struct {
+}
+
+func (m *VolumeResizeResponse) Reset()                    { *m = VolumeResizeResponse{} }
+func (m *VolumeResizeResponse) String() string            { return proto.CompactTextString(m) }
+func (*VolumeResizeResponse) ProtoMessage()               {}
+func (*VolumeResizeResponse) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{171} }
+
+// VolumeSnapshotInfo describes a copy-on-write snapshot of a named volume
+type VolumeSnapshotInfo struct {
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// the named volume of the snapshot
+	Volume    string            `protobuf:"bytes,2,opt,name=volume,proto3" json:"volume,omitempty"`
+	Driver    string            `protobuf:"bytes,3,opt,name=driver,proto3" json:"driver,omitempty"`
+	SizeBytes int64             `protobuf:"varint,4,opt,name=sizeBytes,proto3" json:"sizeBytes,omitempty"`
+	Labels    map[string]string `protobuf:"bytes,5,rep,name=labels" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	CreatedAt int64             `protobuf:"varint,6,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
+}
+
+func (m *VolumeSnapshotInfo) Reset()                    { *m = VolumeSnapshotInfo{} }
+func (m *VolumeSnapshotInfo) String() string            { return proto.CompactTextString(m) }
+func (*VolumeSnapshotInfo) ProtoMessage()               {}
+func (*VolumeSnapshotInfo) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{172} }
+
+func (m *VolumeSnapshotInfo) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *VolumeSnapshotInfo) GetVolume() string {
+	if m != nil {
+		return m.Volume
+	}
+	return ""
+}
+
+func (m *VolumeSnapshotInfo) GetDriver() string {
+	if m != nil {
+		return m.Driver
+	}
+	return ""
+}
+
+func (m *VolumeSnapshotInfo) GetSizeBytes() int64 {
+	if m != nil {
+		return m.SizeBytes
+	}
+	return 0
+}
+
+func (m *VolumeSnapshotInfo) GetLabels() map[string]string {
+	if m != nil {
+		return m.Labels
+	}
+	return nil
+}
+
+func (m *VolumeSnapshotInfo) GetCreatedAt() int64 {
+	if m != nil {
+		return m.CreatedAt
+	}
+	return 0
+}
+
+type VolumeSnapshotRequest struct {
+	Volume string            `protobuf:"bytes,1,opt,name=volume,proto3" json:"volume,omitempty"`
+	Name   string            `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Labels map[string]string `protobuf:"bytes,3,rep,name=labels" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (m *VolumeSnapshotRequest) Reset()                    { *m = VolumeSnapshotRequest{} }
+func (m *VolumeSnapshotRequest) String() string            { return proto.CompactTextString(m) }
+func (*VolumeSnapshotRequest) ProtoMessage()               {}
+func (*VolumeSnapshotRequest) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{173} }
+
+func (m *VolumeSnapshotRequest) GetVolume() string {
+	if m != nil {
+		return m.Volume
+	}
+	return ""
+}
+
+func (m *VolumeSnapshotRequest) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *VolumeSnapshotRequest) GetLabels() map[string]string {
+	if m != nil {
+		return m.Labels
+	}
+	return nil
+}
+
+type VolumeSnapshotResponse struct {
+	Snapshot *VolumeSnapshotInfo `protobuf:"bytes,1,opt,name=snapshot" json:"snapshot,omitempty"`
+}
+
+func (m *VolumeSnapshotResponse) Reset()                    { *m = VolumeSnapshotResponse{} }
+func (m *VolumeSnapshotResponse) String() string            { return proto.CompactTextString(m) }
+func (*VolumeSnapshotResponse) ProtoMessage()               {}
+func (*VolumeSnapshotResponse) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{174} }
+
+func (m *VolumeSnapshotResponse) GetSnapshot() *VolumeSnapshotInfo {
+	if m != nil {
+		return m.Snapshot
+	}
+	return nil
+}
+
+type VolumeSnapshotListRequest struct {
+	Volume string `protobuf:"bytes,1,opt,name=volume,proto3" json:"volume,omitempty"`
+}
+
+func (m *VolumeSnapshotListRequest) Reset()         { *m = VolumeSnapshotListRequest{} }
+func (m *VolumeSnapshotListRequest) String() string { return proto.CompactTextString(m) }
+func (*VolumeSnapshotListRequest) ProtoMessage()    {}
+func (*VolumeSnapshotListRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptorTypes, []int{175}
+}
+
+func (m *VolumeSnapshotListRequest) GetVolume() string {
+	if m != nil {
+		return m.Volume
+	}
+	return ""
+}
+
+type VolumeSnapshotListResponse struct {
+	Snapshots []*VolumeSnapshotInfo `protobuf:"bytes,1,rep,name=snapshots" json:"snapshots,omitempty"`
+}
+
+func (m *VolumeSnapshotListResponse) Reset()         { *m = VolumeSnapshotListResponse{} }
+func (m *VolumeSnapshotListResponse) String() string { return proto.CompactTextString(m) }
+func (*VolumeSnapshotListResponse) ProtoMessage()    {}
+func (*VolumeSnapshotListResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptorTypes, []int{176}
+}
+
+func (m *VolumeSnapshotListResponse) GetSnapshots() []*VolumeSnapshotInfo {
+	if m != nil {
+		return m.Snapshots
+	}
+	return nil
+}
+
+type VolumeSnapshotRemoveRequest struct {
+	Volume   string `protobuf:"bytes,1,opt,name=volume,proto3" json:"volume,omitempty"`
+	Snapshot string `protobuf:"bytes,2,opt,name=snapshot,proto3" json:"snapshot,omitempty"`
+}
+
+func (m *VolumeSnapshotRemoveRequest) Reset()         { *m = VolumeSnapshotRemoveRequest{} }
+func (m *VolumeSnapshotRemoveRequest) String() string { return proto.CompactTextString(m) }
+func (*VolumeSnapshotRemoveRequest) ProtoMessage()    {}
+func (*VolumeSnapshotRemoveRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptorTypes, []int{177}
+}
+
+func (m *VolumeSnapshotRemoveRequest) GetVolume() string {
+	if m != nil {
+		return m.Volume
+	}
+	return ""
+}
+
+func (m *VolumeSnapshotRemoveRequest) GetSnapshot() string {
+	if m != nil {
+		return m.Snapshot
+	}
+	return ""
+}
+
+type VolumeSnapshotRemoveResponse struct {
+}
+
+func (m *VolumeSnapshotRemoveResponse) Reset()         { *m = VolumeSnapshotRemoveResponse{} }
+func (m *VolumeSnapshotRemoveResponse) String() string { return proto.CompactTextString(m) }
+func (*VolumeSnapshotRemoveResponse) ProtoMessage()    {}
+func (*VolumeSnapshotRemoveResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptorTypes, []int{178}
+}
+
+type VolumeRestoreRequest struct {
+	Volume   string `protobuf:"bytes,1,opt,name=volume,proto3" json:"volume,omitempty"`
+	Snapshot string `protobuf:"bytes,2,opt,name=snapshot,proto3" json:"snapshot,omitempty"`
+}
+
+func (m *VolumeRestoreRequest) Reset()                    { *m = VolumeRestoreRequest{} }
+func (m *VolumeRestoreRequest) String() string            { return proto.CompactTextString(m) }
+func (*VolumeRestoreRequest) ProtoMessage()               {}
+func (*VolumeRestoreRequest) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{179} }
+
+func (m *VolumeRestoreRequest) GetVolume() string {
+	if m != nil {
+		return m.Volume
+	}
+	return ""
+}
+
+func (m *VolumeRestoreRequest) GetSnapshot() string {
+	if m != nil {
+		return m.Snapshot
+	}
+	return ""
+}
+
+type VolumeRestoreResponse struct {
+}
+
+func (m *VolumeRestoreResponse) Reset()                    { *m = VolumeRestoreResponse{} }
+func (m *VolumeRestoreResponse) String() string            { return proto.CompactTextString(m) }
+func (*VolumeRestoreResponse) ProtoMessage()               {}
+func (*VolumeRestoreResponse) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{180} }
+
+type VolumeCloneRequest struct {
+	Volume   string `protobuf:"bytes,1,opt,name=volume,proto3" json:"volume,omitempty"`
+	Snapshot string `protobuf:"bytes,2,opt,name=snapshot,proto3" json:"snapshot,omitempty"`
+	// name of the new named volume
+	Name   string            `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Labels map[string]string `protobuf:"bytes,4,rep,name=labels" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (m *VolumeCloneRequest) Reset()                    { *m = VolumeCloneRequest{} }
+func (m *VolumeCloneRequest) String() string            { return proto.CompactTextString(m) }
+func (*VolumeCloneRequest) ProtoMessage()               {}
+func (*VolumeCloneRequest) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{181} }
+
+func (m *VolumeCloneRequest) GetVolume() string {
+	if m != nil {
+		return m.Volume
+	}
+	return ""
+}
+
+func (m *VolumeCloneRequest) GetSnapshot() string {
+	if m != nil {
+		return m.Snapshot
+	}
+	return ""
+}
+
+func (m *VolumeCloneRequest) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *VolumeCloneRequest) GetLabels() map[string]string {
+	if m != nil {
+		return m.Labels
+	}
+	return nil
+}
+
+type VolumeCloneResponse struct {
+	Volume *VolumeInfo `protobuf:"bytes,1,opt,name=volume" json:"volume,omitempty"`
+}
+
+func (m *VolumeCloneResponse) Reset()                    { *m = VolumeCloneResponse{} }
+func (m *VolumeCloneResponse) String() string            { return proto.CompactTextString(m) }
+func (*VolumeCloneResponse) ProtoMessage()               {}
+func (*VolumeCloneResponse) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{182} }
+
+func (m *VolumeCloneResponse) GetVolume() *VolumeInfo {
+	if m != nil {
+		return m.Volume
+	}
+	return nil
+}
+
+// NetworkInfo describes a user-defined network, the interfaces referencing
+// the network get their addresses from its pool.
+type NetworkInfo struct {
+	Name   string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Bridge string `protobuf:"bytes,2,opt,name=bridge,proto3" json:"bridge,omitempty"`
+	// in CIDR, i.e. 10.10.0.0/24
+	Subnet  string `protobuf:"bytes,3,opt,name=subnet,proto3" json:"subnet,omitempty"`
+	Gateway string `protobuf:"bytes,4,opt,name=gateway,proto3" json:"gateway,omitempty"`
+	// in CIDR within the subnet, the addresses are allocated from the
+	// whole subnet if it is empty
+	IpRange string `protobuf:"bytes,5,opt,name=ipRange,proto3" json:"ipRange,omitempty"`
+	// the addresses never allocated
+	Reserved  []string          `protobuf:"bytes,6,rep,name=reserved" json:"reserved,omitempty"`
+	Labels    map[string]string `protobuf:"bytes,7,rep,name=labels" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	CreatedAt int64             `protobuf:"varint,8,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
+	// the bridge has been created by hyperd, and is deleted with the network
+	BridgeCreated bool `protobuf:"varint,9,opt,name=bridgeCreated,proto3" json:"bridgeCreated,omitempty"`
+	// the gateway has been added to the existing bridge by hyperd, and is
+	// removed from the bridge with the network
+	GatewayAdded bool `protobuf:"varint,11,opt,name=gatewayAdded,proto3" json:"gatewayAdded,omitempty"`
+	// the addresses allocated to the pods
+	Leases []*NetworkLease `protobuf:"bytes,10,rep,name=leases" json:"leases,omitempty"`
+}
+
+func (m *NetworkInfo) Reset()                    { *m = NetworkInfo{} }
+func (m *NetworkInfo) String() string            { return proto.CompactTextString(m) }
+func (*NetworkInfo) ProtoMessage()               {}
+func (*NetworkInfo) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{183} }
+
+func (m *NetworkInfo) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *NetworkInfo) GetBridge() string {
+	if m != nil {
+		return m.Bridge
+	}
+	return ""
+}
+
+func (m *NetworkInfo) GetSubnet() string {
+	if m != nil {
+		return m.Subnet
+	}
+	return ""
+}
+
+func (m *NetworkInfo) GetGateway() string {
+	if m != nil {
+		return m.Gateway
+	}
+	return ""
+}
+
+func (m *NetworkInfo) GetIpRange() string {
+	if m != nil {
+		return m.IpRange
+	}
+	return ""
+}
+
+func (m *NetworkInfo) GetReserved() []string {
+	if m != nil {
+		return m.Reserved
+	}
+	return nil
+}
+
+func (m *NetworkInfo) GetLabels() map[string]string {
+	if m != nil {
+		return m.Labels
+	}
+	return nil
+}
+
+func (m *NetworkInfo) GetCreatedAt() int64 {
+	if m != nil {
+		return m.CreatedAt
+	}
+	return 0
+}
+
+func (m *NetworkInfo) GetBridgeCreated() bool {
+	if m != nil {
+		return m.BridgeCreated
+	}
+	return false
+}
+
+func (m *NetworkInfo) GetGatewayAdded() bool {
+	if m != nil {
+		return m.GatewayAdded
+	}
+	return false
+}
+
+func (m *NetworkInfo) GetLeases() []*NetworkLease {
+	if m != nil {
+		return m.Leases
+	}
+	return nil
+}
+
+type NetworkLease struct {
+	Ip    string `protobuf:"bytes,1,opt,name=ip,proto3" json:"ip,omitempty"`
+	PodID string `protobuf:"bytes,2,opt,name=podID,proto3" json:"podID,omitempty"`
+}
+
+func (m *NetworkLease) Reset()                    { *m = NetworkLease{} }
+func (m *NetworkLease) String() string            { return proto.CompactTextString(m) }
+func (*NetworkLease) ProtoMessage()               {}
+func (*NetworkLease) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{184} }
+
+func (m *NetworkLease) GetIp() string {
+	if m != nil {
+		return m.Ip
+	}
+	return ""
+}
+
+func (m *NetworkLease) GetPodID() string {
+	if m != nil {
+		return m.PodID
+	}
+	return ""
+}
+
+type NetworkCreateRequest struct {
+	Name     string            `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Bridge   string            `protobuf:"bytes,2,opt,name=bridge,proto3" json:"bridge,omitempty"`
+	Subnet   string            `protobuf:"bytes,3,opt,name=subnet,proto3" json:"subnet,omitempty"`
+	Gateway  string            `protobuf:"bytes,4,opt,name=gateway,proto3" json:"gateway,omitempty"`
+	IpRange  string            `protobuf:"bytes,5,opt,name=ipRange,proto3" json:"ipRange,omitempty"`
+	Reserved []string          `protobuf:"bytes,6,rep,name=reserved" json:"reserved,omitempty"`
+	Labels   map[string]string `protobuf:"bytes,7,rep,name=labels" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (m *NetworkCreateRequest) Reset()                    { *m = NetworkCreateRequest{} }
+func (m *NetworkCreateRequest) String() string            { return proto.CompactTextString(m) }
+func (*NetworkCreateRequest) ProtoMessage()               {}
+func (*NetworkCreateRequest) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{185} }
+
+func (m *NetworkCreateRequest) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *NetworkCreateRequest) GetBridge() string {
+	if m != nil {
+		return m.Bridge
+	}
+	return ""
+}
+
+func (m *NetworkCreateRequest) GetSubnet() string {
+	if m != nil {
+		return m.Subnet
+	}
+	return ""
+}
+
+func (m *NetworkCreateRequest) GetGateway() string {
+	if m != nil {
+		return m.Gateway
+	}
+	return ""
+}
+
+func (m *NetworkCreateRequest) GetIpRange() string {
+	if m != nil {
+		return m.IpRange
+	}
+	return ""
+}
+
+func (m *NetworkCreateRequest) GetReserved() []string {
+	if m != nil {
+		return m.Reserved
+	}
+	return nil
+}
+
+func (m *NetworkCreateRequest) GetLabels() map[string]string {
+	if m != nil {
+		return m.Labels
+	}
+	return nil
+}
+
+type NetworkCreateResponse struct {
+	Network *NetworkInfo `protobuf:"bytes,1,opt,name=network" json:"network,omitempty"`
+}
+
+func (m *NetworkCreateResponse) Reset()                    { *m = NetworkCreateResponse{} }
+func (m *NetworkCreateResponse) String() string            { return proto.CompactTextString(m) }
+func (*NetworkCreateResponse) ProtoMessage()               {}
+func (*NetworkCreateResponse) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{186} }
+
+func (m *NetworkCreateResponse) GetNetwork() *NetworkInfo {
+	if m != nil {
+		return m.Network
+	}
+	return nil
+}
+
+type NetworkListRequest struct {
+}
+
+func (m *NetworkListRequest) Reset()                    { *m = NetworkListRequest{} }
+func (m *NetworkListRequest) String() string            { return proto.CompactTextString(m) }
+func (*NetworkListRequest) ProtoMessage()               {}
+func (*NetworkListRequest) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{187} }
+
+type NetworkListResponse struct {
+	Networks []*NetworkInfo `protobuf:"bytes,1,rep,name=networks" json:"networks,omitempty"`
+}
+
+func (m *NetworkListResponse) Reset()                    { *m = NetworkListResponse{} }
+func (m *NetworkListResponse) String() string            { return proto.CompactTextString(m) }
+func (*NetworkListResponse) ProtoMessage()               {}
+func (*NetworkListResponse) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{188} }
+
+func (m *NetworkListResponse) GetNetworks() []*NetworkInfo {
+	if m != nil {
+		return m.Networks
+	}
+	return nil
+}
+
+type NetworkInspectRequest struct {
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+func (m *NetworkInspectRequest) Reset()                    { *m = NetworkInspectRequest{} }
+func (m *NetworkInspectRequest) String() string            { return proto.CompactTextString(m) }
+func (*NetworkInspectRequest) ProtoMessage()               {}
+func (*NetworkInspectRequest) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{189} }
+
+func (m *NetworkInspectRequest) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+type NetworkInspectResponse struct {
+	Network *NetworkInfo `protobuf:"bytes,1,opt,name=network" json:"network,omitempty"`
+}
+
+func (m *NetworkInspectResponse) Reset()                    { *m = NetworkInspectResponse{} }
+func (m *NetworkInspectResponse) String() string            { return proto.CompactTextString(m) }
+func (*NetworkInspectResponse) ProtoMessage()               {}
+func (*NetworkInspectResponse) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{190} }
+
+func (m *NetworkInspectResponse) GetNetwork() *NetworkInfo {
+	if m != nil {
+		return m.Network
+	}
+	return nil
+}
+
+type NetworkRemoveRequest struct {
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+func (m *NetworkRemoveRequest) Reset()                    { *m = NetworkRemoveRequest{} }
+func (m *NetworkRemoveRequest) String() string            { return proto.CompactTextString(m) }
+func (*NetworkRemoveRequest) ProtoMessage()               {}
+func (*NetworkRemoveRequest) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{191} }
+
+func (m *NetworkRemoveRequest) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+type NetworkRemoveResponse struct {
+}
+
+func (m *NetworkRemoveResponse) Reset()                    { *m = NetworkRemoveResponse{} }
+func (m *NetworkRemoveResponse) String() string            { return proto.CompactTextString(m) }
+func (*NetworkRemoveResponse) ProtoMessage()               {}
+func (*NetworkRemoveResponse) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{192} }
+
+// OrphanResource is a resource left behind by removed or failed pods and
+// volumes, which is not recorded by any pod or named volume.
+type OrphanResource struct {
+	// dbkey, device, volume, snapshot or mount
+	Kind string `protobuf:"bytes,1,opt,name=kind,proto3" json:"kind,omitempty"`
+	// the daemondb key, device name or path of the resource
+	Id string `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	// the pod or volume the resource belonged to
+	Owner   string `protobuf:"bytes,3,opt,name=owner,proto3" json:"owner,omitempty"`
+	Removed bool   `protobuf:"varint,4,opt,name=removed,proto3" json:"removed,omitempty"`
+	Error   string `protobuf:"bytes,5,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (m *OrphanResource) Reset()                    { *m = OrphanResource{} }
+func (m *OrphanResource) String() string            { return proto.CompactTextString(m) }
+func (*OrphanResource) ProtoMessage()               {}
+func (*OrphanResource) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{193} }
+
+func (m *OrphanResource) GetKind() string {
+	if m != nil {
+		return m.Kind
+	}
+	return ""
+}
+
+func (m *OrphanResource) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *OrphanResource) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *OrphanResource) GetRemoved() bool {
+	if m != nil {
+		return m.Removed
+	}
+	return false
+}
+
+func (m *OrphanResource) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+type SystemPruneRequest struct {
+	// only report the orphans without removing them
+	DryRun bool `protobuf:"varint,1,opt,name=dryRun,proto3" json:"dryRun,omitempty"`
+}
+
+func (m *SystemPruneRequest) Reset()                    { *m = SystemPruneRequest{} }
+func (m *SystemPruneRequest) String() string            { return proto.CompactTextString(m) }
+func (*SystemPruneRequest) ProtoMessage()               {}
+func (*SystemPruneRequest) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{194} }
+
+func (m *SystemPruneRequest) GetDryRun() bool {
+	if m != nil {
+		return m.DryRun
+	}
+	return false
+}
+
+type SystemPruneResponse struct {
+	Orphans []*OrphanResource `protobuf:"bytes,1,rep,name=orphans" json:"orphans,omitempty"`
+}
+
+func (m *SystemPruneResponse) Reset()                    { *m = SystemPruneResponse{} }
+func (m *SystemPruneResponse) String() string            { return proto.CompactTextString(m) }
+func (*SystemPruneResponse) ProtoMessage()               {}
+func (*SystemPruneResponse) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{195} }
+
+func (m *SystemPruneResponse) GetOrphans() []*OrphanResource {
+	if m != nil {
+		return m.Orphans
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*ContainerPort)(nil), "types.ContainerPort")
+	proto.RegisterType((*EnvironmentVar)(nil), "types.EnvironmentVar")
+	proto.RegisterType((*VolumeMount)(nil), "types.VolumeMount")
+	proto.RegisterType((*WaitingStatus)(nil), "types.WaitingStatus")
+	proto.RegisterType((*RunningStatus)(nil), "types.RunningStatus")
 	proto.RegisterType((*TermStatus)(nil), "types.TermStatus")
 	proto.RegisterType((*ContainerStatus)(nil), "types.ContainerStatus")
+	proto.RegisterType((*HealthStatus)(nil), "types.HealthStatus")
 	proto.RegisterType((*ContainerInfo)(nil), "types.ContainerInfo")
 	proto.RegisterType((*Container)(nil), "types.Container")
 	proto.RegisterType((*RBDVolumeSource)(nil), "types.RBDVolumeSource")
@@ -4490,6 +6594,7 @@ func init() {
 	proto.RegisterType((*ContainersStats)(nil), "types.ContainersStats")
 	proto.RegisterType((*PodInfoRequest)(nil), "types.PodInfoRequest")
 	proto.RegisterType((*PodInfoResponse)(nil), "types.PodInfoResponse")
+	proto.RegisterType((*ListFilter)(nil), "types.ListFilter")
 	proto.RegisterType((*PodListRequest)(nil), "types.PodListRequest")
 	proto.RegisterType((*PodListResult)(nil), "types.PodListResult")
 	proto.RegisterType((*PodListResponse)(nil), "types.PodListResponse")
@@ -4513,7 +6618,12 @@ func init() {
 	proto.RegisterType((*UserUser)(nil), "types.UserUser")
 	proto.RegisterType((*Ulimit)(nil), "types.Ulimit")
 	proto.RegisterType((*UserContainer)(nil), "types.UserContainer")
+	proto.RegisterType((*UserProbeExec)(nil), "types.UserProbeExec")
+	proto.RegisterType((*UserProbeTCPSocket)(nil), "types.UserProbeTCPSocket")
+	proto.RegisterType((*UserProbeHTTPGet)(nil), "types.UserProbeHTTPGet")
+	proto.RegisterType((*UserProbe)(nil), "types.UserProbe")
 	proto.RegisterType((*UserResource)(nil), "types.UserResource")
+	proto.RegisterType((*UserContainerResource)(nil), "types.UserContainerResource")
 	proto.RegisterType((*UserFile)(nil), "types.UserFile")
 	proto.RegisterType((*UserVolumeOption)(nil), "types.UserVolumeOption")
 	proto.RegisterType((*UserVolume)(nil), "types.UserVolume")
@@ -4524,6 +6634,7 @@ func init() {
 	proto.RegisterType((*PortMapping)(nil), "types.PortMapping")
 	proto.RegisterType((*PortmappingWhiteList)(nil), "types.PortmappingWhiteList")
 	proto.RegisterType((*UserPod)(nil), "types.UserPod")
+	proto.RegisterType((*HostAlias)(nil), "types.HostAlias")
 	proto.RegisterType((*PodCreateRequest)(nil), "types.PodCreateRequest")
 	proto.RegisterType((*PodCreateResponse)(nil), "types.PodCreateResponse")
 	proto.RegisterType((*PodRemoveRequest)(nil), "types.PodRemoveRequest")
@@ -4552,6 +6663,10 @@ func init() {
 	proto.RegisterType((*ContainerStartResponse)(nil), "types.ContainerStartResponse")
 	proto.RegisterType((*ContainerRenameRequest)(nil), "types.ContainerRenameRequest")
 	proto.RegisterType((*ContainerRenameResponse)(nil), "types.ContainerRenameResponse")
+	proto.RegisterType((*ContainerCopyFromRequest)(nil), "types.ContainerCopyFromRequest")
+	proto.RegisterType((*ContainerCopyData)(nil), "types.ContainerCopyData")
+	proto.RegisterType((*ContainerCopyToRequest)(nil), "types.ContainerCopyToRequest")
+	proto.RegisterType((*ContainerCopyToResponse)(nil), "types.ContainerCopyToResponse")
 	proto.RegisterType((*ContainerRemoveRequest)(nil), "types.ContainerRemoveRequest")
 	proto.RegisterType((*ContainerRemoveResponse)(nil), "types.ContainerRemoveResponse")
 	proto.RegisterType((*AuthConfig)(nil), "types.AuthConfig")
@@ -4578,6 +6693,12 @@ func init() {
 	proto.RegisterType((*PortMappingListResponse)(nil), "types.PortMappingListResponse")
 	proto.RegisterType((*PortMappingModifyRequest)(nil), "types.PortMappingModifyRequest")
 	proto.RegisterType((*PortMappingModifyResponse)(nil), "types.PortMappingModifyResponse")
+	proto.RegisterType((*PodInterfaceListRequest)(nil), "types.PodInterfaceListRequest")
+	proto.RegisterType((*PodInterfaceListResponse)(nil), "types.PodInterfaceListResponse")
+	proto.RegisterType((*PodInterfaceAddRequest)(nil), "types.PodInterfaceAddRequest")
+	proto.RegisterType((*PodInterfaceAddResponse)(nil), "types.PodInterfaceAddResponse")
+	proto.RegisterType((*PodInterfaceRemoveRequest)(nil), "types.PodInterfaceRemoveRequest")
+	proto.RegisterType((*PodInterfaceRemoveResponse)(nil), "types.PodInterfaceRemoveResponse")
 	proto.RegisterType((*PodStopRequest)(nil), "types.PodStopRequest")
 	proto.RegisterType((*PodStopResponse)(nil), "types.PodStopResponse")
 	proto.RegisterType((*PodSignalRequest)(nil), "types.PodSignalRequest")
@@ -4586,1464 +6707,2293 @@ func init() {
 	proto.RegisterType((*PodPauseResponse)(nil), "types.PodPauseResponse")
 	proto.RegisterType((*PodUnpauseRequest)(nil), "types.PodUnpauseRequest")
 	proto.RegisterType((*PodUnpauseResponse)(nil), "types.PodUnpauseResponse")
+	proto.RegisterType((*PodUpdateResourcesRequest)(nil), "types.PodUpdateResourcesRequest")
+	proto.RegisterType((*PodUpdateResourcesResponse)(nil), "types.PodUpdateResourcesResponse")
 	proto.RegisterType((*PodLabelsRequest)(nil), "types.PodLabelsRequest")
 	proto.RegisterType((*PodLabelsResponse)(nil), "types.PodLabelsResponse")
 	proto.RegisterType((*PodStatsRequest)(nil), "types.PodStatsRequest")
 	proto.RegisterType((*PodStatsResponse)(nil), "types.PodStatsResponse")
+	proto.RegisterType((*PodStatsStreamRequest)(nil), "types.PodStatsStreamRequest")
+	proto.RegisterType((*StatsRates)(nil), "types.StatsRates")
+	proto.RegisterType((*ContainerStatsRates)(nil), "types.ContainerStatsRates")
+	proto.RegisterType((*PodStatsStreamResponse)(nil), "types.PodStatsStreamResponse")
 	proto.RegisterType((*PingRequest)(nil), "types.PingRequest")
 	proto.RegisterType((*PingResponse)(nil), "types.PingResponse")
 	proto.RegisterType((*ContainerSignalRequest)(nil), "types.ContainerSignalRequest")
 	proto.RegisterType((*ContainerSignalResponse)(nil), "types.ContainerSignalResponse")
 	proto.RegisterType((*TTYResizeRequest)(nil), "types.TTYResizeRequest")
 	proto.RegisterType((*TTYResizeResponse)(nil), "types.TTYResizeResponse")
+	proto.RegisterType((*Event)(nil), "types.Event")
+	proto.RegisterType((*EventsRequest)(nil), "types.EventsRequest")
+	proto.RegisterType((*VolumeInfo)(nil), "types.VolumeInfo")
+	proto.RegisterType((*VolumeCreateRequest)(nil), "types.VolumeCreateRequest")
+	proto.RegisterType((*VolumeCreateResponse)(nil), "types.VolumeCreateResponse")
+	proto.RegisterType((*VolumeListRequest)(nil), "types.VolumeListRequest")
+	proto.RegisterType((*VolumeListResponse)(nil), "types.VolumeListResponse")
+	proto.RegisterType((*VolumeInspectRequest)(nil), "types.VolumeInspectRequest")
+	proto.RegisterType((*VolumeInspectResponse)(nil), "types.VolumeInspectResponse")
+	proto.RegisterType((*VolumeRemoveRequest)(nil), "types.VolumeRemoveRequest")
+	proto.RegisterType((*VolumeRemoveResponse)(nil), "types.VolumeRemoveResponse")
+	proto.RegisterType((*VolumeResizeRequest)(nil), "types.VolumeResizeRequest")
+	proto.RegisterType((*VolumeResizeResponse)(nil), "types.VolumeResizeResponse")
+	proto.RegisterType((*VolumeSnapshotInfo)(nil), "types.VolumeSnapshotInfo")
+	proto.RegisterType((*VolumeSnapshotRequest)(nil), "types.VolumeSnapshotRequest")
+	proto.RegisterType((*VolumeSnapshotResponse)(nil), "types.VolumeSnapshotResponse")
+	proto.RegisterType((*VolumeSnapshotListRequest)(nil), "types.VolumeSnapshotListRequest")
+	proto.RegisterType((*VolumeSnapshotListResponse)(nil), "types.VolumeSnapshotListResponse")
+	proto.RegisterType((*VolumeSnapshotRemoveRequest)(nil), "types.VolumeSnapshotRemoveRequest")
+	proto.RegisterType((*VolumeSnapshotRemoveResponse)(nil), "types.VolumeSnapshotRemoveResponse")
+	proto.RegisterType((*VolumeRestoreRequest)(nil), "types.VolumeRestoreRequest")
+	proto.RegisterType((*VolumeRestoreResponse)(nil), "types.VolumeRestoreResponse")
+	proto.RegisterType((*VolumeCloneRequest)(nil), "types.VolumeCloneRequest")
+	proto.RegisterType((*VolumeCloneResponse)(nil), "types.VolumeCloneResponse")
+	proto.RegisterType((*NetworkInfo)(nil), "types.NetworkInfo")
+	proto.RegisterType((*NetworkLease)(nil), "types.NetworkLease")
+	proto.RegisterType((*NetworkCreateRequest)(nil), "types.NetworkCreateRequest")
+	proto.RegisterType((*NetworkCreateResponse)(nil), "types.NetworkCreateResponse")
+	proto.RegisterType((*NetworkListRequest)(nil), "types.NetworkListRequest")
+	proto.RegisterType((*NetworkListResponse)(nil), "types.NetworkListResponse")
+	proto.RegisterType((*NetworkInspectRequest)(nil), "types.NetworkInspectRequest")
+	proto.RegisterType((*NetworkInspectResponse)(nil), "types.NetworkInspectResponse")
+	proto.RegisterType((*NetworkRemoveRequest)(nil), "types.NetworkRemoveRequest")
+	proto.RegisterType((*NetworkRemoveResponse)(nil), "types.NetworkRemoveResponse")
+	proto.RegisterType((*OrphanResource)(nil), "types.OrphanResource")
+	proto.RegisterType((*SystemPruneRequest)(nil), "types.SystemPruneRequest")
+	proto.RegisterType((*SystemPruneResponse)(nil), "types.SystemPruneResponse")
+	proto.RegisterEnum("types.ExecStartResponse_StreamType", ExecStartResponse_StreamType_name, ExecStartResponse_StreamType_value)
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// Client API for PublicAPI service
+
+type PublicAPIClient interface {
+	// PodList gets a list of pods
+	PodList(ctx context.Context, in *PodListRequest, opts ...grpc.CallOption) (*PodListResponse, error)
+	// PodCreate creates a pod according to UserPod
+	PodCreate(ctx context.Context, in *PodCreateRequest, opts ...grpc.CallOption) (*PodCreateResponse, error)
+	// PodInfo gets pod's info by podID
+	PodInfo(ctx context.Context, in *PodInfoRequest, opts ...grpc.CallOption) (*PodInfoResponse, error)
+	// PodRemove deletes a pod by podID
+	PodRemove(ctx context.Context, in *PodRemoveRequest, opts ...grpc.CallOption) (*PodRemoveResponse, error)
+	// PodStart starts a pod
+	PodStart(ctx context.Context, in *PodStartRequest, opts ...grpc.CallOption) (*PodStartResponse, error)
+	// PodStop stops a pod
+	PodStop(ctx context.Context, in *PodStopRequest, opts ...grpc.CallOption) (*PodStopResponse, error)
+	// PodSignal sends a signal to all containers of specified pod
+	PodSignal(ctx context.Context, in *PodSignalRequest, opts ...grpc.CallOption) (*PodSignalResponse, error)
+	// PodPause pauses a pod
+	PodPause(ctx context.Context, in *PodPauseRequest, opts ...grpc.CallOption) (*PodPauseResponse, error)
+	// PodUnpause unpauses a pod
+	PodUnpause(ctx context.Context, in *PodUnpauseRequest, opts ...grpc.CallOption) (*PodUnpauseResponse, error)
+	// PodUpdateResources hotplugs vcpus and memory to a running pod
+	PodUpdateResources(ctx context.Context, in *PodUpdateResourcesRequest, opts ...grpc.CallOption) (*PodUpdateResourcesResponse, error)
+	// ExecVM executes a command outside of any containers.
+	ExecVM(ctx context.Context, opts ...grpc.CallOption) (PublicAPI_ExecVMClient, error)
+	// ContainerList gets a list of containers
+	ContainerList(ctx context.Context, in *ContainerListRequest, opts ...grpc.CallOption) (*ContainerListResponse, error)
+	// ContainerInfo gets container's info by container's id or name
+	ContainerInfo(ctx context.Context, in *ContainerInfoRequest, opts ...grpc.CallOption) (*ContainerInfoResponse, error)
+	// ImageList gets a list of images by filters
+	ImageList(ctx context.Context, in *ImageListRequest, opts ...grpc.CallOption) (*ImageListResponse, error)
+	// VMList gets a list of HyperVMs
+	VMList(ctx context.Context, in *VMListRequest, opts ...grpc.CallOption) (*VMListResponse, error)
+	// SetPodLabels sets labels of given pod
+	SetPodLabels(ctx context.Context, in *PodLabelsRequest, opts ...grpc.CallOption) (*PodLabelsResponse, error)
+	// PodStats gets pod stats of a given pod
+	PodStats(ctx context.Context, in *PodStatsRequest, opts ...grpc.CallOption) (*PodStatsResponse, error)
+	// PodStatsStream streams the stats samples of the given pods
+	PodStatsStream(ctx context.Context, in *PodStatsStreamRequest, opts ...grpc.CallOption) (PublicAPI_PodStatsStreamClient, error)
+	// ContainerLogs gets the log of specified container
+	ContainerLogs(ctx context.Context, in *ContainerLogsRequest, opts ...grpc.CallOption) (PublicAPI_ContainerLogsClient, error)
+	// ContainerCreate creates a container in specified pod
+	ContainerCreate(ctx context.Context, in *ContainerCreateRequest, opts ...grpc.CallOption) (*ContainerCreateResponse, error)
+	// ContainerStart starts a container in a specified pod
+	ContainerStart(ctx context.Context, in *ContainerStartRequest, opts ...grpc.CallOption) (*ContainerStartResponse, error)
+	// ContainerRename renames a container
+	ContainerRename(ctx context.Context, in *ContainerRenameRequest, opts ...grpc.CallOption) (*ContainerRenameResponse, error)
+	// ContainerCopyFrom copies files out of a container as a tar stream
+	ContainerCopyFrom(ctx context.Context, in *ContainerCopyFromRequest, opts ...grpc.CallOption) (PublicAPI_ContainerCopyFromClient, error)
+	// ContainerCopyTo extracts a tar stream to a container
+	ContainerCopyTo(ctx context.Context, opts ...grpc.CallOption) (PublicAPI_ContainerCopyToClient, error)
+	// TODO: ContainerCommit commits the changes of the specified container
+	// ContainerSignal sends a signal to specified container
+	ContainerSignal(ctx context.Context, in *ContainerSignalRequest, opts ...grpc.CallOption) (*ContainerSignalResponse, error)
+	// TODO: ContainerLabels updates labels of the specified container
+	// ContainerStop stops the specified container
+	ContainerStop(ctx context.Context, in *ContainerStopRequest, opts ...grpc.CallOption) (*ContainerStopResponse, error)
+	// ContainerRemove removes a container from a specified pod
+	ContainerRemove(ctx context.Context, in *ContainerRemoveRequest, opts ...grpc.CallOption) (*ContainerRemoveResponse, error)
+	// ExecCreate creates exec in specified container
+	ExecCreate(ctx context.Context, in *ExecCreateRequest, opts ...grpc.CallOption) (*ExecCreateResponse, error)
+	// ExecStart starts exec
+	ExecStart(ctx context.Context, opts ...grpc.CallOption) (PublicAPI_ExecStartClient, error)
+	// ExecSignal sends a signal to specified exec in specified container
+	ExecSignal(ctx context.Context, in *ExecSignalRequest, opts ...grpc.CallOption) (*ExecSignalResponse, error)
+	// Attach attaches to the specified container
+	Attach(ctx context.Context, opts ...grpc.CallOption) (PublicAPI_AttachClient, error)
+	// Wait gets the exit code of the specified container
+	Wait(ctx context.Context, in *WaitRequest, opts ...grpc.CallOption) (*WaitResponse, error)
+	// TTYResize resizes the tty of the specified container
+	TTYResize(ctx context.Context, in *TTYResizeRequest, opts ...grpc.CallOption) (*TTYResizeResponse, error)
+	// ServiceList gets a list of services
+	ServiceList(ctx context.Context, in *ServiceListRequest, opts ...grpc.CallOption) (*ServiceListResponse, error)
+	// ServiceAdd add a service to a pod
+	ServiceAdd(ctx context.Context, in *ServiceAddRequest, opts ...grpc.CallOption) (*ServiceAddResponse, error)
+	// ServiceDelete delete a service from a pod
+	ServiceDelete(ctx context.Context, in *ServiceDelRequest, opts ...grpc.CallOption) (*ServiceDelResponse, error)
+	// ServiceUpdate updates an existing service of a pod
+	ServiceUpdate(ctx context.Context, in *ServiceUpdateRequest, opts ...grpc.CallOption) (*ServiceUpdateResponse, error)
+	// PortMappingList get a list of PortMappings
+	PortMappingList(ctx context.Context, in *PortMappingListRequest, opts ...grpc.CallOption) (*PortMappingListResponse, error)
+	// PortMappingAdd add a list of PortMapping rules to a Pod
+	PortMappingAdd(ctx context.Context, in *PortMappingModifyRequest, opts ...grpc.CallOption) (*PortMappingModifyResponse, error)
+	// PortMappingDel remove a list of PortMapping rules from a Pod
+	PortMappingDel(ctx context.Context, in *PortMappingModifyRequest, opts ...grpc.CallOption) (*PortMappingModifyResponse, error)
+	// PodInterfaceList get the network interfaces of a Pod
+	PodInterfaceList(ctx context.Context, in *PodInterfaceListRequest, opts ...grpc.CallOption) (*PodInterfaceListResponse, error)
+	// PodInterfaceAdd hot-plug a network interface to a running Pod
+	PodInterfaceAdd(ctx context.Context, in *PodInterfaceAddRequest, opts ...grpc.CallOption) (*PodInterfaceAddResponse, error)
+	// PodInterfaceRemove unplug a network interface from a running Pod
+	PodInterfaceRemove(ctx context.Context, in *PodInterfaceRemoveRequest, opts ...grpc.CallOption) (*PodInterfaceRemoveResponse, error)
+	// ImagePull pulls a image from registry
+	ImagePull(ctx context.Context, in *ImagePullRequest, opts ...grpc.CallOption) (PublicAPI_ImagePullClient, error)
+	// ImagePush pushes a local image to registry
+	ImagePush(ctx context.Context, in *ImagePushRequest, opts ...grpc.CallOption) (PublicAPI_ImagePushClient, error)
+	// ImageRemove deletes a image from hyperd
+	ImageRemove(ctx context.Context, in *ImageRemoveRequest, opts ...grpc.CallOption) (*ImageRemoveResponse, error)
+	// VolumeCreate creates a named volume
+	VolumeCreate(ctx context.Context, in *VolumeCreateRequest, opts ...grpc.CallOption) (*VolumeCreateResponse, error)
+	// VolumeList gets a list of named volumes
+	VolumeList(ctx context.Context, in *VolumeListRequest, opts ...grpc.CallOption) (*VolumeListResponse, error)
+	// VolumeInspect gets the info of a named volume
+	VolumeInspect(ctx context.Context, in *VolumeInspectRequest, opts ...grpc.CallOption) (*VolumeInspectResponse, error)
+	// VolumeRemove deletes a named volume which is not used by any pods
+	VolumeRemove(ctx context.Context, in *VolumeRemoveRequest, opts ...grpc.CallOption) (*VolumeRemoveResponse, error)
+	// VolumeResize grows a volume of a Pod, and the filesystem on it if the Pod is running
+	VolumeResize(ctx context.Context, in *VolumeResizeRequest, opts ...grpc.CallOption) (*VolumeResizeResponse, error)
+	// VolumeSnapshot takes a copy-on-write snapshot of a named volume
+	VolumeSnapshot(ctx context.Context, in *VolumeSnapshotRequest, opts ...grpc.CallOption) (*VolumeSnapshotResponse, error)
+	// VolumeSnapshotList gets the snapshots of a named volume
+	VolumeSnapshotList(ctx context.Context, in *VolumeSnapshotListRequest, opts ...grpc.CallOption) (*VolumeSnapshotListResponse, error)
+	// VolumeSnapshotRemove deletes a snapshot of a named volume
+	VolumeSnapshotRemove(ctx context.Context, in *VolumeSnapshotRemoveRequest, opts ...grpc.CallOption) (*VolumeSnapshotRemoveResponse, error)
+	// VolumeRestore rolls a named volume, which is not used by any pods, back to a snapshot
+	VolumeRestore(ctx context.Context, in *VolumeRestoreRequest, opts ...grpc.CallOption) (*VolumeRestoreResponse, error)
+	// VolumeClone creates a named volume from a snapshot of another one
+	VolumeClone(ctx context.Context, in *VolumeCloneRequest, opts ...grpc.CallOption) (*VolumeCloneResponse, error)
+	// NetworkCreate creates a user-defined network
+	NetworkCreate(ctx context.Context, in *NetworkCreateRequest, opts ...grpc.CallOption) (*NetworkCreateResponse, error)
+	// NetworkList gets a list of user-defined networks
+	NetworkList(ctx context.Context, in *NetworkListRequest, opts ...grpc.CallOption) (*NetworkListResponse, error)
+	// NetworkInspect gets the info and the leases of a user-defined network
+	NetworkInspect(ctx context.Context, in *NetworkInspectRequest, opts ...grpc.CallOption) (*NetworkInspectResponse, error)
+	// NetworkRemove deletes a user-defined network which has no leases
+	NetworkRemove(ctx context.Context, in *NetworkRemoveRequest, opts ...grpc.CallOption) (*NetworkRemoveResponse, error)
+	// Ping checks if hyperd is running (returns 'OK' on success)
+	Ping(ctx context.Context, in *PingRequest, opts ...grpc.CallOption) (*PingResponse, error)
+	// Info gets the info of hyperd
+	Info(ctx context.Context, in *InfoRequest, opts ...grpc.CallOption) (*InfoResponse, error)
+	// Version gets the version and apiVersion of hyperd
+	Version(ctx context.Context, in *VersionRequest, opts ...grpc.CallOption) (*VersionResponse, error)
+	// SystemPrune removes the resources left behind by removed or failed pods
+	SystemPrune(ctx context.Context, in *SystemPruneRequest, opts ...grpc.CallOption) (*SystemPruneResponse, error)
+	// Events streams the lifecycle events of pods and containers
+	Events(ctx context.Context, in *EventsRequest, opts ...grpc.CallOption) (PublicAPI_EventsClient, error)
+}
+
+type publicAPIClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewPublicAPIClient(cc *grpc.ClientConn) PublicAPIClient {
+	return &publicAPIClient{cc}
+}
+
+func (c *publicAPIClient) PodList(ctx context.Context, in *PodListRequest, opts ...grpc.CallOption) (*PodListResponse, error) {
+	out := new(PodListResponse)
+	err := grpc.Invoke(ctx, "/types.PublicAPI/PodList", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *publicAPIClient) PodCreate(ctx context.Context, in *PodCreateRequest, opts ...grpc.CallOption) (*PodCreateResponse, error) {
+	out := new(PodCreateResponse)
+	err := grpc.Invoke(ctx, "/types.PublicAPI/PodCreate", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *publicAPIClient) PodInfo(ctx context.Context, in *PodInfoRequest, opts ...grpc.CallOption) (*PodInfoResponse, error) {
+	out := new(PodInfoResponse)
+	err := grpc.Invoke(ctx, "/types.PublicAPI/PodInfo", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *publicAPIClient) PodRemove(ctx context.Context, in *PodRemoveRequest, opts ...grpc.CallOption) (*PodRemoveResponse, error) {
+	out := new(PodRemoveResponse)
+	err := grpc.Invoke(ctx, "/types.PublicAPI/PodRemove", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *publicAPIClient) PodStart(ctx context.Context, in *PodStartRequest, opts ...grpc.CallOption) (*PodStartResponse, error) {
+	out := new(PodStartResponse)
+	err := grpc.Invoke(ctx, "/types.PublicAPI/PodStart", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *publicAPIClient) PodStop(ctx context.Context, in *PodStopRequest, opts ...grpc.CallOption) (*PodStopResponse, error) {
+	out := new(PodStopResponse)
+	err := grpc.Invoke(ctx, "/types.PublicAPI/PodStop", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *publicAPIClient) PodSignal(ctx context.Context, in *PodSignalRequest, opts ...grpc.CallOption) (*PodSignalResponse, error) {
+	out := new(PodSignalResponse)
+	err := grpc.Invoke(ctx, "/types.PublicAPI/PodSignal", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *publicAPIClient) PodPause(ctx context.Context, in *PodPauseRequest, opts ...grpc.CallOption) (*PodPauseResponse, error) {
+	out := new(PodPauseResponse)
+	err := grpc.Invoke(ctx, "/types.PublicAPI/PodPause", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *publicAPIClient) PodUnpause(ctx context.Context, in *PodUnpauseRequest, opts ...grpc.CallOption) (*PodUnpauseResponse, error) {
+	out := new(PodUnpauseResponse)
+	err := grpc.Invoke(ctx, "/types.PublicAPI/PodUnpause", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *publicAPIClient) PodUpdateResources(ctx context.Context, in *PodUpdateResourcesRequest, opts ...grpc.CallOption) (*PodUpdateResourcesResponse, error) {
+	out := new(PodUpdateResourcesResponse)
+	err := grpc.Invoke(ctx, "/types.PublicAPI/PodUpdateResources", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *publicAPIClient) ExecVM(ctx context.Context, opts ...grpc.CallOption) (PublicAPI_ExecVMClient, error) {
+	stream, err := grpc.NewClientStream(ctx, &_PublicAPI_serviceDesc.Streams[0], c.cc, "/types.PublicAPI/ExecVM", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &publicAPIExecVMClient{stream}
+	return x, nil
+}
+
+type PublicAPI_ExecVMClient interface {
+	Send(*ExecVMRequest) error
+	Recv() (*ExecVMResponse, error)
+	grpc.ClientStream
+}
+
+type publicAPIExecVMClient struct {
+	grpc.ClientStream
+}
+
+func (x *publicAPIExecVMClient) Send(m *ExecVMRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *publicAPIExecVMClient) Recv() (*ExecVMResponse, error) {
+	m := new(ExecVMResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *publicAPIClient) ContainerList(ctx context.Context, in *ContainerListRequest, opts ...grpc.CallOption) (*ContainerListResponse, error) {
+	out := new(ContainerListResponse)
+	err := grpc.Invoke(ctx, "/types.PublicAPI/ContainerList", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *publicAPIClient) ContainerInfo(ctx context.Context, in *ContainerInfoRequest, opts ...grpc.CallOption) (*ContainerInfoResponse, error) {
+	out := new(ContainerInfoResponse)
+	err := grpc.Invoke(ctx, "/types.PublicAPI/ContainerInfo", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *publicAPIClient) ImageList(ctx context.Context, in *ImageListRequest, opts ...grpc.CallOption) (*ImageListResponse, error) {
+	out := new(ImageListResponse)
+	err := grpc.Invoke(ctx, "/types.PublicAPI/ImageList", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *publicAPIClient) VMList(ctx context.Context, in *VMListRequest, opts ...grpc.CallOption) (*VMListResponse, error) {
+	out := new(VMListResponse)
+	err := grpc.Invoke(ctx, "/types.PublicAPI/VMList", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *publicAPIClient) SetPodLabels(ctx context.Context, in *PodLabelsRequest, opts ...grpc.CallOption) (*PodLabelsResponse, error) {
+	out := new(PodLabelsResponse)
+	err := grpc.Invoke(ctx, "/types.PublicAPI/SetPodLabels", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *publicAPIClient) PodStats(ctx context.Context, in *PodStatsRequest, opts ...grpc.CallOption) (*PodStatsResponse, error) {
+	out := new(PodStatsResponse)
+	err := grpc.Invoke(ctx, "/types.PublicAPI/PodStats", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *publicAPIClient) PodStatsStream(ctx context.Context, in *PodStatsStreamRequest, opts ...grpc.CallOption) (PublicAPI_PodStatsStreamClient, error) {
+	stream, err := grpc.NewClientStream(ctx, &_PublicAPI_serviceDesc.Streams[1], c.cc, "/types.PublicAPI/PodStatsStream", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &publicAPIPodStatsStreamClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type PublicAPI_PodStatsStreamClient interface {
+	Recv() (*PodStatsStreamResponse, error)
+	grpc.ClientStream
+}
+
+type publicAPIPodStatsStreamClient struct {
+	grpc.ClientStream
+}
+
+func (x *publicAPIPodStatsStreamClient) Recv() (*PodStatsStreamResponse, error) {
+	m := new(PodStatsStreamResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *publicAPIClient) ContainerLogs(ctx context.Context, in *ContainerLogsRequest, opts ...grpc.CallOption) (PublicAPI_ContainerLogsClient, error) {
+	stream, err := grpc.NewClientStream(ctx, &_PublicAPI_serviceDesc.Streams[2], c.cc, "/types.PublicAPI/ContainerLogs", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &publicAPIContainerLogsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type PublicAPI_ContainerLogsClient interface {
+	Recv() (*ContainerLogsResponse, error)
+	grpc.ClientStream
+}
+
+type publicAPIContainerLogsClient struct {
+	grpc.ClientStream
+}
+
+func (x *publicAPIContainerLogsClient) Recv() (*ContainerLogsResponse, error) {
+	m := new(ContainerLogsResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *publicAPIClient) ContainerCreate(ctx context.Context, in *ContainerCreateRequest, opts ...grpc.CallOption) (*ContainerCreateResponse, error) {
+	out := new(ContainerCreateResponse)
+	err := grpc.Invoke(ctx, "/types.PublicAPI/ContainerCreate", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *publicAPIClient) ContainerStart(ctx context.Context, in *ContainerStartRequest, opts ...grpc.CallOption) (*ContainerStartResponse, error) {
+	out := new(ContainerStartResponse)
+	err := grpc.Invoke(ctx, "/types.PublicAPI/ContainerStart", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *publicAPIClient) ContainerRename(ctx context.Context, in *ContainerRenameRequest, opts ...grpc.CallOption) (*ContainerRenameResponse, error) {
+	out := new(ContainerRenameResponse)
+	err := grpc.Invoke(ctx, "/types.PublicAPI/ContainerRename", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *publicAPIClient) ContainerCopyFrom(ctx context.Context, in *ContainerCopyFromRequest, opts ...grpc.CallOption) (PublicAPI_ContainerCopyFromClient, error) {
+	stream, err := grpc.NewClientStream(ctx, &_PublicAPI_serviceDesc.Streams[3], c.cc, "/types.PublicAPI/ContainerCopyFrom", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &publicAPIContainerCopyFromClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type PublicAPI_ContainerCopyFromClient interface {
+	Recv() (*ContainerCopyData, error)
+	grpc.ClientStream
+}
+
+type publicAPIContainerCopyFromClient struct {
+	grpc.ClientStream
+}
+
+func (x *publicAPIContainerCopyFromClient) Recv() (*ContainerCopyData, error) {
+	m := new(ContainerCopyData)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *publicAPIClient) ContainerCopyTo(ctx context.Context, opts ...grpc.CallOption) (PublicAPI_ContainerCopyToClient, error) {
+	stream, err := grpc.NewClientStream(ctx, &_PublicAPI_serviceDesc.Streams[4], c.cc, "/types.PublicAPI/ContainerCopyTo", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &publicAPIContainerCopyToClient{stream}
+	return x, nil
+}
+
+type PublicAPI_ContainerCopyToClient interface {
+	Send(*ContainerCopyToRequest) error
+	CloseAndRecv() (*ContainerCopyToResponse, error)
+	grpc.ClientStream
+}
+
+type publicAPIContainerCopyToClient struct {
+	grpc.ClientStream
+}
+
+func (x *publicAPIContainerCopyToClient) Send(m *ContainerCopyToRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *publicAPIContainerCopyToClient) CloseAndRecv() (*ContainerCopyToResponse, error) {
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	m := new(ContainerCopyToResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *publicAPIClient) ContainerSignal(ctx context.Context, in *ContainerSignalRequest, opts ...grpc.CallOption) (*ContainerSignalResponse, error) {
+	out := new(ContainerSignalResponse)
+	err := grpc.Invoke(ctx, "/types.PublicAPI/ContainerSignal", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *publicAPIClient) ContainerStop(ctx context.Context, in *ContainerStopRequest, opts ...grpc.CallOption) (*ContainerStopResponse, error) {
+	out := new(ContainerStopResponse)
+	err := grpc.Invoke(ctx, "/types.PublicAPI/ContainerStop", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *publicAPIClient) ContainerRemove(ctx context.Context, in *ContainerRemoveRequest, opts ...grpc.CallOption) (*ContainerRemoveResponse, error) {
+	out := new(ContainerRemoveResponse)
+	err := grpc.Invoke(ctx, "/types.PublicAPI/ContainerRemove", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *publicAPIClient) ExecCreate(ctx context.Context, in *ExecCreateRequest, opts ...grpc.CallOption) (*ExecCreateResponse, error) {
+	out := new(ExecCreateResponse)
+	err := grpc.Invoke(ctx, "/types.PublicAPI/ExecCreate", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *publicAPIClient) ExecStart(ctx context.Context, opts ...grpc.CallOption) (PublicAPI_ExecStartClient, error) {
+	stream, err := grpc.NewClientStream(ctx, &_PublicAPI_serviceDesc.Streams[5], c.cc, "/types.PublicAPI/ExecStart", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &publicAPIExecStartClient{stream}
+	return x, nil
+}
+
+type PublicAPI_ExecStartClient interface {
+	Send(*ExecStartRequest) error
+	Recv() (*ExecStartResponse, error)
+	grpc.ClientStream
+}
+
+type publicAPIExecStartClient struct {
+	grpc.ClientStream
+}
+
+func (x *publicAPIExecStartClient) Send(m *ExecStartRequest) error {
+	return x.ClientStream.SendMsg(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
+func (x *publicAPIExecStartClient) Recv() (*ExecStartResponse, error) {
+	m := new(ExecStartResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
 
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+func (c *publicAPIClient) ExecSignal(ctx context.Context, in *ExecSignalRequest, opts ...grpc.CallOption) (*ExecSignalResponse, error) {
+	out := new(ExecSignalResponse)
+	err := grpc.Invoke(ctx, "/types.PublicAPI/ExecSignal", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *publicAPIClient) Attach(ctx context.Context, opts ...grpc.CallOption) (PublicAPI_AttachClient, error) {
+	stream, err := grpc.NewClientStream(ctx, &_PublicAPI_serviceDesc.Streams[6], c.cc, "/types.PublicAPI/Attach", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &publicAPIAttachClient{stream}
+	return x, nil
+}
+
+type PublicAPI_AttachClient interface {
+	Send(*AttachMessage) error
+	Recv() (*AttachMessage, error)
+	grpc.ClientStream
+}
+
+type publicAPIAttachClient struct {
+	grpc.ClientStream
+}
+
+func (x *publicAPIAttachClient) Send(m *AttachMessage) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *publicAPIAttachClient) Recv() (*AttachMessage, error) {
+	m := new(AttachMessage)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *publicAPIClient) Wait(ctx context.Context, in *WaitRequest, opts ...grpc.CallOption) (*WaitResponse, error) {
+	out := new(WaitResponse)
+	err := grpc.Invoke(ctx, "/types.PublicAPI/Wait", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *publicAPIClient) TTYResize(ctx context.Context, in *TTYResizeRequest, opts ...grpc.CallOption) (*TTYResizeResponse, error) {
+	out := new(TTYResizeResponse)
+	err := grpc.Invoke(ctx, "/types.PublicAPI/TTYResize", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *publicAPIClient) ServiceList(ctx context.Context, in *ServiceListRequest, opts ...grpc.CallOption) (*ServiceListResponse, error) {
+	out := new(ServiceListResponse)
+	err := grpc.Invoke(ctx, "/types.PublicAPI/ServiceList", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *publicAPIClient) ServiceAdd(ctx context.Context, in *ServiceAddRequest, opts ...grpc.CallOption) (*ServiceAddResponse, error) {
+	out := new(ServiceAddResponse)
+	err := grpc.Invoke(ctx, "/types.PublicAPI/ServiceAdd", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *publicAPIClient) ServiceDelete(ctx context.Context, in *ServiceDelRequest, opts ...grpc.CallOption) (*ServiceDelResponse, error) {
+	out := new(ServiceDelResponse)
+	err := grpc.Invoke(ctx, "/types.PublicAPI/ServiceDelete", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *publicAPIClient) ServiceUpdate(ctx context.Context, in *ServiceUpdateRequest, opts ...grpc.CallOption) (*ServiceUpdateResponse, error) {
+	out := new(ServiceUpdateResponse)
+	err := grpc.Invoke(ctx, "/types.PublicAPI/ServiceUpdate", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *publicAPIClient) PortMappingList(ctx context.Context, in *PortMappingListRequest, opts ...grpc.CallOption) (*PortMappingListResponse, error) {
+	out := new(PortMappingListResponse)
+	err := grpc.Invoke(ctx, "/types.PublicAPI/PortMappingList", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *publicAPIClient) PortMappingAdd(ctx context.Context, in *PortMappingModifyRequest, opts ...grpc.CallOption) (*PortMappingModifyResponse, error) {
+	out := new(PortMappingModifyResponse)
+	err := grpc.Invoke(ctx, "/types.PublicAPI/PortMappingAdd", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *publicAPIClient) PortMappingDel(ctx context.Context, in *PortMappingModifyRequest, opts ...grpc.CallOption) (*PortMappingModifyResponse, error) {
+	out := new(PortMappingModifyResponse)
+	err := grpc.Invoke(ctx, "/types.PublicAPI/PortMappingDel", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *publicAPIClient) PodInterfaceList(ctx context.Context, in *PodInterfaceListRequest, opts ...grpc.CallOption) (*PodInterfaceListResponse, error) {
+	out := new(PodInterfaceListResponse)
+	err := grpc.Invoke(ctx, "/types.PublicAPI/PodInterfaceList", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *publicAPIClient) PodInterfaceAdd(ctx context.Context, in *PodInterfaceAddRequest, opts ...grpc.CallOption) (*PodInterfaceAddResponse, error) {
+	out := new(PodInterfaceAddResponse)
+	err := grpc.Invoke(ctx, "/types.PublicAPI/PodInterfaceAdd", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *publicAPIClient) PodInterfaceRemove(ctx context.Context, in *PodInterfaceRemoveRequest, opts ...grpc.CallOption) (*PodInterfaceRemoveResponse, error) {
+	out := new(PodInterfaceRemoveResponse)
+	err := grpc.Invoke(ctx, "/types.PublicAPI/PodInterfaceRemove", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *publicAPIClient) ImagePull(ctx context.Context, in *ImagePullRequest, opts ...grpc.CallOption) (PublicAPI_ImagePullClient, error) {
+	stream, err := grpc.NewClientStream(ctx, &_PublicAPI_serviceDesc.Streams[7], c.cc, "/types.PublicAPI/ImagePull", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &publicAPIImagePullClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type PublicAPI_ImagePullClient interface {
+	Recv() (*ImagePullResponse, error)
+	grpc.ClientStream
+}
+
+type publicAPIImagePullClient struct {
+	grpc.ClientStream
+}
+
+func (x *publicAPIImagePullClient) Recv() (*ImagePullResponse, error) {
+	m := new(ImagePullResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *publicAPIClient) ImagePush(ctx context.Context, in *ImagePushRequest, opts ...grpc.CallOption) (PublicAPI_ImagePushClient, error) {
+	stream, err := grpc.NewClientStream(ctx, &_PublicAPI_serviceDesc.Streams[8], c.cc, "/types.PublicAPI/ImagePush", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &publicAPIImagePushClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type PublicAPI_ImagePushClient interface {
+	Recv() (*ImagePushResponse, error)
+	grpc.ClientStream
+}
+
+type publicAPIImagePushClient struct {
+	grpc.ClientStream
+}
+
+func (x *publicAPIImagePushClient) Recv() (*ImagePushResponse, error) {
+	m := new(ImagePushResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *publicAPIClient) ImageRemove(ctx context.Context, in *ImageRemoveRequest, opts ...grpc.CallOption) (*ImageRemoveResponse, error) {
+	out := new(ImageRemoveResponse)
+	err := grpc.Invoke(ctx, "/types.PublicAPI/ImageRemove", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *publicAPIClient) VolumeCreate(ctx context.Context, in *VolumeCreateRequest, opts ...grpc.CallOption) (*VolumeCreateResponse, error) {
+	out := new(VolumeCreateResponse)
+	err := grpc.Invoke(ctx, "/types.PublicAPI/VolumeCreate", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *publicAPIClient) VolumeList(ctx context.Context, in *VolumeListRequest, opts ...grpc.CallOption) (*VolumeListResponse, error) {
+	out := new(VolumeListResponse)
+	err := grpc.Invoke(ctx, "/types.PublicAPI/VolumeList", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *publicAPIClient) VolumeInspect(ctx context.Context, in *VolumeInspectRequest, opts ...grpc.CallOption) (*VolumeInspectResponse, error) {
+	out := new(VolumeInspectResponse)
+	err := grpc.Invoke(ctx, "/types.PublicAPI/VolumeInspect", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *publicAPIClient) VolumeRemove(ctx context.Context, in *VolumeRemoveRequest, opts ...grpc.CallOption) (*VolumeRemoveResponse, error) {
+	out := new(VolumeRemoveResponse)
+	err := grpc.Invoke(ctx, "/types.PublicAPI/VolumeRemove", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *publicAPIClient) VolumeResize(ctx context.Context, in *VolumeResizeRequest, opts ...grpc.CallOption) (*VolumeResizeResponse, error) {
+	out := new(VolumeResizeResponse)
+	err := grpc.Invoke(ctx, "/types.PublicAPI/VolumeResize", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *publicAPIClient) VolumeSnapshot(ctx context.Context, in *VolumeSnapshotRequest, opts ...grpc.CallOption) (*VolumeSnapshotResponse, error) {
+	out := new(VolumeSnapshotResponse)
+	err := grpc.Invoke(ctx, "/types.PublicAPI/VolumeSnapshot", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
 
-// Client API for PublicAPI service
+func (c *publicAPIClient) VolumeSnapshotList(ctx context.Context, in *VolumeSnapshotListRequest, opts ...grpc.CallOption) (*VolumeSnapshotListResponse, error) {
+	out := new(VolumeSnapshotListResponse)
+	err := grpc.Invoke(ctx, "/types.PublicAPI/VolumeSnapshotList", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
 
-type PublicAPIClient interface {
-	// PodList gets a list of pods
-	PodList(ctx context.Context, in *PodListRequest, opts ...grpc.CallOption) (*PodListResponse, error)
-	// PodCreate creates a pod according to UserPod
-	PodCreate(ctx context.Context, in *PodCreateRequest, opts ...grpc.CallOption) (*PodCreateResponse, error)
-	// PodInfo gets pod's info by podID
-	PodInfo(ctx context.Context, in *PodInfoRequest, opts ...grpc.CallOption) (*PodInfoResponse, error)
-	// PodRemove deletes a pod by podID
-	PodRemove(ctx context.Context, in *PodRemoveRequest, opts ...grpc.CallOption) (*PodRemoveResponse, error)
-	// PodStart starts a pod
-	PodStart(ctx context.Context, in *PodStartRequest, opts ...grpc.CallOption) (*PodStartResponse, error)
-	// PodStop stops a pod
-	PodStop(ctx context.Context, in *PodStopRequest, opts ...grpc.CallOption) (*PodStopResponse, error)
-	// PodSignal sends a signal to all containers of specified pod
-	PodSignal(ctx context.Context, in *PodSignalRequest, opts ...grpc.CallOption) (*PodSignalResponse, error)
-	// PodPause pauses a pod
-	PodPause(ctx context.Context, in *PodPauseRequest, opts ...grpc.CallOption) (*PodPauseResponse, error)
-	// PodUnpause unpauses a pod
-	PodUnpause(ctx context.Context, in *PodUnpauseRequest, opts ...grpc.CallOption) (*PodUnpauseResponse, error)
-	// ExecVM executes a command outside of any containers.
-	ExecVM(ctx context.Context, opts ...grpc.CallOption) (PublicAPI_ExecVMClient, error)
-	// ContainerList gets a list of containers
-	ContainerList(ctx context.Context, in *ContainerListRequest, opts ...grpc.CallOption) (*ContainerListResponse, error)
-	// ContainerInfo gets container's info by container's id or name
-	ContainerInfo(ctx context.Context, in *ContainerInfoRequest, opts ...grpc.CallOption) (*ContainerInfoResponse, error)
-	// ImageList gets a list of images by filters
-	ImageList(ctx context.Context, in *ImageListRequest, opts ...grpc.CallOption) (*ImageListResponse, error)
-	// VMList gets a list of HyperVMs
-	VMList(ctx context.Context, in *VMListRequest, opts ...grpc.CallOption) (*VMListResponse, error)
-	// SetPodLabels sets labels of given pod
-	SetPodLabels(ctx context.Context, in *PodLabelsRequest, opts ...grpc.CallOption) (*PodLabelsResponse, error)
-	// PodStats gets pod stats of a given pod
-	PodStats(ctx context.Context, in *PodStatsRequest, opts ...grpc.CallOption) (*PodStatsResponse, error)
-	// ContainerLogs gets the log of specified container
-	ContainerLogs(ctx context.Context, in *ContainerLogsRequest, opts ...grpc.CallOption) (PublicAPI_ContainerLogsClient, error)
-	// ContainerCreate creates a container in specified pod
-	ContainerCreate(ctx context.Context, in *ContainerCreateRequest, opts ...grpc.CallOption) (*ContainerCreateResponse, error)
-	// ContainerStart starts a container in a specified pod
-	ContainerStart(ctx context.Context, in *ContainerStartRequest, opts ...grpc.CallOption) (*ContainerStartResponse, error)
-	// ContainerRename renames a container
-	ContainerRename(ctx context.Context, in *ContainerRenameRequest, opts ...grpc.CallOption) (*ContainerRenameResponse, error)
-	// TODO: ContainerCommit commits the changes of the specified container
-	// ContainerSignal sends a signal to specified container
-	ContainerSignal(ctx context.Context, in *ContainerSignalRequest, opts ...grpc.CallOption) (*ContainerSignalResponse, error)
-	// TODO: ContainerLabels updates labels of the specified container
-	// ContainerStop stops the specified container
-	ContainerStop(ctx context.Context, in *ContainerStopRequest, opts ...grpc.CallOption) (*ContainerStopResponse, error)
-	// ContainerRemove removes a container from a specified pod
-	ContainerRemove(ctx context.Context, in *ContainerRemoveRequest, opts ...grpc.CallOption) (*ContainerRemoveResponse, error)
-	// ExecCreate creates exec in specified container
-	ExecCreate(ctx context.Context, in *ExecCreateRequest, opts ...grpc.CallOption) (*ExecCreateResponse, error)
-	// ExecStart starts exec
-	ExecStart(ctx context.Context, opts ...grpc.CallOption) (PublicAPI_ExecStartClient, error)
-	// ExecSignal sends a signal to specified exec in specified container
-	ExecSignal(ctx context.Context, in *ExecSignalRequest, opts ...grpc.CallOption) (*ExecSignalResponse, error)
-	// Attach attaches to the specified container
-	Attach(ctx context.Context, opts ...grpc.CallOption) (PublicAPI_AttachClient, error)
-	// Wait gets the exit code of the specified container
-	Wait(ctx context.Context, in *WaitRequest, opts ...grpc.CallOption) (*WaitResponse, error)
-	// TTYResize resizes the tty of the specified container
-	TTYResize(ctx context.Context, in *TTYResizeRequest, opts ...grpc.CallOption) (*TTYResizeResponse, error)
-	// ServiceList gets a list of services
-	ServiceList(ctx context.Context, in *ServiceListRequest, opts ...grpc.CallOption) (*ServiceListResponse, error)
-	// ServiceAdd add a service to a pod
-	ServiceAdd(ctx context.Context, in *ServiceAddRequest, opts ...grpc.CallOption) (*ServiceAddResponse, error)
-	// ServiceDelete delete a service from a pod
-	ServiceDelete(ctx context.Context, in *ServiceDelRequest, opts ...grpc.CallOption) (*ServiceDelResponse, error)
-	// ServiceUpdate updates an existing service of a pod
-	ServiceUpdate(ctx context.Context, in *ServiceUpdateRequest, opts ...grpc.CallOption) (*ServiceUpdateResponse, error)
-	// PortMappingList get a list of PortMappings
-	PortMappingList(ctx context.Context, in *PortMappingListRequest, opts ...grpc.CallOption) (*PortMappingListResponse, error)
-	// PortMappingAdd add a list of PortMapping rules to a Pod
-	PortMappingAdd(ctx context.Context, in *PortMappingModifyRequest, opts ...grpc.CallOption) (*PortMappingModifyResponse, error)
-	// PortMappingDel remove a list of PortMapping rules from a Pod
-	PortMappingDel(ctx context.Context, in *PortMappingModifyRequest, opts ...grpc.CallOption) (*PortMappingModifyResponse, error)
-	// ImagePull pulls a image from registry
-	ImagePull(ctx context.Context, in *ImagePullRequest, opts ...grpc.CallOption) (PublicAPI_ImagePullClient, error)
-	// ImagePush pushes a local image to registry
-	ImagePush(ctx context.Context, in *ImagePushRequest, opts ...grpc.CallOption) (PublicAPI_ImagePushClient, error)
-	// ImageRemove deletes a image from hyperd
-	ImageRemove(ctx context.Context, in *ImageRemoveRequest, opts ...grpc.CallOption) (*ImageRemoveResponse, error)
-	// Ping checks if hyperd is running (returns 'OK' on success)
-	Ping(ctx context.Context, in *PingRequest, opts ...grpc.CallOption) (*PingResponse, error)
-	// Info gets the info of hyperd
-	Info(ctx context.Context, in *InfoRequest, opts ...grpc.CallOption) (*InfoResponse, error)
-	// Version gets the version and apiVersion of hyperd
-	Version(ctx context.Context, in *VersionRequest, opts ...grpc.CallOption) (*VersionResponse, error)
+func (c *publicAPIClient) VolumeSnapshotRemove(ctx context.Context, in *VolumeSnapshotRemoveRequest, opts ...grpc.CallOption) (*VolumeSnapshotRemoveResponse, error) {
+	out := new(VolumeSnapshotRemoveResponse)
+	err := grpc.Invoke(ctx, "/types.PublicAPI/VolumeSnapshotRemove", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-type publicAPIClient struct {
-	cc *grpc.ClientConn
+func (c *publicAPIClient) VolumeRestore(ctx context.Context, in *VolumeRestoreRequest, opts ...grpc.CallOption) (*VolumeRestoreResponse, error) {
+	out := new(VolumeRestoreResponse)
+	err := grpc.Invoke(ctx, "/types.PublicAPI/VolumeRestore", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func NewPublicAPIClient(cc *grpc.ClientConn) PublicAPIClient {
-	return &publicAPIClient{cc}
+func (c *publicAPIClient) VolumeClone(ctx context.Context, in *VolumeCloneRequest, opts ...grpc.CallOption) (*VolumeCloneResponse, error) {
+	out := new(VolumeCloneResponse)
+	err := grpc.Invoke(ctx, "/types.PublicAPI/VolumeClone", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (c *publicAPIClient) PodList(ctx context.Context, in *PodListRequest, opts ...grpc.CallOption) (*PodListResponse, error) {
-	out := new(PodListResponse)
-	err := grpc.Invoke(ctx, "/types.PublicAPI/PodList", in, out, c.cc, opts...)
+func (c *publicAPIClient) NetworkCreate(ctx context.Context, in *NetworkCreateRequest, opts ...grpc.CallOption) (*NetworkCreateResponse, error) {
+	out := new(NetworkCreateResponse)
+	err := grpc.Invoke(ctx, "/types.PublicAPI/NetworkCreate", in, out, c.cc, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *publicAPIClient) PodCreate(ctx context.Context, in *PodCreateRequest, opts ...grpc.CallOption) (*PodCreateResponse, error) {
-	out := new(PodCreateResponse)
-	err := grpc.Invoke(ctx, "/types.PublicAPI/PodCreate", in, out, c.cc, opts...)
+func (c *publicAPIClient) NetworkList(ctx context.Context, in *NetworkListRequest, opts ...grpc.CallOption) (*NetworkListResponse, error) {
+	out := new(NetworkListResponse)
+	err := grpc.Invoke(ctx, "/types.PublicAPI/NetworkList", in, out, c.cc, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *publicAPIClient) PodInfo(ctx context.Context, in *PodInfoRequest, opts ...grpc.CallOption) (*PodInfoResponse, error) {
-	out := new(PodInfoResponse)
-	err := grpc.Invoke(ctx, "/types.PublicAPI/PodInfo", in, out, c.cc, opts...)
+func (c *publicAPIClient) NetworkInspect(ctx context.Context, in *NetworkInspectRequest, opts ...grpc.CallOption) (*NetworkInspectResponse, error) {
+	out := new(NetworkInspectResponse)
+	err := grpc.Invoke(ctx, "/types.PublicAPI/NetworkInspect", in, out, c.cc, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *publicAPIClient) PodRemove(ctx context.Context, in *PodRemoveRequest, opts ...grpc.CallOption) (*PodRemoveResponse, error) {
-	out := new(PodRemoveResponse)
-	err := grpc.Invoke(ctx, "/types.PublicAPI/PodRemove", in, out, c.cc, opts...)
+func (c *publicAPIClient) NetworkRemove(ctx context.Context, in *NetworkRemoveRequest, opts ...grpc.CallOption) (*NetworkRemoveResponse, error) {
+	out := new(NetworkRemoveResponse)
+	err := grpc.Invoke(ctx, "/types.PublicAPI/NetworkRemove", in, out, c.cc, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *publicAPIClient) PodStart(ctx context.Context, in *PodStartRequest, opts ...grpc.CallOption) (*PodStartResponse, error) {
-	out := new(PodStartResponse)
-	err := grpc.Invoke(ctx, "/types.PublicAPI/PodStart", in, out, c.cc, opts...)
+func (c *publicAPIClient) Ping(ctx context.Context, in *PingRequest, opts ...grpc.CallOption) (*PingResponse, error) {
+	out := new(PingResponse)
+	err := grpc.Invoke(ctx, "/types.PublicAPI/Ping", in, out, c.cc, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *publicAPIClient) PodStop(ctx context.Context, in *PodStopRequest, opts ...grpc.CallOption) (*PodStopResponse, error) {
-	out := new(PodStopResponse)
-	err := grpc.Invoke(ctx, "/types.PublicAPI/PodStop", in, out, c.cc, opts...)
+func (c *publicAPIClient) Info(ctx context.Context, in *InfoRequest, opts ...grpc.CallOption) (*InfoResponse, error) {
+	out := new(InfoResponse)
+	err := grpc.Invoke(ctx, "/types.PublicAPI/Info", in, out, c.cc, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *publicAPIClient) PodSignal(ctx context.Context, in *PodSignalRequest, opts ...grpc.CallOption) (*PodSignalResponse, error) {
-	out := new(PodSignalResponse)
-	err := grpc.Invoke(ctx, "/types.PublicAPI/PodSignal", in, out, c.cc, opts...)
+func (c *publicAPIClient) Version(ctx context.Context, in *VersionRequest, opts ...grpc.CallOption) (*VersionResponse, error) {
+	out := new(VersionResponse)
+	err := grpc.Invoke(ctx, "/types.PublicAPI/Version", in, out, c.cc, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *publicAPIClient) PodPause(ctx context.Context, in *PodPauseRequest, opts ...grpc.CallOption) (*PodPauseResponse, error) {
-	out := new(PodPauseResponse)
-	err := grpc.Invoke(ctx, "/types.PublicAPI/PodPause", in, out, c.cc, opts...)
+func (c *publicAPIClient) SystemPrune(ctx context.Context, in *SystemPruneRequest, opts ...grpc.CallOption) (*SystemPruneResponse, error) {
+	out := new(SystemPruneResponse)
+	err := grpc.Invoke(ctx, "/types.PublicAPI/SystemPrune", in, out, c.cc, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *publicAPIClient) PodUnpause(ctx context.Context, in *PodUnpauseRequest, opts ...grpc.CallOption) (*PodUnpauseResponse, error) {
-	out := new(PodUnpauseResponse)
-	err := grpc.Invoke(ctx, "/types.PublicAPI/PodUnpause", in, out, c.cc, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (c *publicAPIClient) Events(ctx context.Context, in *EventsRequest, opts ...grpc.CallOption) (PublicAPI_EventsClient, error) {
+	stream, err := grpc.NewClientStream(ctx, &_PublicAPI_serviceDesc.Streams[9], c.cc, "/types.PublicAPI/Events", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &publicAPIEventsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type PublicAPI_EventsClient interface {
+	Recv() (*Event, error)
+	grpc.ClientStream
+}
+
+type publicAPIEventsClient struct {
+	grpc.ClientStream
+}
+
+func (x *publicAPIEventsClient) Recv() (*Event, error) {
+	m := new(Event)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// Server API for PublicAPI service
+
+type PublicAPIServer interface {
+	// PodList gets a list of pods
+	PodList(context.Context, *PodListRequest) (*PodListResponse, error)
+	// PodCreate creates a pod according to UserPod
+	PodCreate(context.Context, *PodCreateRequest) (*PodCreateResponse, error)
+	// PodInfo gets pod's info by podID
+	PodInfo(context.Context, *PodInfoRequest) (*PodInfoResponse, error)
+	// PodRemove deletes a pod by podID
+	PodRemove(context.Context, *PodRemoveRequest) (*PodRemoveResponse, error)
+	// PodStart starts a pod
+	PodStart(context.Context, *PodStartRequest) (*PodStartResponse, error)
+	// PodStop stops a pod
+	PodStop(context.Context, *PodStopRequest) (*PodStopResponse, error)
+	// PodSignal sends a signal to all containers of specified pod
+	PodSignal(context.Context, *PodSignalRequest) (*PodSignalResponse, error)
+	// PodPause pauses a pod
+	PodPause(context.Context, *PodPauseRequest) (*PodPauseResponse, error)
+	// PodUnpause unpauses a pod
+	PodUnpause(context.Context, *PodUnpauseRequest) (*PodUnpauseResponse, error)
+	// PodUpdateResources hotplugs vcpus and memory to a running pod
+	PodUpdateResources(context.Context, *PodUpdateResourcesRequest) (*PodUpdateResourcesResponse, error)
+	// ExecVM executes a command outside of any containers.
+	ExecVM(PublicAPI_ExecVMServer) error
+	// ContainerList gets a list of containers
+	ContainerList(context.Context, *ContainerListRequest) (*ContainerListResponse, error)
+	// ContainerInfo gets container's info by container's id or name
+	ContainerInfo(context.Context, *ContainerInfoRequest) (*ContainerInfoResponse, error)
+	// ImageList gets a list of images by filters
+	ImageList(context.Context, *ImageListRequest) (*ImageListResponse, error)
+	// VMList gets a list of HyperVMs
+	VMList(context.Context, *VMListRequest) (*VMListResponse, error)
+	// SetPodLabels sets labels of given pod
+	SetPodLabels(context.Context, *PodLabelsRequest) (*PodLabelsResponse, error)
+	// PodStats gets pod stats of a given pod
+	PodStats(context.Context, *PodStatsRequest) (*PodStatsResponse, error)
+	// PodStatsStream streams the stats samples of the given pods
+	PodStatsStream(*PodStatsStreamRequest, PublicAPI_PodStatsStreamServer) error
+	// ContainerLogs gets the log of specified container
+	ContainerLogs(*ContainerLogsRequest, PublicAPI_ContainerLogsServer) error
+	// ContainerCreate creates a container in specified pod
+	ContainerCreate(context.Context, *ContainerCreateRequest) (*ContainerCreateResponse, error)
+	// ContainerStart starts a container in a specified pod
+	ContainerStart(context.Context, *ContainerStartRequest) (*ContainerStartResponse, error)
+	// ContainerRename renames a container
+	ContainerRename(context.Context, *ContainerRenameRequest) (*ContainerRenameResponse, error)
+	// ContainerCopyFrom copies files out of a container as a tar stream
+	ContainerCopyFrom(*ContainerCopyFromRequest, PublicAPI_ContainerCopyFromServer) error
+	// ContainerCopyTo extracts a tar stream to a container
+	ContainerCopyTo(PublicAPI_ContainerCopyToServer) error
+	// TODO: ContainerCommit commits the changes of the specified container
+	// ContainerSignal sends a signal to specified container
+	ContainerSignal(context.Context, *ContainerSignalRequest) (*ContainerSignalResponse, error)
+	// TODO: ContainerLabels updates labels of the specified container
+	// ContainerStop stops the specified container
+	ContainerStop(context.Context, *ContainerStopRequest) (*ContainerStopResponse, error)
+	// ContainerRemove removes a container from a specified pod
+	ContainerRemove(context.Context, *ContainerRemoveRequest) (*ContainerRemoveResponse, error)
+	// ExecCreate creates exec in specified container
+	ExecCreate(context.Context, *ExecCreateRequest) (*ExecCreateResponse, error)
+	// ExecStart starts exec
+	ExecStart(PublicAPI_ExecStartServer) error
+	// ExecSignal sends a signal to specified exec in specified container
+	ExecSignal(context.Context, *ExecSignalRequest) (*ExecSignalResponse, error)
+	// Attach attaches to the specified container
+	Attach(PublicAPI_AttachServer) error
+	// Wait gets the exit code of the specified container
+	Wait(context.Context, *WaitRequest) (*WaitResponse, error)
+	// TTYResize resizes the tty of the specified container
+	TTYResize(context.Context, *TTYResizeRequest) (*TTYResizeResponse, error)
+	// ServiceList gets a list of services
+	ServiceList(context.Context, *ServiceListRequest) (*ServiceListResponse, error)
+	// ServiceAdd add a service to a pod
+	ServiceAdd(context.Context, *ServiceAddRequest) (*ServiceAddResponse, error)
+	// ServiceDelete delete a service from a pod
+	ServiceDelete(context.Context, *ServiceDelRequest) (*ServiceDelResponse, error)
+	// ServiceUpdate updates an existing service of a pod
+	ServiceUpdate(context.Context, *ServiceUpdateRequest) (*ServiceUpdateResponse, error)
+	// PortMappingList get a list of PortMappings
+	PortMappingList(context.Context, *PortMappingListRequest) (*PortMappingListResponse, error)
+	// PortMappingAdd add a list of PortMapping rules to a Pod
+	PortMappingAdd(context.Context, *PortMappingModifyRequest) (*PortMappingModifyResponse, error)
+	// PortMappingDel remove a list of PortMapping rules from a Pod
+	PortMappingDel(context.Context, *PortMappingModifyRequest) (*PortMappingModifyResponse, error)
+	// PodInterfaceList get the network interfaces of a Pod
+	PodInterfaceList(context.Context, *PodInterfaceListRequest) (*PodInterfaceListResponse, error)
+	// PodInterfaceAdd hot-plug a network interface to a running Pod
+	PodInterfaceAdd(context.Context, *PodInterfaceAddRequest) (*PodInterfaceAddResponse, error)
+	// PodInterfaceRemove unplug a network interface from a running Pod
+	PodInterfaceRemove(context.Context, *PodInterfaceRemoveRequest) (*PodInterfaceRemoveResponse, error)
+	// ImagePull pulls a image from registry
+	ImagePull(*ImagePullRequest, PublicAPI_ImagePullServer) error
+	// ImagePush pushes a local image to registry
+	ImagePush(*ImagePushRequest, PublicAPI_ImagePushServer) error
+	// ImageRemove deletes a image from hyperd
+	ImageRemove(context.Context, *ImageRemoveRequest) (*ImageRemoveResponse, error)
+	// VolumeCreate creates a named volume
+	VolumeCreate(context.Context, *VolumeCreateRequest) (*VolumeCreateResponse, error)
+	// VolumeList gets a list of named volumes
+	VolumeList(context.Context, *VolumeListRequest) (*VolumeListResponse, error)
+	// VolumeInspect gets the info of a named volume
+	VolumeInspect(context.Context, *VolumeInspectRequest) (*VolumeInspectResponse, error)
+	// VolumeRemove deletes a named volume which is not used by any pods
+	VolumeRemove(context.Context, *VolumeRemoveRequest) (*VolumeRemoveResponse, error)
+	// VolumeResize grows a volume of a Pod, and the filesystem on it if the Pod is running
+	VolumeResize(context.Context, *VolumeResizeRequest) (*VolumeResizeResponse, error)
+	// VolumeSnapshot takes a copy-on-write snapshot of a named volume
+	VolumeSnapshot(context.Context, *VolumeSnapshotRequest) (*VolumeSnapshotResponse, error)
+	// VolumeSnapshotList gets the snapshots of a named volume
+	VolumeSnapshotList(context.Context, *VolumeSnapshotListRequest) (*VolumeSnapshotListResponse, error)
+	// VolumeSnapshotRemove deletes a snapshot of a named volume
+	VolumeSnapshotRemove(context.Context, *VolumeSnapshotRemoveRequest) (*VolumeSnapshotRemoveResponse, error)
+	// VolumeRestore rolls a named volume, which is not used by any pods, back to a snapshot
+	VolumeRestore(context.Context, *VolumeRestoreRequest) (*VolumeRestoreResponse, error)
+	// VolumeClone creates a named volume from a snapshot of another one
+	VolumeClone(context.Context, *VolumeCloneRequest) (*VolumeCloneResponse, error)
+	// NetworkCreate creates a user-defined network
+	NetworkCreate(context.Context, *NetworkCreateRequest) (*NetworkCreateResponse, error)
+	// NetworkList gets a list of user-defined networks
+	NetworkList(context.Context, *NetworkListRequest) (*NetworkListResponse, error)
+	// NetworkInspect gets the info and the leases of a user-defined network
+	NetworkInspect(context.Context, *NetworkInspectRequest) (*NetworkInspectResponse, error)
+	// NetworkRemove deletes a user-defined network which has no leases
+	NetworkRemove(context.Context, *NetworkRemoveRequest) (*NetworkRemoveResponse, error)
+	// Ping checks if hyperd is running (returns 'OK' on success)
+	Ping(context.Context, *PingRequest) (*PingResponse, error)
+	// Info gets the info of hyperd
+	Info(context.Context, *InfoRequest) (*InfoResponse, error)
+	// Version gets the version and apiVersion of hyperd
+	Version(context.Context, *VersionRequest) (*VersionResponse, error)
+	// SystemPrune removes the resources left behind by removed or failed pods
+	SystemPrune(context.Context, *SystemPruneRequest) (*SystemPruneResponse, error)
+	// Events streams the lifecycle events of pods and containers
+	Events(*EventsRequest, PublicAPI_EventsServer) error
+}
+
+func RegisterPublicAPIServer(s *grpc.Server, srv PublicAPIServer) {
+	s.RegisterService(&_PublicAPI_serviceDesc, srv)
 }
 
-func (c *publicAPIClient) ExecVM(ctx context.Context, opts ...grpc.CallOption) (PublicAPI_ExecVMClient, error) {
-	stream, err := grpc.NewClientStream(ctx, &_PublicAPI_serviceDesc.Streams[0], c.cc, "/types.PublicAPI/ExecVM", opts...)
-	if err != nil {
+func _PublicAPI_PodList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PodListRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	x := &publicAPIExecVMClient{stream}
-	return x, nil
-}
-
-type PublicAPI_ExecVMClient interface {
-	Send(*ExecVMRequest) error
-	Recv() (*ExecVMResponse, error)
-	grpc.ClientStream
-}
-
-type publicAPIExecVMClient struct {
-	grpc.ClientStream
-}
-
-func (x *publicAPIExecVMClient) Send(m *ExecVMRequest) error {
-	return x.ClientStream.SendMsg(m)
-}
-
-func (x *publicAPIExecVMClient) Recv() (*ExecVMResponse, error) {
-	m := new(ExecVMResponse)
-	if err := x.ClientStream.RecvMsg(m); err != nil {
-		return nil, err
+	if interceptor == nil {
+		return srv.(PublicAPIServer).PodList(ctx, in)
 	}
-	return m, nil
-}
-
-func (c *publicAPIClient) ContainerList(ctx context.Context, in *ContainerListRequest, opts ...grpc.CallOption) (*ContainerListResponse, error) {
-	out := new(ContainerListResponse)
-	err := grpc.Invoke(ctx, "/types.PublicAPI/ContainerList", in, out, c.cc, opts...)
-	if err != nil {
-		return nil, err
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/types.PublicAPI/PodList",
 	}
-	return out, nil
-}
-
-func (c *publicAPIClient) ContainerInfo(ctx context.Context, in *ContainerInfoRequest, opts ...grpc.CallOption) (*ContainerInfoResponse, error) {
-	out := new(ContainerInfoResponse)
-	err := grpc.Invoke(ctx, "/types.PublicAPI/ContainerInfo", in, out, c.cc, opts...)
-	if err != nil {
-		return nil, err
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PublicAPIServer).PodList(ctx, req.(*PodListRequest))
 	}
-	return out, nil
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *publicAPIClient) ImageList(ctx context.Context, in *ImageListRequest, opts ...grpc.CallOption) (*ImageListResponse, error) {
-	out := new(ImageListResponse)
-	err := grpc.Invoke(ctx, "/types.PublicAPI/ImageList", in, out, c.cc, opts...)
-	if err != nil {
+func _PublicAPI_PodCreate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PodCreateRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
-}
-
-func (c *publicAPIClient) VMList(ctx context.Context, in *VMListRequest, opts ...grpc.CallOption) (*VMListResponse, error) {
-	out := new(VMListResponse)
-	err := grpc.Invoke(ctx, "/types.PublicAPI/VMList", in, out, c.cc, opts...)
-	if err != nil {
-		return nil, err
+	if interceptor == nil {
+		return srv.(PublicAPIServer).PodCreate(ctx, in)
 	}
-	return out, nil
-}
-
-func (c *publicAPIClient) SetPodLabels(ctx context.Context, in *PodLabelsRequest, opts ...grpc.CallOption) (*PodLabelsResponse, error) {
-	out := new(PodLabelsResponse)
-	err := grpc.Invoke(ctx, "/types.PublicAPI/SetPodLabels", in, out, c.cc, opts...)
-	if err != nil {
-		return nil, err
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/types.PublicAPI/PodCreate",
 	}
-	return out, nil
-}
-
-func (c *publicAPIClient) PodStats(ctx context.Context, in *PodStatsRequest, opts ...grpc.CallOption) (*PodStatsResponse, error) {
-	out := new(PodStatsResponse)
-	err := grpc.Invoke(ctx, "/types.PublicAPI/PodStats", in, out, c.cc, opts...)
-	if err != nil {
-		return nil, err
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PublicAPIServer).PodCreate(ctx, req.(*PodCreateRequest))
 	}
-	return out, nil
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *publicAPIClient) ContainerLogs(ctx context.Context, in *ContainerLogsRequest, opts ...grpc.CallOption) (PublicAPI_ContainerLogsClient, error) {
-	stream, err := grpc.NewClientStream(ctx, &_PublicAPI_serviceDesc.Streams[1], c.cc, "/types.PublicAPI/ContainerLogs", opts...)
-	if err != nil {
+func _PublicAPI_PodInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PodInfoRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	x := &publicAPIContainerLogsClient{stream}
-	if err := x.ClientStream.SendMsg(in); err != nil {
-		return nil, err
+	if interceptor == nil {
+		return srv.(PublicAPIServer).PodInfo(ctx, in)
 	}
-	if err := x.ClientStream.CloseSend(); err != nil {
-		return nil, err
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/types.PublicAPI/PodInfo",
 	}
-	return x, nil
-}
-
-type PublicAPI_ContainerLogsClient interface {
-	Recv() (*ContainerLogsResponse, error)
-	grpc.ClientStream
-}
-
-type publicAPIContainerLogsClient struct {
-	grpc.ClientStream
-}
-
-func (x *publicAPIContainerLogsClient) Recv() (*ContainerLogsResponse, error) {
-	m := new(ContainerLogsResponse)
-	if err := x.ClientStream.RecvMsg(m); err != nil {
-		return nil, err
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PublicAPIServer).PodInfo(ctx, req.(*PodInfoRequest))
 	}
-	return m, nil
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *publicAPIClient) ContainerCreate(ctx context.Context, in *ContainerCreateRequest, opts ...grpc.CallOption) (*ContainerCreateResponse, error) {
-	out := new(ContainerCreateResponse)
-	err := grpc.Invoke(ctx, "/types.PublicAPI/ContainerCreate", in, out, c.cc, opts...)
-	if err != nil {
+func _PublicAPI_PodRemove_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PodRemoveRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(PublicAPIServer).PodRemove(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/types.PublicAPI/PodRemove",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PublicAPIServer).PodRemove(ctx, req.(*PodRemoveRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *publicAPIClient) ContainerStart(ctx context.Context, in *ContainerStartRequest, opts ...grpc.CallOption) (*ContainerStartResponse, error) {
-	out := new(ContainerStartResponse)
-	err := grpc.Invoke(ctx, "/types.PublicAPI/ContainerStart", in, out, c.cc, opts...)
-	if err != nil {
+func _PublicAPI_PodStart_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PodStartRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(PublicAPIServer).PodStart(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/types.PublicAPI/PodStart",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PublicAPIServer).PodStart(ctx, req.(*PodStartRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *publicAPIClient) ContainerRename(ctx context.Context, in *ContainerRenameRequest, opts ...grpc.CallOption) (*ContainerRenameResponse, error) {
-	out := new(ContainerRenameResponse)
-	err := grpc.Invoke(ctx, "/types.PublicAPI/ContainerRename", in, out, c.cc, opts...)
-	if err != nil {
+func _PublicAPI_PodStop_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PodStopRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(PublicAPIServer).PodStop(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/types.PublicAPI/PodStop",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PublicAPIServer).PodStop(ctx, req.(*PodStopRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *publicAPIClient) ContainerSignal(ctx context.Context, in *ContainerSignalRequest, opts ...grpc.CallOption) (*ContainerSignalResponse, error) {
-	out := new(ContainerSignalResponse)
-	err := grpc.Invoke(ctx, "/types.PublicAPI/ContainerSignal", in, out, c.cc, opts...)
-	if err != nil {
+func _PublicAPI_PodSignal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PodSignalRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(PublicAPIServer).PodSignal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/types.PublicAPI/PodSignal",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PublicAPIServer).PodSignal(ctx, req.(*PodSignalRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *publicAPIClient) ContainerStop(ctx context.Context, in *ContainerStopRequest, opts ...grpc.CallOption) (*ContainerStopResponse, error) {
-	out := new(ContainerStopResponse)
-	err := grpc.Invoke(ctx, "/types.PublicAPI/ContainerStop", in, out, c.cc, opts...)
-	if err != nil {
+func _PublicAPI_PodPause_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PodPauseRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(PublicAPIServer).PodPause(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/types.PublicAPI/PodPause",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PublicAPIServer).PodPause(ctx, req.(*PodPauseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *publicAPIClient) ContainerRemove(ctx context.Context, in *ContainerRemoveRequest, opts ...grpc.CallOption) (*ContainerRemoveResponse, error) {
-	out := new(ContainerRemoveResponse)
-	err := grpc.Invoke(ctx, "/types.PublicAPI/ContainerRemove", in, out, c.cc, opts...)
-	if err != nil {
+func _PublicAPI_PodUnpause_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PodUnpauseRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(PublicAPIServer).PodUnpause(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/types.PublicAPI/PodUnpause",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PublicAPIServer).PodUnpause(ctx, req.(*PodUnpauseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *publicAPIClient) ExecCreate(ctx context.Context, in *ExecCreateRequest, opts ...grpc.CallOption) (*ExecCreateResponse, error) {
-	out := new(ExecCreateResponse)
-	err := grpc.Invoke(ctx, "/types.PublicAPI/ExecCreate", in, out, c.cc, opts...)
-	if err != nil {
+func _PublicAPI_PodUpdateResources_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PodUpdateResourcesRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(PublicAPIServer).PodUpdateResources(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/types.PublicAPI/PodUpdateResources",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PublicAPIServer).PodUpdateResources(ctx, req.(*PodUpdateResourcesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *publicAPIClient) ExecStart(ctx context.Context, opts ...grpc.CallOption) (PublicAPI_ExecStartClient, error) {
-	stream, err := grpc.NewClientStream(ctx, &_PublicAPI_serviceDesc.Streams[2], c.cc, "/types.PublicAPI/ExecStart", opts...)
-	if err != nil {
-		return nil, err
-	}
-	x := &publicAPIExecStartClient{stream}
-	return x, nil
+func _PublicAPI_ExecVM_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(PublicAPIServer).ExecVM(&publicAPIExecVMServer{stream})
 }
 
-type PublicAPI_ExecStartClient interface {
-	Send(*ExecStartRequest) error
-	Recv() (*ExecStartResponse, error)
-	grpc.ClientStream
+type PublicAPI_ExecVMServer interface {
+	Send(*ExecVMResponse) error
+	Recv() (*ExecVMRequest, error)
+	grpc.ServerStream
 }
 
-type publicAPIExecStartClient struct {
-	grpc.ClientStream
+type publicAPIExecVMServer struct {
+	grpc.ServerStream
 }
 
-func (x *publicAPIExecStartClient) Send(m *ExecStartRequest) error {
-	return x.ClientStream.SendMsg(m)
+func (x *publicAPIExecVMServer) Send(m *ExecVMResponse) error {
+	return x.ServerStream.SendMsg(m)
 }
 
-func (x *publicAPIExecStartClient) Recv() (*ExecStartResponse, error) {
-	m := new(ExecStartResponse)
-	if err := x.ClientStream.RecvMsg(m); err != nil {
+func (x *publicAPIExecVMServer) Recv() (*ExecVMRequest, error) {
+	m := new(ExecVMRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
 		return nil, err
 	}
 	return m, nil
 }
 
-func (c *publicAPIClient) ExecSignal(ctx context.Context, in *ExecSignalRequest, opts ...grpc.CallOption) (*ExecSignalResponse, error) {
-	out := new(ExecSignalResponse)
-	err := grpc.Invoke(ctx, "/types.PublicAPI/ExecSignal", in, out, c.cc, opts...)
-	if err != nil {
+func _PublicAPI_ContainerList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ContainerListRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(PublicAPIServer).ContainerList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/types.PublicAPI/ContainerList",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PublicAPIServer).ContainerList(ctx, req.(*ContainerListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *publicAPIClient) Attach(ctx context.Context, opts ...grpc.CallOption) (PublicAPI_AttachClient, error) {
-	stream, err := grpc.NewClientStream(ctx, &_PublicAPI_serviceDesc.Streams[3], c.cc, "/types.PublicAPI/Attach", opts...)
-	if err != nil {
+func _PublicAPI_ContainerInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ContainerInfoRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	x := &publicAPIAttachClient{stream}
-	return x, nil
-}
-
-type PublicAPI_AttachClient interface {
-	Send(*AttachMessage) error
-	Recv() (*AttachMessage, error)
-	grpc.ClientStream
-}
-
-type publicAPIAttachClient struct {
-	grpc.ClientStream
-}
-
-func (x *publicAPIAttachClient) Send(m *AttachMessage) error {
-	return x.ClientStream.SendMsg(m)
+	if interceptor == nil {
+		return srv.(PublicAPIServer).ContainerInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/types.PublicAPI/ContainerInfo",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PublicAPIServer).ContainerInfo(ctx, req.(*ContainerInfoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (x *publicAPIAttachClient) Recv() (*AttachMessage, error) {
-	m := new(AttachMessage)
-	if err := x.ClientStream.RecvMsg(m); err != nil {
+func _PublicAPI_ImageList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImageListRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return m, nil
+	if interceptor == nil {
+		return srv.(PublicAPIServer).ImageList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/types.PublicAPI/ImageList",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PublicAPIServer).ImageList(ctx, req.(*ImageListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *publicAPIClient) Wait(ctx context.Context, in *WaitRequest, opts ...grpc.CallOption) (*WaitResponse, error) {
-	out := new(WaitResponse)
-	err := grpc.Invoke(ctx, "/types.PublicAPI/Wait", in, out, c.cc, opts...)
-	if err != nil {
+func _PublicAPI_VMList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VMListRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(PublicAPIServer).VMList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/types.PublicAPI/VMList",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PublicAPIServer).VMList(ctx, req.(*VMListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *publicAPIClient) TTYResize(ctx context.Context, in *TTYResizeRequest, opts ...grpc.CallOption) (*TTYResizeResponse, error) {
-	out := new(TTYResizeResponse)
-	err := grpc.Invoke(ctx, "/types.PublicAPI/TTYResize", in, out, c.cc, opts...)
-	if err != nil {
+func _PublicAPI_SetPodLabels_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PodLabelsRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(PublicAPIServer).SetPodLabels(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/types.PublicAPI/SetPodLabels",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PublicAPIServer).SetPodLabels(ctx, req.(*PodLabelsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *publicAPIClient) ServiceList(ctx context.Context, in *ServiceListRequest, opts ...grpc.CallOption) (*ServiceListResponse, error) {
-	out := new(ServiceListResponse)
-	err := grpc.Invoke(ctx, "/types.PublicAPI/ServiceList", in, out, c.cc, opts...)
-	if err != nil {
+func _PublicAPI_PodStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PodStatsRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(PublicAPIServer).PodStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/types.PublicAPI/PodStats",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PublicAPIServer).PodStats(ctx, req.(*PodStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *publicAPIClient) ServiceAdd(ctx context.Context, in *ServiceAddRequest, opts ...grpc.CallOption) (*ServiceAddResponse, error) {
-	out := new(ServiceAddResponse)
-	err := grpc.Invoke(ctx, "/types.PublicAPI/ServiceAdd", in, out, c.cc, opts...)
-	if err != nil {
-		return nil, err
+func _PublicAPI_PodStatsStream_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(PodStatsStreamRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
 	}
-	return out, nil
+	return srv.(PublicAPIServer).PodStatsStream(m, &publicAPIPodStatsStreamServer{stream})
 }
 
-func (c *publicAPIClient) ServiceDelete(ctx context.Context, in *ServiceDelRequest, opts ...grpc.CallOption) (*ServiceDelResponse, error) {
-	out := new(ServiceDelResponse)
-	err := grpc.Invoke(ctx, "/types.PublicAPI/ServiceDelete", in, out, c.cc, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type PublicAPI_PodStatsStreamServer interface {
+	Send(*PodStatsStreamResponse) error
+	grpc.ServerStream
 }
 
-func (c *publicAPIClient) ServiceUpdate(ctx context.Context, in *ServiceUpdateRequest, opts ...grpc.CallOption) (*ServiceUpdateResponse, error) {
-	out := new(ServiceUpdateResponse)
-	err := grpc.Invoke(ctx, "/types.PublicAPI/ServiceUpdate", in, out, c.cc, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type publicAPIPodStatsStreamServer struct {
+	grpc.ServerStream
 }
 
-func (c *publicAPIClient) PortMappingList(ctx context.Context, in *PortMappingListRequest, opts ...grpc.CallOption) (*PortMappingListResponse, error) {
-	out := new(PortMappingListResponse)
-	err := grpc.Invoke(ctx, "/types.PublicAPI/PortMappingList", in, out, c.cc, opts...)
-	if err != nil {
-		return nil, err
+func (x *publicAPIPodStatsStreamServer) Send(m *PodStatsStreamResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _PublicAPI_ContainerLogs_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ContainerLogsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
 	}
-	return out, nil
+	return srv.(PublicAPIServer).ContainerLogs(m, &publicAPIContainerLogsServer{stream})
 }
 
-func (c *publicAPIClient) PortMappingAdd(ctx context.Context, in *PortMappingModifyRequest, opts ...grpc.CallOption) (*PortMappingModifyResponse, error) {
-	out := new(PortMappingModifyResponse)
-	err := grpc.Invoke(ctx, "/types.PublicAPI/PortMappingAdd", in, out, c.cc, opts...)
-	if err != nil {
+type PublicAPI_ContainerLogsServer interface {
+	Send(*ContainerLogsResponse) error
+	grpc.ServerStream
+}
+
+type publicAPIContainerLogsServer struct {
+	grpc.ServerStream
+}
+
+func (x *publicAPIContainerLogsServer) Send(m *ContainerLogsResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _PublicAPI_ContainerCreate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ContainerCreateRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(PublicAPIServer).ContainerCreate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/types.PublicAPI/ContainerCreate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PublicAPIServer).ContainerCreate(ctx, req.(*ContainerCreateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *publicAPIClient) PortMappingDel(ctx context.Context, in *PortMappingModifyRequest, opts ...grpc.CallOption) (*PortMappingModifyResponse, error) {
-	out := new(PortMappingModifyResponse)
-	err := grpc.Invoke(ctx, "/types.PublicAPI/PortMappingDel", in, out, c.cc, opts...)
-	if err != nil {
+func _PublicAPI_ContainerStart_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ContainerStartRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(PublicAPIServer).ContainerStart(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/types.PublicAPI/ContainerStart",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PublicAPIServer).ContainerStart(ctx, req.(*ContainerStartRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *publicAPIClient) ImagePull(ctx context.Context, in *ImagePullRequest, opts ...grpc.CallOption) (PublicAPI_ImagePullClient, error) {
-	stream, err := grpc.NewClientStream(ctx, &_PublicAPI_serviceDesc.Streams[4], c.cc, "/types.PublicAPI/ImagePull", opts...)
-	if err != nil {
+func _PublicAPI_ContainerRename_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ContainerRenameRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	x := &publicAPIImagePullClient{stream}
-	if err := x.ClientStream.SendMsg(in); err != nil {
-		return nil, err
+	if interceptor == nil {
+		return srv.(PublicAPIServer).ContainerRename(ctx, in)
 	}
-	if err := x.ClientStream.CloseSend(); err != nil {
-		return nil, err
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/types.PublicAPI/ContainerRename",
 	}
-	return x, nil
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PublicAPIServer).ContainerRename(ctx, req.(*ContainerRenameRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-type PublicAPI_ImagePullClient interface {
-	Recv() (*ImagePullResponse, error)
-	grpc.ClientStream
+func _PublicAPI_ContainerCopyFrom_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ContainerCopyFromRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(PublicAPIServer).ContainerCopyFrom(m, &publicAPIContainerCopyFromServer{stream})
 }
 
-type publicAPIImagePullClient struct {
-	grpc.ClientStream
+type PublicAPI_ContainerCopyFromServer interface {
+	Send(*ContainerCopyData) error
+	grpc.ServerStream
 }
 
-func (x *publicAPIImagePullClient) Recv() (*ImagePullResponse, error) {
-	m := new(ImagePullResponse)
-	if err := x.ClientStream.RecvMsg(m); err != nil {
-		return nil, err
-	}
-	return m, nil
+type publicAPIContainerCopyFromServer struct {
+	grpc.ServerStream
 }
 
-func (c *publicAPIClient) ImagePush(ctx context.Context, in *ImagePushRequest, opts ...grpc.CallOption) (PublicAPI_ImagePushClient, error) {
-	stream, err := grpc.NewClientStream(ctx, &_PublicAPI_serviceDesc.Streams[5], c.cc, "/types.PublicAPI/ImagePush", opts...)
-	if err != nil {
-		return nil, err
-	}
-	x := &publicAPIImagePushClient{stream}
-	if err := x.ClientStream.SendMsg(in); err != nil {
-		return nil, err
-	}
-	if err := x.ClientStream.CloseSend(); err != nil {
-		return nil, err
-	}
-	return x, nil
+func (x *publicAPIContainerCopyFromServer) Send(m *ContainerCopyData) error {
+	return x.ServerStream.SendMsg(m)
 }
 
-type PublicAPI_ImagePushClient interface {
-	Recv() (*ImagePushResponse, error)
-	grpc.ClientStream
+func _PublicAPI_ContainerCopyTo_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(PublicAPIServer).ContainerCopyTo(&publicAPIContainerCopyToServer{stream})
 }
 
-type publicAPIImagePushClient struct {
-	grpc.ClientStream
+type PublicAPI_ContainerCopyToServer interface {
+	SendAndClose(*ContainerCopyToResponse) error
+	Recv() (*ContainerCopyToRequest, error)
+	grpc.ServerStream
 }
 
-func (x *publicAPIImagePushClient) Recv() (*ImagePushResponse, error) {
-	m := new(ImagePushResponse)
-	if err := x.ClientStream.RecvMsg(m); err != nil {
-		return nil, err
-	}
-	return m, nil
+type publicAPIContainerCopyToServer struct {
+	grpc.ServerStream
 }
 
-func (c *publicAPIClient) ImageRemove(ctx context.Context, in *ImageRemoveRequest, opts ...grpc.CallOption) (*ImageRemoveResponse, error) {
-	out := new(ImageRemoveResponse)
-	err := grpc.Invoke(ctx, "/types.PublicAPI/ImageRemove", in, out, c.cc, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (x *publicAPIContainerCopyToServer) SendAndClose(m *ContainerCopyToResponse) error {
+	return x.ServerStream.SendMsg(m)
 }
 
-func (c *publicAPIClient) Ping(ctx context.Context, in *PingRequest, opts ...grpc.CallOption) (*PingResponse, error) {
-	out := new(PingResponse)
-	err := grpc.Invoke(ctx, "/types.PublicAPI/Ping", in, out, c.cc, opts...)
-	if err != nil {
+func (x *publicAPIContainerCopyToServer) Recv() (*ContainerCopyToRequest, error) {
+	m := new(ContainerCopyToRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
 		return nil, err
 	}
-	return out, nil
+	return m, nil
 }
 
-func (c *publicAPIClient) Info(ctx context.Context, in *InfoRequest, opts ...grpc.CallOption) (*InfoResponse, error) {
-	out := new(InfoResponse)
-	err := grpc.Invoke(ctx, "/types.PublicAPI/Info", in, out, c.cc, opts...)
-	if err != nil {
+func _PublicAPI_ContainerSignal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ContainerSignalRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(PublicAPIServer).ContainerSignal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/types.PublicAPI/ContainerSignal",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PublicAPIServer).ContainerSignal(ctx, req.(*ContainerSignalRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *publicAPIClient) Version(ctx context.Context, in *VersionRequest, opts ...grpc.CallOption) (*VersionResponse, error) {
-	out := new(VersionResponse)
-	err := grpc.Invoke(ctx, "/types.PublicAPI/Version", in, out, c.cc, opts...)
-	if err != nil {
+func _PublicAPI_ContainerStop_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ContainerStopRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
-}
-
-// Server API for PublicAPI service
-
-type PublicAPIServer interface {
-	// PodList gets a list of pods
-	PodList(context.Context, *PodListRequest) (*PodListResponse, error)
-	// PodCreate creates a pod according to UserPod
-	PodCreate(context.Context, *PodCreateRequest) (*PodCreateResponse, error)
-	// PodInfo gets pod's info by podID
-	PodInfo(context.Context, *PodInfoRequest) (*PodInfoResponse, error)
-	// PodRemove deletes a pod by podID
-	PodRemove(context.Context, *PodRemoveRequest) (*PodRemoveResponse, error)
-	// PodStart starts a pod
-	PodStart(context.Context, *PodStartRequest) (*PodStartResponse, error)
-	// PodStop stops a pod
-	PodStop(context.Context, *PodStopRequest) (*PodStopResponse, error)
-	// PodSignal sends a signal to all containers of specified pod
-	PodSignal(context.Context, *PodSignalRequest) (*PodSignalResponse, error)
-	// PodPause pauses a pod
-	PodPause(context.Context, *PodPauseRequest) (*PodPauseResponse, error)
-	// PodUnpause unpauses a pod
-	PodUnpause(context.Context, *PodUnpauseRequest) (*PodUnpauseResponse, error)
-	// ExecVM executes a command outside of any containers.
-	ExecVM(PublicAPI_ExecVMServer) error
-	// ContainerList gets a list of containers
-	ContainerList(context.Context, *ContainerListRequest) (*ContainerListResponse, error)
-	// ContainerInfo gets container's info by container's id or name
-	ContainerInfo(context.Context, *ContainerInfoRequest) (*ContainerInfoResponse, error)
-	// ImageList gets a list of images by filters
-	ImageList(context.Context, *ImageListRequest) (*ImageListResponse, error)
-	// VMList gets a list of HyperVMs
-	VMList(context.Context, *VMListRequest) (*VMListResponse, error)
-	// SetPodLabels sets labels of given pod
-	SetPodLabels(context.Context, *PodLabelsRequest) (*PodLabelsResponse, error)
-	// PodStats gets pod stats of a given pod
-	PodStats(context.Context, *PodStatsRequest) (*PodStatsResponse, error)
-	// ContainerLogs gets the log of specified container
-	ContainerLogs(*ContainerLogsRequest, PublicAPI_ContainerLogsServer) error
-	// ContainerCreate creates a container in specified pod
-	ContainerCreate(context.Context, *ContainerCreateRequest) (*ContainerCreateResponse, error)
-	// ContainerStart starts a container in a specified pod
-	ContainerStart(context.Context, *ContainerStartRequest) (*ContainerStartResponse, error)
-	// ContainerRename renames a container
-	ContainerRename(context.Context, *ContainerRenameRequest) (*ContainerRenameResponse, error)
-	// TODO: ContainerCommit commits the changes of the specified container
-	// ContainerSignal sends a signal to specified container
-	ContainerSignal(context.Context, *ContainerSignalRequest) (*ContainerSignalResponse, error)
-	// TODO: ContainerLabels updates labels of the specified container
-	// ContainerStop stops the specified container
-	ContainerStop(context.Context, *ContainerStopRequest) (*ContainerStopResponse, error)
-	// ContainerRemove removes a container from a specified pod
-	ContainerRemove(context.Context, *ContainerRemoveRequest) (*ContainerRemoveResponse, error)
-	// ExecCreate creates exec in specified container
-	ExecCreate(context.Context, *ExecCreateRequest) (*ExecCreateResponse, error)
-	// ExecStart starts exec
-	ExecStart(PublicAPI_ExecStartServer) error
-	// ExecSignal sends a signal to specified exec in specified container
-	ExecSignal(context.Context, *ExecSignalRequest) (*ExecSignalResponse, error)
-	// Attach attaches to the specified container
-	Attach(PublicAPI_AttachServer) error
-	// Wait gets the exit code of the specified container
-	Wait(context.Context, *WaitRequest) (*WaitResponse, error)
-	// TTYResize resizes the tty of the specified container
-	TTYResize(context.Context, *TTYResizeRequest) (*TTYResizeResponse, error)
-	// ServiceList gets a list of services
-	ServiceList(context.Context, *ServiceListRequest) (*ServiceListResponse, error)
-	// ServiceAdd add a service to a pod
-	ServiceAdd(context.Context, *ServiceAddRequest) (*ServiceAddResponse, error)
-	// ServiceDelete delete a service from a pod
-	ServiceDelete(context.Context, *ServiceDelRequest) (*ServiceDelResponse, error)
-	// ServiceUpdate updates an existing service of a pod
-	ServiceUpdate(context.Context, *ServiceUpdateRequest) (*ServiceUpdateResponse, error)
-	// PortMappingList get a list of PortMappings
-	PortMappingList(context.Context, *PortMappingListRequest) (*PortMappingListResponse, error)
-	// PortMappingAdd add a list of PortMapping rules to a Pod
-	PortMappingAdd(context.Context, *PortMappingModifyRequest) (*PortMappingModifyResponse, error)
-	// PortMappingDel remove a list of PortMapping rules from a Pod
-	PortMappingDel(context.Context, *PortMappingModifyRequest) (*PortMappingModifyResponse, error)
-	// ImagePull pulls a image from registry
-	ImagePull(*ImagePullRequest, PublicAPI_ImagePullServer) error
-	// ImagePush pushes a local image to registry
-	ImagePush(*ImagePushRequest, PublicAPI_ImagePushServer) error
-	// ImageRemove deletes a image from hyperd
-	ImageRemove(context.Context, *ImageRemoveRequest) (*ImageRemoveResponse, error)
-	// Ping checks if hyperd is running (returns 'OK' on success)
-	Ping(context.Context, *PingRequest) (*PingResponse, error)
-	// Info gets the info of hyperd
-	Info(context.Context, *InfoRequest) (*InfoResponse, error)
-	// Version gets the version and apiVersion of hyperd
-	Version(context.Context, *VersionRequest) (*VersionResponse, error)
+	if interceptor == nil {
+		return srv.(PublicAPIServer).ContainerStop(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/types.PublicAPI/ContainerStop",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PublicAPIServer).ContainerStop(ctx, req.(*ContainerStopRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func RegisterPublicAPIServer(s *grpc.Server, srv PublicAPIServer) {
-	s.RegisterService(&_PublicAPI_serviceDesc, srv)
+func _PublicAPI_ContainerRemove_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ContainerRemoveRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PublicAPIServer).ContainerRemove(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/types.PublicAPI/ContainerRemove",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PublicAPIServer).ContainerRemove(ctx, req.(*ContainerRemoveRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func _PublicAPI_PodList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(PodListRequest)
+func _PublicAPI_ExecCreate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExecCreateRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(PublicAPIServer).PodList(ctx, in)
+		return srv.(PublicAPIServer).ExecCreate(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/types.PublicAPI/PodList",
+		FullMethod: "/types.PublicAPI/ExecCreate",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(PublicAPIServer).PodList(ctx, req.(*PodListRequest))
+		return srv.(PublicAPIServer).ExecCreate(ctx, req.(*ExecCreateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PublicAPI_ExecStart_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(PublicAPIServer).ExecStart(&publicAPIExecStartServer{stream})
+}
+
+type PublicAPI_ExecStartServer interface {
+	Send(*ExecStartResponse) error
+	Recv() (*ExecStartRequest, error)
+	grpc.ServerStream
+}
+
+type publicAPIExecStartServer struct {
+	grpc.ServerStream
+}
+
+func (x *publicAPIExecStartServer) Send(m *ExecStartResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *publicAPIExecStartServer) Recv() (*ExecStartRequest, error) {
+	m := new(ExecStartRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
 	}
-	return interceptor(ctx, in, info, handler)
+	return m, nil
 }
 
-func _PublicAPI_PodCreate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(PodCreateRequest)
+func _PublicAPI_ExecSignal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExecSignalRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(PublicAPIServer).PodCreate(ctx, in)
+		return srv.(PublicAPIServer).ExecSignal(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/types.PublicAPI/PodCreate",
+		FullMethod: "/types.PublicAPI/ExecSignal",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(PublicAPIServer).PodCreate(ctx, req.(*PodCreateRequest))
+		return srv.(PublicAPIServer).ExecSignal(ctx, req.(*ExecSignalRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _PublicAPI_PodInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(PodInfoRequest)
-	if err := dec(in); err != nil {
+func _PublicAPI_Attach_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(PublicAPIServer).Attach(&publicAPIAttachServer{stream})
+}
+
+type PublicAPI_AttachServer interface {
+	Send(*AttachMessage) error
+	Recv() (*AttachMessage, error)
+	grpc.ServerStream
+}
+
+type publicAPIAttachServer struct {
+	grpc.ServerStream
+}
+
+func (x *publicAPIAttachServer) Send(m *AttachMessage) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *publicAPIAttachServer) Recv() (*AttachMessage, error) {
+	m := new(AttachMessage)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(PublicAPIServer).PodInfo(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/types.PublicAPI/PodInfo",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(PublicAPIServer).PodInfo(ctx, req.(*PodInfoRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return m, nil
 }
 
-func _PublicAPI_PodRemove_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(PodRemoveRequest)
+func _PublicAPI_Wait_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WaitRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(PublicAPIServer).PodRemove(ctx, in)
+		return srv.(PublicAPIServer).Wait(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/types.PublicAPI/PodRemove",
+		FullMethod: "/types.PublicAPI/Wait",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(PublicAPIServer).PodRemove(ctx, req.(*PodRemoveRequest))
+		return srv.(PublicAPIServer).Wait(ctx, req.(*WaitRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _PublicAPI_PodStart_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(PodStartRequest)
+func _PublicAPI_TTYResize_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TTYResizeRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(PublicAPIServer).PodStart(ctx, in)
+		return srv.(PublicAPIServer).TTYResize(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/types.PublicAPI/PodStart",
+		FullMethod: "/types.PublicAPI/TTYResize",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(PublicAPIServer).PodStart(ctx, req.(*PodStartRequest))
+		return srv.(PublicAPIServer).TTYResize(ctx, req.(*TTYResizeRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _PublicAPI_PodStop_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(PodStopRequest)
+func _PublicAPI_ServiceList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ServiceListRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(PublicAPIServer).PodStop(ctx, in)
+		return srv.(PublicAPIServer).ServiceList(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/types.PublicAPI/PodStop",
+		FullMethod: "/types.PublicAPI/ServiceList",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(PublicAPIServer).PodStop(ctx, req.(*PodStopRequest))
+		return srv.(PublicAPIServer).ServiceList(ctx, req.(*ServiceListRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _PublicAPI_PodSignal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(PodSignalRequest)
+func _PublicAPI_ServiceAdd_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ServiceAddRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(PublicAPIServer).PodSignal(ctx, in)
+		return srv.(PublicAPIServer).ServiceAdd(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/types.PublicAPI/PodSignal",
+		FullMethod: "/types.PublicAPI/ServiceAdd",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(PublicAPIServer).PodSignal(ctx, req.(*PodSignalRequest))
+		return srv.(PublicAPIServer).ServiceAdd(ctx, req.(*ServiceAddRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _PublicAPI_PodPause_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(PodPauseRequest)
+func _PublicAPI_ServiceDelete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ServiceDelRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(PublicAPIServer).PodPause(ctx, in)
+		return srv.(PublicAPIServer).ServiceDelete(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/types.PublicAPI/PodPause",
+		FullMethod: "/types.PublicAPI/ServiceDelete",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(PublicAPIServer).PodPause(ctx, req.(*PodPauseRequest))
+		return srv.(PublicAPIServer).ServiceDelete(ctx, req.(*ServiceDelRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _PublicAPI_PodUnpause_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(PodUnpauseRequest)
+func _PublicAPI_ServiceUpdate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ServiceUpdateRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(PublicAPIServer).PodUnpause(ctx, in)
+		return srv.(PublicAPIServer).ServiceUpdate(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/types.PublicAPI/PodUnpause",
+		FullMethod: "/types.PublicAPI/ServiceUpdate",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(PublicAPIServer).PodUnpause(ctx, req.(*PodUnpauseRequest))
+		return srv.(PublicAPIServer).ServiceUpdate(ctx, req.(*ServiceUpdateRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _PublicAPI_ExecVM_Handler(srv interface{}, stream grpc.ServerStream) error {
-	return srv.(PublicAPIServer).ExecVM(&publicAPIExecVMServer{stream})
-}
-
-type PublicAPI_ExecVMServer interface {
-	Send(*ExecVMResponse) error
-	Recv() (*ExecVMRequest, error)
-	grpc.ServerStream
-}
-
-type publicAPIExecVMServer struct {
-	grpc.ServerStream
-}
-
-func (x *publicAPIExecVMServer) Send(m *ExecVMResponse) error {
-	return x.ServerStream.SendMsg(m)
-}
-
-func (x *publicAPIExecVMServer) Recv() (*ExecVMRequest, error) {
-	m := new(ExecVMRequest)
-	if err := x.ServerStream.RecvMsg(m); err != nil {
-		return nil, err
-	}
-	return m, nil
-}
-
-func _PublicAPI_ContainerList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ContainerListRequest)
+func _PublicAPI_PortMappingList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PortMappingListRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(PublicAPIServer).ContainerList(ctx, in)
+		return srv.(PublicAPIServer).PortMappingList(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/types.PublicAPI/ContainerList",
+		FullMethod: "/types.PublicAPI/PortMappingList",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(PublicAPIServer).ContainerList(ctx, req.(*ContainerListRequest))
+		return srv.(PublicAPIServer).PortMappingList(ctx, req.(*PortMappingListRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _PublicAPI_ContainerInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ContainerInfoRequest)
+func _PublicAPI_PortMappingAdd_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PortMappingModifyRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(PublicAPIServer).ContainerInfo(ctx, in)
+		return srv.(PublicAPIServer).PortMappingAdd(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/types.PublicAPI/ContainerInfo",
+		FullMethod: "/types.PublicAPI/PortMappingAdd",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(PublicAPIServer).ContainerInfo(ctx, req.(*ContainerInfoRequest))
+		return srv.(PublicAPIServer).PortMappingAdd(ctx, req.(*PortMappingModifyRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _PublicAPI_ImageList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ImageListRequest)
+func _PublicAPI_PortMappingDel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PortMappingModifyRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(PublicAPIServer).ImageList(ctx, in)
+		return srv.(PublicAPIServer).PortMappingDel(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/types.PublicAPI/ImageList",
+		FullMethod: "/types.PublicAPI/PortMappingDel",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(PublicAPIServer).ImageList(ctx, req.(*ImageListRequest))
+		return srv.(PublicAPIServer).PortMappingDel(ctx, req.(*PortMappingModifyRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _PublicAPI_VMList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(VMListRequest)
+func _PublicAPI_PodInterfaceList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PodInterfaceListRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(PublicAPIServer).VMList(ctx, in)
+		return srv.(PublicAPIServer).PodInterfaceList(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/types.PublicAPI/VMList",
+		FullMethod: "/types.PublicAPI/PodInterfaceList",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(PublicAPIServer).VMList(ctx, req.(*VMListRequest))
+		return srv.(PublicAPIServer).PodInterfaceList(ctx, req.(*PodInterfaceListRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _PublicAPI_SetPodLabels_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(PodLabelsRequest)
+func _PublicAPI_PodInterfaceAdd_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PodInterfaceAddRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(PublicAPIServer).SetPodLabels(ctx, in)
+		return srv.(PublicAPIServer).PodInterfaceAdd(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/types.PublicAPI/SetPodLabels",
+		FullMethod: "/types.PublicAPI/PodInterfaceAdd",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(PublicAPIServer).SetPodLabels(ctx, req.(*PodLabelsRequest))
+		return srv.(PublicAPIServer).PodInterfaceAdd(ctx, req.(*PodInterfaceAddRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _PublicAPI_PodStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(PodStatsRequest)
+func _PublicAPI_PodInterfaceRemove_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PodInterfaceRemoveRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(PublicAPIServer).PodStats(ctx, in)
+		return srv.(PublicAPIServer).PodInterfaceRemove(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/types.PublicAPI/PodStats",
+		FullMethod: "/types.PublicAPI/PodInterfaceRemove",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(PublicAPIServer).PodStats(ctx, req.(*PodStatsRequest))
+		return srv.(PublicAPIServer).PodInterfaceRemove(ctx, req.(*PodInterfaceRemoveRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _PublicAPI_ContainerLogs_Handler(srv interface{}, stream grpc.ServerStream) error {
-	m := new(ContainerLogsRequest)
+func _PublicAPI_ImagePull_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ImagePullRequest)
 	if err := stream.RecvMsg(m); err != nil {
 		return err
 	}
-	return srv.(PublicAPIServer).ContainerLogs(m, &publicAPIContainerLogsServer{stream})
+	return srv.(PublicAPIServer).ImagePull(m, &publicAPIImagePullServer{stream})
 }
 
-type PublicAPI_ContainerLogsServer interface {
-	Send(*ContainerLogsResponse) error
+type PublicAPI_ImagePullServer interface {
+	Send(*ImagePullResponse) error
 	grpc.ServerStream
 }
 
-type publicAPIContainerLogsServer struct {
+type publicAPIImagePullServer struct {
 	grpc.ServerStream
 }
 
-func (x *publicAPIContainerLogsServer) Send(m *ContainerLogsResponse) error {
+func (x *publicAPIImagePullServer) Send(m *ImagePullResponse) error {
 	return x.ServerStream.SendMsg(m)
 }
 
-func _PublicAPI_ContainerCreate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ContainerCreateRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(PublicAPIServer).ContainerCreate(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/types.PublicAPI/ContainerCreate",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(PublicAPIServer).ContainerCreate(ctx, req.(*ContainerCreateRequest))
+func _PublicAPI_ImagePush_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ImagePushRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
 	}
-	return interceptor(ctx, in, info, handler)
+	return srv.(PublicAPIServer).ImagePush(m, &publicAPIImagePushServer{stream})
 }
 
-func _PublicAPI_ContainerStart_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ContainerStartRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(PublicAPIServer).ContainerStart(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/types.PublicAPI/ContainerStart",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(PublicAPIServer).ContainerStart(ctx, req.(*ContainerStartRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+type PublicAPI_ImagePushServer interface {
+	Send(*ImagePushResponse) error
+	grpc.ServerStream
 }
 
-func _PublicAPI_ContainerRename_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ContainerRenameRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(PublicAPIServer).ContainerRename(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/types.PublicAPI/ContainerRename",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(PublicAPIServer).ContainerRename(ctx, req.(*ContainerRenameRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+type publicAPIImagePushServer struct {
+	grpc.ServerStream
 }
 
-func _PublicAPI_ContainerSignal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ContainerSignalRequest)
+func (x *publicAPIImagePushServer) Send(m *ImagePushResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _PublicAPI_ImageRemove_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImageRemoveRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(PublicAPIServer).ContainerSignal(ctx, in)
+		return srv.(PublicAPIServer).ImageRemove(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/types.PublicAPI/ContainerSignal",
+		FullMethod: "/types.PublicAPI/ImageRemove",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(PublicAPIServer).ContainerSignal(ctx, req.(*ContainerSignalRequest))
+		return srv.(PublicAPIServer).ImageRemove(ctx, req.(*ImageRemoveRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _PublicAPI_ContainerStop_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ContainerStopRequest)
+func _PublicAPI_VolumeCreate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VolumeCreateRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(PublicAPIServer).ContainerStop(ctx, in)
+		return srv.(PublicAPIServer).VolumeCreate(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/types.PublicAPI/ContainerStop",
+		FullMethod: "/types.PublicAPI/VolumeCreate",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(PublicAPIServer).ContainerStop(ctx, req.(*ContainerStopRequest))
+		return srv.(PublicAPIServer).VolumeCreate(ctx, req.(*VolumeCreateRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _PublicAPI_ContainerRemove_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ContainerRemoveRequest)
+func _PublicAPI_VolumeList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VolumeListRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(PublicAPIServer).ContainerRemove(ctx, in)
+		return srv.(PublicAPIServer).VolumeList(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/types.PublicAPI/ContainerRemove",
+		FullMethod: "/types.PublicAPI/VolumeList",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(PublicAPIServer).ContainerRemove(ctx, req.(*ContainerRemoveRequest))
+		return srv.(PublicAPIServer).VolumeList(ctx, req.(*VolumeListRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _PublicAPI_ExecCreate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ExecCreateRequest)
+func _PublicAPI_VolumeInspect_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VolumeInspectRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(PublicAPIServer).ExecCreate(ctx, in)
+		return srv.(PublicAPIServer).VolumeInspect(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/types.PublicAPI/ExecCreate",
+		FullMethod: "/types.PublicAPI/VolumeInspect",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(PublicAPIServer).ExecCreate(ctx, req.(*ExecCreateRequest))
+		return srv.(PublicAPIServer).VolumeInspect(ctx, req.(*VolumeInspectRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _PublicAPI_ExecStart_Handler(srv interface{}, stream grpc.ServerStream) error {
-	return srv.(PublicAPIServer).ExecStart(&publicAPIExecStartServer{stream})
-}
-
-type PublicAPI_ExecStartServer interface {
-	Send(*ExecStartResponse) error
-	Recv() (*ExecStartRequest, error)
-	grpc.ServerStream
-}
-
-type publicAPIExecStartServer struct {
-	grpc.ServerStream
-}
-
-func (x *publicAPIExecStartServer) Send(m *ExecStartResponse) error {
-	return x.ServerStream.SendMsg(m)
-}
-
-func (x *publicAPIExecStartServer) Recv() (*ExecStartRequest, error) {
-	m := new(ExecStartRequest)
-	if err := x.ServerStream.RecvMsg(m); err != nil {
-		return nil, err
-	}
-	return m, nil
-}
-
-func _PublicAPI_ExecSignal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ExecSignalRequest)
+func _PublicAPI_VolumeRemove_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VolumeRemoveRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(PublicAPIServer).ExecSignal(ctx, in)
+		return srv.(PublicAPIServer).VolumeRemove(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/types.PublicAPI/ExecSignal",
+		FullMethod: "/types.PublicAPI/VolumeRemove",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(PublicAPIServer).ExecSignal(ctx, req.(*ExecSignalRequest))
+		return srv.(PublicAPIServer).VolumeRemove(ctx, req.(*VolumeRemoveRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _PublicAPI_Attach_Handler(srv interface{}, stream grpc.ServerStream) error {
-	return srv.(PublicAPIServer).Attach(&publicAPIAttachServer{stream})
-}
-
-type PublicAPI_AttachServer interface {
-	Send(*AttachMessage) error
-	Recv() (*AttachMessage, error)
-	grpc.ServerStream
-}
-
-type publicAPIAttachServer struct {
-	grpc.ServerStream
-}
-
-func (x *publicAPIAttachServer) Send(m *AttachMessage) error {
-	return x.ServerStream.SendMsg(m)
-}
-
-func (x *publicAPIAttachServer) Recv() (*AttachMessage, error) {
-	m := new(AttachMessage)
-	if err := x.ServerStream.RecvMsg(m); err != nil {
-		return nil, err
-	}
-	return m, nil
-}
-
-func _PublicAPI_Wait_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(WaitRequest)
+func _PublicAPI_VolumeResize_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VolumeResizeRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(PublicAPIServer).Wait(ctx, in)
+		return srv.(PublicAPIServer).VolumeResize(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/types.PublicAPI/Wait",
+		FullMethod: "/types.PublicAPI/VolumeResize",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(PublicAPIServer).Wait(ctx, req.(*WaitRequest))
+		return srv.(PublicAPIServer).VolumeResize(ctx, req.(*VolumeResizeRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _PublicAPI_TTYResize_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(TTYResizeRequest)
+func _PublicAPI_VolumeSnapshot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VolumeSnapshotRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(PublicAPIServer).TTYResize(ctx, in)
+		return srv.(PublicAPIServer).VolumeSnapshot(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/types.PublicAPI/TTYResize",
+		FullMethod: "/types.PublicAPI/VolumeSnapshot",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(PublicAPIServer).TTYResize(ctx, req.(*TTYResizeRequest))
+		return srv.(PublicAPIServer).VolumeSnapshot(ctx, req.(*VolumeSnapshotRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _PublicAPI_ServiceList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ServiceListRequest)
+func _PublicAPI_VolumeSnapshotList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VolumeSnapshotListRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(PublicAPIServer).ServiceList(ctx, in)
+		return srv.(PublicAPIServer).VolumeSnapshotList(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/types.PublicAPI/ServiceList",
+		FullMethod: "/types.PublicAPI/VolumeSnapshotList",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(PublicAPIServer).ServiceList(ctx, req.(*ServiceListRequest))
+		return srv.(PublicAPIServer).VolumeSnapshotList(ctx, req.(*VolumeSnapshotListRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _PublicAPI_ServiceAdd_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ServiceAddRequest)
+func _PublicAPI_VolumeSnapshotRemove_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VolumeSnapshotRemoveRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(PublicAPIServer).ServiceAdd(ctx, in)
+		return srv.(PublicAPIServer).VolumeSnapshotRemove(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/types.PublicAPI/ServiceAdd",
+		FullMethod: "/types.PublicAPI/VolumeSnapshotRemove",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(PublicAPIServer).ServiceAdd(ctx, req.(*ServiceAddRequest))
+		return srv.(PublicAPIServer).VolumeSnapshotRemove(ctx, req.(*VolumeSnapshotRemoveRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _PublicAPI_ServiceDelete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ServiceDelRequest)
+func _PublicAPI_VolumeRestore_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VolumeRestoreRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(PublicAPIServer).ServiceDelete(ctx, in)
+		return srv.(PublicAPIServer).VolumeRestore(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/types.PublicAPI/ServiceDelete",
+		FullMethod: "/types.PublicAPI/VolumeRestore",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(PublicAPIServer).ServiceDelete(ctx, req.(*ServiceDelRequest))
+		return srv.(PublicAPIServer).VolumeRestore(ctx, req.(*VolumeRestoreRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _PublicAPI_ServiceUpdate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ServiceUpdateRequest)
+func _PublicAPI_VolumeClone_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VolumeCloneRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(PublicAPIServer).ServiceUpdate(ctx, in)
+		return srv.(PublicAPIServer).VolumeClone(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/types.PublicAPI/ServiceUpdate",
+		FullMethod: "/types.PublicAPI/VolumeClone",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(PublicAPIServer).ServiceUpdate(ctx, req.(*ServiceUpdateRequest))
+		return srv.(PublicAPIServer).VolumeClone(ctx, req.(*VolumeCloneRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _PublicAPI_PortMappingList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(PortMappingListRequest)
+func _PublicAPI_NetworkCreate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NetworkCreateRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(PublicAPIServer).PortMappingList(ctx, in)
+		return srv.(PublicAPIServer).NetworkCreate(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/types.PublicAPI/PortMappingList",
+		FullMethod: "/types.PublicAPI/NetworkCreate",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(PublicAPIServer).PortMappingList(ctx, req.(*PortMappingListRequest))
+		return srv.(PublicAPIServer).NetworkCreate(ctx, req.(*NetworkCreateRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _PublicAPI_PortMappingAdd_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(PortMappingModifyRequest)
+func _PublicAPI_NetworkList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NetworkListRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(PublicAPIServer).PortMappingAdd(ctx, in)
+		return srv.(PublicAPIServer).NetworkList(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/types.PublicAPI/PortMappingAdd",
+		FullMethod: "/types.PublicAPI/NetworkList",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(PublicAPIServer).PortMappingAdd(ctx, req.(*PortMappingModifyRequest))
+		return srv.(PublicAPIServer).NetworkList(ctx, req.(*NetworkListRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _PublicAPI_PortMappingDel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(PortMappingModifyRequest)
+func _PublicAPI_NetworkInspect_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NetworkInspectRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(PublicAPIServer).PortMappingDel(ctx, in)
+		return srv.(PublicAPIServer).NetworkInspect(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/types.PublicAPI/PortMappingDel",
+		FullMethod: "/types.PublicAPI/NetworkInspect",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(PublicAPIServer).PortMappingDel(ctx, req.(*PortMappingModifyRequest))
+		return srv.(PublicAPIServer).NetworkInspect(ctx, req.(*NetworkInspectRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _PublicAPI_ImagePull_Handler(srv interface{}, stream grpc.ServerStream) error {
-	m := new(ImagePullRequest)
-	if err := stream.RecvMsg(m); err != nil {
-		return err
-	}
-	return srv.(PublicAPIServer).ImagePull(m, &publicAPIImagePullServer{stream})
-}
-
-type PublicAPI_ImagePullServer interface {
-	Send(*ImagePullResponse) error
-	grpc.ServerStream
-}
-
-type publicAPIImagePullServer struct {
-	grpc.ServerStream
-}
-
-func (x *publicAPIImagePullServer) Send(m *ImagePullResponse) error {
-	return x.ServerStream.SendMsg(m)
-}
-
-func _PublicAPI_ImagePush_Handler(srv interface{}, stream grpc.ServerStream) error {
-	m := new(ImagePushRequest)
-	if err := stream.RecvMsg(m); err != nil {
-		return err
-	}
-	return srv.(PublicAPIServer).ImagePush(m, &publicAPIImagePushServer{stream})
-}
-
-type PublicAPI_ImagePushServer interface {
-	Send(*ImagePushResponse) error
-	grpc.ServerStream
-}
-
-type publicAPIImagePushServer struct {
-	grpc.ServerStream
-}
-
-func (x *publicAPIImagePushServer) Send(m *ImagePushResponse) error {
-	return x.ServerStream.SendMsg(m)
-}
-
-func _PublicAPI_ImageRemove_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ImageRemoveRequest)
+func _PublicAPI_NetworkRemove_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NetworkRemoveRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(PublicAPIServer).ImageRemove(ctx, in)
+		return srv.(PublicAPIServer).NetworkRemove(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/types.PublicAPI/ImageRemove",
+		FullMethod: "/types.PublicAPI/NetworkRemove",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(PublicAPIServer).ImageRemove(ctx, req.(*ImageRemoveRequest))
+		return srv.(PublicAPIServer).NetworkRemove(ctx, req.(*NetworkRemoveRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -6102,6 +9052,45 @@ func _PublicAPI_Version_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _PublicAPI_SystemPrune_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SystemPruneRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PublicAPIServer).SystemPrune(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/types.PublicAPI/SystemPrune",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PublicAPIServer).SystemPrune(ctx, req.(*SystemPruneRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PublicAPI_Events_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(EventsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(PublicAPIServer).Events(m, &publicAPIEventsServer{stream})
+}
+
+type PublicAPI_EventsServer interface {
+	Send(*Event) error
+	grpc.ServerStream
+}
+
+type publicAPIEventsServer struct {
+	grpc.ServerStream
+}
+
+func (x *publicAPIEventsServer) Send(m *Event) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 var _PublicAPI_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "types.PublicAPI",
 	HandlerType: (*PublicAPIServer)(nil),
@@ -6142,6 +9131,10 @@ var _PublicAPI_serviceDesc = grpc.ServiceDesc{
 			MethodName: "PodUnpause",
 			Handler:    _PublicAPI_PodUnpause_Handler,
 		},
+		{
+			MethodName: "PodUpdateResources",
+			Handler:    _PublicAPI_PodUpdateResources_Handler,
+		},
 		{
 			MethodName: "ContainerList",
 			Handler:    _PublicAPI_ContainerList_Handler,
@@ -6234,10 +9227,78 @@ var _PublicAPI_serviceDesc = grpc.ServiceDesc{
 			MethodName: "PortMappingDel",
 			Handler:    _PublicAPI_PortMappingDel_Handler,
 		},
+		{
+			MethodName: "PodInterfaceList",
+			Handler:    _PublicAPI_PodInterfaceList_Handler,
+		},
+		{
+			MethodName: "PodInterfaceAdd",
+			Handler:    _PublicAPI_PodInterfaceAdd_Handler,
+		},
+		{
+			MethodName: "PodInterfaceRemove",
+			Handler:    _PublicAPI_PodInterfaceRemove_Handler,
+		},
 		{
 			MethodName: "ImageRemove",
 			Handler:    _PublicAPI_ImageRemove_Handler,
 		},
+		{
+			MethodName: "VolumeCreate",
+			Handler:    _PublicAPI_VolumeCreate_Handler,
+		},
+		{
+			MethodName: "VolumeList",
+			Handler:    _PublicAPI_VolumeList_Handler,
+		},
+		{
+			MethodName: "VolumeInspect",
+			Handler:    _PublicAPI_VolumeInspect_Handler,
+		},
+		{
+			MethodName: "VolumeRemove",
+			Handler:    _PublicAPI_VolumeRemove_Handler,
+		},
+		{
+			MethodName: "VolumeResize",
+			Handler:    _PublicAPI_VolumeResize_Handler,
+		},
+		{
+			MethodName: "VolumeSnapshot",
+			Handler:    _PublicAPI_VolumeSnapshot_Handler,
+		},
+		{
+			MethodName: "VolumeSnapshotList",
+			Handler:    _PublicAPI_VolumeSnapshotList_Handler,
+		},
+		{
+			MethodName: "VolumeSnapshotRemove",
+			Handler:    _PublicAPI_VolumeSnapshotRemove_Handler,
+		},
+		{
+			MethodName: "VolumeRestore",
+			Handler:    _PublicAPI_VolumeRestore_Handler,
+		},
+		{
+			MethodName: "VolumeClone",
+			Handler:    _PublicAPI_VolumeClone_Handler,
+		},
+		{
+			MethodName: "NetworkCreate",
+			Handler:    _PublicAPI_NetworkCreate_Handler,
+		},
+		{
+			MethodName: "NetworkList",
+			Handler:    _PublicAPI_NetworkList_Handler,
+		},
+		{
+			MethodName: "NetworkInspect",
+			Handler:    _PublicAPI_NetworkInspect_Handler,
+		},
+		{
+			MethodName: "NetworkRemove",
+			Handler:    _PublicAPI_NetworkRemove_Handler,
+		},
 		{
 			MethodName: "Ping",
 			Handler:    _PublicAPI_Ping_Handler,
@@ -6250,6 +9311,10 @@ var _PublicAPI_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Version",
 			Handler:    _PublicAPI_Version_Handler,
 		},
+		{
+			MethodName: "SystemPrune",
+			Handler:    _PublicAPI_SystemPrune_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -6258,11 +9323,26 @@ var _PublicAPI_serviceDesc = grpc.ServiceDesc{
 			ServerStreams: true,
 			ClientStreams: true,
 		},
+		{
+			StreamName:    "PodStatsStream",
+			Handler:       _PublicAPI_PodStatsStream_Handler,
+			ServerStreams: true,
+		},
 		{
 			StreamName:    "ContainerLogs",
 			Handler:       _PublicAPI_ContainerLogs_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "ContainerCopyFrom",
+			Handler:       _PublicAPI_ContainerCopyFrom_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "ContainerCopyTo",
+			Handler:       _PublicAPI_ContainerCopyTo_Handler,
+			ClientStreams: true,
+		},
 		{
 			StreamName:    "ExecStart",
 			Handler:       _PublicAPI_ExecStart_Handler,
@@ -6285,6 +9365,11 @@ var _PublicAPI_serviceDesc = grpc.ServiceDesc{
 			Handler:       _PublicAPI_ImagePush_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "Events",
+			Handler:       _PublicAPI_Events_Handler,
+			ServerStreams: true,
+		},
 	},
 	Metadata: "types.proto",
 }
@@ -6292,339 +9377,480 @@ var _PublicAPI_serviceDesc = grpc.ServiceDesc{
 func init() { proto.RegisterFile("types.proto", fileDescriptorTypes) }
 
 var fileDescriptorTypes = []byte{
-	// 5342 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x09, 0x6e, 0x88, 0x02, 0xff, 0xb4, 0x3c, 0x4d, 0x6f, 0x1c, 0x47,
-	0x76, 0x9a, 0x2f, 0x0e, 0xe7, 0xf1, 0x53, 0x25, 0x8a, 0x1a, 0xd1, 0x5a, 0x5b, 0xdb, 0x1b, 0x5b,
-	0xb2, 0x1c, 0xd3, 0xb6, 0xd6, 0xb1, 0xbd, 0x72, 0x8c, 0x35, 0x4d, 0xca, 0x6b, 0x22, 0xa2, 0x4d,
-	0x37, 0x25, 0x19, 0x46, 0x16, 0xd8, 0xb4, 0xa6, 0x8b, 0xc3, 0x36, 0x7b, 0xba, 0x27, 0xdd, 0x3d,
-	0x94, 0xe8, 0x5b, 0x72, 0x5a, 0xc0, 0x08, 0x72, 0x58, 0x20, 0xc8, 0xe6, 0x98, 0xe4, 0x10, 0xe4,
-	0x9a, 0x53, 0x82, 0x5c, 0x72, 0xc9, 0x29, 0x7f, 0x24, 0xd9, 0x4b, 0x6e, 0xb9, 0x05, 0xc1, 0x7b,
-	0xf5, 0xaa, 0xba, 0xaa, 0xa7, 0x39, 0xa4, 0x6c, 0xe5, 0x40, 0xa8, 0xdf, 0xab, 0x57, 0xaf, 0x5e,
-	0xbd, 0xaa, 0x7a, 0x5f, 0x55, 0x23, 0x58, 0x28, 0x4e, 0xc7, 0x32, 0xdf, 0x1c, 0x67, 0x69, 0x91,
-	0x8a, 0x0e, 0x01, 0xde, 0x6f, 0x1b, 0xb0, 0xb4, 0x9d, 0x26, 0x45, 0x10, 0x25, 0x32, 0xdb, 0x4f,
-	0xb3, 0x42, 0x08, 0x68, 0x27, 0xc1, 0x48, 0xf6, 0x1b, 0x37, 0x1b, 0xb7, 0x7b, 0x3e, 0x7d, 0x8b,
-	0x0d, 0x98, 0x3f, 0x4a, 0xf3, 0x02, 0xdb, 0xfb, 0xcd, 0x9b, 0x8d, 0xdb, 0x1d, 0xdf, 0xc0, 0xe2,
-	0xf7, 0x60, 0x69, 0x60, 0x33, 0xe8, 0xb7, 0x88, 0xc0, 0x45, 0x22, 0x07, 0x1a, 0x77, 0x90, 0xc6,
-	0xfd, 0x36, 0x71, 0x36, 0xb0, 0x58, 0x87, 0x39, 0xe4, 0xb6, 0xbb, 0xdf, 0xef, 0x50, 0x0b, 0x43,
-	0xde, 0x07, 0xb0, 0x7c, 0x3f, 0x39, 0x89, 0xb2, 0x34, 0x19, 0xc9, 0xa4, 0x78, 0x1c, 0x64, 0x62,
-	0x15, 0x5a, 0x32, 0x39, 0x61, 0xd1, 0xf0, 0x53, 0xac, 0x41, 0xe7, 0x24, 0x88, 0x27, 0x92, 0xc4,
-	0xea, 0xf9, 0x0a, 0xf0, 0xfe, 0x18, 0x16, 0x1e, 0xa7, 0xf1, 0x64, 0x24, 0xf7, 0xd2, 0x49, 0x52,
-	0x3f, 0xa5, 0x1b, 0xd0, 0x1b, 0x61, 0xe3, 0x7e, 0x50, 0x1c, 0x71, 0xe7, 0x12, 0x81, 0xe2, 0x66,
-	0x32, 0x08, 0xbf, 0x48, 0xe2, 0x53, 0x9a, 0xcf, 0xbc, 0x6f, 0x60, 0xef, 0x16, 0x2c, 0x7d, 0x15,
-	0x44, 0x45, 0x94, 0x0c, 0x0f, 0x8a, 0xa0, 0x98, 0xe4, 0x28, 0x7f, 0x26, 0x83, 0x3c, 0x4d, 0x78,
-	0x00, 0x86, 0xbc, 0x37, 0x61, 0xc9, 0x9f, 0x24, 0x49, 0x49, 0x78, 0x03, 0x7a, 0x79, 0x11, 0x64,
-	0x85, 0x0c, 0xb7, 0x0a, 0xa6, 0x2d, 0x11, 0xde, 0x5f, 0x37, 0x00, 0x1e, 0xca, 0x6c, 0xc4, 0xc4,
-	0x1b, 0x30, 0x2f, 0x9f, 0x45, 0xc5, 0x76, 0x1a, 0x2a, 0xc1, 0x3b, 0xbe, 0x81, 0xad, 0x11, 0x9b,
-	0xf6, 0x88, 0xa2, 0x0f, 0xdd, 0x91, 0xcc, 0xf3, 0x60, 0x28, 0x49, 0xea, 0x9e, 0xaf, 0x41, 0x77,
-	0xe8, 0x76, 0x65, 0x68, 0xf1, 0x32, 0xc0, 0x61, 0x94, 0x44, 0xf9, 0x11, 0x35, 0xab, 0x55, 0xb0,
-	0x30, 0xde, 0x7f, 0x37, 0x60, 0xc5, 0xec, 0x12, 0x96, 0xaf, 0x4e, 0xa9, 0x37, 0x61, 0xc1, 0x2c,
-	0xfb, 0xee, 0x0e, 0x0b, 0x67, 0xa3, 0x70, 0xbd, 0xc6, 0x47, 0x41, 0xae, 0xe5, 0x53, 0x80, 0xd8,
-	0x84, 0xee, 0x53, 0xa5, 0x52, 0x92, 0x6d, 0xe1, 0xee, 0xda, 0xa6, 0xda, 0xab, 0x8e, 0xa2, 0x7d,
-	0x4d, 0x84, 0xf4, 0x99, 0xd2, 0x2c, 0x09, 0x5b, 0xd2, 0x3b, 0xfa, 0xf6, 0x35, 0x91, 0x78, 0x07,
-	0xa0, 0x90, 0xd9, 0x28, 0x4a, 0x82, 0x42, 0x86, 0xfd, 0x39, 0xea, 0x72, 0x99, 0xbb, 0x94, 0x2a,
-	0xf7, 0x2d, 0x22, 0xef, 0xef, 0xec, 0x83, 0xb1, 0x9b, 0x1c, 0xa6, 0x62, 0x13, 0x7a, 0x66, 0x26,
-	0x34, 0xeb, 0x85, 0xbb, 0xab, 0xcc, 0xc3, 0x10, 0xfa, 0x25, 0x09, 0xaa, 0x7c, 0x90, 0xc9, 0x40,
-	0xa9, 0x1c, 0x55, 0xd1, 0xf2, 0x4b, 0x04, 0x29, 0x22, 0x0d, 0x77, 0x77, 0x8c, 0x22, 0x10, 0x10,
-	0x9b, 0x30, 0x97, 0x93, 0x2c, 0xac, 0x87, 0xf5, 0xea, 0x00, 0x2c, 0x29, 0x53, 0x79, 0x7f, 0xd9,
-	0x86, 0x9e, 0x69, 0xfb, 0xfe, 0x4b, 0x12, 0x8d, 0xca, 0x2d, 0xa3, 0x00, 0xdc, 0x4a, 0xf4, 0xb1,
-	0xbb, 0xc3, 0xdb, 0x45, 0x83, 0xe2, 0x36, 0xac, 0xd0, 0xe7, 0xfe, 0x24, 0x8e, 0xf7, 0xd3, 0x38,
-	0x1a, 0x9c, 0xf2, 0x8e, 0xa9, 0xa2, 0x71, 0x5b, 0x3d, 0x4d, 0xb3, 0xe3, 0x28, 0x19, 0xee, 0x44,
-	0x19, 0xa9, 0xbd, 0xe7, 0x5b, 0x18, 0x94, 0x77, 0x92, 0xcb, 0xac, 0xdf, 0x55, 0xf2, 0xe2, 0x37,
-	0x1e, 0xf1, 0xa2, 0x38, 0xed, 0xcf, 0xd3, 0xa1, 0xc3, 0x4f, 0x3c, 0x08, 0x83, 0x74, 0x34, 0x0a,
-	0x92, 0x30, 0xef, 0xf7, 0x6e, 0xb6, 0xd0, 0x74, 0x68, 0x18, 0x39, 0x04, 0xd9, 0x30, 0xef, 0x03,
-	0xe1, 0xe9, 0x5b, 0xdc, 0x41, 0xcd, 0x66, 0x45, 0xde, 0x5f, 0xb8, 0xd9, 0xb2, 0xb6, 0x86, 0x63,
-	0xe5, 0x7c, 0x45, 0x22, 0x6e, 0x29, 0x83, 0xb2, 0x48, 0x94, 0x57, 0x99, 0xd2, 0x35, 0x3a, 0xca,
-	0xce, 0xbc, 0x07, 0x8b, 0x27, 0xa5, 0x45, 0xc9, 0xfb, 0x4b, 0xd4, 0x43, 0x70, 0x0f, 0xcb, 0xd8,
-	0xf8, 0x0e, 0x9d, 0x78, 0x17, 0xe6, 0xe2, 0xe0, 0x89, 0x8c, 0xf3, 0xfe, 0x32, 0xf5, 0xb8, 0x51,
-	0x95, 0x66, 0xf3, 0x01, 0x35, 0xdf, 0x4f, 0x8a, 0xec, 0xd4, 0x67, 0xda, 0x8d, 0x9f, 0xc1, 0x82,
-	0x85, 0x46, 0x9d, 0x1c, 0xcb, 0x53, 0x6d, 0xf6, 0x8e, 0xe5, 0x69, 0xbd, 0xd9, 0xbb, 0xd7, 0xfc,
-	0xa0, 0xe1, 0xfd, 0x73, 0x03, 0x56, 0xfc, 0x4f, 0x76, 0x94, 0x44, 0x07, 0xe9, 0x24, 0x1b, 0x90,
-	0xf9, 0x1e, 0xa5, 0x49, 0x54, 0xa4, 0x59, 0xde, 0x6f, 0x28, 0x0d, 0x6a, 0xb8, 0x5c, 0xfd, 0xa6,
-	0xbd, 0xfa, 0xeb, 0x30, 0x77, 0x98, 0x3f, 0x3c, 0x1d, 0xeb, 0x4d, 0xc1, 0x10, 0xea, 0x7b, 0x9c,
-	0x1a, 0x13, 0x4e, 0xdf, 0x66, 0x15, 0x3b, 0xd6, 0x2a, 0xf6, 0xa1, 0x7b, 0x2c, 0x4f, 0x33, 0x3c,
-	0xa0, 0x6a, 0xd9, 0x35, 0xe8, 0x58, 0xd6, 0x6e, 0xc5, 0xb2, 0x9e, 0x42, 0x6f, 0x3f, 0x0d, 0x95,
-	0xe8, 0xb5, 0x9b, 0x79, 0x1d, 0xe6, 0x72, 0x9a, 0x92, 0xb6, 0x7b, 0x0a, 0x42, 0x7c, 0x98, 0x45,
-	0x27, 0x32, 0xd3, 0xe2, 0x2a, 0x48, 0xdc, 0x86, 0x56, 0xf6, 0x24, 0xac, 0x9c, 0xa5, 0x8a, 0x76,
-	0x7c, 0x24, 0xf1, 0xfe, 0xbc, 0x09, 0xdd, 0xfd, 0x34, 0x3c, 0x18, 0xcb, 0x81, 0xb8, 0x03, 0x5d,
-	0xb5, 0x86, 0x4a, 0x5b, 0xe5, 0x31, 0x37, 0xc2, 0xf9, 0x9a, 0x40, 0xbc, 0x0d, 0x60, 0xce, 0x52,
-	0xde, 0x6f, 0x3a, 0xe4, 0xa5, 0x55, 0xb0, 0x68, 0xc4, 0x5d, 0xb3, 0x23, 0x5a, 0x44, 0xbd, 0x51,
-	0x32, 0xc7, 0xd1, 0xeb, 0xf6, 0x03, 0xea, 0xe2, 0x64, 0x30, 0x9e, 0xd0, 0x44, 0x3a, 0x3e, 0x7d,
-	0xe3, 0x9c, 0x47, 0x72, 0x94, 0x66, 0xea, 0xf4, 0x75, 0x7c, 0x86, 0x7e, 0xc8, 0xde, 0xf9, 0xb3,
-	0x26, 0x2d, 0x00, 0x1b, 0x78, 0x63, 0xaa, 0x1b, 0xb6, 0xa9, 0xb6, 0x5c, 0x4c, 0xd3, 0x75, 0x31,
-	0xa5, 0x53, 0x6a, 0x39, 0x4e, 0xa9, 0x74, 0xef, 0x6d, 0xdb, 0xbd, 0x6b, 0x0b, 0x88, 0x5e, 0xbf,
-	0xa5, 0x2d, 0xe0, 0xbe, 0x71, 0x54, 0x0f, 0xa3, 0x91, 0xe4, 0xbd, 0x53, 0x22, 0xc4, 0xc7, 0xb0,
-	0x32, 0x70, 0x4d, 0x61, 0xbf, 0x4b, 0x5a, 0x3c, 0xcb, 0x50, 0x56, 0xc9, 0x4b, 0x57, 0x47, 0x03,
-	0xcc, 0xdb, 0xae, 0x0e, 0x31, 0xde, 0x7f, 0x36, 0x68, 0x23, 0x90, 0xc5, 0x37, 0x36, 0xba, 0x61,
-	0xdb, 0x68, 0x01, 0xed, 0xe3, 0x28, 0x09, 0x79, 0xfa, 0xf4, 0x8d, 0x5c, 0x83, 0x71, 0xf4, 0x58,
-	0x66, 0x79, 0x64, 0xe6, 0x6f, 0x61, 0xc4, 0x32, 0x34, 0x4f, 0x46, 0x3c, 0xff, 0xe6, 0xc9, 0xc8,
-	0xf5, 0x0d, 0x9d, 0xaa, 0x6f, 0xf0, 0xa0, 0x9d, 0x8f, 0xe5, 0x80, 0x1d, 0xd5, 0xb2, 0xbb, 0x41,
-	0x7c, 0x6a, 0x13, 0xb7, 0x8d, 0xa7, 0xe8, 0x3a, 0xae, 0xc8, 0xac, 0x9f, 0xf6, 0x11, 0xb8, 0x62,
-	0xe3, 0x34, 0xfc, 0x3c, 0x30, 0xd3, 0xd5, 0xa0, 0xf7, 0xb7, 0x4d, 0xe8, 0xed, 0x92, 0x55, 0xc7,
-	0xd9, 0x2e, 0x43, 0x33, 0x0a, 0x79, 0xaa, 0xcd, 0x28, 0xa4, 0x90, 0x2d, 0xc8, 0x64, 0x52, 0x18,
-	0xb7, 0x61, 0x60, 0x75, 0x8a, 0xc7, 0xe9, 0xc3, 0x60, 0xa8, 0xb6, 0x71, 0xcf, 0x37, 0x30, 0x7a,
-	0x1c, 0xfc, 0xde, 0x89, 0x86, 0x32, 0x2f, 0xd0, 0x91, 0x61, 0xb3, 0x8d, 0x42, 0x89, 0x78, 0xb2,
-	0x3c, 0x77, 0x0d, 0x62, 0xdf, 0x93, 0x28, 0x2b, 0x26, 0x41, 0x7c, 0x10, 0x7d, 0xab, 0xd6, 0xbf,
-	0xe5, 0xdb, 0x28, 0xcb, 0xa0, 0x76, 0x1d, 0x83, 0x6a, 0xe6, 0xf1, 0xa2, 0x0d, 0xea, 0xbf, 0x35,
-	0x61, 0x9e, 0x95, 0x9a, 0x8b, 0x1f, 0x43, 0x0b, 0xcf, 0xa1, 0xf2, 0xfe, 0x2b, 0x7a, 0xcf, 0x8d,
-	0x27, 0xd4, 0xea, 0x63, 0x9b, 0xb8, 0x05, 0x9d, 0x27, 0x71, 0x3a, 0x38, 0x26, 0x4e, 0x65, 0x98,
-	0xf1, 0x49, 0x7c, 0x1c, 0xa5, 0x8a, 0x4c, 0xb5, 0x8b, 0x3b, 0xe6, 0x00, 0xb7, 0x88, 0x52, 0x3b,
-	0x93, 0x3d, 0x42, 0x2a, 0x52, 0xa6, 0x10, 0x6f, 0x42, 0x37, 0x91, 0x05, 0xba, 0x4e, 0x36, 0x66,
-	0x57, 0x98, 0xf8, 0x73, 0x85, 0x55, 0xd4, 0x9a, 0x46, 0x6c, 0xe2, 0x26, 0x8f, 0x65, 0x7e, 0x9a,
-	0x17, 0x72, 0x44, 0xe7, 0xab, 0xdc, 0x46, 0x9f, 0xe6, 0x8a, 0xd8, 0xa2, 0xc0, 0xed, 0x58, 0x44,
-	0x23, 0x99, 0x17, 0xc1, 0x68, 0xcc, 0x4a, 0x2f, 0x11, 0xce, 0xa1, 0x53, 0x9d, 0xcf, 0x3a, 0x74,
-	0xcc, 0xba, 0x4a, 0xee, 0x1d, 0xc0, 0xbc, 0x56, 0x92, 0x78, 0x15, 0x3a, 0x13, 0x32, 0x1f, 0x53,
-	0x4a, 0x7c, 0x84, 0x68, 0x5f, 0xb5, 0xe2, 0x4e, 0x78, 0x90, 0x06, 0xe1, 0xd6, 0x89, 0xcc, 0xb4,
-	0xad, 0xe9, 0xf8, 0x36, 0xca, 0x0b, 0x89, 0x29, 0x75, 0xc2, 0xe5, 0x2b, 0xd2, 0x22, 0x88, 0x89,
-	0x69, 0xdb, 0x57, 0x00, 0x5a, 0x9e, 0xb1, 0xcc, 0xb6, 0xc7, 0x13, 0x32, 0xcc, 0x6d, 0x9f, 0x21,
-	0xe3, 0xb1, 0x5a, 0x44, 0xac, 0x3c, 0x16, 0xba, 0x16, 0xa5, 0xae, 0x36, 0x61, 0x19, 0xf2, 0xfe,
-	0xa3, 0x0d, 0x50, 0xae, 0x9d, 0xf8, 0x02, 0xae, 0x45, 0xe9, 0x81, 0xcc, 0x4e, 0xa2, 0x81, 0xfc,
-	0xe4, 0xb4, 0x90, 0xb9, 0x2f, 0x07, 0x93, 0x2c, 0x8f, 0x4e, 0x24, 0xfb, 0x8a, 0xab, 0xd5, 0xf5,
-	0x56, 0x1b, 0xf1, 0xac, 0x5e, 0xe2, 0x17, 0x70, 0xc5, 0x34, 0x85, 0x25, 0xb3, 0xe6, 0x2c, 0x66,
-	0x75, 0x3d, 0xc4, 0x36, 0x5c, 0x8e, 0xd2, 0x2f, 0x27, 0x72, 0x62, 0xb3, 0x69, 0xcd, 0x62, 0x33,
-	0x4d, 0x2f, 0xf6, 0x60, 0xdd, 0xf0, 0x46, 0x73, 0x58, 0x72, 0x6a, 0xcf, 0xe2, 0x74, 0x46, 0x27,
-	0x35, 0x39, 0x8c, 0xe1, 0x5d, 0x5e, 0x9d, 0x73, 0x26, 0x37, 0xd5, 0x43, 0x4d, 0x6e, 0x4f, 0x66,
-	0x43, 0x7b, 0x72, 0x73, 0xe7, 0x4c, 0xae, 0x42, 0x2f, 0x7e, 0x0e, 0x2b, 0x51, 0xea, 0x4a, 0xd2,
-	0x9d, 0xc5, 0xa2, 0x4a, 0x2d, 0xb6, 0x60, 0x35, 0x97, 0x03, 0x0c, 0x9b, 0x4a, 0x0e, 0xf3, 0xb3,
-	0x38, 0x4c, 0x91, 0x7b, 0xff, 0xd5, 0x80, 0x65, 0x97, 0xa8, 0x36, 0xd0, 0x11, 0xd0, 0x46, 0x86,
-	0xda, 0xc7, 0xe0, 0xb7, 0x15, 0xfc, 0xb4, 0x9c, 0xe0, 0x67, 0x0d, 0x3a, 0xa3, 0xe0, 0x9b, 0x34,
-	0xe3, 0x8d, 0xab, 0x00, 0xc2, 0x46, 0x49, 0xaa, 0xc2, 0x32, 0xc4, 0x22, 0x20, 0x7e, 0x0a, 0x6d,
-	0xf4, 0x0a, 0xac, 0xba, 0x57, 0x6a, 0xa5, 0xde, 0x2c, 0xe5, 0x27, 0xe2, 0x8d, 0xf7, 0xa1, 0x57,
-	0x4a, 0x7b, 0x8e, 0xe9, 0x6c, 0xdb, 0xa6, 0xf3, 0x77, 0x0d, 0x58, 0xb0, 0xac, 0x19, 0x52, 0x96,
-	0x47, 0xbf, 0xad, 0x4f, 0x7a, 0x99, 0x25, 0x1c, 0xc8, 0x82, 0x99, 0x58, 0x18, 0xf4, 0x16, 0x87,
-	0x41, 0x14, 0x0f, 0x92, 0x82, 0x0f, 0xac, 0x06, 0xc5, 0x27, 0x56, 0xe9, 0x61, 0x27, 0x28, 0x02,
-	0xb6, 0x8d, 0x37, 0xa6, 0x0d, 0xa9, 0xfa, 0x44, 0x1a, 0xdf, 0xed, 0x22, 0x3e, 0x83, 0xd5, 0xa3,
-	0x48, 0x66, 0x41, 0x36, 0x38, 0x8a, 0x06, 0x41, 0x4c, 0x6c, 0x3a, 0x17, 0x60, 0x33, 0xd5, 0xcb,
-	0xfb, 0x12, 0xae, 0xd6, 0x92, 0x92, 0x03, 0x1e, 0x1e, 0x06, 0x93, 0xb8, 0xe0, 0x89, 0x6b, 0x10,
-	0xa7, 0x3e, 0x1e, 0x8e, 0x82, 0x6f, 0x54, 0x23, 0x4f, 0xbd, 0xc4, 0x78, 0xdf, 0x35, 0x60, 0xd1,
-	0xb6, 0xf0, 0xe2, 0x0f, 0x00, 0xa2, 0xa4, 0x90, 0xd9, 0x61, 0x30, 0x30, 0xd1, 0xa9, 0xde, 0x7b,
-	0xbb, 0xba, 0x81, 0xed, 0x7b, 0x49, 0x28, 0x6e, 0x42, 0xab, 0x18, 0x8c, 0xd9, 0x23, 0x69, 0x47,
-	0xf0, 0x70, 0x30, 0x46, 0x4a, 0x1f, 0x9b, 0x30, 0xe4, 0x28, 0x06, 0xe3, 0xf7, 0xd8, 0x15, 0x55,
-	0x49, 0xa8, 0xcd, 0xfb, 0xa7, 0x26, 0x74, 0x19, 0x83, 0xe6, 0x19, 0xbd, 0xc3, 0x93, 0x98, 0x4a,
-	0x04, 0x3c, 0x2f, 0x1b, 0x85, 0xb3, 0xce, 0x4f, 0x93, 0x03, 0x99, 0xe8, 0x89, 0x69, 0x90, 0x5b,
-	0x7c, 0x39, 0x38, 0xd1, 0x0b, 0xca, 0x20, 0x86, 0x15, 0x87, 0x51, 0x82, 0xc7, 0xff, 0x1d, 0xde,
-	0xcd, 0x06, 0xb6, 0xda, 0xee, 0xf2, 0x9e, 0x36, 0x30, 0xb6, 0xa1, 0xbb, 0x42, 0x80, 0xdc, 0x57,
-	0xdb, 0x37, 0x30, 0x6e, 0xba, 0x41, 0x9c, 0xe6, 0x92, 0xe2, 0xa4, 0xb6, 0xaf, 0x00, 0x0a, 0xc0,
-	0xf0, 0x83, 0xba, 0xcc, 0x53, 0x4b, 0x89, 0x40, 0x09, 0xe3, 0x20, 0x2f, 0xb6, 0x06, 0xc7, 0xfd,
-	0x9e, 0x92, 0x90, 0x41, 0x3c, 0x84, 0x71, 0x94, 0x17, 0x32, 0xe9, 0x83, 0x72, 0x13, 0x0a, 0xa2,
-	0x90, 0x26, 0x4e, 0x73, 0x4c, 0x78, 0x16, 0x54, 0x0f, 0x06, 0xbd, 0x5f, 0x37, 0x61, 0xd9, 0x5d,
-	0x9a, 0xda, 0x13, 0xdf, 0x87, 0x6e, 0xf6, 0x8c, 0x7c, 0x83, 0x56, 0x17, 0x83, 0x28, 0x6a, 0xf6,
-	0x6c, 0x3f, 0x18, 0x1c, 0xcb, 0x22, 0x67, 0x85, 0x95, 0x08, 0x8a, 0xc4, 0x9e, 0xdd, 0xcf, 0x32,
-	0xcc, 0xed, 0x58, 0x65, 0x1a, 0x56, 0x3d, 0x77, 0xb2, 0x74, 0x3c, 0xe6, 0x48, 0x8b, 0x7a, 0x32,
-	0x02, 0x47, 0x2c, 0x78, 0x44, 0xa5, 0x33, 0x0d, 0x52, 0x38, 0x60, 0x46, 0x54, 0x6a, 0x2b, 0x11,
-	0xa4, 0x6c, 0x3d, 0xe2, 0x3c, 0x2b, 0xdb, 0x1a, 0xb1, 0x30, 0x23, 0xf6, 0x74, 0x4f, 0x46, 0x78,
-	0xbf, 0x6b, 0x41, 0x97, 0xc3, 0x0f, 0x4a, 0xd9, 0x24, 0x7a, 0x0c, 0x5d, 0x34, 0x53, 0x10, 0x2e,
-	0x57, 0x1c, 0x8d, 0x22, 0xbd, 0x69, 0x14, 0x50, 0x5a, 0x8e, 0x96, 0x6d, 0x39, 0x6e, 0x40, 0x2f,
-	0x38, 0x09, 0xa2, 0x38, 0x78, 0x12, 0x4b, 0x9e, 0x7c, 0x89, 0x10, 0xaf, 0xc1, 0x32, 0x66, 0x96,
-	0xf9, 0x76, 0x3a, 0x1a, 0xc7, 0xb2, 0x30, 0x2a, 0xa8, 0x60, 0x55, 0xbc, 0x1a, 0x84, 0xb9, 0x72,
-	0x17, 0xac, 0x0b, 0x1b, 0x85, 0x14, 0xc6, 0x90, 0x07, 0x21, 0x6b, 0xc4, 0x46, 0xe9, 0xac, 0xd6,
-	0xe4, 0x14, 0x6d, 0xdf, 0xc0, 0xe2, 0x36, 0xac, 0x3c, 0xcd, 0xa2, 0x42, 0x5a, 0x82, 0x28, 0xcd,
-	0x54, 0xd1, 0xc2, 0x83, 0x45, 0x85, 0x62, 0x51, 0xd4, 0x16, 0x73, 0x70, 0x38, 0x2b, 0x1e, 0xf8,
-	0xab, 0x2c, 0x2a, 0x70, 0x23, 0xaa, 0xfd, 0x56, 0xc1, 0xa2, 0x6e, 0xa8, 0x1f, 0x89, 0xb4, 0xa8,
-	0x74, 0x63, 0x10, 0x38, 0x52, 0x94, 0xee, 0x26, 0xfb, 0x59, 0x3a, 0xcc, 0x64, 0x9e, 0xf7, 0x97,
-	0xd4, 0x48, 0x36, 0x0e, 0x57, 0x48, 0x39, 0xc0, 0xfe, 0xb2, 0xda, 0xea, 0x0a, 0x42, 0x09, 0x9e,
-	0xca, 0x68, 0x78, 0x54, 0xc8, 0x70, 0x57, 0xb5, 0xaf, 0x28, 0x09, 0x5c, 0xac, 0xf7, 0x0f, 0x4d,
-	0xab, 0x68, 0xc8, 0xab, 0x5e, 0xa9, 0x46, 0x35, 0xa6, 0xab, 0x51, 0x1c, 0x61, 0x37, 0x2f, 0x12,
-	0x61, 0xb7, 0x2e, 0x1c, 0x61, 0xb7, 0x9f, 0x27, 0xc2, 0xee, 0x3c, 0x77, 0x84, 0x3d, 0xf7, 0x7c,
-	0x11, 0x76, 0xb7, 0x12, 0x61, 0x7b, 0xaf, 0xc1, 0x32, 0xe7, 0x9c, 0xbe, 0xfc, 0xd3, 0x89, 0xcc,
-	0x8b, 0xfa, 0xd4, 0xd3, 0xfb, 0x10, 0x56, 0x0c, 0x5d, 0x3e, 0x4e, 0x93, 0x1c, 0x77, 0x17, 0xa6,
-	0x73, 0x88, 0xe2, 0x80, 0xda, 0x4a, 0x17, 0x89, 0x50, 0x37, 0x7b, 0xf7, 0x68, 0x90, 0x07, 0x51,
-	0x5e, 0xcc, 0x1c, 0x84, 0x8a, 0x0d, 0x23, 0x93, 0xf3, 0xd1, 0xb7, 0xf7, 0xbf, 0x0d, 0x58, 0x32,
-	0x9d, 0x73, 0x74, 0x5d, 0xf5, 0x7d, 0xad, 0x5c, 0xb3, 0xe9, 0xe4, 0x9a, 0x86, 0x6b, 0xab, 0xe4,
-	0x4a, 0x11, 0x4d, 0x59, 0xed, 0xec, 0x99, 0x8c, 0x75, 0x76, 0x76, 0xfc, 0x81, 0xc9, 0x00, 0x95,
-	0xda, 0x6f, 0x96, 0x13, 0x2e, 0xe5, 0x7b, 0xd1, 0x59, 0xe0, 0x16, 0x69, 0x9e, 0xf9, 0x2b, 0xcd,
-	0x6f, 0xd2, 0x5c, 0x11, 0xc5, 0x8e, 0x78, 0xad, 0x4e, 0x10, 0x5f, 0x13, 0x79, 0x1f, 0xc3, 0x9a,
-	0x39, 0x0e, 0xdf, 0x6f, 0x15, 0xbe, 0x6b, 0xc0, 0x95, 0x0a, 0x0b, 0x5a, 0x8b, 0xf3, 0x4f, 0x95,
-	0x7d, 0x49, 0x63, 0xad, 0x8e, 0x8b, 0x3c, 0xa3, 0x26, 0x7d, 0xc6, 0x2a, 0x79, 0x5f, 0xc3, 0xd5,
-	0xaa, 0x30, 0x4a, 0x31, 0x1f, 0x5b, 0x83, 0x59, 0xea, 0xd9, 0xa8, 0x66, 0x8b, 0x96, 0x92, 0xdc,
-	0x0e, 0xde, 0xbb, 0x96, 0xaa, 0xec, 0x53, 0x71, 0xa3, 0x5a, 0x82, 0xef, 0x59, 0x05, 0x77, 0xef,
-	0xc0, 0x12, 0xc8, 0x39, 0x23, 0xf7, 0x2c, 0x81, 0xac, 0x93, 0x32, 0x55, 0x19, 0xa6, 0x4e, 0x2e,
-	0xa9, 0xb7, 0x0f, 0x8b, 0x8f, 0xf7, 0x2c, 0x5d, 0xeb, 0x75, 0x69, 0x58, 0xfb, 0xd8, 0xe8, 0xad,
-	0x59, 0xaf, 0xb7, 0x96, 0xa3, 0xb7, 0x9f, 0xc1, 0x92, 0xe6, 0xf8, 0xbc, 0x1b, 0xe0, 0x23, 0x58,
-	0x36, 0xc2, 0xa8, 0xa9, 0xbd, 0x01, 0x73, 0x27, 0x23, 0x4b, 0xc9, 0xda, 0x6a, 0xd9, 0x32, 0xfb,
-	0x4c, 0xe2, 0xfd, 0x12, 0x56, 0xa9, 0x4c, 0x62, 0x0f, 0x4e, 0xf5, 0xb0, 0xb8, 0x90, 0xd9, 0x56,
-	0x36, 0xcc, 0x59, 0x02, 0x0b, 0x43, 0x95, 0x60, 0x82, 0x74, 0xc9, 0x55, 0x41, 0x78, 0x78, 0x82,
-	0x38, 0xe6, 0xcb, 0x31, 0xfc, 0xf4, 0xb6, 0xe1, 0xb2, 0xc5, 0xdd, 0x1c, 0x92, 0x5e, 0xa4, 0x91,
-	0x95, 0x6a, 0xaa, 0xa9, 0xd8, 0xf8, 0x25, 0x09, 0x5a, 0xb8, 0xc7, 0x7b, 0xdb, 0x74, 0xd6, 0xb5,
-	0x84, 0xab, 0x65, 0xcd, 0xa5, 0xa3, 0x1c, 0x40, 0x59, 0xfa, 0x6c, 0xda, 0xa5, 0x4f, 0xef, 0x35,
-	0x58, 0x2d, 0x3b, 0xb3, 0x00, 0x35, 0xeb, 0xe5, 0xbd, 0x8a, 0x83, 0xf8, 0x72, 0x94, 0x9e, 0x98,
-	0x41, 0xea, 0xc8, 0xfe, 0x10, 0xd9, 0x69, 0xb2, 0x92, 0xdd, 0xa0, 0xbc, 0x91, 0xa3, 0x6f, 0x8a,
-	0x30, 0x83, 0x49, 0x6e, 0xac, 0x06, 0x01, 0xde, 0x6f, 0x1a, 0x70, 0xf9, 0x51, 0x2e, 0xb3, 0xed,
-	0xea, 0x3d, 0xa8, 0xb9, 0x49, 0x6d, 0x9c, 0x77, 0x93, 0xda, 0xac, 0xbb, 0x49, 0xa5, 0x60, 0x84,
-	0x72, 0x6d, 0xeb, 0xb6, 0xd5, 0x46, 0xcd, 0xba, 0x6b, 0xf5, 0x7e, 0xdd, 0x80, 0x2b, 0x28, 0x15,
-	0xd7, 0xb1, 0xe5, 0xa1, 0xcc, 0x64, 0x32, 0x50, 0x85, 0xfd, 0xa0, 0x38, 0xd2, 0xf3, 0xc7, 0x6f,
-	0x54, 0xb3, 0x2a, 0x73, 0xeb, 0xa5, 0x57, 0xd0, 0xac, 0xcb, 0x51, 0xf1, 0x3a, 0x86, 0x75, 0x45,
-	0x10, 0xc5, 0xec, 0x72, 0xb5, 0x73, 0xb6, 0xc6, 0x64, 0x02, 0xef, 0x1f, 0x59, 0x41, 0x9f, 0x46,
-	0xf1, 0x39, 0x82, 0x50, 0xe8, 0x1f, 0xcb, 0xa4, 0x34, 0x5c, 0x06, 0x26, 0x7a, 0x99, 0x8d, 0xb4,
-	0x5f, 0xc1, 0x6f, 0x53, 0xdf, 0x69, 0x5b, 0x37, 0x12, 0x6b, 0xd0, 0x19, 0x66, 0xe9, 0x64, 0xcc,
-	0xd7, 0x14, 0x0a, 0x10, 0xb7, 0x8c, 0xb8, 0x73, 0x4e, 0xc0, 0x61, 0xe4, 0xd2, 0xc2, 0xfe, 0x09,
-	0xcc, 0x23, 0x0e, 0xff, 0x6a, 0xc3, 0x77, 0xc3, 0xbe, 0x69, 0xb3, 0xbf, 0x03, 0xab, 0x41, 0x18,
-	0x46, 0x45, 0x94, 0x26, 0x41, 0xfc, 0x0b, 0x44, 0xe9, 0x72, 0xe9, 0x14, 0xde, 0xdb, 0x81, 0xb9,
-	0x47, 0x2a, 0xd8, 0x15, 0xd0, 0xfe, 0xdc, 0xe2, 0xaf, 0xdd, 0xe7, 0x67, 0x41, 0x16, 0x72, 0x54,
-	0x4c, 0xdf, 0x88, 0x3b, 0x48, 0x0f, 0x75, 0x56, 0x4c, 0xdf, 0xde, 0xdf, 0xcf, 0xc1, 0x92, 0xb3,
-	0xeb, 0xce, 0x92, 0xb6, 0xe6, 0xd2, 0xa7, 0x0f, 0x5d, 0x8c, 0x6d, 0xc2, 0x48, 0x5f, 0xa3, 0x68,
-	0x10, 0x77, 0x66, 0x26, 0xa9, 0x0a, 0xcf, 0x17, 0x7e, 0x4a, 0xb3, 0x2e, 0x52, 0x5f, 0xdd, 0x75,
-	0xca, 0xab, 0xbb, 0x0f, 0xa8, 0xa8, 0x36, 0x28, 0xe2, 0x8a, 0xab, 0x76, 0x24, 0xdc, 0x3c, 0x20,
-	0x12, 0x76, 0xd5, 0x8a, 0x5e, 0xbc, 0x0e, 0x6d, 0x99, 0x9c, 0xe4, 0x95, 0x02, 0x4d, 0xe5, 0x66,
-	0x8e, 0x48, 0x28, 0xf5, 0x52, 0xf7, 0x81, 0x54, 0x8c, 0xe9, 0xf9, 0x1a, 0x44, 0xdb, 0x26, 0x91,
-	0xeb, 0x38, 0x8d, 0x92, 0x82, 0xef, 0x0e, 0x2d, 0x8c, 0xd8, 0xd4, 0x37, 0x85, 0x40, 0xa3, 0xf4,
-	0xeb, 0xa4, 0xb3, 0x6f, 0x0b, 0xdf, 0x2d, 0x2f, 0x86, 0x16, 0x1c, 0x97, 0x56, 0x73, 0xa2, 0xca,
-	0x2b, 0xa2, 0x4d, 0xe8, 0x50, 0x20, 0xc8, 0xb7, 0x8c, 0xfd, 0xea, 0x16, 0x33, 0x3d, 0x14, 0x99,
-	0xf8, 0x09, 0xef, 0xde, 0xa5, 0xa9, 0x1d, 0x89, 0x7f, 0xbc, 0x9d, 0x3f, 0xa8, 0xdc, 0x2b, 0xd6,
-	0x6b, 0xb6, 0xee, 0x2e, 0x49, 0x95, 0xf9, 0x57, 0x4c, 0x99, 0xff, 0x65, 0x80, 0x83, 0x22, 0x1d,
-	0x1f, 0x44, 0xc3, 0x24, 0x88, 0xfb, 0x97, 0x95, 0x03, 0x28, 0x31, 0xe2, 0x16, 0x74, 0x27, 0xb4,
-	0x2f, 0xf3, 0xbe, 0xa0, 0xa1, 0x96, 0xf4, 0x50, 0x84, 0xf5, 0x75, 0x2b, 0x25, 0xcd, 0xe9, 0x90,
-	0xde, 0x53, 0x5c, 0x51, 0xdb, 0x87, 0x41, 0xc7, 0x60, 0xac, 0xb9, 0x06, 0x03, 0x63, 0x32, 0x6b,
-	0xfd, 0x9f, 0x27, 0x26, 0xfb, 0x21, 0xe1, 0xdc, 0x3d, 0x58, 0x24, 0x65, 0x4a, 0xae, 0xa1, 0xe9,
-	0x0b, 0xb6, 0x46, 0xed, 0x05, 0x9b, 0xeb, 0x65, 0x0e, 0x95, 0x29, 0xc0, 0xb5, 0x3b, 0xeb, 0xb1,
-	0x8c, 0x4c, 0x06, 0x69, 0x18, 0x25, 0x43, 0x6d, 0xad, 0x34, 0x8c, 0x32, 0x4e, 0xb2, 0x88, 0x8f,
-	0x17, 0x7e, 0xaa, 0xdd, 0x9b, 0x14, 0x32, 0xd1, 0xcf, 0x32, 0x34, 0x88, 0xde, 0xba, 0xdc, 0x57,
-	0x5f, 0x8c, 0xd1, 0x58, 0x18, 0xcb, 0xd6, 0xa8, 0xbf, 0x6b, 0x6d, 0x4e, 0xdd, 0xb5, 0x9a, 0x7b,
-	0xdf, 0x96, 0x7b, 0xef, 0xeb, 0xfd, 0xb6, 0x01, 0x50, 0xb2, 0x7f, 0xde, 0xdb, 0xd6, 0xc3, 0x34,
-	0x1b, 0x05, 0x85, 0xb9, 0x1c, 0x26, 0x48, 0xbc, 0x05, 0x73, 0x29, 0x89, 0xc9, 0xb6, 0xff, 0xda,
-	0xd4, 0xe9, 0x50, 0xb3, 0xf0, 0x99, 0x4c, 0xdd, 0x32, 0x53, 0x9d, 0xb3, 0xa3, 0x6f, 0x99, 0x11,
-	0xf2, 0xfe, 0xa2, 0xa1, 0x8c, 0x98, 0x29, 0x9b, 0x20, 0xe5, 0x93, 0x2c, 0x0a, 0x87, 0xa6, 0x5a,
-	0xa0, 0x20, 0xda, 0xcc, 0xda, 0xe6, 0x36, 0xa3, 0x31, 0xe5, 0xac, 0x87, 0x34, 0x11, 0x16, 0x4d,
-	0x41, 0xa8, 0xf7, 0x51, 0x30, 0x60, 0x0d, 0xe3, 0x27, 0x6a, 0x6d, 0x18, 0x14, 0xf2, 0x69, 0xa0,
-	0x5f, 0x2f, 0x68, 0x90, 0xcc, 0x58, 0x30, 0xe6, 0xbb, 0x47, 0xfc, 0xf4, 0x3e, 0x03, 0x81, 0xe2,
-	0xe8, 0x02, 0x7e, 0x30, 0x38, 0x96, 0x49, 0x68, 0xdd, 0x6b, 0x36, 0x9c, 0x7b, 0xcd, 0x19, 0x8f,
-	0xa5, 0xbc, 0xbf, 0x69, 0xc0, 0x82, 0xc5, 0x8a, 0x6e, 0x3b, 0xd5, 0xa7, 0x61, 0x53, 0x22, 0x1c,
-	0x47, 0xde, 0xac, 0x3c, 0x9a, 0x3a, 0x3f, 0x0c, 0x78, 0x0b, 0x3a, 0x38, 0x6e, 0xce, 0xa5, 0xfb,
-	0xeb, 0xd6, 0x6a, 0xb8, 0x33, 0xf1, 0x15, 0x9d, 0xf7, 0x57, 0x0d, 0x58, 0xc4, 0xdc, 0x25, 0x1d,
-	0x6e, 0xa7, 0xc9, 0x61, 0x34, 0x34, 0x55, 0xe8, 0x86, 0x55, 0x85, 0x7e, 0x1f, 0xe6, 0x06, 0xd4,
-	0xca, 0x57, 0x14, 0xaf, 0x58, 0x49, 0x8f, 0xee, 0xb8, 0xa9, 0xfe, 0x61, 0xbb, 0xa3, 0xc8, 0xf1,
-	0xb4, 0x5a, 0xe8, 0xe7, 0x3a, 0xad, 0xc7, 0xb0, 0x80, 0x33, 0xda, 0x0b, 0xc6, 0x63, 0xdc, 0xd6,
-	0x53, 0x71, 0x52, 0xa3, 0x92, 0xcc, 0x4c, 0x45, 0x5a, 0xac, 0x3c, 0x13, 0x69, 0xd9, 0x8a, 0x6d,
-	0x55, 0x22, 0xa4, 0x04, 0xd6, 0x90, 0x66, 0xa4, 0x06, 0xfb, 0xea, 0x28, 0x2a, 0x28, 0x32, 0x45,
-	0x5f, 0x4e, 0x15, 0xd5, 0x24, 0x88, 0xb9, 0x24, 0xa0, 0x1f, 0x53, 0x4c, 0xe1, 0x91, 0x56, 0x3e,
-	0xab, 0xd0, 0x36, 0x15, 0x6d, 0x15, 0xef, 0xfd, 0x66, 0x0e, 0xba, 0xb8, 0x26, 0xfb, 0x69, 0x58,
-	0x77, 0x05, 0x8b, 0x32, 0xdb, 0x81, 0x8f, 0x86, 0xcd, 0xe2, 0xb4, 0xac, 0xc5, 0xf9, 0xbe, 0x7e,
-	0xfa, 0x6e, 0x25, 0xa5, 0xb6, 0xfd, 0xda, 0x7e, 0x1a, 0xd6, 0xfa, 0x91, 0xb7, 0xd0, 0xa8, 0xb3,
-	0x7d, 0xe8, 0x3a, 0x15, 0x13, 0xdb, 0xb2, 0xfa, 0x86, 0x48, 0xbc, 0x0a, 0xad, 0x38, 0x1d, 0x52,
-	0x79, 0xac, 0xa4, 0xb5, 0xb7, 0x8d, 0x8f, 0xed, 0x28, 0x5d, 0x98, 0xe8, 0x97, 0x3e, 0xf8, 0x29,
-	0xde, 0x75, 0xde, 0x58, 0x80, 0x93, 0x6b, 0x3b, 0xfe, 0xce, 0x79, 0x67, 0xf1, 0xaa, 0x76, 0xbb,
-	0xca, 0x55, 0x4f, 0x45, 0x76, 0xec, 0x6d, 0xdf, 0x28, 0x7d, 0xba, 0xf2, 0xcf, 0x35, 0x11, 0xab,
-	0x71, 0xe5, 0xef, 0x3a, 0xe5, 0xf7, 0xa5, 0x29, 0x49, 0x8c, 0xc1, 0x72, 0xaa, 0xef, 0x9b, 0x30,
-	0xcf, 0xe7, 0x52, 0x7b, 0x6b, 0x31, 0x7d, 0x16, 0x7d, 0x43, 0x23, 0xbe, 0x84, 0xab, 0xe3, 0x9a,
-	0x1d, 0x98, 0x93, 0xd3, 0x5e, 0xb8, 0xfb, 0x92, 0x51, 0xdd, 0x34, 0x8d, 0x5f, 0xdf, 0x53, 0xbc,
-	0x07, 0x8b, 0x56, 0x43, 0xde, 0x5f, 0x75, 0xc4, 0xb0, 0x0e, 0x97, 0xef, 0xd0, 0x61, 0x70, 0x10,
-	0x26, 0xb9, 0x32, 0xdb, 0x79, 0xff, 0xb2, 0x8a, 0xa0, 0x4a, 0x0c, 0xda, 0xaf, 0x30, 0xc9, 0x0f,
-	0x64, 0x90, 0x0d, 0x8e, 0x28, 0x3c, 0xe8, 0xf9, 0x25, 0xe2, 0x87, 0x38, 0x68, 0x1f, 0x56, 0xf7,
-	0xd3, 0xd0, 0x4d, 0x04, 0x55, 0xa9, 0xeb, 0x60, 0x2c, 0x07, 0x95, 0x52, 0x17, 0x6f, 0x53, 0x5f,
-	0x37, 0xd7, 0x27, 0xe4, 0xde, 0xeb, 0x70, 0xd9, 0xe2, 0xc9, 0x09, 0x5d, 0x7d, 0xa1, 0xed, 0x36,
-	0x0d, 0xef, 0xa6, 0x88, 0xf5, 0x94, 0x1f, 0x11, 0xd3, 0xef, 0x9d, 0x25, 0xfe, 0x7b, 0xc3, 0xae,
-	0x0a, 0xa5, 0xc3, 0xfc, 0x42, 0xa5, 0x0e, 0xe5, 0x82, 0xe3, 0x38, 0x7d, 0x4a, 0xdc, 0xe6, 0x7d,
-	0x86, 0x70, 0xbd, 0x4c, 0x55, 0x31, 0xe7, 0xe4, 0xcc, 0xc2, 0x90, 0xd1, 0xd0, 0xc9, 0x19, 0x1a,
-	0x8d, 0x20, 0x8a, 0x51, 0xb0, 0x3c, 0x4a, 0x06, 0xda, 0x09, 0x2b, 0x40, 0x55, 0x2f, 0xc2, 0x74,
-	0xa2, 0x2e, 0x54, 0xe6, 0x7d, 0x86, 0x18, 0x2f, 0xb3, 0x8c, 0x5f, 0x6f, 0x31, 0xe4, 0xbd, 0x6e,
-	0x57, 0x83, 0x68, 0x1e, 0xac, 0x8b, 0x55, 0x75, 0xec, 0x71, 0x0a, 0x8b, 0x74, 0xc2, 0x31, 0xf8,
-	0xda, 0xa1, 0xf7, 0x59, 0x33, 0x5e, 0x92, 0x96, 0xc5, 0x93, 0xa6, 0x53, 0x3c, 0x59, 0x82, 0x05,
-	0xab, 0x20, 0xe4, 0x7d, 0xd7, 0x82, 0x45, 0xa7, 0xd4, 0xb3, 0x0c, 0x4d, 0xb3, 0x42, 0xcd, 0xdd,
-	0x1d, 0x54, 0x88, 0xf3, 0x3e, 0x0b, 0xd7, 0xc3, 0xb6, 0x12, 0x18, 0x30, 0x60, 0xf2, 0x93, 0xb3,
-	0x07, 0x65, 0xc8, 0x7a, 0x51, 0xd6, 0x76, 0x5e, 0x94, 0xbd, 0x09, 0xdd, 0x90, 0x05, 0xeb, 0x38,
-	0x05, 0x17, 0x7b, 0x46, 0xbe, 0xa6, 0x41, 0x83, 0x1c, 0xa6, 0x83, 0x63, 0x99, 0xf9, 0x69, 0x5a,
-	0x94, 0x8f, 0x20, 0x5d, 0xa4, 0xd8, 0x04, 0x11, 0x25, 0xa1, 0x7c, 0x86, 0xa6, 0x40, 0x66, 0x5b,
-	0x61, 0x48, 0x35, 0x79, 0xf5, 0x2a, 0xb2, 0xa6, 0x45, 0xdc, 0x86, 0x15, 0xf9, 0x4c, 0x0e, 0x26,
-	0x78, 0x06, 0xd5, 0xb8, 0xfc, 0xb2, 0xa7, 0x8a, 0xa6, 0x08, 0x50, 0x8e, 0x1e, 0xd2, 0xd3, 0x88,
-	0x1e, 0x15, 0x52, 0x0d, 0xac, 0xde, 0xf2, 0x85, 0x39, 0xdd, 0x32, 0xb4, 0x7c, 0xfa, 0x46, 0xce,
-	0xe9, 0x58, 0x66, 0x01, 0x3d, 0xba, 0x55, 0xb5, 0xed, 0x05, 0xc5, 0xb9, 0x82, 0x36, 0x8b, 0xb6,
-	0x58, 0x2e, 0x9a, 0x17, 0xc0, 0xe5, 0xfb, 0xcf, 0xe4, 0xc0, 0x3d, 0xb5, 0xe7, 0x17, 0x27, 0xad,
-	0x04, 0xae, 0xe9, 0x26, 0x70, 0xec, 0xa9, 0x5a, 0xc6, 0x53, 0x79, 0xbf, 0x0f, 0xc2, 0x1e, 0x82,
-	0x57, 0x7d, 0x1d, 0xe6, 0x70, 0xe6, 0x86, 0x3d, 0x43, 0xde, 0x13, 0x58, 0x45, 0xea, 0x03, 0x74,
-	0x7e, 0x17, 0x97, 0xa7, 0xe4, 0xd6, 0xb4, 0xb9, 0xd1, 0x41, 0x29, 0xc2, 0x48, 0xbd, 0xef, 0x5a,
-	0xf4, 0x15, 0xe0, 0xbd, 0xa1, 0x26, 0xcd, 0x63, 0x94, 0x02, 0xf1, 0xe9, 0x51, 0xfb, 0x9e, 0x21,
-	0xef, 0x11, 0x2c, 0x21, 0xf1, 0xe3, 0xbd, 0xd9, 0xb5, 0xbf, 0xb3, 0x35, 0x52, 0x2f, 0xc3, 0x0e,
-	0x2c, 0x6b, 0xb6, 0xb3, 0x05, 0x70, 0x5e, 0x95, 0x37, 0xdd, 0x57, 0xe5, 0x9e, 0xe4, 0x99, 0x50,
-	0xde, 0xf7, 0xc3, 0xd5, 0x85, 0x22, 0xa8, 0xa4, 0xb2, 0x45, 0x3b, 0x8c, 0x21, 0x6f, 0x4d, 0x2d,
-	0xa1, 0x1e, 0x46, 0x09, 0xec, 0xdd, 0xa2, 0x02, 0xbb, 0xb3, 0x52, 0xf5, 0x06, 0x57, 0x90, 0x69,
-	0x76, 0xd4, 0xed, 0x05, 0xb0, 0xf0, 0x55, 0x10, 0x15, 0x17, 0xb3, 0x9d, 0x37, 0xa0, 0x37, 0xce,
-	0xd2, 0x81, 0xcc, 0xf3, 0x5d, 0xfd, 0x88, 0xaf, 0x44, 0xa0, 0xd4, 0x49, 0xfa, 0x59, 0x90, 0x0c,
-	0x79, 0xd7, 0x31, 0xe4, 0xdd, 0x81, 0x45, 0x35, 0x04, 0x2b, 0x78, 0xc6, 0xf3, 0x7c, 0xef, 0x3e,
-	0x2c, 0x6d, 0x15, 0x45, 0x30, 0x38, 0xda, 0xe3, 0xa7, 0x91, 0xe7, 0x2b, 0x51, 0x40, 0x3b, 0x0c,
-	0x8a, 0x80, 0xe4, 0x59, 0xf4, 0xe9, 0xdb, 0xfb, 0x06, 0xd6, 0x8d, 0x49, 0x75, 0xcf, 0x94, 0x5d,
-	0xd0, 0xb6, 0xfc, 0x61, 0x7d, 0x50, 0xe4, 0x92, 0x9e, 0xe1, 0x1b, 0x3f, 0x84, 0x6b, 0x53, 0x63,
-	0xf1, 0x4c, 0xcf, 0x15, 0xde, 0xbb, 0x67, 0xd9, 0x7e, 0x67, 0x05, 0x7f, 0x0c, 0x8b, 0x86, 0xee,
-	0x57, 0x26, 0xb2, 0xb5, 0xfa, 0x86, 0x5e, 0xdf, 0x9a, 0xa4, 0xbb, 0xa8, 0x63, 0xab, 0xc5, 0xa7,
-	0x62, 0x9f, 0x66, 0x7b, 0x07, 0x56, 0xd3, 0x38, 0xdc, 0x76, 0x2e, 0x34, 0x14, 0xeb, 0x29, 0x3c,
-	0xd2, 0x26, 0xf2, 0xe9, 0x76, 0xcd, 0xe5, 0xc7, 0x14, 0xde, 0xbb, 0x6e, 0x29, 0x41, 0x8f, 0xc8,
-	0xc2, 0x7c, 0xe8, 0x08, 0x63, 0x87, 0x05, 0x17, 0x98, 0xa3, 0xcb, 0xd7, 0x8e, 0x14, 0xbc, 0x7f,
-	0x69, 0x00, 0x6c, 0x4d, 0x8a, 0x23, 0xce, 0xb8, 0x36, 0x60, 0x1e, 0x73, 0x7a, 0xcb, 0x1d, 0x1a,
-	0x58, 0xbd, 0xc7, 0xcc, 0xf3, 0xa7, 0x69, 0x16, 0x96, 0xef, 0x31, 0x15, 0x4c, 0xef, 0xe0, 0x27,
-	0xc5, 0x91, 0x4e, 0x06, 0xf0, 0x1b, 0x17, 0x5a, 0x8e, 0x4a, 0x67, 0xaf, 0x00, 0xf4, 0x48, 0x39,
-	0x39, 0x93, 0x80, 0xdd, 0x8c, 0xf2, 0xfa, 0x2e, 0x52, 0x25, 0x12, 0xc3, 0x28, 0x2f, 0xb2, 0xd3,
-	0x22, 0x3d, 0x96, 0x89, 0xf6, 0x5b, 0x0e, 0xd2, 0x0b, 0xf8, 0x3e, 0x61, 0x7f, 0x12, 0xc7, 0xd6,
-	0xa1, 0x55, 0xa5, 0xc5, 0x86, 0x5d, 0x5a, 0xa4, 0x9c, 0x5a, 0xd7, 0x27, 0xf0, 0x53, 0xbc, 0x6a,
-	0x49, 0x5c, 0x06, 0xdd, 0xa5, 0x2a, 0xd4, 0x24, 0xbc, 0x5b, 0x7c, 0xa9, 0xa0, 0x86, 0x28, 0xc3,
-	0x2b, 0x3a, 0x2c, 0x0d, 0xeb, 0xb0, 0xfc, 0xca, 0xc8, 0x92, 0x1f, 0x59, 0x45, 0xfd, 0x4c, 0x8e,
-	0x53, 0x1d, 0x58, 0xe0, 0xf7, 0x8b, 0x90, 0x04, 0x07, 0x98, 0x21, 0xc9, 0x63, 0x10, 0x44, 0x38,
-	0x15, 0x3d, 0xd6, 0xe8, 0x65, 0x0d, 0x3a, 0x87, 0xa9, 0xae, 0xb0, 0xcc, 0xfb, 0x0a, 0xa0, 0x23,
-	0x9a, 0x4d, 0x12, 0xc9, 0x26, 0x48, 0x01, 0xde, 0x16, 0x2c, 0x10, 0xdf, 0x1d, 0x19, 0xcb, 0x82,
-	0xaa, 0xb5, 0x93, 0xa4, 0x08, 0x86, 0x52, 0x6f, 0x39, 0x0d, 0x62, 0x4b, 0x28, 0xd5, 0x43, 0x03,
-	0x2e, 0x08, 0x31, 0xe8, 0x6d, 0xc1, 0x15, 0x47, 0x34, 0x9e, 0xc5, 0x1d, 0x13, 0x04, 0x35, 0x9c,
-	0xbc, 0xc0, 0x1a, 0x4e, 0x07, 0x46, 0x9e, 0x6f, 0xc5, 0xab, 0x07, 0x45, 0x3a, 0x7e, 0x2e, 0x37,
-	0x8f, 0x91, 0x28, 0xfa, 0x24, 0xf5, 0x6b, 0x18, 0x0d, 0x7a, 0xd7, 0x1c, 0xfb, 0x81, 0x3c, 0xf9,
-	0x74, 0xac, 0xc2, 0x32, 0xbf, 0xa0, 0xd6, 0x01, 0xdf, 0x1f, 0xc1, 0x8a, 0xc1, 0xb0, 0xf4, 0x7d,
-	0xe8, 0x9e, 0xf0, 0xc3, 0x6b, 0x56, 0x04, 0x83, 0x95, 0x57, 0xd9, 0xcd, 0xea, 0xab, 0x6c, 0xef,
-	0x3e, 0x5c, 0xe1, 0xec, 0xab, 0x72, 0x67, 0x55, 0xe6, 0x6b, 0x8d, 0xf3, 0xf3, 0x35, 0xef, 0x0e,
-	0x08, 0x87, 0xcd, 0x2c, 0xef, 0xf5, 0x35, 0x5c, 0x66, 0xda, 0xad, 0x30, 0x9c, 0x1d, 0x04, 0xd8,
-	0x62, 0x34, 0x2f, 0x20, 0xc6, 0x9a, 0x11, 0x83, 0x58, 0xb3, 0x0a, 0xcb, 0x01, 0x77, 0x64, 0xfc,
-	0xff, 0x35, 0x20, 0xb1, 0xe6, 0x01, 0x7f, 0x09, 0x6b, 0x8c, 0x7d, 0x34, 0x0e, 0x2d, 0x9f, 0xf5,
-	0x62, 0xc6, 0xbc, 0x06, 0x57, 0x2b, 0xdc, 0x79, 0xd8, 0x4d, 0x58, 0xb7, 0xd2, 0xd8, 0xf3, 0x17,
-	0xe2, 0x4b, 0xb8, 0x36, 0x45, 0xcf, 0xeb, 0xcf, 0xc9, 0xf2, 0x9e, 0x4e, 0x96, 0x1b, 0xb3, 0x93,
-	0x65, 0x4d, 0xe7, 0x1d, 0x41, 0xdf, 0x6a, 0xdc, 0x4b, 0xc3, 0xe8, 0xf0, 0x74, 0xf6, 0xec, 0xab,
-	0x23, 0x35, 0x2f, 0x38, 0xd2, 0x4b, 0x70, 0xbd, 0x66, 0x24, 0xd6, 0x84, 0x7a, 0x4c, 0x62, 0x9f,
-	0xcd, 0x59, 0x8f, 0x49, 0xec, 0xf3, 0xf6, 0x1c, 0x79, 0xeb, 0xc7, 0x2a, 0x0a, 0x73, 0x42, 0xc5,
-	0xfa, 0x39, 0x96, 0x61, 0x60, 0xd3, 0x09, 0x03, 0xaf, 0x50, 0xe2, 0x5c, 0x1b, 0x05, 0xee, 0xe3,
-	0x10, 0x17, 0x89, 0x02, 0x99, 0x90, 0x3b, 0xab, 0xfc, 0xfe, 0x51, 0x32, 0x3e, 0xbf, 0xfb, 0x1a,
-	0x08, 0x9b, 0x94, 0x19, 0xfc, 0x6b, 0x83, 0xb8, 0xaa, 0x9a, 0xc5, 0xec, 0x59, 0x6d, 0xc0, 0x7c,
-	0x7a, 0x22, 0xb3, 0x2c, 0x0a, 0xb5, 0xed, 0x36, 0xb0, 0xf8, 0xb0, 0xf2, 0x0b, 0x9f, 0x9f, 0x58,
-	0xb5, 0x2e, 0x9b, 0xf5, 0x8b, 0x7e, 0xa3, 0xa2, 0x34, 0xaa, 0x87, 0xa8, 0xc6, 0xd5, 0xc5, 0xec,
-	0x19, 0x79, 0x3f, 0xd7, 0x71, 0x75, 0x91, 0x5b, 0xaf, 0x0b, 0xe6, 0xc7, 0x8c, 0xab, 0x3c, 0xd7,
-	0x37, 0xa4, 0x86, 0x00, 0x53, 0xf3, 0x7d, 0xdc, 0xaa, 0x6c, 0xa9, 0xdf, 0x86, 0x45, 0x05, 0x96,
-	0x61, 0xe4, 0xd1, 0xe9, 0x58, 0x66, 0x16, 0xbb, 0x9e, 0x6f, 0xa3, 0xbc, 0x23, 0x3b, 0x14, 0xbc,
-	0xc0, 0xce, 0x3a, 0xff, 0xa7, 0x8d, 0x67, 0xa5, 0x20, 0x76, 0x40, 0x56, 0xd9, 0x81, 0xdf, 0xc2,
-	0xea, 0xc3, 0x87, 0x5f, 0xfb, 0x32, 0x8f, 0xbe, 0x95, 0x2f, 0x24, 0x65, 0x7c, 0x1a, 0x85, 0x1c,
-	0x5c, 0x74, 0x7c, 0x05, 0xd0, 0xcd, 0x01, 0xbd, 0x95, 0xe3, 0x1f, 0x74, 0x31, 0x84, 0x0b, 0x68,
-	0x8d, 0xad, 0x04, 0xba, 0xfb, 0x3f, 0xeb, 0xd0, 0xdb, 0x9f, 0x3c, 0x89, 0xa3, 0xc1, 0xd6, 0xfe,
-	0xae, 0xb8, 0x47, 0xbf, 0x4e, 0xa2, 0x82, 0xf4, 0xd5, 0xea, 0x73, 0x23, 0x12, 0x76, 0x63, 0x7d,
-	0xea, 0x15, 0x92, 0x9a, 0xd8, 0x25, 0xf1, 0x31, 0xfd, 0xba, 0x4b, 0x45, 0xf7, 0xe2, 0x5a, 0x49,
-	0xe6, 0xe4, 0x16, 0x1b, 0xfd, 0xe9, 0x06, 0xc3, 0xe1, 0x5e, 0xf9, 0xdb, 0xa8, 0xab, 0x95, 0x67,
-	0x66, 0xd3, 0xa3, 0xdb, 0x75, 0x19, 0x33, 0xba, 0x8a, 0x3c, 0xec, 0xd1, 0x9d, 0x30, 0xc9, 0x1e,
-	0xbd, 0x12, 0x29, 0x5f, 0x12, 0x1f, 0xe9, 0x1f, 0xe2, 0x64, 0x85, 0x58, 0x77, 0xf6, 0xa1, 0xc9,
-	0x38, 0x36, 0xae, 0x4d, 0xe1, 0x2b, 0xc2, 0xa3, 0xbd, 0xb3, 0x85, 0xb7, 0xec, 0xe4, 0xc6, 0x7a,
-	0x15, 0x5d, 0x11, 0x9e, 0x6f, 0x44, 0xed, 0x31, 0xec, 0x6d, 0x6a, 0x0b, 0x5f, 0xd9, 0x55, 0x5a,
-	0x78, 0x32, 0x58, 0xb6, 0xf0, 0xb6, 0xa9, 0xb3, 0x85, 0x77, 0x2d, 0xdb, 0x25, 0xb1, 0x0d, 0x50,
-	0x1a, 0x2c, 0x61, 0x0d, 0xe4, 0x9a, 0xbb, 0x8d, 0xeb, 0x35, 0x2d, 0x86, 0xc9, 0x87, 0x30, 0xa7,
-	0xca, 0x04, 0x42, 0x67, 0x8a, 0x4e, 0x31, 0x62, 0xe3, 0x6a, 0x05, 0xab, 0x3b, 0xde, 0x6e, 0xbc,
-	0xdd, 0x10, 0x0f, 0xac, 0xdf, 0x43, 0xd3, 0xfe, 0x7b, 0xa9, 0xfe, 0x3d, 0x97, 0x62, 0x75, 0xe3,
-	0x8c, 0xc7, 0x5e, 0x5a, 0x94, 0x07, 0xd5, 0x5f, 0x57, 0xbf, 0x54, 0xfb, 0x18, 0xeb, 0x2c, 0x6e,
-	0xd3, 0x7b, 0xcb, 0x3c, 0x3d, 0x32, 0xcb, 0x53, 0x7d, 0xea, 0x64, 0x96, 0x67, 0xea, 0x95, 0x92,
-	0x77, 0x49, 0xbc, 0x0f, 0x73, 0xea, 0xc9, 0x94, 0x51, 0x8d, 0xf3, 0x46, 0xcb, 0xa8, 0xc6, 0x7d,
-	0x7e, 0x45, 0x0b, 0xb3, 0x78, 0x20, 0x0b, 0x63, 0x77, 0xed, 0xcd, 0xe1, 0x18, 0x7b, 0x7b, 0x73,
-	0x54, 0x4c, 0xb4, 0xb5, 0xb3, 0x8b, 0xbc, 0xb2, 0xb3, 0x8d, 0xd5, 0xae, 0xec, 0xec, 0xc2, 0xee,
-	0xfe, 0xb9, 0xbd, 0x34, 0xe9, 0x30, 0xaf, 0x59, 0x9a, 0xb2, 0xb2, 0x5c, 0xb3, 0x34, 0x56, 0xb9,
-	0xd6, 0xbb, 0xf4, 0x76, 0x43, 0xf8, 0xd6, 0xc3, 0x5d, 0x36, 0x17, 0x3f, 0xaa, 0x76, 0x72, 0x8d,
-	0xc6, 0xcb, 0x67, 0x35, 0x1b, 0x19, 0xbf, 0x80, 0x65, 0x37, 0xcf, 0x17, 0x37, 0x6a, 0x7e, 0xb2,
-	0x59, 0x1e, 0xe4, 0x1f, 0x9d, 0xd1, 0x6a, 0x18, 0xda, 0x42, 0xaa, 0x64, 0x7d, 0x5a, 0x48, 0xa7,
-	0x6c, 0x30, 0x2d, 0x64, 0x25, 0xc7, 0x77, 0x79, 0xf2, 0x61, 0x9f, 0x96, 0xc3, 0x39, 0xf2, 0x2f,
-	0x9f, 0xd5, 0x5c, 0xbb, 0xd3, 0xc9, 0xf8, 0xbc, 0x34, 0x3d, 0xb3, 0xd2, 0x04, 0xdd, 0xa8, 0x6f,
-	0x3c, 0x63, 0xd6, 0x64, 0x4b, 0x6b, 0x66, 0x6d, 0x5b, 0xd4, 0x97, 0xcf, 0x6a, 0xb6, 0x6d, 0x4b,
-	0x59, 0x53, 0x35, 0xb6, 0x65, 0xaa, 0x92, 0x6b, 0x6c, 0xcb, 0x74, 0x01, 0xd6, 0xbb, 0x24, 0x76,
-	0xa0, 0x67, 0xca, 0xa0, 0xe6, 0x10, 0x54, 0x8b, 0xaf, 0x1b, 0xfd, 0xe9, 0x06, 0xc7, 0xc8, 0xb0,
-	0x28, 0xac, 0x7b, 0x87, 0xda, 0x51, 0xfb, 0xf5, 0x9a, 0x16, 0xcb, 0xd0, 0xcf, 0xa9, 0xf2, 0x9b,
-	0x39, 0xcb, 0x4e, 0x35, 0x6e, 0xa3, 0x16, 0xcb, 0x02, 0xbc, 0x03, 0x6d, 0xfa, 0x05, 0x88, 0xb0,
-	0xfe, 0x03, 0x0a, 0x3d, 0xe8, 0x15, 0x07, 0x67, 0x1b, 0x1f, 0xe3, 0xb5, 0xcd, 0xcc, 0xab, 0x31,
-	0x84, 0x99, 0xf9, 0x94, 0x83, 0xf7, 0x2e, 0x89, 0x4f, 0x61, 0xc1, 0x4a, 0x20, 0x85, 0x9e, 0xdc,
-	0x74, 0x52, 0xb9, 0xb1, 0x51, 0xd7, 0x64, 0x2f, 0x64, 0x99, 0x01, 0x1a, 0xed, 0x4d, 0xe5, 0x9b,
-	0x1b, 0xd7, 0x6b, 0x5a, 0x2c, 0x61, 0x96, 0xca, 0xac, 0x4e, 0x5a, 0x1b, 0x62, 0x2a, 0x8d, 0xac,
-	0xf2, 0xb1, 0xb3, 0x40, 0xda, 0xf7, 0x4e, 0xa6, 0x66, 0xf6, 0x7d, 0x5d, 0x76, 0x68, 0xf6, 0x7d,
-	0x7d, 0x72, 0x47, 0xfb, 0xbe, 0x92, 0xae, 0x99, 0x7d, 0x5f, 0x9f, 0xf6, 0x99, 0x7d, 0x7f, 0x46,
-	0x96, 0xe7, 0x5d, 0x12, 0x8f, 0x30, 0x51, 0x32, 0x8d, 0xa8, 0xb2, 0x57, 0xa6, 0xfb, 0x38, 0x69,
-	0xdc, 0xc6, 0xcd, 0xb3, 0x09, 0xce, 0x60, 0xbb, 0x23, 0xe3, 0x17, 0xc3, 0xf6, 0x13, 0xf6, 0x71,
-	0xfb, 0x93, 0x38, 0x76, 0x7d, 0x9c, 0x55, 0x7e, 0x73, 0x7d, 0x9c, 0x5d, 0x34, 0x23, 0xc3, 0x5e,
-	0xf2, 0xc8, 0x8f, 0xaa, 0x3c, 0x4c, 0xd9, 0xac, 0xca, 0xa3, 0x2c, 0x77, 0x11, 0x8f, 0x4f, 0xb9,
-	0x0c, 0xc5, 0xd6, 0xe7, 0xba, 0x4d, 0xec, 0x5a, 0x9e, 0x8d, 0xba, 0x26, 0x33, 0x9f, 0x77, 0xa0,
-	0x8d, 0xf9, 0x81, 0x39, 0x69, 0x56, 0xee, 0x60, 0x4e, 0x9a, 0x9d, 0x40, 0xa8, 0x2e, 0x14, 0x2b,
-	0x98, 0xfa, 0x94, 0x15, 0x22, 0x5c, 0x71, 0x70, 0x76, 0xd0, 0xa7, 0x7f, 0x83, 0x6f, 0x5c, 0xb8,
-	0x53, 0x51, 0x32, 0x41, 0x5f, 0xa5, 0xac, 0xe4, 0x5d, 0x7a, 0x32, 0x47, 0x6f, 0x42, 0x7e, 0xfa,
-	0x7f, 0x01, 0x00, 0x00, 0xff, 0xff, 0x8e, 0xe9, 0xe0, 0x4e, 0x38, 0x49, 0x00, 0x00,
+	// 7588 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x7d, 0x5d, 0x8f, 0x1c, 0xc9,
+	0x91, 0x98, 0xaa, 0x3f, 0xa6, 0xbb, 0x63, 0x3e, 0x38, 0xac, 0xf9, 0x60, 0xb3, 0x38, 0xcb, 0xe5,
+	0xd6, 0x6a, 0x97, 0x5c, 0xae, 0x34, 0xbb, 0x4b, 0xad, 0x76, 0x29, 0xae, 0xd6, 0xda, 0x59, 0x0e,
+	0xb9, 0x1c, 0x8b, 0x5c, 0xce, 0xd6, 0x0c, 0x57, 0x10, 0x24, 0x58, 0x2a, 0x76, 0xe5, 0xcc, 0x94,
+	0xd8, 0x5d, 0xd5, 0xae, 0xaa, 0x1e, 0x72, 0xf4, 0x64, 0xf8, 0xc1, 0x10, 0x20, 0xc0, 0x0f, 0x36,
+	0x60, 0xd8, 0x6f, 0x86, 0x04, 0x1b, 0xb0, 0x1f, 0xec, 0x07, 0x3f, 0xf8, 0x03, 0x7e, 0xb0, 0x64,
+	0xdc, 0x01, 0x07, 0xdc, 0xc3, 0x3d, 0xdd, 0x01, 0x07, 0xdc, 0x0f, 0xb8, 0xd3, 0x2f, 0x38, 0xe0,
+	0x70, 0x38, 0x44, 0x7e, 0x55, 0x64, 0x56, 0x75, 0xcf, 0x70, 0xc9, 0xc5, 0x3d, 0x10, 0xec, 0x88,
+	0x8c, 0x8c, 0x8c, 0x8c, 0x8c, 0xcc, 0x8c, 0x8c, 0x8c, 0xac, 0x81, 0xf9, 0xe2, 0x64, 0xcc, 0xf2,
+	0xcd, 0x71, 0x96, 0x16, 0xa9, 0xdb, 0xe6, 0x80, 0xff, 0x1f, 0x1c, 0x58, 0xbc, 0x9d, 0x26, 0x45,
+	0x18, 0x27, 0x2c, 0xdb, 0x4d, 0xb3, 0xc2, 0x75, 0xa1, 0x95, 0x84, 0x23, 0xd6, 0x77, 0xae, 0x38,
+	0xd7, 0x7a, 0x01, 0xff, 0xed, 0x7a, 0xd0, 0x3d, 0x4a, 0xf3, 0x02, 0xcb, 0xfb, 0x8d, 0x2b, 0xce,
+	0xb5, 0x76, 0xa0, 0x61, 0xf7, 0x9b, 0xb0, 0x38, 0xa0, 0x0c, 0xfa, 0x4d, 0x4e, 0x60, 0x22, 0x91,
+	0x03, 0x6f, 0x77, 0x90, 0x0e, 0xfb, 0x2d, 0xce, 0x59, 0xc3, 0xee, 0x3a, 0xcc, 0x21, 0xb7, 0x9d,
+	0xdd, 0x7e, 0x9b, 0x97, 0x48, 0xc8, 0xbf, 0x09, 0x4b, 0x77, 0x92, 0xe3, 0x38, 0x4b, 0x93, 0x11,
+	0x4b, 0x8a, 0x2f, 0xc3, 0xcc, 0x5d, 0x86, 0x26, 0x4b, 0x8e, 0xa5, 0x68, 0xf8, 0xd3, 0x5d, 0x85,
+	0xf6, 0x71, 0x38, 0x9c, 0x30, 0x2e, 0x56, 0x2f, 0x10, 0x80, 0xff, 0x13, 0x98, 0xff, 0x32, 0x1d,
+	0x4e, 0x46, 0xec, 0x41, 0x3a, 0x49, 0xea, 0xbb, 0xb4, 0x01, 0xbd, 0x11, 0x16, 0xee, 0x86, 0xc5,
+	0x91, 0xac, 0x5c, 0x22, 0x50, 0xdc, 0x8c, 0x85, 0xd1, 0xc3, 0x64, 0x78, 0xc2, 0xfb, 0xd3, 0x0d,
+	0x34, 0xec, 0x5f, 0x85, 0xc5, 0x1f, 0x85, 0x71, 0x11, 0x27, 0x87, 0x7b, 0x45, 0x58, 0x4c, 0x72,
+	0x94, 0x3f, 0x63, 0x61, 0x9e, 0x26, 0xb2, 0x01, 0x09, 0xf9, 0xdf, 0x86, 0xc5, 0x60, 0x92, 0x24,
+	0x25, 0xe1, 0x06, 0xf4, 0xf2, 0x22, 0xcc, 0x0a, 0x16, 0x6d, 0x15, 0x92, 0xb6, 0x44, 0xf8, 0xff,
+	0xde, 0x01, 0xd8, 0x67, 0xd9, 0x48, 0x12, 0x7b, 0xd0, 0x65, 0xcf, 0xe2, 0xe2, 0x76, 0x1a, 0x09,
+	0xc1, 0xdb, 0x81, 0x86, 0x49, 0x8b, 0x0d, 0xda, 0xa2, 0xdb, 0x87, 0xce, 0x88, 0xe5, 0x79, 0x78,
+	0xc8, 0xb8, 0xd4, 0xbd, 0x40, 0x81, 0x66, 0xd3, 0x2d, 0xab, 0x69, 0xf7, 0x32, 0xc0, 0x41, 0x9c,
+	0xc4, 0xf9, 0x11, 0x2f, 0x16, 0xa3, 0x40, 0x30, 0xfe, 0xef, 0x1a, 0x70, 0x4e, 0x5b, 0x89, 0x94,
+	0xaf, 0x4e, 0xa9, 0x57, 0x60, 0x5e, 0x0f, 0xfb, 0xce, 0xb6, 0x14, 0x8e, 0xa2, 0x70, 0xbc, 0xc6,
+	0x47, 0x61, 0xae, 0xe4, 0x13, 0x80, 0xbb, 0x09, 0x9d, 0xa7, 0x42, 0xa5, 0x5c, 0xb6, 0xf9, 0x1b,
+	0xab, 0x9b, 0xc2, 0x56, 0x0d, 0x45, 0x07, 0x8a, 0x08, 0xe9, 0x33, 0xa1, 0xd9, 0x7e, 0xdb, 0xa0,
+	0x37, 0xf4, 0x1d, 0x28, 0x22, 0xf7, 0x3d, 0x80, 0x82, 0x65, 0xa3, 0x38, 0x09, 0x0b, 0x16, 0xf5,
+	0xe7, 0x78, 0x95, 0xf3, 0xb2, 0x4a, 0xa9, 0xf2, 0x80, 0x10, 0xb9, 0x3e, 0x2c, 0x64, 0x8c, 0x6b,
+	0xe8, 0x36, 0x5a, 0x45, 0xbf, 0xc3, 0x87, 0xc0, 0xc0, 0xb9, 0x6f, 0xc3, 0xdc, 0x11, 0x0b, 0x87,
+	0xc5, 0x51, 0xbf, 0xcb, 0x59, 0xae, 0x48, 0x96, 0xf7, 0x38, 0x52, 0x32, 0x95, 0x24, 0xfe, 0x7f,
+	0x74, 0x60, 0x81, 0x16, 0xe0, 0x20, 0xe6, 0xfc, 0x97, 0x32, 0x1b, 0x01, 0xa1, 0x8a, 0xd0, 0xd6,
+	0x4e, 0xb8, 0xfa, 0xba, 0x81, 0x00, 0x70, 0x9a, 0x1d, 0x84, 0xf1, 0x90, 0x77, 0x2e, 0x63, 0xe1,
+	0x13, 0x35, 0xcd, 0x0c, 0x24, 0x0e, 0xf3, 0x30, 0xcc, 0x8b, 0xdd, 0x2c, 0x7d, 0xcc, 0xd4, 0x30,
+	0x6b, 0x04, 0x0e, 0x33, 0x02, 0x0f, 0x27, 0xc5, 0x78, 0xa2, 0x87, 0xb9, 0xc4, 0xf8, 0xbf, 0xa5,
+	0x8b, 0xc1, 0x4e, 0x72, 0x90, 0xba, 0x9b, 0xd0, 0xd3, 0xa3, 0xc7, 0xc5, 0x9c, 0xbf, 0xb1, 0x2c,
+	0x3b, 0xa9, 0x09, 0x83, 0x92, 0x04, 0xdb, 0x1f, 0x64, 0x2c, 0x14, 0x66, 0x86, 0xf2, 0x37, 0x83,
+	0x12, 0xc1, 0x07, 0x3f, 0x8d, 0x76, 0xb6, 0xf5, 0xe0, 0x23, 0xe0, 0x6e, 0x6a, 0x3d, 0x88, 0xb1,
+	0x5f, 0xb7, 0x1b, 0x50, 0x8a, 0x14, 0x54, 0xfe, 0x9f, 0xb4, 0xa0, 0xa7, 0xcb, 0xbe, 0xba, 0x19,
+	0xc6, 0xa3, 0x72, 0x9a, 0x08, 0x00, 0xa7, 0x0f, 0xff, 0xb1, 0xb3, 0x2d, 0x75, 0xa7, 0x40, 0xf7,
+	0x1a, 0x9c, 0xe3, 0x3f, 0x77, 0x27, 0xc3, 0xe1, 0x6e, 0x3a, 0x8c, 0x07, 0x27, 0x52, 0x7d, 0x36,
+	0x1a, 0x75, 0xfc, 0x34, 0xcd, 0x9e, 0xc4, 0xc9, 0xe1, 0x76, 0x9c, 0x71, 0x53, 0xeb, 0x05, 0x04,
+	0x83, 0xf2, 0x4e, 0x72, 0x96, 0x71, 0x7b, 0xea, 0x05, 0xfc, 0x37, 0x2e, 0x6b, 0x45, 0x71, 0xc2,
+	0x8d, 0xa8, 0x1b, 0xe0, 0x4f, 0x9c, 0xfc, 0x83, 0x74, 0x34, 0x0a, 0x93, 0x28, 0xef, 0xf7, 0xae,
+	0x34, 0x71, 0xb9, 0x54, 0x30, 0x72, 0x08, 0xb3, 0xc3, 0xbc, 0x0f, 0x1c, 0xcf, 0x7f, 0xbb, 0xd7,
+	0x51, 0xb3, 0x59, 0x91, 0xf7, 0xe7, 0xaf, 0x34, 0xc9, 0x74, 0x30, 0x56, 0xf6, 0x40, 0x90, 0xb8,
+	0x57, 0xc5, 0x22, 0xba, 0xc0, 0x29, 0xd7, 0x24, 0xa5, 0xb9, 0xd0, 0x8a, 0xb5, 0xf5, 0x03, 0x58,
+	0x38, 0x2e, 0x57, 0xd1, 0xbc, 0xbf, 0xc8, 0x6b, 0xb8, 0xb2, 0x06, 0x59, 0x60, 0x03, 0x83, 0xce,
+	0x7d, 0x1f, 0xe6, 0x86, 0xe1, 0x63, 0x36, 0xcc, 0xfb, 0x4b, 0xbc, 0xc6, 0x86, 0x2d, 0xcd, 0xe6,
+	0x7d, 0x5e, 0x7c, 0x27, 0x29, 0xb2, 0x93, 0x40, 0xd2, 0xba, 0x37, 0x71, 0xc9, 0xcd, 0xd3, 0x49,
+	0x36, 0x60, 0xfd, 0x73, 0x57, 0x1c, 0x52, 0xef, 0x51, 0xce, 0xb2, 0xd2, 0xda, 0x24, 0x4d, 0xa0,
+	0xa9, 0xbd, 0xef, 0xc1, 0x3c, 0x61, 0x88, 0xda, 0x7c, 0xc2, 0x4e, 0xd4, 0x26, 0xf1, 0x84, 0x9d,
+	0xd4, 0x6f, 0x12, 0xb7, 0x1a, 0x37, 0x1d, 0xff, 0x7f, 0x3b, 0x70, 0x2e, 0xf8, 0x74, 0x5b, 0xf4,
+	0x65, 0x8f, 0xb3, 0x43, 0xdd, 0x8f, 0xd2, 0x24, 0x2e, 0xd2, 0x0c, 0x67, 0x26, 0xd7, 0xbd, 0x82,
+	0x4b, 0xbb, 0x69, 0x50, 0xbb, 0x59, 0x87, 0xb9, 0x83, 0x7c, 0xff, 0x64, 0xac, 0xcc, 0x49, 0x42,
+	0x38, 0x52, 0xe3, 0x54, 0x6f, 0x78, 0xfc, 0xb7, 0x1e, 0xff, 0x36, 0x19, 0xff, 0x3e, 0x74, 0x9e,
+	0xb0, 0x93, 0x0c, 0x97, 0x33, 0x61, 0x30, 0x0a, 0x34, 0xf6, 0xa1, 0x8e, 0xb5, 0x0f, 0xfd, 0x95,
+	0x03, 0xbd, 0xdd, 0x34, 0x12, 0xb2, 0xd7, 0xce, 0x03, 0x5c, 0x61, 0x84, 0x42, 0xe5, 0x36, 0x21,
+	0x20, 0xc4, 0x47, 0x59, 0x7c, 0xcc, 0x32, 0x25, 0xaf, 0x80, 0xdc, 0x6b, 0xd0, 0xcc, 0x1e, 0x47,
+	0xd6, 0x34, 0xb4, 0xd4, 0x13, 0x20, 0x09, 0xdf, 0x4e, 0xe2, 0x5f, 0xb2, 0x4f, 0x4f, 0x0a, 0x96,
+	0xf3, 0xae, 0x34, 0x83, 0x12, 0x81, 0xa5, 0x93, 0x9c, 0x45, 0xa2, 0x74, 0x4e, 0x94, 0x6a, 0x84,
+	0xfb, 0x26, 0x2c, 0x85, 0xc7, 0x61, 0x3c, 0x0c, 0x1f, 0x0f, 0x25, 0x83, 0x0e, 0x27, 0xb1, 0xb0,
+	0xfe, 0xff, 0x6a, 0x40, 0x67, 0x37, 0x8d, 0xf6, 0xc6, 0x6c, 0xe0, 0x5e, 0x87, 0x8e, 0x30, 0x31,
+	0x31, 0x24, 0xe5, 0x2a, 0xa4, 0x15, 0x10, 0x28, 0x02, 0xf7, 0x5d, 0x00, 0x3d, 0xd5, 0xf3, 0x7e,
+	0xc3, 0x20, 0x2f, 0xcd, 0x88, 0xd0, 0xb8, 0x37, 0xb4, 0xc1, 0x36, 0x39, 0xb5, 0x57, 0x32, 0xc7,
+	0xd6, 0x6b, 0xcd, 0xd5, 0x85, 0xd6, 0xf1, 0x60, 0x3c, 0xe1, 0xca, 0x6a, 0x07, 0xfc, 0x37, 0xea,
+	0x75, 0xc4, 0x46, 0x69, 0x26, 0x16, 0x87, 0x76, 0x20, 0x21, 0xf7, 0x7d, 0x80, 0x38, 0x29, 0x58,
+	0x76, 0x10, 0x0e, 0xb8, 0x42, 0xe8, 0x14, 0x45, 0xe3, 0xde, 0x51, 0x85, 0x01, 0xa1, 0x7b, 0x21,
+	0xb3, 0x6e, 0x70, 0xd3, 0xd8, 0xd3, 0x1b, 0x8a, 0xd8, 0x73, 0x1d, 0xba, 0xe7, 0x12, 0x5f, 0xa1,
+	0x61, 0xfa, 0x0a, 0xa5, 0x77, 0xd1, 0x34, 0xbc, 0x8b, 0xd2, 0x4f, 0x6b, 0x51, 0x3f, 0x4d, 0x2d,
+	0xeb, 0xe8, 0xbe, 0x35, 0xd5, 0xb2, 0xbe, 0xab, 0x3d, 0x8e, 0xfd, 0x78, 0xc4, 0xa4, 0x59, 0x97,
+	0x08, 0xf7, 0x13, 0x38, 0x37, 0x30, 0xd7, 0xf7, 0x7e, 0xe7, 0x4a, 0x93, 0x98, 0x9d, 0xbd, 0xfa,
+	0xdb, 0xe4, 0xa5, 0xcf, 0xc2, 0x1b, 0xe8, 0x52, 0x9f, 0x85, 0xb7, 0xf0, 0x01, 0x2c, 0xe0, 0x7a,
+	0xf7, 0x20, 0x1c, 0x8f, 0xe3, 0xe4, 0x50, 0x2c, 0xa3, 0xe5, 0xea, 0xb5, 0x5b, 0x16, 0x05, 0x06,
+	0x9d, 0xff, 0xd7, 0x0e, 0x37, 0x3b, 0xbe, 0xfd, 0xe9, 0x0d, 0xcb, 0xa1, 0x1b, 0x96, 0x0b, 0xad,
+	0x27, 0x71, 0x12, 0x49, 0xb5, 0xf1, 0xdf, 0x28, 0x4d, 0x38, 0x8e, 0xbf, 0x64, 0x59, 0x1e, 0x6b,
+	0xbd, 0x11, 0x8c, 0xbb, 0x04, 0x8d, 0xe3, 0x91, 0xd4, 0x5b, 0xe3, 0x78, 0x64, 0x6e, 0x94, 0x6d,
+	0x7b, 0xa3, 0xf4, 0xa1, 0x95, 0x8f, 0xd9, 0x40, 0x7a, 0x2a, 0x4b, 0xa6, 0x39, 0x06, 0xbc, 0xcc,
+	0xbd, 0xa6, 0xb7, 0xcd, 0x8e, 0xb1, 0x2f, 0xeb, 0x71, 0xd7, 0x0e, 0x45, 0x1f, 0x3a, 0xe3, 0x34,
+	0xfa, 0x3c, 0xd4, 0x6a, 0x52, 0xa0, 0xff, 0x9b, 0x06, 0xf4, 0x76, 0xf8, 0x16, 0x87, 0xbd, 0x5d,
+	0x82, 0x46, 0x1c, 0xc9, 0xae, 0x36, 0xe2, 0x88, 0xfb, 0xec, 0x61, 0xc6, 0x92, 0x42, 0xef, 0xa1,
+	0x1a, 0x16, 0x0b, 0xd3, 0x38, 0xdd, 0x0f, 0x0f, 0xc5, 0xa4, 0xe9, 0x05, 0x1a, 0xc6, 0xed, 0x17,
+	0x7f, 0x6f, 0xc7, 0x87, 0x2c, 0x2f, 0x70, 0x57, 0xc7, 0x62, 0x8a, 0x42, 0x89, 0x64, 0x67, 0x65,
+	0xdf, 0x15, 0x88, 0x75, 0x8f, 0xe3, 0xac, 0x98, 0x84, 0xc3, 0xbd, 0xf8, 0x97, 0x4c, 0x2e, 0x1e,
+	0x14, 0x45, 0x76, 0x97, 0x8e, 0xb1, 0xbb, 0xe8, 0x7e, 0xd4, 0x4d, 0xd7, 0x17, 0x99, 0x4c, 0xbf,
+	0x6b, 0x40, 0x57, 0x2a, 0x35, 0x77, 0x5f, 0x83, 0x26, 0xce, 0x7a, 0xe1, 0x0a, 0x9d, 0x53, 0xb6,
+	0x3a, 0x9e, 0xf0, 0xd2, 0x00, 0xcb, 0xdc, 0xab, 0xd0, 0x7e, 0x3c, 0x4c, 0x07, 0x4f, 0xfa, 0x0d,
+	0xc3, 0xcf, 0xfc, 0x74, 0xf8, 0x24, 0x4e, 0x05, 0x99, 0x28, 0x77, 0xaf, 0xeb, 0xe5, 0xa2, 0x79,
+	0xc5, 0x21, 0xb6, 0xf9, 0x80, 0x23, 0x05, 0xa9, 0xa4, 0x70, 0xbf, 0x0d, 0x9d, 0x84, 0x15, 0xe8,
+	0x47, 0xf4, 0x5b, 0x86, 0xaf, 0xf9, 0xb9, 0xc0, 0x0a, 0x6a, 0x45, 0xe3, 0x6e, 0xe2, 0xe4, 0x18,
+	0xb2, 0xfc, 0x24, 0x2f, 0xd8, 0x88, 0xcf, 0xcb, 0xd2, 0x8c, 0xee, 0xe6, 0x82, 0x98, 0x50, 0xa0,
+	0x39, 0x16, 0xf1, 0x88, 0xe5, 0x45, 0x38, 0x1a, 0xab, 0x15, 0x5b, 0x23, 0x8c, 0xc9, 0x2a, 0x2a,
+	0x4f, 0x9b, 0xac, 0x92, 0xb5, 0x4d, 0xee, 0xef, 0x41, 0x57, 0x29, 0xc9, 0x7d, 0x03, 0xda, 0x13,
+	0xbe, 0xec, 0x54, 0x94, 0xf8, 0x08, 0xd1, 0x81, 0x28, 0x45, 0x4b, 0xb8, 0x9f, 0x86, 0xd1, 0xd6,
+	0x31, 0xcb, 0xd4, 0x1a, 0xd5, 0x0e, 0x28, 0xca, 0x8f, 0xa0, 0xab, 0x2a, 0xe1, 0xf0, 0x15, 0x69,
+	0x11, 0x0e, 0x39, 0xd3, 0x56, 0x20, 0x00, 0x5c, 0xb1, 0xc6, 0x2c, 0xbb, 0x3d, 0x9e, 0xf0, 0x6d,
+	0xa0, 0x15, 0x48, 0x48, 0x6f, 0xc2, 0x4d, 0x4e, 0xcc, 0x7f, 0x23, 0xad, 0x54, 0x57, 0x8b, 0x63,
+	0x25, 0xe4, 0xff, 0x69, 0x0b, 0xa0, 0x1c, 0x3b, 0xf7, 0x21, 0x5c, 0x88, 0xd3, 0x3d, 0x96, 0x1d,
+	0xc7, 0x03, 0xb1, 0x4f, 0x05, 0x6c, 0x30, 0xc9, 0xf2, 0xf8, 0x98, 0xf5, 0x1d, 0xc3, 0xa3, 0xd2,
+	0x75, 0x84, 0x21, 0x4e, 0xab, 0xe5, 0x7e, 0x06, 0x2b, 0xba, 0x28, 0x2a, 0x99, 0x35, 0x66, 0x31,
+	0xab, 0xab, 0xe1, 0xde, 0x86, 0xf3, 0x71, 0xfa, 0xc5, 0x84, 0x4d, 0x28, 0x9b, 0xe6, 0x2c, 0x36,
+	0x55, 0x7a, 0xf7, 0x01, 0xac, 0x6b, 0xde, 0xb8, 0x8c, 0x96, 0x9c, 0x5a, 0xb3, 0x38, 0x4d, 0xa9,
+	0x24, 0x3a, 0x87, 0x87, 0x38, 0x93, 0x57, 0xfb, 0x94, 0xce, 0x55, 0x6a, 0x88, 0xce, 0x3d, 0x60,
+	0xd9, 0x21, 0xed, 0xdc, 0xdc, 0x29, 0x9d, 0xb3, 0xe8, 0xdd, 0x1f, 0xc0, 0xb9, 0x38, 0x35, 0x25,
+	0xe9, 0xcc, 0x62, 0x61, 0x53, 0xbb, 0x5b, 0xb0, 0x9c, 0xb3, 0x01, 0x7a, 0x82, 0x25, 0x87, 0xee,
+	0x2c, 0x0e, 0x15, 0x72, 0xff, 0x6f, 0x1c, 0x58, 0x32, 0x89, 0x6a, 0x5d, 0x37, 0x17, 0x5a, 0xc8,
+	0x50, 0xed, 0x31, 0xf8, 0x9b, 0xb8, 0x73, 0x4d, 0xc3, 0x9d, 0x5b, 0x85, 0xf6, 0x28, 0xfc, 0x45,
+	0x9a, 0x49, 0xc3, 0x15, 0x00, 0xc7, 0xc6, 0x49, 0x2a, 0x3c, 0xcd, 0x56, 0x20, 0x00, 0xf7, 0x3b,
+	0xd0, 0xc2, 0x5d, 0x41, 0xaa, 0xee, 0xd5, 0x5a, 0xa9, 0x37, 0x4b, 0xf9, 0x39, 0xb1, 0xf7, 0x21,
+	0xf4, 0x4a, 0x69, 0x4f, 0x59, 0x3a, 0x5b, 0x74, 0xe9, 0xfc, 0x83, 0x03, 0xf3, 0x64, 0x35, 0x43,
+	0xca, 0x72, 0xea, 0xb7, 0xd4, 0x4c, 0x2f, 0x8f, 0x4c, 0x7b, 0xac, 0x90, 0x4c, 0x08, 0x06, 0x77,
+	0x0b, 0x3c, 0xe5, 0x0e, 0x92, 0x42, 0x4e, 0x58, 0x05, 0xba, 0x9f, 0x92, 0xd8, 0xd3, 0x76, 0x58,
+	0x84, 0x72, 0x6d, 0xdc, 0xa8, 0x2e, 0xa4, 0xe2, 0x27, 0xd2, 0x04, 0x66, 0x15, 0xf7, 0x1e, 0x2c,
+	0x1f, 0xc5, 0x2c, 0x0b, 0xb3, 0xc1, 0x51, 0x3c, 0x08, 0x87, 0x9c, 0x4d, 0xfb, 0x0c, 0x6c, 0x2a,
+	0xb5, 0xfc, 0x2f, 0x60, 0xad, 0x96, 0x94, 0x6f, 0xc0, 0x87, 0x07, 0xe1, 0x64, 0x58, 0xc8, 0x8e,
+	0x2b, 0x10, 0xbb, 0x3e, 0x3e, 0x1c, 0x85, 0xbf, 0x10, 0x85, 0xb2, 0xeb, 0x25, 0xc6, 0xff, 0xb5,
+	0x03, 0x0b, 0x74, 0x85, 0x77, 0xbf, 0x6b, 0xb8, 0x92, 0xe6, 0x8a, 0xa3, 0xdd, 0x48, 0xb9, 0xbe,
+	0x97, 0x84, 0xee, 0x15, 0x68, 0x16, 0x83, 0xb1, 0xdc, 0x91, 0xd4, 0x46, 0xb0, 0x3f, 0x18, 0x23,
+	0x65, 0x80, 0x45, 0xe8, 0x72, 0x14, 0x83, 0xf1, 0x07, 0xfd, 0x66, 0x2d, 0x09, 0x2f, 0xf3, 0xff,
+	0x47, 0x03, 0x3a, 0x12, 0x83, 0xcb, 0x33, 0xcb, 0x8b, 0xf0, 0xf1, 0x90, 0xc7, 0x88, 0x64, 0xbf,
+	0x28, 0x0a, 0x7b, 0x9d, 0x9f, 0x24, 0x7b, 0x2c, 0x51, 0x1d, 0x53, 0xa0, 0x2c, 0x09, 0xd8, 0xe0,
+	0x58, 0x0d, 0xa8, 0x04, 0xd1, 0xad, 0x38, 0x88, 0x13, 0x9c, 0xfe, 0xef, 0x49, 0x6b, 0xd6, 0x30,
+	0x29, 0xbb, 0x21, 0x6d, 0x5a, 0xc3, 0x58, 0x86, 0xdb, 0x15, 0x02, 0x7c, 0xfb, 0x6a, 0x05, 0x1a,
+	0x46, 0xa3, 0x1b, 0x0c, 0xd3, 0x9c, 0x71, 0x3f, 0xa9, 0x15, 0x08, 0x80, 0x3b, 0x60, 0xf8, 0x83,
+	0x57, 0xe9, 0xf2, 0x92, 0x12, 0x81, 0x12, 0x62, 0x5c, 0x64, 0x6b, 0xf0, 0xa4, 0xdf, 0x13, 0x12,
+	0x4a, 0x10, 0x27, 0xe1, 0x30, 0xce, 0x0b, 0x96, 0xf4, 0x41, 0x6c, 0x13, 0x02, 0xc2, 0x1a, 0x58,
+	0x1d, 0xcf, 0x70, 0xf3, 0xa2, 0x86, 0x04, 0xfd, 0x5f, 0x35, 0x60, 0xc9, 0x1c, 0x9a, 0xda, 0x19,
+	0xdf, 0x87, 0x4e, 0xf6, 0x4c, 0x9c, 0x87, 0xa4, 0xba, 0x24, 0x88, 0xa2, 0x66, 0xcf, 0x76, 0xc3,
+	0xc1, 0x13, 0x56, 0xe4, 0x52, 0x61, 0x25, 0x82, 0x7b, 0x62, 0xcf, 0xee, 0x64, 0x19, 0x1e, 0x57,
+	0xa5, 0xca, 0x14, 0x2c, 0x6a, 0x6e, 0x67, 0xe9, 0x78, 0x2c, 0x3d, 0xad, 0x56, 0x50, 0x22, 0xb0,
+	0xc5, 0xe2, 0x59, 0x79, 0x48, 0x6b, 0x05, 0x0a, 0xc4, 0x7a, 0x85, 0x6e, 0x51, 0xa8, 0xad, 0x57,
+	0xd0, 0x16, 0x0b, 0xd5, 0x62, 0x57, 0x2a, 0x9b, 0xb4, 0x58, 0xe8, 0x16, 0x7b, 0xaa, 0xa6, 0x44,
+	0xf8, 0x7f, 0x68, 0x42, 0x47, 0xba, 0x1f, 0xfc, 0x10, 0xca, 0x70, 0xc7, 0x50, 0xe1, 0x2f, 0x01,
+	0xe1, 0x70, 0x0d, 0xe3, 0x51, 0xac, 0x8c, 0x46, 0x00, 0xe5, 0xca, 0xd1, 0xa4, 0x2b, 0xc7, 0x06,
+	0xf4, 0xf4, 0xa1, 0x51, 0x76, 0xbe, 0x44, 0xe0, 0x41, 0x13, 0x0f, 0xcb, 0xf9, 0xed, 0x74, 0x34,
+	0x1e, 0xb2, 0x42, 0xab, 0xc0, 0xc2, 0x0a, 0x7f, 0x35, 0x8c, 0x72, 0xb1, 0x5d, 0x48, 0x5d, 0x50,
+	0x14, 0x52, 0xe8, 0x85, 0x3c, 0x8c, 0xa4, 0x46, 0x28, 0x4a, 0x1d, 0xd4, 0xf5, 0x59, 0xa4, 0x15,
+	0x68, 0x18, 0x83, 0x47, 0x4f, 0xb3, 0xb8, 0x60, 0x44, 0x10, 0xa1, 0x19, 0x1b, 0x8d, 0x41, 0x47,
+	0x81, 0x92, 0xa2, 0x08, 0x13, 0x33, 0x70, 0xd8, 0x2b, 0xd9, 0xf0, 0x8f, 0xb2, 0xb8, 0x40, 0x43,
+	0x14, 0xf6, 0x66, 0x61, 0x51, 0x37, 0xbc, 0x1e, 0x17, 0x69, 0x41, 0xe8, 0x46, 0x23, 0xb0, 0xa5,
+	0x38, 0xdd, 0x49, 0x76, 0xb3, 0xf4, 0x30, 0x63, 0x39, 0xc6, 0x76, 0x78, 0x4b, 0x14, 0x87, 0x23,
+	0x24, 0x36, 0xc0, 0xfe, 0x92, 0x30, 0x75, 0x01, 0xa1, 0x04, 0x4f, 0x59, 0x7c, 0x78, 0x54, 0xb0,
+	0x68, 0x47, 0x94, 0x9f, 0x13, 0x12, 0x98, 0x58, 0xff, 0xdf, 0x34, 0x49, 0xd4, 0x58, 0x8e, 0xba,
+	0x15, 0x9a, 0x73, 0xaa, 0xa1, 0x39, 0xe9, 0x61, 0x37, 0xce, 0xe2, 0x61, 0x37, 0xcf, 0xec, 0x61,
+	0xb7, 0x9e, 0xc7, 0xc3, 0x6e, 0x3f, 0xb7, 0x87, 0x3d, 0xf7, 0x7c, 0x1e, 0x76, 0xc7, 0xf6, 0xb0,
+	0x69, 0xf0, 0xab, 0xfb, 0x3c, 0xc1, 0x2f, 0x77, 0x13, 0x5c, 0xd1, 0x01, 0xee, 0x07, 0xef, 0xb2,
+	0x6c, 0x80, 0x0b, 0x2e, 0xda, 0x97, 0x13, 0xd4, 0x94, 0xf8, 0xf7, 0x60, 0x49, 0x9e, 0x6e, 0x03,
+	0xf6, 0xcf, 0x27, 0x2c, 0x2f, 0xa6, 0x1c, 0x72, 0xf1, 0x20, 0xc6, 0x03, 0x2a, 0x8f, 0x74, 0x88,
+	0xa0, 0x1b, 0x50, 0x94, 0xff, 0x11, 0x9c, 0xd3, 0x9c, 0xf2, 0x71, 0x9a, 0xe4, 0x68, 0xe9, 0x9d,
+	0xb1, 0x40, 0x49, 0xe7, 0x9e, 0x1c, 0x5d, 0x39, 0xa1, 0x2a, 0xf6, 0x7f, 0x0e, 0x70, 0x3f, 0xce,
+	0x8b, 0xbb, 0xf1, 0xb0, 0x60, 0x19, 0x06, 0xb7, 0xf9, 0x39, 0x6d, 0x8f, 0x0d, 0xb9, 0x11, 0x4b,
+	0x51, 0x4c, 0x24, 0x09, 0x98, 0x37, 0xf8, 0x91, 0x92, 0x04, 0xcc, 0x55, 0x30, 0xb7, 0xa9, 0x83,
+	0x72, 0x3e, 0xe3, 0x1d, 0xc5, 0x46, 0x66, 0x77, 0x14, 0x03, 0x39, 0x23, 0x7d, 0xc2, 0xe5, 0xbf,
+	0xdd, 0xb7, 0x60, 0xee, 0x80, 0x4b, 0x66, 0x59, 0x58, 0x29, 0x72, 0x20, 0x09, 0xfc, 0xbf, 0x77,
+	0x60, 0x51, 0xb7, 0x93, 0x4f, 0x86, 0xd3, 0x9a, 0x21, 0x87, 0xf0, 0x86, 0x71, 0x08, 0xd7, 0x02,
+	0x34, 0x89, 0x00, 0xeb, 0x46, 0x4c, 0xbc, 0xec, 0xea, 0xec, 0xb0, 0xc1, 0x4d, 0x7d, 0x34, 0x16,
+	0xf6, 0x78, 0xa5, 0xd4, 0x7e, 0x29, 0xdf, 0xcb, 0x3e, 0x1e, 0x6f, 0xc1, 0xb9, 0x92, 0xbf, 0x30,
+	0x83, 0x4d, 0xde, 0x57, 0x44, 0xf5, 0x1d, 0x23, 0xd8, 0x65, 0x08, 0x12, 0x28, 0x22, 0xff, 0x09,
+	0xac, 0x6a, 0x13, 0xff, 0xda, 0x07, 0xec, 0xbf, 0x3b, 0xb0, 0x62, 0xb5, 0xc6, 0x87, 0xed, 0xf4,
+	0x95, 0x89, 0xde, 0x74, 0x92, 0x81, 0x34, 0x91, 0x53, 0x2e, 0x39, 0xa6, 0x0d, 0xa8, 0x7d, 0xcd,
+	0xd4, 0xae, 0x5e, 0x33, 0xf9, 0x3f, 0x86, 0x35, 0x5b, 0x60, 0xa1, 0xe7, 0x4f, 0x88, 0x40, 0x44,
+	0xdb, 0x9e, 0x7d, 0x2a, 0x27, 0x3a, 0x37, 0x2b, 0xf8, 0xef, 0x13, 0xcd, 0xd3, 0x35, 0x61, 0xc3,
+	0xbe, 0xf7, 0xe9, 0x91, 0x5b, 0x1e, 0x7f, 0x0f, 0xd6, 0xac, 0x5a, 0x52, 0xa0, 0x5b, 0x44, 0x20,
+	0xb2, 0x0a, 0x54, 0xae, 0x23, 0x78, 0x25, 0x93, 0xd4, 0xdf, 0x85, 0x85, 0x2f, 0x1f, 0x90, 0xf1,
+	0x50, 0xc3, 0xec, 0x90, 0x61, 0xd6, 0xba, 0x6d, 0xd4, 0xeb, 0xb6, 0x49, 0x75, 0xeb, 0x47, 0xb0,
+	0xa8, 0x38, 0x7e, 0x8d, 0xf6, 0xf4, 0x31, 0x2c, 0x69, 0xb9, 0x85, 0x16, 0xde, 0x86, 0xb9, 0xe3,
+	0x11, 0x19, 0x0f, 0xb5, 0x91, 0xd0, 0xee, 0x05, 0x92, 0xc4, 0xff, 0x29, 0x2c, 0xf3, 0xc8, 0x15,
+	0x95, 0x93, 0x87, 0x36, 0x91, 0xf9, 0x16, 0xde, 0xf3, 0x38, 0x2a, 0xb4, 0xa9, 0x30, 0xfc, 0xbe,
+	0x41, 0x48, 0x27, 0xe3, 0xfa, 0x02, 0xc2, 0x69, 0x1b, 0x0e, 0x87, 0xf2, 0xc2, 0x1a, 0x7f, 0xfa,
+	0xb7, 0xe1, 0x3c, 0xe1, 0xae, 0xa7, 0x67, 0x2f, 0x56, 0x48, 0x2b, 0x9c, 0xae, 0x83, 0x68, 0x41,
+	0x49, 0x82, 0x0b, 0xfd, 0x97, 0x0f, 0x6e, 0xf3, 0x55, 0x46, 0x49, 0xb8, 0x5c, 0x86, 0xc1, 0xda,
+	0x41, 0xd3, 0x8c, 0x7d, 0x37, 0x68, 0xec, 0xdb, 0x7f, 0x13, 0x96, 0xcb, 0xca, 0x52, 0x80, 0x9a,
+	0xa1, 0xf5, 0xdf, 0xc0, 0x46, 0x02, 0x36, 0x4a, 0x8f, 0x75, 0x23, 0x75, 0x64, 0xdf, 0x87, 0xe5,
+	0x92, 0xac, 0x64, 0x37, 0x28, 0x6f, 0xc9, 0xf9, 0x6f, 0xee, 0xf4, 0x87, 0x93, 0x5c, 0xaf, 0x57,
+	0x1c, 0xf0, 0xff, 0xad, 0x03, 0xe7, 0x8d, 0x0d, 0x55, 0xe5, 0x26, 0xe8, 0xec, 0x06, 0xe7, 0xb4,
+	0xec, 0x86, 0x46, 0x5d, 0x76, 0x03, 0xf7, 0x0f, 0x79, 0xf8, 0x83, 0x64, 0x40, 0x50, 0xd4, 0xac,
+	0xfc, 0x07, 0xff, 0x57, 0x0e, 0xac, 0xa0, 0x54, 0xf2, 0x22, 0x83, 0x1d, 0xb0, 0x8c, 0x25, 0x03,
+	0xde, 0xaf, 0x31, 0x66, 0x27, 0xc8, 0xfe, 0xe3, 0x6f, 0x54, 0xb3, 0xd8, 0x83, 0xd5, 0xd0, 0x0b,
+	0x68, 0x56, 0xc2, 0x02, 0x1a, 0x73, 0xc4, 0x8a, 0x30, 0x1e, 0xf6, 0x5b, 0x86, 0x31, 0x93, 0x36,
+	0x25, 0x81, 0xff, 0x5f, 0xa5, 0x82, 0xee, 0xc6, 0xc3, 0x53, 0x04, 0xe1, 0xa7, 0xb1, 0x21, 0x4b,
+	0xca, 0x75, 0x50, 0xc3, 0x9c, 0x9e, 0x65, 0x23, 0xb5, 0xa3, 0xe1, 0x6f, 0x1d, 0x72, 0x6b, 0x91,
+	0x7b, 0xaf, 0x55, 0x68, 0x1f, 0x66, 0xe9, 0x64, 0x2c, 0x2f, 0xc3, 0x04, 0xe0, 0x5e, 0xd5, 0xe2,
+	0xce, 0x19, 0x3e, 0xa0, 0x96, 0x4b, 0x09, 0xfb, 0x73, 0xe8, 0x22, 0x0e, 0xff, 0xd5, 0x9e, 0xa8,
+	0x34, 0xfb, 0x06, 0x65, 0x7f, 0x1d, 0x96, 0xc3, 0x28, 0x8a, 0x8b, 0x38, 0x4d, 0xc2, 0xe1, 0x67,
+	0x88, 0x52, 0x11, 0xec, 0x0a, 0xde, 0xdf, 0x86, 0xb9, 0x47, 0xe2, 0xfc, 0xe1, 0x42, 0xeb, 0x73,
+	0xc2, 0x5f, 0x6d, 0xdc, 0xf7, 0xc2, 0x2c, 0x92, 0x07, 0x15, 0xfe, 0x1b, 0x71, 0x7b, 0xe9, 0x81,
+	0x0a, 0x54, 0xf0, 0xdf, 0xfe, 0x9f, 0x75, 0x60, 0xd1, 0xb0, 0xba, 0x69, 0xd2, 0xd6, 0x5c, 0x2d,
+	0xf6, 0xa1, 0x83, 0xee, 0x66, 0x14, 0xab, 0xbb, 0x3a, 0x05, 0xa2, 0x65, 0xca, 0x5d, 0x42, 0x5e,
+	0x48, 0x0b, 0xcd, 0x9a, 0x48, 0x75, 0xb5, 0xdc, 0x2e, 0xaf, 0x96, 0x6f, 0xf2, 0x38, 0xe7, 0xa0,
+	0x18, 0x5a, 0x4e, 0x82, 0x21, 0xe1, 0xe6, 0x1e, 0x27, 0x91, 0x4e, 0x82, 0xa0, 0x77, 0xdf, 0x82,
+	0x16, 0x4b, 0x8e, 0xf3, 0x7e, 0x67, 0xd6, 0xcd, 0x31, 0x27, 0xe1, 0xa7, 0x61, 0x71, 0x5f, 0xcd,
+	0xe3, 0x63, 0xbd, 0x40, 0x81, 0xb8, 0xb6, 0x31, 0xe4, 0x3a, 0x4e, 0x63, 0xee, 0xa7, 0x62, 0x21,
+	0xc1, 0xb8, 0x9b, 0xea, 0x26, 0x1b, 0x78, 0x2b, 0xfd, 0x3a, 0xe9, 0xe8, 0x6d, 0xf6, 0xfb, 0xe5,
+	0xcd, 0xe0, 0xbc, 0xb1, 0xfb, 0xd5, 0xcc, 0xa8, 0xf2, 0x8e, 0x70, 0x13, 0xda, 0xdc, 0x37, 0xef,
+	0x2f, 0x54, 0x5a, 0x31, 0x4c, 0x3f, 0x10, 0x64, 0xee, 0xeb, 0xd2, 0x7a, 0x17, 0x2b, 0x16, 0x89,
+	0xff, 0xa4, 0x39, 0xdf, 0xb4, 0xee, 0xbd, 0xeb, 0x35, 0x5b, 0x77, 0x99, 0x28, 0x6e, 0x5e, 0xce,
+	0xe9, 0x9b, 0x97, 0xcb, 0x00, 0x7b, 0x45, 0x3a, 0xde, 0x8b, 0x0f, 0x93, 0x70, 0xd8, 0x3f, 0xcf,
+	0xf1, 0x04, 0xe3, 0x5e, 0x85, 0xce, 0x84, 0xdb, 0x65, 0xde, 0x77, 0x79, 0x53, 0x8b, 0xaa, 0x29,
+	0x8e, 0x0d, 0x54, 0x29, 0x8f, 0x63, 0xa4, 0x87, 0x3c, 0xc7, 0x69, 0x45, 0x98, 0x8f, 0x04, 0x8d,
+	0x05, 0x63, 0xd5, 0x5a, 0x30, 0x3e, 0x80, 0xc5, 0x61, 0x7c, 0xcc, 0x12, 0x96, 0xe7, 0x22, 0x93,
+	0x64, 0xcd, 0xb8, 0x61, 0xc2, 0xfe, 0x70, 0x7c, 0x60, 0x92, 0xb9, 0x37, 0xc5, 0x81, 0x3b, 0x2e,
+	0x2b, 0xae, 0x4f, 0xa9, 0x68, 0xd1, 0x19, 0xe7, 0x9f, 0x0b, 0xcf, 0x7b, 0xf9, 0x4f, 0x6c, 0xf5,
+	0x79, 0x3c, 0xd7, 0x17, 0x71, 0x7a, 0xdf, 0x12, 0x33, 0x9a, 0x0b, 0x7f, 0xe7, 0x19, 0x1b, 0x50,
+	0x83, 0x77, 0x0c, 0x83, 0xf7, 0xaf, 0x81, 0xab, 0x49, 0xf7, 0x6f, 0xef, 0xee, 0xa5, 0x18, 0x44,
+	0x11, 0xa9, 0x01, 0x7a, 0xbf, 0xe1, 0xbf, 0xfd, 0x00, 0x96, 0x35, 0xe5, 0xbd, 0xfd, 0xfd, 0xdd,
+	0xcf, 0x24, 0x9d, 0xbd, 0xf4, 0xaa, 0xba, 0x8d, 0xb2, 0x2e, 0xf7, 0x81, 0x06, 0x47, 0x6c, 0x54,
+	0xc6, 0x86, 0x39, 0xe4, 0xff, 0x6d, 0x03, 0x7a, 0x9a, 0xa9, 0x7b, 0x0d, 0x5a, 0xec, 0x19, 0x1b,
+	0x58, 0x6e, 0x99, 0xd1, 0x93, 0x80, 0x53, 0xb8, 0x1f, 0x42, 0xaf, 0x18, 0x8c, 0x85, 0xb0, 0xf2,
+	0x2c, 0x7e, 0xd1, 0x26, 0xd7, 0xbd, 0x09, 0x4a, 0x5a, 0xf7, 0x3d, 0xe8, 0x1c, 0x15, 0xc5, 0xf8,
+	0x33, 0x56, 0x48, 0xd7, 0xe9, 0x82, 0x5d, 0x4d, 0x76, 0x2d, 0x50, 0x74, 0xee, 0xbb, 0xb0, 0x12,
+	0x27, 0x71, 0x11, 0x87, 0xc3, 0x6d, 0x36, 0x0c, 0x4f, 0xf6, 0xd8, 0x20, 0xc5, 0xbc, 0x17, 0x71,
+	0xb3, 0x5e, 0x57, 0x84, 0x6b, 0xdf, 0x98, 0x65, 0x71, 0x1a, 0x29, 0x5a, 0xe1, 0x36, 0x9b, 0x48,
+	0x8c, 0x53, 0xe0, 0x09, 0x3b, 0x9d, 0x14, 0x8a, 0x6c, 0x8e, 0x93, 0x59, 0x58, 0xdc, 0x11, 0x30,
+	0xa0, 0x3c, 0xc9, 0xd8, 0xfe, 0x51, 0xc6, 0xf2, 0xa3, 0x74, 0x18, 0xc9, 0x74, 0xaf, 0x0a, 0x1e,
+	0x69, 0xf3, 0xc9, 0x60, 0xc0, 0xf2, 0xbc, 0xa4, 0xed, 0x0a, 0x5a, 0x1b, 0xef, 0xdf, 0x82, 0x05,
+	0xbe, 0x3a, 0xa8, 0xa3, 0xba, 0x4a, 0x19, 0x70, 0x6a, 0x53, 0x06, 0x4c, 0xb7, 0xe9, 0x3f, 0x39,
+	0xb0, 0x56, 0x6b, 0xfa, 0xdc, 0x35, 0x1f, 0x4f, 0xf6, 0x8e, 0xc2, 0x8c, 0xe5, 0x32, 0xec, 0x5a,
+	0x22, 0x78, 0xe2, 0xd0, 0x78, 0xf2, 0xc5, 0x24, 0x2d, 0x42, 0x99, 0x7f, 0xa5, 0x61, 0x59, 0x73,
+	0x97, 0xeb, 0x48, 0xc5, 0x11, 0x35, 0x82, 0x48, 0xd2, 0xa2, 0x92, 0x60, 0xad, 0x71, 0x1c, 0xe5,
+	0xf7, 0x79, 0x4c, 0x4e, 0x1e, 0x39, 0x35, 0xc2, 0x3f, 0x80, 0xae, 0x5a, 0x34, 0xa7, 0x65, 0x8e,
+	0xb2, 0x64, 0x90, 0x46, 0x18, 0x17, 0x95, 0x6e, 0x82, 0x82, 0x71, 0xc2, 0x4d, 0xb2, 0x58, 0x1a,
+	0x2c, 0xfe, 0x14, 0xb3, 0x28, 0x29, 0x58, 0xa2, 0x72, 0x14, 0x15, 0x88, 0x6e, 0x72, 0xb9, 0xa0,
+	0x3f, 0x1c, 0xe3, 0x2e, 0xad, 0x5d, 0x0a, 0xa7, 0x3e, 0x95, 0xa6, 0x51, 0x49, 0xa5, 0xd1, 0x69,
+	0x3d, 0x4d, 0x33, 0xad, 0xc7, 0xff, 0x9f, 0x0e, 0x40, 0xc9, 0xfe, 0x79, 0x73, 0x69, 0x0e, 0xd2,
+	0x6c, 0x14, 0x16, 0x3a, 0xf7, 0x87, 0x43, 0xee, 0x3b, 0x30, 0x97, 0x72, 0x31, 0xfb, 0xad, 0xca,
+	0x34, 0xa0, 0xbd, 0x08, 0x24, 0x19, 0x67, 0x94, 0x23, 0x8d, 0xca, 0x82, 0x15, 0x90, 0x99, 0x6a,
+	0x33, 0x67, 0xa5, 0xda, 0xf8, 0xff, 0xdf, 0x11, 0x2b, 0x91, 0x0e, 0x30, 0x23, 0x9f, 0xc7, 0x59,
+	0x1c, 0x1d, 0xea, 0xb8, 0xaa, 0x80, 0xf8, 0x1e, 0xa3, 0x5c, 0xa1, 0x46, 0x3c, 0x46, 0xba, 0xf8,
+	0x80, 0x77, 0x53, 0x0a, 0x2e, 0x20, 0x1c, 0x95, 0x51, 0x38, 0x90, 0xfa, 0xc7, 0x9f, 0xa8, 0xd3,
+	0xc3, 0xb0, 0x60, 0x4f, 0x43, 0x95, 0xf4, 0xa6, 0x40, 0xa4, 0x2d, 0xc2, 0xb1, 0xcc, 0xee, 0xc0,
+	0x9f, 0xa8, 0x65, 0x59, 0xf8, 0x81, 0x4c, 0x71, 0xd3, 0x30, 0xf2, 0x51, 0x11, 0x36, 0x99, 0x87,
+	0x20, 0x41, 0xff, 0x9e, 0x58, 0x23, 0xd5, 0x05, 0x29, 0xc6, 0x99, 0x93, 0x88, 0xe4, 0x9b, 0x38,
+	0x46, 0xbe, 0xc9, 0x8c, 0x6c, 0x64, 0xff, 0xbf, 0x39, 0x30, 0x4f, 0x58, 0x71, 0xed, 0x89, 0x9f,
+	0x9a, 0x4d, 0x89, 0x30, 0xbc, 0xf2, 0x86, 0x95, 0x95, 0x7c, 0xba, 0x4f, 0xff, 0x0e, 0xb4, 0xb1,
+	0xdd, 0x5c, 0x5e, 0x8d, 0xd2, 0xf5, 0xd1, 0xec, 0x49, 0x20, 0xe8, 0xb4, 0x5d, 0xb5, 0x4b, 0xbb,
+	0xf2, 0xff, 0x9d, 0x03, 0x0b, 0x18, 0x16, 0x49, 0x0f, 0x6f, 0xa7, 0xc9, 0x41, 0x7c, 0xa8, 0x6f,
+	0xfe, 0x1c, 0x72, 0xf3, 0xf7, 0x21, 0xcc, 0x0d, 0x78, 0x69, 0xbf, 0x61, 0xdc, 0xdb, 0xd1, 0x8a,
+	0x9b, 0xe2, 0x3f, 0xe9, 0x58, 0x08, 0x72, 0xdc, 0xe2, 0x08, 0xfa, 0xb9, 0xb6, 0xb8, 0x27, 0x30,
+	0x4f, 0x92, 0x64, 0xaa, 0x07, 0x21, 0xc7, 0x0a, 0x7e, 0x54, 0x8e, 0x52, 0x52, 0xa1, 0x0a, 0x36,
+	0x94, 0xdd, 0xb4, 0x8e, 0x40, 0x09, 0xac, 0x22, 0xcd, 0x48, 0x34, 0xf6, 0xa3, 0xa3, 0xb8, 0xe0,
+	0x47, 0x4f, 0x5c, 0x6e, 0xf9, 0x2d, 0x56, 0x12, 0x0e, 0x65, 0x18, 0x56, 0xe5, 0xe4, 0x55, 0xf0,
+	0x48, 0xcb, 0x9e, 0x59, 0xb4, 0x22, 0x50, 0x58, 0xc1, 0xfb, 0x7f, 0x34, 0x07, 0x1d, 0xbe, 0x21,
+	0xa5, 0x51, 0x5d, 0xda, 0x0b, 0xca, 0x4c, 0x4f, 0x36, 0x0a, 0xd6, 0x83, 0xd3, 0x24, 0x83, 0xf3,
+	0x55, 0x1d, 0xf1, 0x1b, 0x56, 0xb4, 0x8e, 0x3a, 0xae, 0xbb, 0x69, 0x54, 0xeb, 0x28, 0xbe, 0x43,
+	0xfc, 0xa4, 0x8e, 0x11, 0xa5, 0x7e, 0x94, 0xd7, 0xb9, 0x47, 0xee, 0x1b, 0xd0, 0x1c, 0xa6, 0x87,
+	0x56, 0x7e, 0x32, 0x35, 0x9b, 0x00, 0xcb, 0x51, 0xba, 0x28, 0x51, 0xa9, 0xa6, 0xf8, 0x13, 0x73,
+	0xd6, 0x48, 0x16, 0x1d, 0x54, 0x72, 0xd6, 0xea, 0x33, 0xe9, 0xde, 0x50, 0x7e, 0xb5, 0xf0, 0xc5,
+	0x2b, 0x47, 0x37, 0x51, 0xea, 0xbe, 0x5d, 0x3a, 0xed, 0xc2, 0x01, 0xaf, 0x39, 0x92, 0x2a, 0x0a,
+	0x2b, 0x7b, 0x6e, 0xf1, 0x6c, 0xd9, 0x73, 0xee, 0x26, 0x74, 0xe5, 0x5c, 0x55, 0xee, 0xb8, 0x5b,
+	0x9d, 0x9f, 0x81, 0xa6, 0x71, 0xbf, 0x80, 0xb5, 0x71, 0x8d, 0x05, 0xe6, 0x32, 0x17, 0xf5, 0x12,
+	0xc9, 0x1b, 0xb3, 0x69, 0x82, 0xfa, 0x9a, 0x2a, 0x03, 0x4d, 0x16, 0xe4, 0xfd, 0xe5, 0xd9, 0x19,
+	0x68, 0x8a, 0x0e, 0xbd, 0xff, 0x28, 0xc9, 0xc5, 0xf6, 0x90, 0xf7, 0xcf, 0x8b, 0x23, 0x52, 0x89,
+	0xc1, 0x35, 0x2d, 0x4a, 0xf2, 0x3d, 0x86, 0x97, 0xcf, 0xdc, 0xff, 0xef, 0x05, 0x25, 0x02, 0xd3,
+	0x1f, 0xd9, 0xb3, 0x22, 0x0b, 0xef, 0xf1, 0xa5, 0x69, 0xc5, 0x08, 0xef, 0x20, 0x6e, 0x6b, 0x18,
+	0x87, 0x79, 0x40, 0x68, 0x5e, 0xc4, 0x0f, 0xfe, 0x1e, 0xf4, 0x34, 0x4f, 0xb9, 0xc3, 0x38, 0x7a,
+	0x87, 0xd9, 0x80, 0x9e, 0x9a, 0x38, 0x6a, 0x26, 0x96, 0x08, 0xf4, 0x76, 0x77, 0xd3, 0xc8, 0x0c,
+	0x2b, 0x89, 0xfb, 0x03, 0x4c, 0x72, 0xb3, 0xee, 0x0f, 0xe4, 0x9c, 0x08, 0x54, 0x71, 0x7d, 0x24,
+	0xd0, 0x7f, 0x0b, 0xce, 0x13, 0x9e, 0x32, 0x3c, 0x54, 0x1b, 0xf5, 0xf3, 0xaf, 0xf1, 0xe6, 0xcd,
+	0x80, 0x53, 0x3d, 0xe5, 0xc7, 0x70, 0x9e, 0x50, 0x3e, 0x77, 0xcc, 0xe9, 0x8f, 0x1d, 0x1a, 0xdd,
+	0x4e, 0x0f, 0xf3, 0x33, 0xc5, 0x58, 0x85, 0x5f, 0x31, 0x1c, 0xa6, 0x4f, 0xe5, 0xd5, 0x8b, 0x84,
+	0xd0, 0x38, 0xf4, 0xb5, 0x51, 0x2e, 0x43, 0x3d, 0x04, 0xc3, 0x57, 0x28, 0x15, 0xea, 0xc1, 0x15,
+	0x2a, 0x8c, 0x87, 0x28, 0x58, 0x1e, 0x27, 0x03, 0xb5, 0xf1, 0x08, 0x40, 0x84, 0x4d, 0xa3, 0x74,
+	0x22, 0x6e, 0xcc, 0xbb, 0x81, 0x84, 0x24, 0x9e, 0x65, 0x99, 0xcc, 0x38, 0x96, 0x90, 0xff, 0x16,
+	0xac, 0x59, 0xfd, 0x90, 0xba, 0x58, 0x16, 0x6b, 0x0c, 0x76, 0x61, 0x81, 0x2f, 0x27, 0xe8, 0xf9,
+	0x6e, 0xf3, 0x94, 0xe2, 0x19, 0x6f, 0x45, 0xe8, 0x6d, 0x0e, 0x8d, 0xda, 0x2e, 0xc2, 0x3c, 0x89,
+	0x44, 0xfb, 0xbf, 0x6e, 0xc2, 0x82, 0x11, 0x63, 0x5e, 0x82, 0x86, 0x1e, 0xa1, 0xc6, 0xce, 0x36,
+	0x2a, 0xc4, 0x48, 0xf7, 0xc5, 0xf1, 0x20, 0x18, 0x6c, 0x87, 0x87, 0x52, 0x72, 0xb9, 0x85, 0x4b,
+	0x88, 0x24, 0x41, 0xb7, 0x8c, 0x24, 0xe8, 0x6f, 0x43, 0x27, 0x92, 0x82, 0xb5, 0x8d, 0xf0, 0x2d,
+	0xed, 0x51, 0xa0, 0x68, 0x70, 0xf5, 0x8f, 0xf0, 0xe4, 0x93, 0x05, 0x69, 0x5a, 0x94, 0x29, 0xff,
+	0x26, 0x12, 0x6f, 0xe9, 0xe2, 0x24, 0x62, 0xcf, 0x70, 0xdd, 0x61, 0xd9, 0x56, 0x14, 0xf1, 0x4b,
+	0x57, 0xe1, 0x20, 0xd5, 0x94, 0xe0, 0x95, 0x31, 0x1e, 0xc3, 0x26, 0x38, 0xe1, 0x45, 0xbb, 0xd2,
+	0x65, 0xb2, 0xd1, 0xdc, 0xad, 0x65, 0xa3, 0x7d, 0x9e, 0xfb, 0xd6, 0x13, 0x0e, 0xbf, 0x82, 0xc5,
+	0x41, 0x31, 0xca, 0xf9, 0x35, 0x72, 0x33, 0xe0, 0xbf, 0x91, 0x73, 0x3a, 0x66, 0x59, 0xc8, 0x9f,
+	0xd5, 0x88, 0xcb, 0xcb, 0x79, 0xc1, 0xd9, 0x42, 0xeb, 0x41, 0x5b, 0x20, 0xde, 0xca, 0x5f, 0x38,
+	0x70, 0x1e, 0x4f, 0x89, 0xe6, 0xb4, 0x3d, 0xfd, 0xea, 0x84, 0x1c, 0x8f, 0x1b, 0x66, 0x3c, 0x48,
+	0xee, 0x8b, 0xcd, 0x72, 0x5f, 0x94, 0x8f, 0xbc, 0x44, 0xda, 0x28, 0xfe, 0xac, 0xcd, 0x99, 0xd7,
+	0xc1, 0xbd, 0x39, 0x1a, 0xdc, 0x23, 0xe1, 0xb2, 0x8e, 0x19, 0x2e, 0xc3, 0x4c, 0x9b, 0x2c, 0x3e,
+	0x8e, 0x87, 0x0c, 0x2f, 0xd6, 0xc5, 0x53, 0x0b, 0x82, 0xf1, 0xbf, 0x05, 0x2e, 0xed, 0x98, 0x34,
+	0xb6, 0x75, 0x98, 0x43, 0x85, 0xeb, 0x4e, 0x49, 0xc8, 0xff, 0x97, 0x0e, 0x2c, 0x23, 0xf9, 0x1e,
+	0xee, 0xf0, 0x67, 0x57, 0x43, 0xc9, 0xae, 0x41, 0xd9, 0xf1, 0x09, 0x5a, 0x44, 0xb1, 0x48, 0x1c,
+	0x5e, 0x08, 0x04, 0x80, 0x22, 0x67, 0xe1, 0x53, 0xfe, 0xb2, 0x67, 0x24, 0x8e, 0xc3, 0xdd, 0x80,
+	0x60, 0xfc, 0xdf, 0xcb, 0xc1, 0x90, 0x42, 0x94, 0x22, 0xcb, 0x69, 0x2d, 0x26, 0xa4, 0x84, 0xdc,
+	0x8f, 0x10, 0x8f, 0x15, 0x79, 0xdb, 0x4b, 0x37, 0x5e, 0x57, 0xf1, 0x3b, 0x9b, 0xc3, 0xa6, 0xe0,
+	0x8f, 0x2f, 0x18, 0x02, 0x59, 0x45, 0x08, 0x1e, 0x17, 0x2c, 0x92, 0x03, 0x25, 0x21, 0xe3, 0x91,
+	0x5a, 0xcb, 0x7c, 0xa4, 0xe6, 0x7f, 0x13, 0x83, 0x58, 0x8a, 0x93, 0x0b, 0x30, 0xb7, 0xb7, 0xbf,
+	0xfd, 0xf0, 0xd1, 0xfe, 0xf2, 0x37, 0xe4, 0xef, 0x3b, 0x41, 0xb0, 0xec, 0xf8, 0x8f, 0x60, 0x11,
+	0x25, 0xf8, 0xf2, 0x81, 0xd2, 0xe2, 0xd4, 0xeb, 0xd3, 0x29, 0x06, 0x54, 0xab, 0x3b, 0x7f, 0x1b,
+	0x96, 0x14, 0xdb, 0x53, 0xf4, 0x42, 0xbb, 0xd0, 0xb0, 0xba, 0xc0, 0xa4, 0x82, 0x79, 0xd4, 0xed,
+	0xc5, 0x87, 0x19, 0x45, 0xe0, 0xac, 0xb8, 0xac, 0xcd, 0x40, 0x42, 0xfe, 0x2a, 0xb8, 0xb4, 0x19,
+	0x21, 0xb0, 0x7f, 0x95, 0x5f, 0xac, 0x1a, 0x16, 0x56, 0xbf, 0x41, 0xb9, 0xb0, 0x5c, 0x12, 0xca,
+	0xca, 0x21, 0xcc, 0x63, 0x22, 0xd3, 0xd9, 0xf6, 0x1a, 0x3c, 0xe2, 0x67, 0xe9, 0x80, 0xe5, 0xf9,
+	0x8e, 0xca, 0x6a, 0x2f, 0x11, 0x28, 0x75, 0x92, 0xde, 0x0b, 0x93, 0x43, 0x35, 0xf6, 0x02, 0xf2,
+	0xaf, 0xc3, 0x82, 0x68, 0x42, 0x2a, 0x78, 0xc6, 0x83, 0x45, 0xff, 0x0e, 0x2c, 0x6e, 0x15, 0x45,
+	0x38, 0x38, 0x7a, 0x20, 0xdf, 0x18, 0x9c, 0xae, 0x44, 0x17, 0x5a, 0x51, 0x28, 0xa3, 0x18, 0x0b,
+	0x01, 0xff, 0xed, 0xff, 0x02, 0xd6, 0xf5, 0x16, 0x64, 0x2e, 0x41, 0xf4, 0xe6, 0x91, 0xf8, 0x0f,
+	0xf5, 0x1e, 0xab, 0x49, 0x3a, 0xc5, 0x97, 0xf8, 0x08, 0x2e, 0x54, 0xda, 0x92, 0x3d, 0x3d, 0x55,
+	0x78, 0xff, 0x16, 0xd9, 0x2b, 0x8d, 0x11, 0x7c, 0x0d, 0x16, 0x34, 0xdd, 0xcf, 0xe2, 0xa8, 0x5a,
+	0x37, 0xf2, 0xfb, 0xb0, 0x6e, 0xd7, 0x95, 0x83, 0x3a, 0x26, 0x25, 0x01, 0xbf, 0x6a, 0x51, 0x6c,
+	0xaf, 0xc3, 0x72, 0x3a, 0x8c, 0x6e, 0x1b, 0xb7, 0xd3, 0x82, 0x75, 0x05, 0x8f, 0xb4, 0x09, 0x7b,
+	0x7a, 0xbb, 0xe6, 0x26, 0xbb, 0x82, 0xf7, 0x2f, 0xc2, 0x85, 0x4a, 0x8b, 0x52, 0x98, 0xfb, 0xd0,
+	0x2f, 0xf5, 0x93, 0x8e, 0x4f, 0xee, 0x66, 0xe9, 0xe8, 0x6c, 0xe6, 0xa6, 0x62, 0x9a, 0x8d, 0x32,
+	0xa6, 0xe9, 0x5f, 0x85, 0xf3, 0x06, 0x37, 0x9e, 0x37, 0xa9, 0x4c, 0xc0, 0x21, 0x26, 0xf0, 0xcf,
+	0xa8, 0x09, 0xa4, 0xe3, 0x93, 0xfd, 0xf4, 0x2b, 0x37, 0xaa, 0xf9, 0x37, 0x09, 0x7f, 0xda, 0x63,
+	0xc5, 0x5f, 0xf6, 0xf8, 0x23, 0x43, 0xfd, 0xd4, 0x71, 0x3c, 0xc3, 0xa8, 0x9a, 0x9a, 0xa4, 0xbe,
+	0xa4, 0xff, 0x7f, 0x1c, 0x80, 0xad, 0x49, 0x71, 0x24, 0x03, 0x00, 0x1e, 0x74, 0x71, 0x87, 0x23,
+	0x0e, 0x93, 0x86, 0xc5, 0x93, 0x8c, 0x3c, 0x7f, 0x9a, 0x66, 0x51, 0xf9, 0x24, 0x43, 0xc0, 0xd8,
+	0x9b, 0x70, 0x52, 0x1c, 0xa9, 0xb3, 0x29, 0xfe, 0x46, 0xd3, 0x66, 0xa3, 0xd2, 0x1d, 0x14, 0x00,
+	0xfa, 0x2c, 0x39, 0x77, 0x37, 0x42, 0xe9, 0x88, 0x88, 0x8d, 0xd5, 0x44, 0x8a, 0x73, 0xed, 0x61,
+	0x9c, 0x17, 0xd9, 0x49, 0x91, 0x3e, 0x61, 0x89, 0xf2, 0x6c, 0x0c, 0xa4, 0x1f, 0xca, 0xfb, 0x6b,
+	0x7c, 0x02, 0x49, 0x96, 0x29, 0x71, 0x95, 0xe5, 0xd0, 0xab, 0x2c, 0x1e, 0x2c, 0x52, 0x61, 0x39,
+	0xfc, 0xe9, 0xbe, 0x41, 0x24, 0x2e, 0xcf, 0x80, 0xa5, 0x2a, 0x44, 0x27, 0xd0, 0x36, 0x48, 0x13,
+	0xa5, 0x03, 0x5e, 0xb1, 0x8d, 0x9f, 0x69, 0x59, 0xf2, 0x23, 0x72, 0x89, 0x9c, 0xb1, 0x71, 0xaa,
+	0x5c, 0x4f, 0xfc, 0xfd, 0x32, 0x24, 0xc9, 0x8f, 0x66, 0x4a, 0xf2, 0x25, 0xb8, 0x9c, 0xb0, 0x72,
+	0xbe, 0xa8, 0xd1, 0xcb, 0x2a, 0xb4, 0x0f, 0x52, 0x15, 0x58, 0xec, 0x06, 0x02, 0x40, 0xec, 0x38,
+	0x9b, 0x24, 0x4c, 0x2e, 0xba, 0x02, 0xf0, 0xb7, 0x60, 0x9e, 0xf3, 0xdd, 0x66, 0x43, 0x56, 0xf0,
+	0xdb, 0xc1, 0x49, 0x52, 0x84, 0x87, 0x4c, 0x99, 0x9c, 0x02, 0xb1, 0x24, 0x62, 0x22, 0xd7, 0x50,
+	0xc6, 0x41, 0x25, 0xe8, 0x6f, 0xc1, 0x8a, 0x21, 0x9a, 0xec, 0xc5, 0x75, 0xed, 0x26, 0x3b, 0xc6,
+	0x31, 0x95, 0x34, 0xa7, 0x5c, 0x67, 0x3f, 0x20, 0x27, 0x1a, 0xbc, 0x95, 0x7a, 0x2e, 0x3f, 0x50,
+	0x06, 0xdf, 0x65, 0x74, 0x5a, 0x81, 0xfe, 0x05, 0x58, 0xb3, 0x78, 0xca, 0xd9, 0xb1, 0x0c, 0x4b,
+	0xf2, 0x11, 0x95, 0x3a, 0x12, 0xfc, 0x10, 0xce, 0x69, 0x8c, 0x94, 0xbe, 0x0f, 0x9d, 0x63, 0x81,
+	0x52, 0x8a, 0x90, 0xa0, 0xf5, 0x30, 0xab, 0x61, 0x3f, 0xcc, 0xf2, 0xef, 0xc0, 0x8a, 0x0c, 0x06,
+	0x58, 0x39, 0x12, 0x65, 0xf8, 0xc0, 0x39, 0x3d, 0x7c, 0xe0, 0x5f, 0x07, 0xd7, 0x60, 0x33, 0x6b,
+	0xbf, 0xfe, 0x31, 0x9c, 0x97, 0xb4, 0x5b, 0x51, 0x34, 0x93, 0xd4, 0x10, 0xa3, 0x71, 0x06, 0x31,
+	0x56, 0xc1, 0xa5, 0xac, 0xa5, 0x0a, 0xcb, 0x06, 0xb7, 0xd9, 0xf0, 0xeb, 0x6a, 0x90, 0xb3, 0x96,
+	0x0d, 0xfe, 0x14, 0x56, 0x25, 0xf6, 0xd1, 0x38, 0x22, 0xbb, 0xf4, 0xcb, 0x69, 0xf3, 0x02, 0xac,
+	0x59, 0xdc, 0x65, 0xb3, 0x9b, 0xb0, 0x4e, 0xa2, 0x2a, 0xa7, 0x0f, 0xc4, 0x17, 0x70, 0xa1, 0x42,
+	0x2f, 0xc7, 0xdf, 0x7e, 0x3d, 0xe8, 0x9c, 0xf1, 0xf5, 0xe0, 0x11, 0xf4, 0x49, 0xe1, 0x83, 0x34,
+	0x8a, 0x0f, 0x4e, 0x66, 0xf7, 0xde, 0x6e, 0xa9, 0x71, 0xc6, 0x96, 0x2e, 0xc1, 0xc5, 0x9a, 0x96,
+	0xa4, 0x26, 0xde, 0xc1, 0x9e, 0x45, 0x3a, 0x32, 0x76, 0xba, 0x2a, 0x76, 0xa1, 0x5f, 0xad, 0x20,
+	0x75, 0xf1, 0x7e, 0xcd, 0x9b, 0x83, 0x53, 0x03, 0x70, 0xfe, 0x63, 0x58, 0xa7, 0x1c, 0x4f, 0x35,
+	0xf5, 0x1b, 0xd0, 0xd3, 0xb5, 0xe5, 0x8d, 0x63, 0x7d, 0x23, 0x25, 0x99, 0xff, 0x00, 0x2e, 0x54,
+	0xda, 0x90, 0x42, 0x1b, 0xec, 0x9c, 0xb3, 0xb1, 0xdb, 0x81, 0x8b, 0x94, 0xdd, 0x19, 0x82, 0x43,
+	0xe4, 0x16, 0xa5, 0x41, 0x6f, 0x51, 0xfc, 0x0d, 0xf0, 0xea, 0x58, 0xc9, 0xe1, 0x79, 0x93, 0xe7,
+	0xa6, 0xd2, 0xa5, 0xb3, 0x7e, 0x54, 0x3e, 0x82, 0x73, 0x9a, 0xee, 0xb9, 0x03, 0x4f, 0x9f, 0x88,
+	0x63, 0x81, 0x71, 0x76, 0x99, 0xda, 0x09, 0x79, 0x2e, 0x69, 0x18, 0xe7, 0x92, 0x15, 0x38, 0x4f,
+	0x38, 0x18, 0xc7, 0x92, 0x5d, 0x6c, 0xe2, 0x2c, 0xc7, 0x12, 0x49, 0x28, 0x2b, 0x8b, 0x00, 0xdd,
+	0xa3, 0x64, 0x7c, 0x7a, 0xf5, 0x55, 0x70, 0x29, 0xa9, 0x64, 0xf0, 0x98, 0x0f, 0x91, 0x9e, 0xf7,
+	0x3c, 0xc8, 0x9d, 0xcf, 0xee, 0x1d, 0x8d, 0x99, 0x37, 0xce, 0x10, 0x33, 0x97, 0x63, 0x57, 0x69,
+	0x43, 0x4a, 0xf0, 0x7f, 0x1d, 0xde, 0x2f, 0x11, 0x31, 0x9d, 0xdd, 0xb2, 0x07, 0xdd, 0xf4, 0x98,
+	0x65, 0x59, 0x1c, 0xa9, 0xcd, 0x5d, 0xc3, 0x78, 0x1c, 0x37, 0xde, 0x9c, 0xbf, 0x4e, 0x62, 0xf3,
+	0x94, 0xf5, 0xcb, 0x4e, 0xd7, 0x15, 0x63, 0xaa, 0x9a, 0xb0, 0x8f, 0x9a, 0xc5, 0xec, 0x1e, 0xf9,
+	0x3f, 0x80, 0xe5, 0x92, 0x50, 0xa7, 0x3b, 0x76, 0xc7, 0x12, 0x67, 0x3d, 0xe9, 0xd4, 0xa4, 0x9a,
+	0xc0, 0xff, 0x21, 0xac, 0x29, 0xac, 0x08, 0x0e, 0xa8, 0xf6, 0xf0, 0xa9, 0x26, 0x36, 0xa1, 0xee,
+	0x77, 0x24, 0x84, 0x3a, 0xe4, 0x13, 0xf4, 0x58, 0x5a, 0x67, 0x3b, 0xd0, 0xb0, 0xff, 0x2f, 0x9a,
+	0x00, 0xa2, 0x81, 0xb0, 0x60, 0x3c, 0x6e, 0x2e, 0xae, 0xb3, 0x79, 0x0a, 0xbc, 0xc3, 0x53, 0xe0,
+	0x09, 0x06, 0xdd, 0x13, 0x92, 0x10, 0x2f, 0xd3, 0xb6, 0x28, 0xaa, 0xa4, 0x10, 0xb7, 0xdd, 0x4d,
+	0x4a, 0xc1, 0x51, 0xe8, 0x14, 0x0b, 0x50, 0x35, 0xd3, 0xe2, 0xcd, 0x98, 0x48, 0xf7, 0x26, 0x5c,
+	0xe0, 0x0f, 0x10, 0x02, 0x16, 0x8a, 0x8f, 0x1e, 0xec, 0xb2, 0x4c, 0x64, 0x1b, 0x70, 0x57, 0xdb,
+	0x09, 0xa6, 0x15, 0xbb, 0xb7, 0xa0, 0xcf, 0x8b, 0xf0, 0x15, 0x07, 0xb3, 0xaa, 0xce, 0xf1, 0xaa,
+	0x53, 0xcb, 0xb1, 0x55, 0x79, 0xa1, 0x1a, 0x3c, 0xb3, 0xaa, 0x76, 0x44, 0xab, 0x53, 0x8a, 0x49,
+	0xcd, 0x7d, 0xbb, 0x66, 0xd7, 0xa8, 0x69, 0x17, 0xfb, 0xff, 0x8a, 0x66, 0x53, 0x93, 0xb1, 0x78,
+	0x59, 0xd9, 0xd4, 0x57, 0xa1, 0x9d, 0x21, 0x43, 0xcb, 0x33, 0x2f, 0x5b, 0x0a, 0x44, 0xb9, 0xff,
+	0x77, 0x0e, 0xdf, 0x6f, 0x0c, 0xcb, 0x9a, 0x75, 0x01, 0x60, 0x3e, 0xc8, 0x68, 0xd8, 0x0f, 0x32,
+	0xa8, 0x51, 0x37, 0x4f, 0x31, 0xea, 0x52, 0xc8, 0xd6, 0x6c, 0x21, 0xdd, 0x4f, 0x61, 0x49, 0x77,
+	0x8f, 0x17, 0xf4, 0xdb, 0xc6, 0xd5, 0x5f, 0x8d, 0x26, 0x03, 0xab, 0x06, 0xf6, 0x86, 0xe1, 0x5b,
+	0x2b, 0x15, 0xf8, 0xe4, 0x00, 0x46, 0xcd, 0x77, 0xd1, 0x47, 0x90, 0x2e, 0xf2, 0xbb, 0xb0, 0x20,
+	0xc0, 0x32, 0x62, 0x71, 0x74, 0x32, 0x66, 0x19, 0x99, 0xa6, 0xbd, 0x80, 0xa2, 0xfc, 0x23, 0x1a,
+	0x75, 0x38, 0xc3, 0x9e, 0x71, 0xfa, 0x37, 0x76, 0xa6, 0x45, 0xbb, 0xe8, 0x49, 0xd8, 0xda, 0x5b,
+	0x7e, 0x09, 0xcb, 0xfb, 0xfb, 0x3f, 0x0e, 0x18, 0xe6, 0x37, 0xbc, 0x94, 0xa8, 0xea, 0xd3, 0x38,
+	0x92, 0xa7, 0xba, 0x76, 0x20, 0x00, 0xa4, 0x3e, 0xe2, 0xef, 0x94, 0x54, 0x9e, 0x8b, 0x80, 0x70,
+	0x61, 0x24, 0x6d, 0x4b, 0x81, 0x7e, 0xd3, 0x80, 0xf6, 0x9d, 0x63, 0x26, 0xbe, 0x21, 0x56, 0xb9,
+	0x96, 0xaf, 0x4f, 0x47, 0xb7, 0x04, 0x6e, 0xce, 0x12, 0xb8, 0x65, 0x08, 0x4c, 0x23, 0x68, 0x6d,
+	0xeb, 0x93, 0x5f, 0xb3, 0x5f, 0xe8, 0x7f, 0x1f, 0x20, 0x2c, 0x8a, 0x2c, 0x7e, 0x3c, 0x11, 0xdf,
+	0x53, 0xa1, 0x1f, 0x46, 0xe0, 0xf2, 0x6f, 0x6e, 0xe9, 0x62, 0xb1, 0x95, 0x10, 0x7a, 0xef, 0x63,
+	0x38, 0x67, 0x15, 0x3f, 0xd7, 0x96, 0xf2, 0x14, 0x16, 0x79, 0x1b, 0xf9, 0x69, 0x6b, 0xb9, 0x4f,
+	0xc2, 0x24, 0x3b, 0xdb, 0xc2, 0xd5, 0xed, 0x05, 0x06, 0x0e, 0x9b, 0xe1, 0x62, 0xab, 0xc7, 0x3c,
+	0x1c, 0x28, 0x6f, 0xb0, 0x5a, 0xbc, 0xe7, 0x02, 0xf0, 0x7f, 0xdf, 0x00, 0x10, 0xb7, 0xc5, 0xfc,
+	0xfb, 0x15, 0x53, 0x6e, 0x99, 0xe4, 0x2d, 0x4f, 0xc3, 0xb8, 0xe5, 0x99, 0xf6, 0x96, 0xba, 0x4c,
+	0xe7, 0x69, 0x19, 0xe9, 0x3c, 0x5f, 0x29, 0x3b, 0xc7, 0xfd, 0xae, 0xf5, 0xad, 0x8a, 0x57, 0x8c,
+	0x6f, 0x27, 0x4d, 0xfb, 0x58, 0x85, 0xf9, 0xca, 0xa7, 0x6b, 0xbf, 0xf2, 0x51, 0xb7, 0x3a, 0xe2,
+	0xb2, 0x9e, 0xff, 0x7e, 0x11, 0x87, 0xe0, 0x2f, 0x1d, 0x58, 0x11, 0xf2, 0x98, 0x11, 0xd5, 0x29,
+	0x1f, 0xcd, 0x2b, 0x7b, 0xdb, 0xb0, 0x7b, 0x5b, 0xea, 0xa8, 0x69, 0xe8, 0xe8, 0x9f, 0x68, 0x2d,
+	0x88, 0x44, 0x99, 0x37, 0x0d, 0x2d, 0x18, 0xad, 0xbe, 0xfc, 0xc7, 0x49, 0xab, 0x66, 0x2b, 0x72,
+	0x3d, 0x7c, 0x4b, 0xa7, 0xd1, 0x3b, 0xc6, 0x92, 0x5d, 0x0e, 0x8c, 0xca, 0xac, 0xc7, 0x75, 0x41,
+	0x60, 0xc9, 0x21, 0xca, 0xdf, 0x02, 0x97, 0x22, 0xb5, 0x27, 0x64, 0x7d, 0xa5, 0xa8, 0x86, 0xad,
+	0xa2, 0xf0, 0xaf, 0x2b, 0xd1, 0x76, 0x92, 0x7c, 0xcc, 0x06, 0xc5, 0x0c, 0xbd, 0xfb, 0x9f, 0xc2,
+	0x9a, 0x45, 0xfb, 0xfc, 0xfd, 0x78, 0x4b, 0x0d, 0x73, 0xe5, 0x91, 0x45, 0xa5, 0xb9, 0x75, 0x58,
+	0x35, 0x49, 0xf5, 0xa5, 0x82, 0x66, 0x41, 0x57, 0xe8, 0xa9, 0x87, 0x8a, 0xda, 0x97, 0x0a, 0x86,
+	0x0d, 0x35, 0xed, 0x7c, 0x36, 0xd2, 0xb4, 0xb1, 0x10, 0xff, 0xeb, 0x86, 0xd2, 0xf8, 0x5e, 0x12,
+	0x8e, 0xf3, 0xa3, 0xb4, 0x98, 0x35, 0xe5, 0x6b, 0x1b, 0x9e, 0xf6, 0xd5, 0x2b, 0x43, 0xa0, 0x96,
+	0x6d, 0xd4, 0x1f, 0x6b, 0xe3, 0x15, 0x5b, 0xf5, 0x1b, 0x86, 0x86, 0xa9, 0x30, 0xa7, 0x4f, 0xe5,
+	0x39, 0x6b, 0x2a, 0xbf, 0x88, 0x65, 0xff, 0x3f, 0x07, 0xd6, 0x4c, 0x19, 0xc8, 0xea, 0x4b, 0x6c,
+	0xa2, 0xec, 0xbf, 0xd2, 0x55, 0x83, 0xe8, 0xea, 0x13, 0xeb, 0x14, 0x72, 0xad, 0xb6, 0x77, 0x5f,
+	0xd3, 0xe4, 0x7c, 0x08, 0xeb, 0x76, 0x3b, 0xd2, 0xac, 0xbf, 0x0b, 0xdd, 0x5c, 0xe2, 0xa4, 0x61,
+	0x5f, 0x9c, 0xaa, 0xf6, 0x40, 0x93, 0xfa, 0xdf, 0x81, 0x8b, 0x66, 0x39, 0x8d, 0x7b, 0x4c, 0x51,
+	0x8b, 0xff, 0x08, 0xbc, 0xba, 0x4a, 0x52, 0x92, 0x0f, 0xa1, 0xa7, 0xd8, 0xab, 0x49, 0x3d, 0x43,
+	0x94, 0x92, 0xd6, 0xff, 0x02, 0x2e, 0xd9, 0x9d, 0xa3, 0xd3, 0x6e, 0xda, 0x20, 0x79, 0xa4, 0xe7,
+	0x32, 0xc0, 0xaf, 0xbb, 0x77, 0x19, 0x36, 0xea, 0x59, 0xca, 0x39, 0xf2, 0x4f, 0xc9, 0xdc, 0x29,
+	0xd2, 0xec, 0x85, 0xda, 0xba, 0x00, 0x6b, 0x16, 0x2f, 0xd9, 0xc8, 0x9f, 0x3b, 0x6a, 0x22, 0xde,
+	0x1e, 0xa6, 0xc9, 0x8b, 0xb4, 0xa1, 0x0d, 0xb2, 0x49, 0x0c, 0xf2, 0x63, 0x6b, 0xaf, 0x30, 0xa7,
+	0x1b, 0x6d, 0xf2, 0x65, 0x5b, 0xe3, 0x27, 0xb0, 0x62, 0x34, 0xf2, 0xfc, 0x2b, 0xec, 0x7f, 0x69,
+	0xc2, 0xbc, 0xcc, 0x30, 0x9c, 0xb5, 0x38, 0xc9, 0xec, 0xdc, 0x86, 0x91, 0x9d, 0x8b, 0xfe, 0xc8,
+	0xe4, 0x71, 0xc2, 0x74, 0x1a, 0xb1, 0x80, 0x68, 0xee, 0x6d, 0xcb, 0xcc, 0xbd, 0xc5, 0x8f, 0x55,
+	0x8e, 0x83, 0x30, 0x39, 0x54, 0x2e, 0x89, 0x02, 0xc5, 0xd3, 0x0e, 0x7e, 0x97, 0x13, 0xf5, 0xe7,
+	0xd4, 0xb7, 0xb9, 0x04, 0xec, 0x7e, 0x60, 0x79, 0x24, 0x97, 0xcd, 0x47, 0xee, 0x5f, 0xd1, 0x25,
+	0xf9, 0x26, 0x2c, 0x8a, 0x7e, 0x88, 0x6d, 0x56, 0x7c, 0xdf, 0xa0, 0x1b, 0x98, 0x48, 0x74, 0xff,
+	0xa4, 0xf0, 0x5b, 0x51, 0xc4, 0x22, 0x9e, 0x77, 0xd2, 0x0d, 0x0c, 0x1c, 0xbe, 0x9d, 0x1c, 0xb2,
+	0x30, 0x67, 0x2a, 0xe5, 0xd0, 0x7a, 0x84, 0x7f, 0x1f, 0xcb, 0x02, 0x49, 0xf2, 0x22, 0xa3, 0xfd,
+	0x3e, 0x2c, 0x50, 0x96, 0x95, 0xdc, 0xb5, 0xfa, 0x3b, 0xe1, 0xff, 0xdc, 0x80, 0x55, 0x59, 0xed,
+	0x74, 0x67, 0xe9, 0x1f, 0x7b, 0xa8, 0x7f, 0x60, 0x0d, 0xf5, 0x55, 0x53, 0x95, 0x5f, 0xab, 0xdf,
+	0x75, 0x07, 0xd6, 0xac, 0x66, 0xe4, 0x74, 0xfa, 0x56, 0x99, 0x03, 0xee, 0x18, 0x9f, 0x64, 0x20,
+	0x06, 0x58, 0xe6, 0x85, 0xaf, 0x82, 0xab, 0x46, 0x89, 0x38, 0x5f, 0x77, 0x60, 0xc5, 0xc0, 0x96,
+	0x57, 0x36, 0x09, 0xcd, 0x11, 0xae, 0xe7, 0xad, 0x69, 0xfc, 0xb7, 0xb5, 0x8c, 0x67, 0xf0, 0xc0,
+	0xee, 0xc2, 0xba, 0x4d, 0xfc, 0x95, 0x7a, 0x74, 0x5d, 0x1b, 0xd0, 0xe9, 0x6e, 0xd8, 0x05, 0x58,
+	0xb3, 0x68, 0xf5, 0x31, 0x79, 0xe9, 0x61, 0x36, 0x3e, 0x0a, 0x13, 0xfa, 0xb4, 0x84, 0x7f, 0x92,
+	0xd0, 0x21, 0x9f, 0x24, 0x14, 0x79, 0xcd, 0x0d, 0x9d, 0xd7, 0xbc, 0x0a, 0xed, 0xf4, 0x69, 0xa2,
+	0xdd, 0x1f, 0x01, 0xa0, 0x6d, 0x65, 0x9c, 0x7b, 0x24, 0x33, 0x8c, 0x14, 0x58, 0x06, 0x1a, 0xda,
+	0x34, 0xd0, 0xf0, 0x2d, 0x70, 0x45, 0x7e, 0xd8, 0x2e, 0x5e, 0x48, 0x92, 0xe5, 0x3f, 0xca, 0x4e,
+	0x82, 0x89, 0xb8, 0x7e, 0xeb, 0x06, 0x12, 0xf2, 0xef, 0xc2, 0x8a, 0x41, 0x2d, 0x75, 0xf6, 0x0e,
+	0x74, 0x52, 0xde, 0x01, 0xfb, 0x13, 0x46, 0x66, 0xb7, 0x02, 0x45, 0x75, 0xe3, 0xb7, 0x6f, 0x40,
+	0x6f, 0x77, 0xf2, 0x78, 0x18, 0x0f, 0xb6, 0x76, 0x77, 0xdc, 0x5b, 0xfc, 0x0b, 0x8d, 0x38, 0xf8,
+	0xee, 0x9a, 0xfd, 0x65, 0x01, 0x2e, 0x8f, 0xb7, 0x6e, 0xa3, 0xa5, 0xe6, 0xbe, 0xe1, 0x7e, 0xc2,
+	0xbf, 0x8c, 0x29, 0xac, 0xd2, 0xbd, 0x50, 0x92, 0x19, 0xd3, 0xc1, 0xeb, 0x57, 0x0b, 0x34, 0x87,
+	0x5b, 0xe5, 0xf7, 0x21, 0xd7, 0xac, 0xcf, 0x5b, 0x54, 0x5b, 0xa7, 0xa9, 0x8b, 0xba, 0x75, 0x31,
+	0x9c, 0xb4, 0x75, 0xc3, 0x18, 0xbc, 0x7e, 0xb5, 0x40, 0x73, 0xf8, 0x58, 0x7d, 0x8c, 0x10, 0x1f,
+	0x7d, 0x19, 0x21, 0x29, 0x9d, 0x64, 0xe2, 0x5d, 0xa8, 0xe0, 0x2d, 0xe1, 0xf1, 0x46, 0x81, 0x0a,
+	0x4f, 0x6e, 0x22, 0xbc, 0x75, 0x1b, 0x6d, 0x09, 0x2f, 0x9f, 0x20, 0xd2, 0x36, 0x68, 0xb8, 0xc8,
+	0xeb, 0x57, 0x0b, 0x2c, 0xe1, 0xf9, 0x95, 0x00, 0x15, 0x9e, 0x5e, 0x26, 0x78, 0x17, 0x2a, 0x78,
+	0x5d, 0xfd, 0x36, 0x40, 0x79, 0x25, 0xe0, 0x92, 0x86, 0xcc, 0x0b, 0x05, 0xef, 0x62, 0x4d, 0x89,
+	0x66, 0xf2, 0x13, 0x70, 0xab, 0xd1, 0x7d, 0x97, 0x7c, 0x2a, 0xa3, 0xfe, 0x72, 0xc1, 0x7b, 0x6d,
+	0x06, 0x85, 0x66, 0xfe, 0x11, 0xcc, 0x89, 0xb4, 0x33, 0x77, 0x95, 0xa4, 0xd7, 0xe9, 0xe4, 0x36,
+	0x6f, 0xcd, 0xc2, 0xaa, 0x8a, 0xd7, 0x9c, 0x77, 0x1d, 0xf7, 0x3e, 0xf9, 0xfa, 0x36, 0x37, 0xee,
+	0x4b, 0xf5, 0x1f, 0x72, 0x10, 0xac, 0x36, 0xea, 0x0b, 0xb5, 0x28, 0xf7, 0xed, 0x6f, 0x79, 0x5f,
+	0xaa, 0xfd, 0x0a, 0xc3, 0x34, 0x6e, 0x55, 0xc3, 0xd5, 0x1f, 0x12, 0xd0, 0x63, 0x6f, 0x7f, 0xb8,
+	0xc0, 0xeb, 0x57, 0x0b, 0x34, 0x87, 0x0f, 0x61, 0x4e, 0x7c, 0x00, 0x41, 0xab, 0xc6, 0xf8, 0x38,
+	0x83, 0xb7, 0x66, 0x61, 0xc9, 0xa8, 0x2f, 0xec, 0xb1, 0x42, 0x5f, 0x5a, 0x50, 0xcb, 0x33, 0x6e,
+	0x4a, 0xbc, 0x7e, 0xb5, 0xa0, 0x3a, 0x6d, 0xf0, 0xcb, 0x53, 0x76, 0x24, 0xb7, 0x76, 0xda, 0x14,
+	0xb4, 0xfa, 0x17, 0xb0, 0x64, 0x06, 0x97, 0xdd, 0x0d, 0x8b, 0xd8, 0xb8, 0xcd, 0xf0, 0x5e, 0x99,
+	0x52, 0xaa, 0x18, 0xbe, 0xeb, 0xb8, 0x9f, 0xd3, 0xd1, 0x4e, 0x0f, 0xf3, 0x9a, 0xd1, 0x2e, 0x93,
+	0xc5, 0xbd, 0x8d, 0xfa, 0x42, 0xc2, 0x2f, 0x20, 0x1f, 0x5b, 0x92, 0xcb, 0xdb, 0x2b, 0x76, 0x25,
+	0x73, 0x91, 0xbb, 0x3c, 0xad, 0x58, 0x77, 0xfb, 0x21, 0x2c, 0x99, 0xa9, 0x68, 0xee, 0x46, 0x4d,
+	0xa4, 0xba, 0x5c, 0x78, 0x5e, 0x99, 0x52, 0xaa, 0x19, 0x52, 0x21, 0x45, 0x3e, 0x59, 0x55, 0x48,
+	0x23, 0xb3, 0xcd, 0xbb, 0x3c, 0xad, 0x98, 0xf0, 0x3c, 0x5f, 0x49, 0x44, 0x73, 0x5f, 0xad, 0xf4,
+	0xcd, 0x4c, 0x51, 0xf3, 0xfa, 0x75, 0x04, 0xfc, 0x33, 0x7e, 0xa8, 0xcc, 0x7d, 0x38, 0x67, 0x65,
+	0x81, 0xd5, 0x28, 0x93, 0x66, 0x9f, 0x79, 0x97, 0xa7, 0x15, 0x97, 0x53, 0xdc, 0xe8, 0xbd, 0x5c,
+	0x46, 0xab, 0x1a, 0x33, 0x16, 0xd3, 0xcb, 0xd3, 0x8a, 0x6b, 0xa7, 0x39, 0x5f, 0xd6, 0x2f, 0x55,
+	0xc7, 0xa0, 0x5c, 0xdc, 0x37, 0xea, 0x0b, 0xa7, 0x8c, 0x0f, 0xdf, 0xa5, 0x6a, 0xc6, 0x87, 0xee,
+	0x55, 0x97, 0xa7, 0x15, 0xd3, 0x55, 0xbb, 0xcc, 0xac, 0xd6, 0xab, 0x76, 0x25, 0x8b, 0xdc, 0xbb,
+	0x58, 0x53, 0xa2, 0x99, 0x6c, 0x43, 0x4f, 0x27, 0x2a, 0xeb, 0x15, 0xc0, 0xce, 0xc0, 0xf6, 0xfa,
+	0xd3, 0x72, 0x9a, 0xe5, 0x0a, 0x2b, 0x45, 0x91, 0xba, 0x37, 0xa8, 0x0d, 0xb5, 0x5f, 0xac, 0x29,
+	0x21, 0x5b, 0xe8, 0x9c, 0xc8, 0x65, 0xd5, 0x0b, 0x99, 0x91, 0xda, 0xea, 0xd5, 0x62, 0xa5, 0x00,
+	0xef, 0x41, 0x8b, 0x7f, 0x5f, 0xd0, 0x25, 0x7f, 0xdf, 0x42, 0x35, 0xba, 0x62, 0xe0, 0xe8, 0xca,
+	0xab, 0xef, 0x25, 0x74, 0xcf, 0xed, 0x5b, 0x12, 0xaf, 0x5f, 0x2d, 0xd0, 0x1c, 0xee, 0xc2, 0x3c,
+	0xc9, 0x4d, 0x72, 0x55, 0xe7, 0xaa, 0xf9, 0x4a, 0x9e, 0x57, 0x57, 0x44, 0x07, 0xb2, 0x4c, 0x2e,
+	0xd2, 0xda, 0xab, 0xa4, 0x32, 0x79, 0x17, 0x6b, 0x4a, 0x88, 0x30, 0x8b, 0x65, 0xc2, 0x10, 0x23,
+	0x06, 0x51, 0xc9, 0x50, 0xf2, 0x2e, 0xd6, 0x94, 0x50, 0xbb, 0x37, 0x92, 0x80, 0xb4, 0xdd, 0xd7,
+	0x25, 0x1e, 0x79, 0x1b, 0xf5, 0x85, 0xd4, 0xee, 0xad, 0x4c, 0x20, 0xf7, 0x95, 0x6a, 0x06, 0x0e,
+	0x55, 0xd5, 0xe5, 0x69, 0xc5, 0x9a, 0xe7, 0x23, 0x58, 0x22, 0x85, 0xa8, 0xb2, 0x57, 0xab, 0x75,
+	0x8c, 0x0c, 0x21, 0xef, 0xca, 0x74, 0x82, 0x29, 0x6c, 0xb7, 0xd9, 0xf0, 0x65, 0xb1, 0x5d, 0xb6,
+	0x13, 0x80, 0xdc, 0xcb, 0xd4, 0x8f, 0xad, 0xa6, 0x12, 0x79, 0xaf, 0x4e, 0x2d, 0x37, 0x15, 0x6b,
+	0x64, 0xe8, 0x10, 0xc5, 0xd6, 0x65, 0x07, 0x79, 0x97, 0xa7, 0x15, 0x5b, 0x1e, 0x9c, 0x95, 0x5b,
+	0x43, 0x3d, 0xb8, 0xfa, 0x0c, 0x1e, 0xef, 0xb5, 0x19, 0x14, 0x9a, 0xf9, 0xa7, 0xd0, 0xd3, 0xc9,
+	0xa6, 0xa6, 0xa3, 0x43, 0x32, 0x5c, 0xbd, 0x7e, 0xb5, 0x80, 0x6c, 0xc5, 0x25, 0x8f, 0xfc, 0xc8,
+	0xe6, 0x91, 0x1f, 0x4d, 0xe1, 0x91, 0x1f, 0x19, 0x3c, 0xee, 0xca, 0x4c, 0x4f, 0xd9, 0xbb, 0x8b,
+	0x94, 0xd8, 0xec, 0x96, 0x57, 0x57, 0xa4, 0xfb, 0xb3, 0x03, 0x0b, 0xf4, 0x06, 0xc4, 0xf5, 0xa6,
+	0x5f, 0xbe, 0x78, 0x97, 0x6a, 0xcb, 0xe8, 0xfc, 0x2f, 0x2f, 0x3d, 0xf4, 0xbc, 0xad, 0x5c, 0x8e,
+	0x78, 0x17, 0x6b, 0x4a, 0xe8, 0xbc, 0x35, 0xae, 0x32, 0xdc, 0x4b, 0x56, 0x40, 0x8d, 0x1e, 0xc5,
+	0xbd, 0x8d, 0xfa, 0xc2, 0x6a, 0xef, 0xa4, 0x9a, 0xcc, 0xde, 0x99, 0x7a, 0xba, 0x54, 0x5b, 0x56,
+	0xc7, 0x8a, 0x2f, 0xb5, 0x36, 0x2b, 0xba, 0xda, 0x5e, 0xaa, 0x2d, 0xa3, 0x6e, 0x93, 0x19, 0xa8,
+	0x75, 0x37, 0x66, 0xc5, 0xd5, 0xbd, 0x57, 0xa6, 0x94, 0x52, 0x8b, 0xaf, 0xc6, 0xa8, 0xb5, 0xc5,
+	0x4f, 0x8d, 0x79, 0x7b, 0xaf, 0xcd, 0xa0, 0xd0, 0xcc, 0x43, 0x58, 0x35, 0xcb, 0xa5, 0x2e, 0xfd,
+	0x29, 0x52, 0x51, 0x9d, 0xbe, 0x3e, 0x93, 0xa6, 0x3a, 0xe8, 0x32, 0x9a, 0xec, 0x56, 0x14, 0x48,
+	0xe2, 0xd5, 0xde, 0x46, 0x7d, 0x21, 0xdd, 0xcf, 0x48, 0xa4, 0xd6, 0xbd, 0x38, 0x35, 0x44, 0xec,
+	0x79, 0x75, 0x45, 0x54, 0x2a, 0x23, 0x48, 0xa5, 0xa5, 0xaa, 0x8b, 0x90, 0x79, 0x1b, 0xf5, 0x85,
+	0x54, 0x2a, 0x12, 0x95, 0xd2, 0x52, 0x55, 0xe3, 0x57, 0x9e, 0x57, 0x57, 0x44, 0x8d, 0xc7, 0x8c,
+	0x34, 0xb9, 0x1b, 0x76, 0x40, 0xc9, 0x98, 0x22, 0xaf, 0x4c, 0x29, 0xad, 0xe9, 0xa6, 0x1c, 0x58,
+	0xab, 0x9b, 0xe6, 0x88, 0x6e, 0xd4, 0x17, 0x6a, 0x6e, 0xef, 0x41, 0x0b, 0x33, 0x4b, 0xb4, 0x07,
+	0x43, 0xb2, 0x4e, 0xbc, 0x15, 0x03, 0x47, 0xab, 0x88, 0x78, 0xb8, 0xfe, 0x4e, 0xf5, 0x41, 0x6a,
+	0x57, 0xb1, 0x8e, 0x9b, 0xb7, 0xa0, 0xa3, 0xfe, 0x72, 0x86, 0x3e, 0x17, 0x1a, 0x49, 0xe0, 0xde,
+	0xba, 0x8d, 0x36, 0xdc, 0x9d, 0x32, 0xe6, 0x54, 0xba, 0x3b, 0x95, 0xa8, 0x95, 0xe7, 0xd5, 0x15,
+	0x69, 0x3e, 0xef, 0xc2, 0x9c, 0x48, 0x6b, 0x28, 0xcf, 0xf2, 0x34, 0xcb, 0xc1, 0x5b, 0xa0, 0x58,
+	0x5c, 0xb3, 0x1f, 0xcf, 0xf1, 0xef, 0x19, 0x7c, 0xe7, 0x1f, 0x06, 0x00, 0x8d, 0xe6, 0x31, 0xbf,
+	0x69, 0x6f, 0x00, 0x00,
 }
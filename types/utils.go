@@ -35,6 +35,7 @@ func (p *UserPod) CloneGlobalPart() *UserPod {
 		Log:           p.Log,
 		Dns:           p.Dns,
 		PortmappingWhiteLists: p.PortmappingWhiteLists,
+		ExtraHosts:            p.ExtraHosts,
 
 		Labels:     map[string]string{},
 		Containers: []*UserContainer{},
@@ -86,6 +87,10 @@ func (p *UserPod) ReorganizeContainers(allowAbsent bool) error {
 			c.Tty = true
 		}
 
+		if c.RestartPolicy == "" {
+			c.RestartPolicy = p.RestartPolicy
+		}
+
 		cv := []*UserVolumeReference{}
 		cf := []*UserFileReference{}
 
@@ -127,6 +132,41 @@ func (p *UserPod) ReorganizeContainers(allowAbsent bool) error {
 	return nil
 }
 
+const (
+	RESTART_POLICY_NEVER     = "never"
+	RESTART_POLICY_ONFAILURE = "onFailure"
+	RESTART_POLICY_ALWAYS    = "always"
+)
+
+// ParseRestartPolicy() splits a restart policy in the form of `never`, `always`
+// or `onFailure[:max-retries]`. An empty policy means `never`, and a zero
+// max-retries for `onFailure` means retrying without limit.
+func ParseRestartPolicy(policy string) (string, int, error) {
+	parts := strings.SplitN(policy, ":", 2)
+	switch strings.ToLower(parts[0]) {
+	case "", strings.ToLower(RESTART_POLICY_NEVER):
+		if len(parts) > 1 {
+			return "", 0, fmt.Errorf("restart policy %s does not accept max-retries", policy)
+		}
+		return RESTART_POLICY_NEVER, 0, nil
+	case strings.ToLower(RESTART_POLICY_ALWAYS):
+		if len(parts) > 1 {
+			return "", 0, fmt.Errorf("restart policy %s does not accept max-retries", policy)
+		}
+		return RESTART_POLICY_ALWAYS, 0, nil
+	case strings.ToLower(RESTART_POLICY_ONFAILURE):
+		if len(parts) == 1 {
+			return RESTART_POLICY_ONFAILURE, 0, nil
+		}
+		retry, err := strconv.ParseUint(parts[1], 10, 31)
+		if err != nil {
+			return "", 0, fmt.Errorf("invalid max-retries %s of restart policy: %v", parts[1], err)
+		}
+		return RESTART_POLICY_ONFAILURE, int(retry), nil
+	}
+	return "", 0, fmt.Errorf("unknown restart policy %s, only never, onFailure[:max-retries] and always are allowed", policy)
+}
+
 type _PortRange struct {
 	start int
 	end   int
@@ -158,6 +198,11 @@ func readPortRange(p string) (*_PortRange, error) {
 	return &_PortRange{int(start), int(start)}, nil
 }
 
+// isAuto returns whether the ports are to be allocated by the daemon
+func (pr *_PortRange) isAuto() bool {
+	return pr.start == 0 && pr.end == 0
+}
+
 func (pr *_PortRange) isRange() bool {
 	return pr.start != pr.end
 }
@@ -190,7 +235,11 @@ func readPortMapping(pm *PortMapping) (*_PortMapping, error) {
 	} else if pm.Protocol != "tcp" && pm.Protocol != "" {
 		return nil, fmt.Errorf("unrecongnized protocol %s", pm.Protocol)
 	}
-	h, err := readPortRange(pm.HostPort)
+	hostPort := pm.HostPort
+	if pm.AutoHostPort() {
+		hostPort = "0"
+	}
+	h, err := readPortRange(hostPort)
 	if err != nil {
 		return nil, err
 	}
@@ -198,7 +247,7 @@ func readPortMapping(pm *PortMapping) (*_PortMapping, error) {
 	if err != nil {
 		return nil, err
 	}
-	if c.isRange() && c.count() != h.count() {
+	if c.isRange() && !h.isAuto() && c.count() != h.count() {
 		return nil, fmt.Errorf("port range mismatch: %d vs %d", h.String(), c.String())
 	}
 	return &_PortMapping{
@@ -217,6 +266,12 @@ func (pm *PortMapping) EqualTo(other *PortMapping) bool {
 	return pm.Protocol == other.Protocol && pm.ContainerPort == other.ContainerPort && pm.HostPort == other.ContainerPort
 }
 
+// AutoHostPort returns whether the host ports should be allocated by the
+// daemon, as many as the container ports.
+func (pm *PortMapping) AutoHostPort() bool {
+	return pm.HostPort == "" || pm.HostPort == "0"
+}
+
 func (pm *PortMapping) SameDestWith(other *PortMapping) bool {
 	if other == nil && pm == nil {
 		return true
@@ -260,6 +315,10 @@ func mergeContinuousPorts(pms []*_PortMapping) ([]*_PortMapping, error) {
 	)
 
 	for _, pm := range pms {
+		if pm.host.isAuto() {
+			results = append(results, pm)
+			continue
+		}
 		if pm.isRange() {
 			for i := pm.host.start; i <= pm.host.end; i++ {
 				if occupy[i] {
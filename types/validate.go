@@ -20,6 +20,7 @@ func (pod *UserPod) Validate() error {
 		"vfs":   true,
 		"rbd":   true,
 		"nas":   true,
+		"named": true,
 	}
 
 	hostnameLen := len(pod.Hostname)
@@ -34,6 +35,12 @@ func (pod *UserPod) Validate() error {
 		}
 	}
 
+	for idx, h := range pod.ExtraHosts {
+		if err := h.validate(); err != nil {
+			return fmt.Errorf("in extra host %d, %v", idx, err)
+		}
+	}
+
 	hasGw := false
 	for idx, config := range pod.Interfaces {
 		if config.Gateway == "" {
@@ -45,6 +52,20 @@ func (pod *UserPod) Validate() error {
 		hasGw = true
 	}
 
+	hasGw6 := false
+	for idx, config := range pod.Interfaces {
+		if config.Gateway6 == "" {
+			continue
+		}
+		if ip := net.ParseIP(config.Gateway6); ip == nil || ip.To4() != nil {
+			return fmt.Errorf("in interface %d, invalid IPv6 gateway %s", idx, config.Gateway6)
+		}
+		if hasGw6 {
+			return fmt.Errorf("in interface %d, Other interface already configured IPv6 Gateway", idx)
+		}
+		hasGw6 = true
+	}
+
 	uniq, vset := keySet(pod.Volumes)
 	if !uniq {
 		if len(vset) > 0 {
@@ -66,9 +87,33 @@ func (pod *UserPod) Validate() error {
 		}
 	}
 
+	if err := ValidateServiceNames(pod.Services); err != nil {
+		return err
+	}
+
+	if _, _, err := ParseRestartPolicy(pod.RestartPolicy); err != nil {
+		return err
+	}
+
 	var permReg = regexp.MustCompile("0[0-7]{3}")
 	for idx, container := range pod.Containers {
 
+		if _, _, err := ParseRestartPolicy(container.RestartPolicy); err != nil {
+			return fmt.Errorf("in container %d, %v", idx, err)
+		}
+
+		if err := container.LivenessProbe.validate(); err != nil {
+			return fmt.Errorf("in container %d, liveness probe %v", idx, err)
+		}
+
+		if err := container.ReadinessProbe.validate(); err != nil {
+			return fmt.Errorf("in container %d, readiness probe %v", idx, err)
+		}
+
+		if err := container.Resource.validate(pod.Resource); err != nil {
+			return fmt.Errorf("in container %d, resource %v", idx, err)
+		}
+
 		if uniq, _ := keySet(container.Volumes); !uniq {
 			return fmt.Errorf("in container %d, volume source are not unique", idx)
 		}
@@ -99,6 +144,9 @@ func (pod *UserPod) Validate() error {
 	}
 
 	for idx, v := range pod.Volumes {
+		if v.SizeBytes < 0 {
+			return fmt.Errorf("in volume %d, invalid volume size %d.", idx, v.SizeBytes)
+		}
 		if v.Format == "" {
 			continue
 		}
@@ -117,6 +165,76 @@ func (pod *UserPod) Validate() error {
 	return nil
 }
 
+func (probe *UserProbe) validate() error {
+	if probe == nil {
+		return nil
+	}
+
+	handlers := 0
+	if probe.Exec != nil {
+		if len(probe.Exec.Command) == 0 {
+			return errors.New("has an empty exec command")
+		}
+		handlers++
+	}
+	if probe.TcpSocket != nil {
+		if probe.TcpSocket.Port <= 0 || probe.TcpSocket.Port > 65535 {
+			return fmt.Errorf("has an invalid tcp port %d", probe.TcpSocket.Port)
+		}
+		handlers++
+	}
+	if probe.HttpGet != nil {
+		if probe.HttpGet.Port <= 0 || probe.HttpGet.Port > 65535 {
+			return fmt.Errorf("has an invalid http port %d", probe.HttpGet.Port)
+		}
+		if s := strings.ToLower(probe.HttpGet.Scheme); s != "" && s != "http" && s != "https" {
+			return fmt.Errorf("has an unsupported http scheme %s", probe.HttpGet.Scheme)
+		}
+		handlers++
+	}
+	if handlers != 1 {
+		return errors.New("should have one and only one of exec, tcpSocket and httpGet")
+	}
+
+	if probe.InitialDelaySeconds < 0 || probe.PeriodSeconds < 0 || probe.TimeoutSeconds < 0 ||
+		probe.FailureThreshold < 0 || probe.SuccessThreshold < 0 {
+		return errors.New("should not have negative delay, period, timeout or threshold")
+	}
+	return nil
+}
+
+// the bounds of the cpu cgroup
+const (
+	minCpuShares = 2
+	maxCpuShares = 262144
+	minCpuPeriod = 1000
+	maxCpuPeriod = 1000000
+	minCpuQuota  = 1000
+)
+
+func (res *UserContainerResource) validate(pod *UserResource) error {
+	if res == nil {
+		return nil
+	}
+
+	if res.CpuShares != 0 && (res.CpuShares < minCpuShares || res.CpuShares > maxCpuShares) {
+		return fmt.Errorf("cpu shares %d should be in range [%d, %d]", res.CpuShares, minCpuShares, maxCpuShares)
+	}
+	if res.CpuPeriod != 0 && (res.CpuPeriod < minCpuPeriod || res.CpuPeriod > maxCpuPeriod) {
+		return fmt.Errorf("cpu period %d should be in range [%d, %d]", res.CpuPeriod, minCpuPeriod, maxCpuPeriod)
+	}
+	if res.CpuQuota != 0 && res.CpuQuota < minCpuQuota {
+		return fmt.Errorf("cpu quota %d should not be less than %d", res.CpuQuota, minCpuQuota)
+	}
+	if res.Memory < 0 || res.PidsLimit < 0 {
+		return errors.New("should not have negative memory or pids limit")
+	}
+	if pod != nil && pod.Memory > 0 && res.Memory > pod.Memory {
+		return fmt.Errorf("memory %dMB exceeds the pod memory %dMB", res.Memory, pod.Memory)
+	}
+	return nil
+}
+
 type item interface {
 	key() string
 }
@@ -165,3 +283,31 @@ func InterfaceSlice(slice interface{}) ([]interface{}, error) {
 
 	return ret, nil
 }
+
+func (h *HostAlias) validate() error {
+	if net.ParseIP(h.Ip) == nil {
+		return fmt.Errorf("invalid ip %s", h.Ip)
+	}
+	if len(h.Hostnames) == 0 {
+		return fmt.Errorf("no hostname for ip %s", h.Ip)
+	}
+	for _, name := range h.Hostnames {
+		for _, seg := range strings.Split(name, ".") {
+			if !utils.IsDNSLabel(seg) {
+				return fmt.Errorf("hostname should fullfil the pattern: %s, input hostname: %s", utils.Dns1123LabelFmt, name)
+			}
+		}
+	}
+	return nil
+}
+
+// ValidateServiceNames checks the names of the services, which are resolved
+// by the DNS server of hyperd.
+func ValidateServiceNames(srvs []*UserService) error {
+	for _, srv := range srvs {
+		if srv.Name != "" && !utils.IsDNSLabel(srv.Name) {
+			return fmt.Errorf("service name should fullfil the pattern: %s, input name: %s", utils.Dns1123LabelFmt, srv.Name)
+		}
+	}
+	return nil
+}
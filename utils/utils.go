@@ -214,6 +214,15 @@ func RsplitN(s, sep string, n int) []string {
 	return ret
 }
 
+// StripPrefixLen returns the address in addr, which may be in CIDR
+func StripPrefixLen(addr string) string {
+	addr = strings.TrimSpace(addr)
+	if i := strings.Index(addr, "/"); i >= 0 {
+		return addr[:i]
+	}
+	return addr
+}
+
 const DockerRestrictedNameChars = `[a-zA-Z0-9][a-zA-Z0-9_.-]`
 
 // RestrictedNamePattern is a regular expression to validate names against the collection of restricted characters.